@@ -0,0 +1,282 @@
+// Package address formats and validates postal addresses by per-country templates keyed by the codes of package countries.
+package address
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/xianb/countries"
+)
+
+// Errors of Validate, use errors.Is to check them
+var (
+	ErrMissingField        = errors.New("missing required field")
+	ErrSubdivisionMismatch = errors.New("subdivision is not in the country")
+)
+
+// Field - 地址字段，模板中的字母
+type Field byte
+
+// Address fields, the letters of the template format
+const (
+	FieldName              Field = 'N' // recipient
+	FieldOrganization      Field = 'O' // company or organization
+	FieldStreet            Field = 'A' // street address lines
+	FieldDependentLocality Field = 'D' // district, suburb or neighbourhood
+	FieldLocality          Field = 'C' // city or town
+	FieldSubdivision       Field = 'S' // state, province or prefecture
+	FieldPostalCode        Field = 'Z' // postal code
+	FieldSortingCode       Field = 'X' // sorting code, example CEDEX in France
+)
+
+// Address - 邮政地址
+type Address struct {
+	Name              string                    `json:"name"`              // 收件人
+	Organization      string                    `json:"organization"`      // 单位
+	StreetLines       []string                  `json:"streetLines"`       // 街道地址
+	DependentLocality string                    `json:"dependentLocality"` // 区、街道
+	Locality          string                    `json:"locality"`          // 城市
+	Subdivision       countries.SubdivisionCode `json:"subdivision"`       // 子区域代码
+	PostalCode        string                    `json:"postalCode"`        // 邮政编码
+	SortingCode       string                    `json:"sortingCode"`       // 分拣代码
+	Country           countries.CountryCode     `json:"country"`           // 国家代码
+}
+
+// Template - 国家的地址格式模板
+type Template struct {
+	Country          countries.CountryCode `json:"country"`          // 国家代码
+	Format           string                `json:"format"`           // 格式：%N 等为字段，%n 为换行
+	Fields           []Field               `json:"fields"`           // 字段顺序
+	Required         []Field               `json:"required"`         // 必填字段
+	Uppercase        []Field               `json:"uppercase"`        // 大写字段
+	SubdivisionLabel string                `json:"subdivisionLabel"` // 子区域名称，例如 "State"、"Prefecture"
+	PostalCodeLabel  string                `json:"postalCodeLabel"`  // 邮政编码名称，例如 "ZIP Code"
+	abbreviate       bool                  // 子区域使用代码（例如 "CA"）而非名称
+}
+
+// String - implements fmt.Stringer, returns the field name in english
+func (f Field) String() string {
+	switch f {
+	case FieldName:
+		return "Name"
+	case FieldOrganization:
+		return "Organization"
+	case FieldStreet:
+		return "Street"
+	case FieldDependentLocality:
+		return "Dependent locality"
+	case FieldLocality:
+		return "Locality"
+	case FieldSubdivision:
+		return "Subdivision"
+	case FieldPostalCode:
+		return "Postal code"
+	case FieldSortingCode:
+		return "Sorting code"
+	}
+	return countries.UnknownMsg
+}
+
+// IsValid - returns true, if the field is correct
+func (f Field) IsValid() bool {
+	return f.String() != countries.UnknownMsg
+}
+
+// ForCountry - returns the address template of the country, example: address.ForCountry(countries.USA) has the format
+// "%N%n%O%n%A%n%C, %S %Z" and the subdivision label "State"; countries without a specific template get a generic one;
+// returns countries.ErrNotFound for unknown codes
+func ForCountry(c countries.CountryCode) (*Template, error) {
+	if !c.IsValid() {
+		return nil, fmt.Errorf("address::ForCountry: country %v err: %w", c, countries.ErrNotFound)
+	}
+	format, required, uppercase, abbreviate := templateOf(c)
+	template := &Template{
+		Country: c, Format: format, Required: fields(required), Uppercase: fields(uppercase), abbreviate: abbreviate,
+	}
+	for i := 0; i+1 < len(format); i++ {
+		if format[i] == '%' && format[i+1] != 'n' {
+			template.Fields = append(template.Fields, Field(format[i+1]))
+			i++
+		}
+	}
+	if template.Has(FieldSubdivision) {
+		template.SubdivisionLabel = subdivisionLabel(c)
+	}
+	if postalCode := c.PostalCode(); postalCode != nil && template.Has(FieldPostalCode) {
+		template.PostalCodeLabel = postalCode.Name
+	}
+	return template, nil
+}
+
+// Has - returns true, if the field is a part of the format of the template
+func (t *Template) Has(field Field) bool {
+	return contains(t.Fields, field)
+}
+
+// IsRequired - returns true, if the field is required in an address of the country
+func (t *Template) IsRequired(field Field) bool {
+	return contains(t.Required, field)
+}
+
+// Format - renders the address as a postal label by the template of its country, lines without values are skipped,
+// the last line is the english name of the country in upper case for international mail, example:
+// "John Doe\n1600 Amphitheatre Pkwy\nMOUNTAIN VIEW, CA 94043\nUNITED STATES"; returns countries.ErrNotFound for unknown countries
+func Format(a *Address) (string, error) {
+	template, err := ForCountry(a.Country)
+	if err != nil {
+		return "", fmt.Errorf("address::Format: country %v err: %w", a.Country, countries.ErrNotFound)
+	}
+	var lines []string
+	for _, line := range strings.Split(template.Format, "%n") {
+		if rendered := template.render(line, a); rendered != "" {
+			lines = append(lines, rendered)
+		}
+	}
+	lines = append(lines, strings.ToUpper(a.Country.String()))
+	return strings.Join(lines, "\n"), nil
+}
+
+// Validate - checks the address: the country is known, required fields of the template are not empty,
+// the subdivision belongs to the country and the postal code matches the format of the country;
+// errors are countries.ErrNotFound, ErrMissingField, ErrSubdivisionMismatch and countries.ErrInvalidFormat
+func Validate(a *Address) error {
+	template, err := ForCountry(a.Country)
+	if err != nil {
+		return fmt.Errorf("address::Validate: country %v err: %w", a.Country, countries.ErrNotFound)
+	}
+	for _, field := range template.Required {
+		if field == FieldSubdivision && len(a.Country.Subdivisions()) == 0 {
+			continue
+		}
+		if strings.TrimSpace(value(a, field, false)) == "" {
+			return fmt.Errorf("address::Validate: %v %s err: %w", a.Country, field, ErrMissingField)
+		}
+	}
+	if a.Subdivision != "" && a.Subdivision != countries.SubdivisionUnknown &&
+		(!a.Subdivision.IsValid() || a.Subdivision.Country() != a.Country) {
+		return fmt.Errorf("address::Validate: %q, %v err: %w", string(a.Subdivision), a.Country, ErrSubdivisionMismatch)
+	}
+	if strings.TrimSpace(a.PostalCode) != "" || template.IsRequired(FieldPostalCode) {
+		if _, err = countries.ValidatePostalCode(a.Country, a.PostalCode); err != nil {
+			return fmt.Errorf("address::Validate: %w", err)
+		}
+	}
+	return nil
+}
+
+// render - returns a line of the format with the values of the address, a literal text before a field is dropped
+// together with an empty field or when it precedes the first non-empty field of the line
+func (t *Template) render(line string, a *Address) string {
+	var b strings.Builder
+	literal, written := "", false
+	for i := 0; i < len(line); i++ {
+		if line[i] != '%' || i+1 == len(line) {
+			literal += string(line[i])
+			continue
+		}
+		field := Field(line[i+1])
+		i++
+		text := value(a, field, t.abbreviate)
+		if contains(t.Uppercase, field) {
+			text = strings.ToUpper(text)
+		}
+		if text = strings.TrimSpace(text); text == "" {
+			literal = ""
+			continue
+		}
+		if written || b.Len() == 0 && !strings.ContainsAny(literal, ",/") {
+			b.WriteString(literal)
+		}
+		b.WriteString(text)
+		literal, written = "", true
+	}
+	if written {
+		b.WriteString(literal)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// value - returns the value of the field of the address, the subdivision as the part of its code after the country
+// (example "CA" of US-CA) with abbreviate, as the english name otherwise
+func value(a *Address, field Field, abbreviate bool) string {
+	switch field {
+	case FieldName:
+		return a.Name
+	case FieldOrganization:
+		return a.Organization
+	case FieldStreet:
+		lines := make([]string, 0, len(a.StreetLines))
+		for _, line := range a.StreetLines {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+		return strings.Join(lines, "\n")
+	case FieldDependentLocality:
+		return a.DependentLocality
+	case FieldLocality:
+		return a.Locality
+	case FieldSubdivision:
+		if a.Subdivision == "" || a.Subdivision == countries.SubdivisionUnknown || !a.Subdivision.IsValid() {
+			return ""
+		}
+		if _, code, ok := strings.Cut(string(a.Subdivision), "-"); ok && abbreviate {
+			return code
+		}
+		return a.Subdivision.String()
+	case FieldPostalCode:
+		if normalized, err := countries.ValidatePostalCode(a.Country, a.PostalCode); err == nil {
+			return normalized
+		}
+		return a.PostalCode
+	case FieldSortingCode:
+		return a.SortingCode
+	}
+	return ""
+}
+
+// subdivisionLabel - returns the most common type of the subdivisions of the country, example: "State" for USA,
+// "Prefecture" for JPN, an empty string for countries without subdivisions
+func subdivisionLabel(c countries.CountryCode) string {
+	counts := map[string]int{}
+	for _, s := range c.Subdivisions() {
+		if label := string(s.SubdivisionType()); label != "" && label != countries.SubdivisionTypeUnknown {
+			counts[label]++
+		}
+	}
+	labels := make([]string, 0, len(counts))
+	for label := range counts {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if counts[labels[i]] != counts[labels[j]] {
+			return counts[labels[i]] > counts[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+	if len(labels) == 0 {
+		return ""
+	}
+	return labels[0]
+}
+
+// fields - returns the fields of the letters
+func fields(letters string) []Field {
+	out := make([]Field, 0, len(letters))
+	for i := 0; i < len(letters); i++ {
+		out = append(out, Field(letters[i]))
+	}
+	return out
+}
+
+// contains - returns true, if the field is in the list
+func contains(list []Field, field Field) bool {
+	for _, f := range list {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package address
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xianb/countries"
+)
+
+//nolint:gocyclo
+func TestForCountry(t *testing.T) {
+	for _, c := range countries.All() {
+		template, err := ForCountry(c)
+		if err != nil || len(template.Fields) == 0 || !template.IsRequired(FieldStreet) {
+			t.Errorf("Test ForCountry() err, country %v, got %v, %v", c, template, err)
+			continue
+		}
+		for _, field := range template.Fields {
+			if !field.IsValid() {
+				t.Errorf("Test ForCountry() err, country %v, got field %q", c, byte(field))
+			}
+		}
+	}
+	template, err := ForCountry(countries.USA)
+	if err != nil || template.SubdivisionLabel != "State" || template.PostalCodeLabel != "ZIP Code" || !template.IsRequired(FieldSubdivision) {
+		t.Errorf("Test ForCountry() err, want %v, got %v, %v", "State", template, err)
+	}
+	if out, _ := ForCountry(countries.JPN); out.SubdivisionLabel != "Prefecture" {
+		t.Errorf("Test ForCountry() err, want %v, got %v", "Prefecture", out.SubdivisionLabel)
+	}
+	if out, _ := ForCountry(countries.CAN); out.SubdivisionLabel != "Province" || out.Fields[len(out.Fields)-1] != FieldPostalCode {
+		t.Errorf("Test ForCountry() err, want %v, got %v", "Province", out.SubdivisionLabel)
+	}
+	if out, _ := ForCountry(countries.DEU); out.SubdivisionLabel != "" || out.Has(FieldSubdivision) || out.PostalCodeLabel != "PLZ" {
+		t.Errorf("Test ForCountry() err, want no subdivision, got %v", out)
+	}
+	if _, err = ForCountry(countries.Unknown); !errors.Is(err, countries.ErrNotFound) {
+		t.Errorf("Test ForCountry() err, want %v, got %v", countries.ErrNotFound, err)
+	}
+	if out := Field('Q'); out.IsValid() || FieldLocality.String() != "Locality" {
+		t.Errorf("Test Field.IsValid() err, want %v, got %v", false, out.IsValid())
+	}
+}
+
+func TestFormat(t *testing.T) {
+	addresses := []struct {
+		address *Address
+		want    string
+	}{
+		{
+			&Address{Name: "John Doe", StreetLines: []string{"1600 Amphitheatre Pkwy"}, Locality: "Mountain View",
+				Subdivision: countries.SubdivisionUSCA, PostalCode: "94043", Country: countries.USA},
+			"John Doe\n1600 Amphitheatre Pkwy\nMOUNTAIN VIEW, CA 94043\nUNITED STATES",
+		},
+		{
+			&Address{Name: "Erika Mustermann", Organization: "Beispiel GmbH", StreetLines: []string{"Heidestraße 17"},
+				Locality: "Köln", PostalCode: "51147", Country: countries.DEU},
+			"Erika Mustermann\nBeispiel GmbH\nHeidestraße 17\n51147 Köln\nGERMANY",
+		},
+		{
+			&Address{Name: "Jane Smith", StreetLines: []string{"10 Downing Street"}, Locality: "London", PostalCode: "sw1a2aa", Country: countries.GBR},
+			"Jane Smith\n10 Downing Street\nLONDON\nSW1A 2AA\nUNITED KINGDOM",
+		},
+		{
+			&Address{Name: "Pierre Martin", StreetLines: []string{"", "8 rue de Rivoli"}, Locality: "Paris", PostalCode: "75004", Country: countries.FRA},
+			"Pierre Martin\n8 rue de Rivoli\n75004 PARIS\nFRANCE",
+		},
+		{
+			&Address{StreetLines: []string{"1 Main St"}, Locality: "Springfield", PostalCode: "62701", Country: countries.USA},
+			"1 Main St\nSPRINGFIELD 62701\nUNITED STATES",
+		},
+		{
+			&Address{StreetLines: []string{"1 Main St"}, Subdivision: countries.SubdivisionUSIL, PostalCode: "62701", Country: countries.USA},
+			"1 Main St\nIL 62701\nUNITED STATES",
+		},
+	}
+	for _, a := range addresses {
+		if out, err := Format(a.address); err != nil || out != a.want {
+			t.Errorf("Test Format() err, want %q, got %q, %v", a.want, out, err)
+		}
+	}
+	if _, err := Format(&Address{}); !errors.Is(err, countries.ErrNotFound) {
+		t.Errorf("Test Format() err, want %v, got %v", countries.ErrNotFound, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := &Address{StreetLines: []string{"1 Main St"}, Locality: "Springfield", Subdivision: countries.SubdivisionUSIL,
+		PostalCode: "62701", Country: countries.USA}
+	if err := Validate(valid); err != nil {
+		t.Errorf("Test Validate() err, want %v, got %v", nil, err)
+	}
+	invalid := []struct {
+		address *Address
+		want    error
+	}{
+		{&Address{StreetLines: []string{"1 Main St"}, Locality: "Springfield", Subdivision: countries.SubdivisionCAON,
+			PostalCode: "62701", Country: countries.USA}, ErrSubdivisionMismatch},
+		{&Address{StreetLines: []string{"1 Main St"}, Locality: "Springfield", Subdivision: countries.SubdivisionUSIL,
+			PostalCode: "6270", Country: countries.USA}, countries.ErrInvalidFormat},
+		{&Address{StreetLines: []string{"1 Main St"}, Subdivision: countries.SubdivisionUSIL, PostalCode: "62701", Country: countries.USA}, ErrMissingField},
+		{&Address{StreetLines: []string{" "}, Locality: "Berlin", PostalCode: "10115", Country: countries.DEU}, ErrMissingField},
+		{&Address{StreetLines: []string{"Unter den Linden 1"}, Locality: "Berlin", Country: countries.DEU}, ErrMissingField},
+		{&Address{StreetLines: []string{"Unter den Linden 1"}, Locality: "Berlin", Country: countries.Unknown}, countries.ErrNotFound},
+	}
+	for _, a := range invalid {
+		if err := Validate(a.address); !errors.Is(err, a.want) {
+			t.Errorf("Test Validate() err, want %v, got %v", a.want, err)
+		}
+	}
+	if err := Validate(&Address{StreetLines: []string{"Queen's Road 1"}, Locality: "Hong Kong", Country: countries.HKG}); err != nil {
+		t.Errorf("Test Validate() err, want %v, got %v", nil, err)
+	}
+}
@@ -0,0 +1,78 @@
+package address
+
+import (
+	"github.com/xianb/countries"
+)
+
+// templateOf - returns the format, required and upper case fields of addresses of the country and whether
+// the subdivision is written as its code; the format uses %N, %O, %A, %D, %C, %S, %Z, %X for fields and %n for a new line
+func templateOf(c countries.CountryCode) (format, required, uppercase string, abbreviate bool) { //nolint:gocyclo
+	switch c {
+	case countries.USA:
+		return "%N%n%O%n%A%n%C, %S %Z", "ACSZ", "CS", true
+	case countries.CAN:
+		return "%N%n%O%n%A%n%C %S %Z", "ACSZ", "ACSZ", true
+	case countries.AUS:
+		return "%O%n%N%n%A%n%C %S %Z", "ACSZ", "CS", true
+	case countries.GBR, countries.GGY, countries.JEY, countries.IMN:
+		return "%N%n%O%n%A%n%C%n%Z", "ACZ", "CZ", false
+	case countries.IRL:
+		return "%N%n%O%n%A%n%D%n%C%n%S%n%Z", "A", "", false
+	case countries.CHE:
+		return "%O%n%N%n%A%nCH-%Z %C", "ACZ", "", false
+	case countries.LIE:
+		return "%O%n%N%n%A%nFL-%Z %C", "ACZ", "", false
+	case countries.FRA, countries.GLP, countries.MTQ, countries.GUF, countries.REU, countries.MYT, countries.BLM, countries.MAF,
+		countries.SPM, countries.MCO:
+		return "%O%n%N%n%A%n%Z %C %X", "ACZ", "CX", false
+	case countries.ITA, countries.SMR, countries.VAT:
+		return "%N%n%O%n%A%n%Z %C %S", "ACSZ", "CS", true
+	case countries.ESP:
+		return "%N%n%O%n%A%n%Z %C %S", "ACSZ", "CS", false
+	case countries.JPN:
+		return "%N%n%O%n%A, %S%n%Z", "ASZ", "S", false
+	case countries.CHN:
+		return "%N%n%O%n%A, %D%n%C%n%S, %Z", "ACS", "", false
+	case countries.KOR:
+		return "%N%n%O%n%A%n%D%n%C%n%S%n%Z", "ACSZ", "", false
+	case countries.TWN:
+		return "%N%n%O%n%A%n%C, %S %Z", "ACSZ", "", false
+	case countries.HKG:
+		return "%N%n%O%n%A%n%C%n%S", "AS", "S", false
+	case countries.BRA:
+		return "%O%n%N%n%A%n%D%n%C-%S%n%Z", "ASCZ", "CS", true
+	case countries.MEX:
+		return "%N%n%O%n%A%n%D%n%Z %C, %S", "ACSZ", "CSZ", false
+	case countries.ARG:
+		return "%N%n%O%n%A%n%Z %C%n%S", "AC", "ACZ", false
+	case countries.IND:
+		return "%N%n%O%n%A%n%D%n%C %Z%n%S", "ACSZ", "", false
+	case countries.RUS, countries.UKR, countries.BLR, countries.KAZ:
+		return "%N%n%O%n%A%n%C%n%S%n%Z", "ACZ", "AC", false
+	case countries.MYS:
+		return "%N%n%O%n%A%n%D%n%Z %C%n%S", "ACZ", "CS", false
+	case countries.IDN:
+		return "%N%n%O%n%A%n%C%n%S %Z", "AS", "", false
+	case countries.THA:
+		return "%N%n%O%n%A%n%D %C%n%S %Z", "AS", "S", false
+	case countries.PHL:
+		return "%N%n%O%n%A%n%D, %C%n%Z %S", "AC", "", false
+	case countries.NZL:
+		return "%N%n%O%n%A%n%D%n%C %Z", "ACZ", "", false
+	case countries.ZAF:
+		return "%N%n%O%n%A%n%D%n%C%n%Z", "ACZ", "", false
+	case countries.SGP:
+		return "%N%n%O%n%A%nSINGAPORE %Z", "AZ", "", false
+	case countries.TUR:
+		return "%N%n%O%n%A%n%Z %C/%S", "ACZ", "", false
+	case countries.EGY:
+		return "%N%n%O%n%A%n%C%n%S%n%Z", "AS", "", false
+	}
+	if postalCode := c.PostalCode(); postalCode != nil {
+		if postalCode.Required {
+			return "%N%n%O%n%A%n%Z %C", "ACZ", "", false
+		}
+		return "%N%n%O%n%A%n%Z %C", "AC", "", false
+	}
+	return "%N%n%O%n%A%n%C", "AC", "", false
+}
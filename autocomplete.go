@@ -0,0 +1,234 @@
+package countries
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// AutocompleteOptions - filters of Autocomplete
+type AutocompleteOptions struct {
+	Kinds   []EntityKind // entity kinds to suggest, all kinds if empty
+	Country CountryCode  // suggest only the country and its capitals, subdivisions and currencies, any country if Unknown
+	Limit   int          // maximum number of suggestions, 10 if <= 0
+}
+
+// Suggestion - a code suggested by Autocomplete
+type Suggestion struct {
+	Code Localizable // CountryCode, CapitalCode, CurrencyCode or SubdivisionCode
+	Kind EntityKind
+	Name string // the name or the code starting with the prefix
+}
+
+// autocompleteDefaultLimit - Limit of AutocompleteOptions by default
+const autocompleteDefaultLimit = 10
+
+// autocompleteEntry - a name or a code of an entity in the index
+type autocompleteEntry struct {
+	key       string
+	name      string
+	code      Localizable
+	kind      EntityKind
+	countries []CountryCode
+}
+
+// trieNode - a node of the radix tree over sorted keys: all keys of entries[lo:hi] share the first end bytes,
+// children are ordered by the byte following the shared prefix, keys ending at the node come before children
+type trieNode struct {
+	end      int
+	lo, hi   int
+	edges    []byte
+	children []*trieNode
+}
+
+// autocompleteIndex - an immutable index, built once
+type autocompleteIndex struct {
+	entries []autocompleteEntry
+	root    *trieNode
+}
+
+var (
+	autocompleteOnce sync.Once
+	autocompleteData *autocompleteIndex
+)
+
+// Type implements Typer interface
+func (_ Suggestion) Type() string {
+	return TypeSuggestion
+}
+
+// Autocomplete - returns countries, capitals, currencies and subdivisions whose code or name in any of NameLanguages,
+// chinese name or its pinyin starts with the prefix, case- and diacritics-insensitive, ordered by the matching name,
+// example: Autocomplete("deu", AutocompleteOptions{Kinds: []EntityKind{EntityKindCountry}})[0].Code == DEU
+// OR Autocomplete("cal", AutocompleteOptions{Country: USA})[0].Code == SubdivisionUSCA;
+// the immutable index is built once on the first call and is safe for concurrent use
+func Autocomplete(prefix string, opts AutocompleteOptions) []Suggestion {
+	key := latinKey(prefix)
+	if key == "" {
+		return nil
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = autocompleteDefaultLimit
+	}
+	index := autocompleteIndexData()
+	node := index.root.find(key, index.entries)
+	if node == nil {
+		return nil
+	}
+
+	suggestions := make([]Suggestion, 0, limit)
+	for i := node.lo; i < node.hi && len(suggestions) < limit; i++ {
+		entry := &index.entries[i]
+		if !entry.matches(opts) || suggested(suggestions, entry.code) {
+			continue
+		}
+		suggestions = append(suggestions, Suggestion{Code: entry.code, Kind: entry.kind, Name: entry.name})
+	}
+	return suggestions
+}
+
+// find - returns the node of all keys starting with the key, nil if there are no such keys
+func (n *trieNode) find(key string, entries []autocompleteEntry) *trieNode {
+	for {
+		shared := entries[n.lo].key
+		if len(key) <= n.end {
+			if strings.HasPrefix(shared, key) {
+				return n
+			}
+			return nil
+		}
+		if shared[:n.end] != key[:n.end] {
+			return nil
+		}
+		next := key[n.end]
+		i := sort.Search(len(n.edges), func(i int) bool { return n.edges[i] >= next })
+		if i == len(n.edges) || n.edges[i] != next {
+			return nil
+		}
+		n = n.children[i]
+	}
+}
+
+// matches - returns true, if the entry passes the filters
+func (e *autocompleteEntry) matches(opts AutocompleteOptions) bool {
+	if len(opts.Kinds) > 0 {
+		found := false
+		for _, kind := range opts.Kinds {
+			if kind == e.kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if opts.Country == Unknown {
+		return true
+	}
+	for _, c := range e.countries {
+		if c == opts.Country {
+			return true
+		}
+	}
+	return false
+}
+
+func suggested(suggestions []Suggestion, code Localizable) bool {
+	for _, suggestion := range suggestions {
+		if suggestion.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// autocompleteIndexData - returns the index of names and codes of all countries, capitals, currencies and subdivisions
+func autocompleteIndexData() *autocompleteIndex {
+	autocompleteOnce.Do(func() {
+		var entries []autocompleteEntry
+		seen := map[string]bool{}
+		add := func(name string, code Localizable, kind EntityKind, countries []CountryCode) {
+			key := latinKey(name)
+			if key == "" || seen[key+"\x00"+CatalogKey(code)] {
+				return
+			}
+			seen[key+"\x00"+CatalogKey(code)] = true
+			entries = append(entries, autocompleteEntry{key: key, name: name, code: code, kind: kind, countries: countries})
+		}
+		addNames := func(name string, code Localizable, kind EntityKind, countries []CountryCode) {
+			add(name, code, kind, countries)
+			if p := pinyin(name); p != "" {
+				add(p, code, kind, countries)
+			}
+		}
+		forms := []NameForm{NameFormDefault, NameFormShort, NameFormVariant, NameFormOfficial}
+		for _, c := range All() {
+			countries := []CountryCode{c}
+			add(c.Alpha2(), c, EntityKindCountry, countries)
+			add(c.Alpha3(), c, EntityKindCountry, countries)
+			add(c.String(), c, EntityKindCountry, countries)
+			for _, lang := range NameLanguages() {
+				for _, form := range forms {
+					addNames(c.NameIn(lang, form), c, EntityKindCountry, countries)
+				}
+			}
+			addNames(c.StringCn(), c, EntityKindCountry, countries)
+		}
+		localizer := DefaultLocalizer()
+		for _, c := range AllCapitals() {
+			if c == CapitalXX {
+				continue
+			}
+			countries := []CountryCode{c.Country()}
+			add(c.String(), c, EntityKindCapital, countries)
+			addNames(localizer.Localized(c, language.Chinese), c, EntityKindCapital, countries)
+		}
+		for _, c := range AllCurrencies() {
+			countries := c.Countries()
+			add(c.Alpha(), c, EntityKindCurrency, countries)
+			add(c.String(), c, EntityKindCurrency, countries)
+			addNames(localizer.Localized(c, language.Chinese), c, EntityKindCurrency, countries)
+		}
+		for _, s := range AllSubdivisions() {
+			countries := []CountryCode{s.Country()}
+			add(string(s), s, EntityKindSubdivision, countries)
+			add(s.String(), s, EntityKindSubdivision, countries)
+			addNames(localizer.Localized(s, language.Chinese), s, EntityKindSubdivision, countries)
+		}
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		autocompleteData = &autocompleteIndex{entries: entries}
+		autocompleteData.root = autocompleteData.build(0, len(entries), 0)
+	})
+	return autocompleteData
+}
+
+// build - returns the node of entries[lo:hi], which keys share at least depth bytes
+func (index *autocompleteIndex) build(lo, hi, depth int) *trieNode {
+	first, last := index.entries[lo].key, index.entries[hi-1].key
+	end := depth
+	for end < len(first) && end < len(last) && first[end] == last[end] {
+		end++
+	}
+	node := &trieNode{end: end, lo: lo, hi: hi}
+	i := lo
+	for i < hi && len(index.entries[i].key) == end {
+		i++
+	}
+	for i < hi {
+		edge := index.entries[i].key[end]
+		j := i + 1
+		for j < hi && index.entries[j].key[end] == edge {
+			j++
+		}
+		node.edges = append(node.edges, edge)
+		node.children = append(node.children, index.build(i, j, end+1))
+		i = j
+	}
+	return node
+}
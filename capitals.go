@@ -10,9 +10,12 @@ type CapitalCode int64
 
 // Capital - capital info
 type Capital struct {
-	Name    string
-	Code    CapitalCode
-	Country CountryCode
+	Name      string
+	Code      CapitalCode
+	Country   CountryCode
+	Role      CapitalRole
+	Latitude  float64
+	Longitude float64
 }
 
 // Type implements Typer interface
@@ -529,6 +532,30 @@ func (c CapitalCode) String() string { //nolint:gocyclo
 		return "Tokyo"
 	case CapitalXX:
 		return "None"
+	case CapitalZACapeTown:
+		return "Cape Town"
+	case CapitalZABloemfontein:
+		return "Bloemfontein"
+	case CapitalBOLaPaz:
+		return "La Paz"
+	case CapitalNLTheHague:
+		return "The Hague"
+	case CapitalMYPutrajaya:
+		return "Putrajaya"
+	case CapitalLKKotte:
+		return "Sri Jayawardenepura Kotte"
+	case CapitalBJCotonou:
+		return "Cotonou"
+	case CapitalCIAbidjan:
+		return "Abidjan"
+	case CapitalTZDarEsSalaam:
+		return "Dar es Salaam"
+	case CapitalSZLobamba:
+		return "Lobamba"
+	case CapitalCLValparaiso:
+		return "Valparaiso"
+	case CapitalBIGitega:
+		return "Gitega"
 	}
 	return UnknownMsg
 }
@@ -1042,6 +1069,30 @@ func (c CapitalCode) Country() CountryCode { //nolint:gocyclo
 		return XK
 	case CapitalJP:
 		return JP
+	case CapitalZACapeTown:
+		return ZA
+	case CapitalZABloemfontein:
+		return ZA
+	case CapitalBOLaPaz:
+		return BO
+	case CapitalNLTheHague:
+		return NL
+	case CapitalMYPutrajaya:
+		return MY
+	case CapitalLKKotte:
+		return LK
+	case CapitalBJCotonou:
+		return BJ
+	case CapitalCIAbidjan:
+		return CI
+	case CapitalTZDarEsSalaam:
+		return TZ
+	case CapitalSZLobamba:
+		return SZ
+	case CapitalCLValparaiso:
+		return CL
+	case CapitalBIGitega:
+		return BI
 	}
 	return Unknown
 }
@@ -1053,10 +1104,14 @@ func (c CapitalCode) IsValid() bool {
 
 // Info - return CapitalCode as Capital info
 func (c CapitalCode) Info() *Capital {
+	latitude, longitude := c.Coordinates()
 	return &Capital{
-		Name:    c.String(),
-		Code:    c,
-		Country: c.Country(),
+		Name:      c.String(),
+		Code:      c,
+		Country:   c.Country(),
+		Role:      c.Role(),
+		Latitude:  latitude,
+		Longitude: longitude,
 	}
 }
 
@@ -1342,6 +1397,18 @@ func AllCapitals() []CapitalCode {
 		CapitalSS,
 		CapitalXK,
 		CapitalJP,
+		CapitalZACapeTown,
+		CapitalZABloemfontein,
+		CapitalBOLaPaz,
+		CapitalNLTheHague,
+		CapitalMYPutrajaya,
+		CapitalLKKotte,
+		CapitalBJCotonou,
+		CapitalCIAbidjan,
+		CapitalTZDarEsSalaam,
+		CapitalSZLobamba,
+		CapitalCLValparaiso,
+		CapitalBIGitega,
 	}
 }
 
@@ -1355,11 +1422,22 @@ func AllCapitalsInfo() []*Capital {
 	return capitals
 }
 
-// CapitalCodeByName - return CapitalCode by name, case-insensitive, example: capitalAE := CapitalCodeByName("Abu-Dhabi") OR capitalAE := CapitalCodeByName("abu-dhabi")
-//
-//nolint:gocyclo
-func CapitalCodeByName(name string) CapitalCode { //nolint:gocyclo
-	switch textPrepare(name) {
+// CapitalCodeByName - return CapitalCode by name, case-insensitive, example: capitalAE := CapitalCodeByName("Abu-Dhabi") OR capitalAE := CapitalCodeByName("abu-dhabi"),
+// chinese names and their pinyin are accepted too, example: capitalJP := CapitalCodeByName("东京") OR capitalJP := CapitalCodeByName("Dōngjīng")
+func CapitalCodeByName(name string) CapitalCode {
+	code := CapitalUnknown
+	if textLookup(name, func(key string) bool {
+		code = capitalByKey(key)
+		return code != CapitalUnknown
+	}, nil) {
+		return code
+	}
+	return capitalByLocalizedName(name)
+}
+
+// capitalByKey - returns CapitalCode by a key of textPrepare, returns CapitalUnknown, if the key not found
+func capitalByKey(key string) CapitalCode { //nolint:gocyclo
+	switch key {
 	case "ORANJESTAD":
 		return CapitalAW
 	case "STJOHNS":
@@ -1690,7 +1768,7 @@ func CapitalCodeByName(name string) CapitalCode { //nolint:gocyclo
 		return CapitalNZ
 	case "JUBA":
 		return CapitalSS
-	case "PRISTINA", "PRISHTINA", "PRIHTINA", "ПРИШТИНА", "PRISHTINË", "PRIŠTINA":
+	case "PRISTINA", "PRISHTINA", "PRIHTINA", "ПРИШТИНА", "PRISHTINE":
 		return CapitalXK
 	case "ASUNCION":
 		return CapitalPY
@@ -1852,11 +1930,35 @@ func CapitalCodeByName(name string) CapitalCode { //nolint:gocyclo
 		return CapitalZW
 	case "XX", "NON", "NONE":
 		return CapitalXX
+	case "CAPETOWN", "KAAPSTAD":
+		return CapitalZACapeTown
+	case "BLOEMFONTEIN":
+		return CapitalZABloemfontein
+	case "LAPAZ":
+		return CapitalBOLaPaz
+	case "THEHAGUE", "DENHAAG", "SGRAVENHAGE":
+		return CapitalNLTheHague
+	case "PUTRAJAYA":
+		return CapitalMYPutrajaya
+	case "SRIJAYAWARDENEPURAKOTTE", "KOTTE":
+		return CapitalLKKotte
+	case "COTONOU":
+		return CapitalBJCotonou
+	case "ABIDJAN":
+		return CapitalCIAbidjan
+	case "DARESSALAAM":
+		return CapitalTZDarEsSalaam
+	case "LOBAMBA":
+		return CapitalSZLobamba
+	case "VALPARAISO":
+		return CapitalCLValparaiso
+	case "GITEGA":
+		return CapitalBIGitega
 	}
 	return CapitalUnknown
 }
 
 // TotalCapitals - returns number of capitals in the package
 func TotalCapitals() int {
-	return 253
+	return 265
 }
@@ -6,6 +6,9 @@ const TypeCapitalCode string = "countries.CapitalCode"
 // TypeCapital for Typer interface
 const TypeCapital string = "countries.Capital"
 
+// TypeCapitalRole for Typer interface
+const TypeCapitalRole string = "countries.CapitalRole"
+
 const (
 	CapitalUnknown CapitalCode = 0
 	CapitalAU      CapitalCode = CapitalCode(AU)
@@ -263,3 +266,30 @@ const (
 	CapitalJP      CapitalCode = CapitalCode(JP)
 	CapitalXX      CapitalCode = CapitalCode(XX)
 )
+
+// Capitals of countries with several capitals, codes are codes of the main capitals + 1000 * n
+const (
+	CapitalZACapeTown     CapitalCode = CapitalZA + 1000 // Cape Town
+	CapitalZABloemfontein CapitalCode = CapitalZA + 2000 // Bloemfontein
+	CapitalBOLaPaz        CapitalCode = CapitalBO + 1000 // La Paz
+	CapitalNLTheHague     CapitalCode = CapitalNL + 1000 // The Hague
+	CapitalMYPutrajaya    CapitalCode = CapitalMY + 1000 // Putrajaya
+	CapitalLKKotte        CapitalCode = CapitalLK + 1000 // Sri Jayawardenepura Kotte
+	CapitalBJCotonou      CapitalCode = CapitalBJ + 1000 // Cotonou
+	CapitalCIAbidjan      CapitalCode = CapitalCI + 1000 // Abidjan
+	CapitalTZDarEsSalaam  CapitalCode = CapitalTZ + 1000 // Dar es Salaam
+	CapitalSZLobamba      CapitalCode = CapitalSZ + 1000 // Lobamba
+	CapitalCLValparaiso   CapitalCode = CapitalCL + 1000 // Valparaiso
+	CapitalBIGitega       CapitalCode = CapitalBI + 1000 // Gitega
+)
+
+// Capital roles
+const (
+	CapitalRoleUnknown        CapitalRole = 0
+	CapitalRoleFull           CapitalRole = 1 // the only capital of the country
+	CapitalRoleExecutive      CapitalRole = 2 // seat of the government, example Pretoria, The Hague
+	CapitalRoleLegislative    CapitalRole = 3 // seat of the parliament, example Cape Town
+	CapitalRoleJudicial       CapitalRole = 4 // seat of the supreme court, example Bloemfontein
+	CapitalRoleConstitutional CapitalRole = 5 // capital by the constitution, example Amsterdam, Sucre
+	CapitalRoleDeFacto        CapitalRole = 6 // seat of most government bodies, but not the capital by law, example Abidjan
+)
@@ -0,0 +1,650 @@
+package countries
+
+import "math"
+
+// CapitalRole - 首都类型（行政、立法、司法、宪法、事实首都）
+type CapitalRole int64 // int64 for database/sql/driver.Valuer compatibility
+
+// earthRadiusKm - the mean radius of the Earth
+const earthRadiusKm = 6371.0088
+
+// Type implements Typer interface
+func (_ CapitalRole) Type() string {
+	return TypeCapitalRole
+}
+
+// String - implements fmt.Stringer, returns a capital role in english
+func (r CapitalRole) String() string {
+	switch r {
+	case CapitalRoleFull:
+		return "Capital"
+	case CapitalRoleExecutive:
+		return "Executive"
+	case CapitalRoleLegislative:
+		return "Legislative"
+	case CapitalRoleJudicial:
+		return "Judicial"
+	case CapitalRoleConstitutional:
+		return "Constitutional"
+	case CapitalRoleDeFacto:
+		return "De facto"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (r CapitalRole) IsValid() bool {
+	return r.String() != UnknownMsg
+}
+
+// Capitals - returns all capitals of the country with their roles, the main capital (Capital()) first,
+// example: ZAF.Capitals() returns Pretoria (executive), Cape Town (legislative) and Bloemfontein (judicial)
+func (c CountryCode) Capitals() []*Capital {
+	codes := c.capitalCodes()
+	capitals := make([]*Capital, 0, len(codes))
+	for _, code := range codes {
+		capitals = append(capitals, code.Info())
+	}
+	return capitals
+}
+
+// capitalCodes - returns codes of all capitals of the country, the main capital first
+func (c CountryCode) capitalCodes() []CapitalCode { //nolint:gocyclo
+	switch c {
+	case ZAF:
+		return []CapitalCode{CapitalZA, CapitalZACapeTown, CapitalZABloemfontein}
+	case BOL:
+		return []CapitalCode{CapitalBO, CapitalBOLaPaz}
+	case NLD:
+		return []CapitalCode{CapitalNL, CapitalNLTheHague}
+	case MYS:
+		return []CapitalCode{CapitalMY, CapitalMYPutrajaya}
+	case LKA:
+		return []CapitalCode{CapitalLK, CapitalLKKotte}
+	case BEN:
+		return []CapitalCode{CapitalBJ, CapitalBJCotonou}
+	case CIV:
+		return []CapitalCode{CapitalCI, CapitalCIAbidjan}
+	case TZA:
+		return []CapitalCode{CapitalTZ, CapitalTZDarEsSalaam}
+	case SWZ:
+		return []CapitalCode{CapitalSZ, CapitalSZLobamba}
+	case CHL:
+		return []CapitalCode{CapitalCL, CapitalCLValparaiso}
+	case BDI:
+		return []CapitalCode{CapitalBI, CapitalBIGitega}
+	}
+	if capital := c.Capital(); capital != CapitalUnknown {
+		return []CapitalCode{capital}
+	}
+	return nil
+}
+
+// Role - returns the role of the capital, CapitalRoleFull for the only capital of a country
+func (c CapitalCode) Role() CapitalRole { //nolint:gocyclo
+	switch c {
+	case CapitalZA, CapitalLK, CapitalSZ, CapitalCL, CapitalBOLaPaz, CapitalNLTheHague, CapitalMYPutrajaya:
+		return CapitalRoleExecutive
+	case CapitalBO, CapitalNL, CapitalBJ, CapitalCI, CapitalTZ, CapitalBIGitega:
+		return CapitalRoleConstitutional
+	case CapitalMY, CapitalZACapeTown, CapitalLKKotte, CapitalSZLobamba, CapitalCLValparaiso:
+		return CapitalRoleLegislative
+	case CapitalBI, CapitalBJCotonou, CapitalCIAbidjan, CapitalTZDarEsSalaam:
+		return CapitalRoleDeFacto
+	case CapitalZABloemfontein:
+		return CapitalRoleJudicial
+	}
+	if c.IsValid() {
+		return CapitalRoleFull
+	}
+	return CapitalRoleUnknown
+}
+
+// Coordinates - returns latitude and longitude of the capital city centre in degrees (WGS 84),
+// zeros for unknown codes and territories without a capital
+func (c CapitalCode) Coordinates() (latitude, longitude float64) {
+	latitude, longitude, _ = capitalCoordinates(c)
+	return latitude, longitude
+}
+
+// Distance - returns the great-circle distance between the capitals in kilometres (haversine formula),
+// example: CapitalDE.Distance(CapitalFR) is about 878, returns -1, if coordinates of any of the capitals are unknown
+func (c CapitalCode) Distance(other CapitalCode) float64 {
+	lat1, lng1, ok1 := capitalCoordinates(c)
+	lat2, lng2, ok2 := capitalCoordinates(other)
+	if !ok1 || !ok2 {
+		return -1
+	}
+	return haversine(lat1, lng1, lat2, lng2)
+}
+
+// haversine - returns the great-circle distance between two points in kilometres
+func haversine(lat1, lng1, lat2, lng2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dPhi, dLambda := (lat2-lat1)*math.Pi/180, (lng2-lng1)*math.Pi/180
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * earthRadiusKm * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// capitalCoordinates - returns coordinates of the capital, ok is false, if they are unknown
+func capitalCoordinates(c CapitalCode) (latitude, longitude float64, ok bool) { //nolint:gocyclo
+	switch c {
+	case CapitalAU:
+		return -35.2809, 149.1300, true
+	case CapitalAT:
+		return 48.2082, 16.3738, true
+	case CapitalAZ:
+		return 40.4093, 49.8671, true
+	case CapitalAL:
+		return 41.3275, 19.8187, true
+	case CapitalDZ:
+		return 36.7538, 3.0588, true
+	case CapitalAS:
+		return -14.2756, -170.7020, true
+	case CapitalAI:
+		return 18.2206, -63.0686, true
+	case CapitalAO:
+		return -8.8390, 13.2894, true
+	case CapitalAD:
+		return 42.5063, 1.5218, true
+	case CapitalAG:
+		return 17.1274, -61.8468, true
+	case CapitalAN:
+		return 12.1091, -68.9316, true
+	case CapitalAE:
+		return 24.4539, 54.3773, true
+	case CapitalAR:
+		return -34.6037, -58.3816, true
+	case CapitalAM:
+		return 40.1792, 44.4991, true
+	case CapitalAW:
+		return 12.5240, -70.0270, true
+	case CapitalAF:
+		return 34.5553, 69.2075, true
+	case CapitalBS:
+		return 25.0443, -77.3504, true
+	case CapitalBD:
+		return 23.8103, 90.4125, true
+	case CapitalBB:
+		return 13.1132, -59.5988, true
+	case CapitalBH:
+		return 26.2285, 50.5860, true
+	case CapitalBY:
+		return 53.9006, 27.5590, true
+	case CapitalBZ:
+		return 17.2510, -88.7590, true
+	case CapitalBE:
+		return 50.8503, 4.3517, true
+	case CapitalBJ:
+		return 6.4969, 2.6289, true
+	case CapitalBM:
+		return 32.2949, -64.7814, true
+	case CapitalBG:
+		return 42.6977, 23.3219, true
+	case CapitalBO:
+		return -19.0196, -65.2619, true
+	case CapitalBA:
+		return 43.8563, 18.4131, true
+	case CapitalBW:
+		return -24.6282, 25.9231, true
+	case CapitalBR:
+		return -15.7939, -47.8828, true
+	case CapitalIO:
+		return -7.3195, 72.4229, true
+	case CapitalBN:
+		return 4.9031, 114.9398, true
+	case CapitalBF:
+		return 12.3714, -1.5197, true
+	case CapitalBI:
+		return -3.3614, 29.3599, true
+	case CapitalBT:
+		return 27.4728, 89.6390, true
+	case CapitalVU:
+		return -17.7334, 168.3273, true
+	case CapitalVA:
+		return 41.9029, 12.4534, true
+	case CapitalGB:
+		return 51.5074, -0.1278, true
+	case CapitalHU:
+		return 47.4979, 19.0402, true
+	case CapitalVE:
+		return 10.4806, -66.9036, true
+	case CapitalVG:
+		return 18.4207, -64.6400, true
+	case CapitalVI:
+		return 18.3419, -64.9307, true
+	case CapitalTL:
+		return -8.5569, 125.5603, true
+	case CapitalVN:
+		return 21.0278, 105.8342, true
+	case CapitalGA:
+		return 0.4162, 9.4673, true
+	case CapitalHT:
+		return 18.5944, -72.3074, true
+	case CapitalGY:
+		return 6.8013, -58.1551, true
+	case CapitalGM:
+		return 13.4549, -16.5790, true
+	case CapitalGH:
+		return 5.6037, -0.1870, true
+	case CapitalGP:
+		return 15.9985, -61.7261, true
+	case CapitalGT:
+		return 14.6349, -90.5069, true
+	case CapitalGN:
+		return 9.6412, -13.5784, true
+	case CapitalGW:
+		return 11.8817, -15.6178, true
+	case CapitalDE:
+		return 52.5200, 13.4050, true
+	case CapitalGI:
+		return 36.1408, -5.3536, true
+	case CapitalHN:
+		return 14.0723, -87.1921, true
+	case CapitalHK:
+		return 22.3193, 114.1694, true
+	case CapitalGD:
+		return 12.0561, -61.7488, true
+	case CapitalGL:
+		return 64.1814, -51.6941, true
+	case CapitalGR:
+		return 37.9838, 23.7275, true
+	case CapitalGE:
+		return 41.7151, 44.8271, true
+	case CapitalGU:
+		return 13.4757, 144.7489, true
+	case CapitalDK:
+		return 55.6761, 12.5683, true
+	case CapitalCD:
+		return -4.4419, 15.2663, true
+	case CapitalDJ:
+		return 11.5721, 43.1456, true
+	case CapitalDM:
+		return 15.3092, -61.3794, true
+	case CapitalDO:
+		return 18.4861, -69.9312, true
+	case CapitalEG:
+		return 30.0444, 31.2357, true
+	case CapitalZM:
+		return -15.3875, 28.3228, true
+	case CapitalEH:
+		return 27.1536, -13.2033, true
+	case CapitalZW:
+		return -17.8252, 31.0335, true
+	case CapitalIL:
+		return 31.7683, 35.2137, true
+	case CapitalIN:
+		return 28.6139, 77.2090, true
+	case CapitalID:
+		return -6.2088, 106.8456, true
+	case CapitalJO:
+		return 31.9454, 35.9284, true
+	case CapitalIQ:
+		return 33.3152, 44.3661, true
+	case CapitalIR:
+		return 35.6892, 51.3890, true
+	case CapitalIE:
+		return 53.3498, -6.2603, true
+	case CapitalIS:
+		return 64.1466, -21.9426, true
+	case CapitalES:
+		return 40.4168, -3.7038, true
+	case CapitalIT:
+		return 41.9028, 12.4964, true
+	case CapitalYE:
+		return 15.3694, 44.1910, true
+	case CapitalKZ:
+		return 51.1694, 71.4491, true
+	case CapitalKY:
+		return 19.2869, -81.3674, true
+	case CapitalKH:
+		return 11.5564, 104.9282, true
+	case CapitalCM:
+		return 3.8480, 11.5021, true
+	case CapitalCA:
+		return 45.4215, -75.6972, true
+	case CapitalQA:
+		return 25.2854, 51.5310, true
+	case CapitalKE:
+		return -1.2921, 36.8219, true
+	case CapitalCY:
+		return 35.1856, 33.3823, true
+	case CapitalKI:
+		return 1.4518, 172.9717, true
+	case CapitalCN:
+		return 39.9042, 116.4074, true
+	case CapitalCC:
+		return -12.1880, 96.8296, true
+	case CapitalCO:
+		return 4.7110, -74.0721, true
+	case CapitalKM:
+		return -11.7172, 43.2473, true
+	case CapitalCG:
+		return -4.2634, 15.2429, true
+	case CapitalKP:
+		return 39.0392, 125.7625, true
+	case CapitalKR:
+		return 37.5665, 126.9780, true
+	case CapitalCR:
+		return 9.9281, -84.0907, true
+	case CapitalCI:
+		return 6.8276, -5.2893, true
+	case CapitalCU:
+		return 23.1136, -82.3666, true
+	case CapitalKW:
+		return 29.3759, 47.9774, true
+	case CapitalKG:
+		return 42.8746, 74.5698, true
+	case CapitalLA:
+		return 17.9757, 102.6331, true
+	case CapitalLV:
+		return 56.9496, 24.1052, true
+	case CapitalLS:
+		return -29.3151, 27.4869, true
+	case CapitalLR:
+		return 6.3156, -10.8074, true
+	case CapitalLB:
+		return 33.8938, 35.5018, true
+	case CapitalLY:
+		return 32.8872, 13.1913, true
+	case CapitalLT:
+		return 54.6872, 25.2797, true
+	case CapitalLI:
+		return 47.1410, 9.5209, true
+	case CapitalLU:
+		return 49.6116, 6.1319, true
+	case CapitalMU:
+		return -20.1609, 57.5012, true
+	case CapitalMR:
+		return 18.0735, -15.9582, true
+	case CapitalMG:
+		return -18.8792, 47.5079, true
+	case CapitalYT:
+		return -12.7806, 45.2279, true
+	case CapitalMO:
+		return 22.1987, 113.5439, true
+	case CapitalMK:
+		return 41.9981, 21.4254, true
+	case CapitalMW:
+		return -13.9626, 33.7741, true
+	case CapitalMY:
+		return 3.1390, 101.6869, true
+	case CapitalML:
+		return 12.6392, -8.0029, true
+	case CapitalMV:
+		return 4.1755, 73.5093, true
+	case CapitalMT:
+		return 35.8989, 14.5146, true
+	case CapitalMP:
+		return 15.1778, 145.7510, true
+	case CapitalMA:
+		return 34.0209, -6.8416, true
+	case CapitalMQ:
+		return 14.6161, -61.0588, true
+	case CapitalMH:
+		return 7.0897, 171.3803, true
+	case CapitalMX:
+		return 19.4326, -99.1332, true
+	case CapitalFM:
+		return 6.9147, 158.1610, true
+	case CapitalMZ:
+		return -25.9692, 32.5732, true
+	case CapitalMD:
+		return 47.0105, 28.8638, true
+	case CapitalMC:
+		return 43.7384, 7.4246, true
+	case CapitalMN:
+		return 47.8864, 106.9057, true
+	case CapitalMS:
+		return 16.7069, -62.2154, true
+	case CapitalMM:
+		return 19.7633, 96.0785, true
+	case CapitalNA:
+		return -22.5609, 17.0658, true
+	case CapitalNR:
+		return -0.5477, 166.9209, true
+	case CapitalNP:
+		return 27.7172, 85.3240, true
+	case CapitalNE:
+		return 13.5116, 2.1254, true
+	case CapitalNG:
+		return 9.0765, 7.3986, true
+	case CapitalNL:
+		return 52.3676, 4.9041, true
+	case CapitalNI:
+		return 12.1150, -86.2362, true
+	case CapitalNU:
+		return -19.0544, -169.9187, true
+	case CapitalNZ:
+		return -41.2865, 174.7762, true
+	case CapitalNC:
+		return -22.2758, 166.4580, true
+	case CapitalNO:
+		return 59.9139, 10.7522, true
+	case CapitalOM:
+		return 23.5880, 58.3829, true
+	case CapitalIM:
+		return 54.1523, -4.4861, true
+	case CapitalNF:
+		return -29.0546, 167.9629, true
+	case CapitalPN:
+		return -25.0663, -130.1005, true
+	case CapitalCX:
+		return -10.4217, 105.6791, true
+	case CapitalSH:
+		return -15.9244, -5.7181, true
+	case CapitalWF:
+		return -13.2825, -176.1745, true
+	case CapitalCV:
+		return 14.9330, -23.5133, true
+	case CapitalCK:
+		return -21.2075, -159.7750, true
+	case CapitalWS:
+		return -13.8507, -171.7514, true
+	case CapitalSJ:
+		return 78.2232, 15.6267, true
+	case CapitalTC:
+		return 21.4612, -71.1419, true
+	case CapitalPK:
+		return 33.6844, 73.0479, true
+	case CapitalPW:
+		return 7.5006, 134.6242, true
+	case CapitalPS:
+		return 31.7834, 35.2339, true
+	case CapitalPA:
+		return 8.9824, -79.5199, true
+	case CapitalPG:
+		return -9.4438, 147.1803, true
+	case CapitalPY:
+		return -25.2637, -57.5759, true
+	case CapitalPE:
+		return -12.0464, -77.0428, true
+	case CapitalPL:
+		return 52.2297, 21.0122, true
+	case CapitalPT:
+		return 38.7223, -9.1393, true
+	case CapitalPR:
+		return 18.4655, -66.1057, true
+	case CapitalRE:
+		return -20.8821, 55.4507, true
+	case CapitalRU:
+		return 55.7558, 37.6173, true
+	case CapitalRW:
+		return -1.9441, 30.0619, true
+	case CapitalRO:
+		return 44.4268, 26.1025, true
+	case CapitalSV:
+		return 13.6929, -89.2182, true
+	case CapitalSM:
+		return 43.9424, 12.4578, true
+	case CapitalST:
+		return 0.3365, 6.7273, true
+	case CapitalSA:
+		return 24.7136, 46.6753, true
+	case CapitalSZ:
+		return -26.3054, 31.1367, true
+	case CapitalSC:
+		return -4.6191, 55.4513, true
+	case CapitalSN:
+		return 14.7167, -17.4677, true
+	case CapitalPM:
+		return 46.7811, -56.1764, true
+	case CapitalVC:
+		return 13.1600, -61.2248, true
+	case CapitalKN:
+		return 17.3026, -62.7177, true
+	case CapitalLC:
+		return 14.0101, -60.9875, true
+	case CapitalSG:
+		return 1.3521, 103.8198, true
+	case CapitalSY:
+		return 33.5138, 36.2765, true
+	case CapitalSK:
+		return 48.1486, 17.1077, true
+	case CapitalSI:
+		return 46.0569, 14.5058, true
+	case CapitalUS:
+		return 38.9072, -77.0369, true
+	case CapitalSB:
+		return -9.4456, 159.9729, true
+	case CapitalSO:
+		return 2.0469, 45.3182, true
+	case CapitalSD:
+		return 15.5007, 32.5599, true
+	case CapitalSR:
+		return 5.8520, -55.2038, true
+	case CapitalSL:
+		return 8.4657, -13.2317, true
+	case CapitalTJ:
+		return 38.5598, 68.7870, true
+	case CapitalTW:
+		return 25.0330, 121.5654, true
+	case CapitalTH:
+		return 13.7563, 100.5018, true
+	case CapitalTZ:
+		return -6.1630, 35.7516, true
+	case CapitalTG:
+		return 6.1256, 1.2254, true
+	case CapitalTO:
+		return -21.1394, -175.2049, true
+	case CapitalTT:
+		return 10.6549, -61.5019, true
+	case CapitalTV:
+		return -8.5211, 179.1983, true
+	case CapitalTN:
+		return 36.8065, 10.1815, true
+	case CapitalTM:
+		return 37.9601, 58.3261, true
+	case CapitalTR:
+		return 39.9334, 32.8597, true
+	case CapitalUG:
+		return 0.3476, 32.5825, true
+	case CapitalUZ:
+		return 41.2995, 69.2401, true
+	case CapitalUA:
+		return 50.4501, 30.5234, true
+	case CapitalUY:
+		return -34.9011, -56.1645, true
+	case CapitalFO:
+		return 62.0079, -6.7908, true
+	case CapitalFJ:
+		return -18.1416, 178.4419, true
+	case CapitalPH:
+		return 14.5995, 120.9842, true
+	case CapitalFI:
+		return 60.1699, 24.9384, true
+	case CapitalFK:
+		return -51.6977, -57.8517, true
+	case CapitalFR:
+		return 48.8566, 2.3522, true
+	case CapitalGF:
+		return 4.9224, -52.3135, true
+	case CapitalPF:
+		return -17.5516, -149.5585, true
+	case CapitalTF:
+		return -49.3500, 70.2167, true
+	case CapitalHR:
+		return 45.8150, 15.9819, true
+	case CapitalCF:
+		return 4.3947, 18.5582, true
+	case CapitalTD:
+		return 12.1348, 15.0557, true
+	case CapitalCZ:
+		return 50.0755, 14.4378, true
+	case CapitalCL:
+		return -33.4489, -70.6693, true
+	case CapitalCH:
+		return 46.9480, 7.4474, true
+	case CapitalSE:
+		return 59.3293, 18.0686, true
+	case CapitalLK:
+		return 6.9271, 79.8612, true
+	case CapitalEC:
+		return -0.1807, -78.4678, true
+	case CapitalGQ:
+		return 3.7504, 8.7371, true
+	case CapitalER:
+		return 15.3229, 38.9251, true
+	case CapitalEE:
+		return 59.4370, 24.7536, true
+	case CapitalET:
+		return 9.0300, 38.7400, true
+	case CapitalZA:
+		return -25.7479, 28.2293, true
+	case CapitalYU:
+		return 44.7866, 20.4489, true
+	case CapitalGS:
+		return -54.2811, -36.5092, true
+	case CapitalJM:
+		return 17.9712, -76.7936, true
+	case CapitalME:
+		return 42.4304, 19.2594, true
+	case CapitalBL:
+		return 17.8962, -62.8498, true
+	case CapitalSX:
+		return 18.0260, -63.0458, true
+	case CapitalRS:
+		return 44.7866, 20.4489, true
+	case CapitalAX:
+		return 60.0973, 19.9348, true
+	case CapitalGG:
+		return 49.4540, -2.5370, true
+	case CapitalJE:
+		return 49.1868, -2.1066, true
+	case CapitalCW:
+		return 12.1091, -68.9316, true
+	case CapitalMF:
+		return 18.0679, -63.0822, true
+	case CapitalSS:
+		return 4.8594, 31.5713, true
+	case CapitalXK:
+		return 42.6629, 21.1655, true
+	case CapitalJP:
+		return 35.6762, 139.6503, true
+	case CapitalZACapeTown:
+		return -33.9249, 18.4241, true
+	case CapitalZABloemfontein:
+		return -29.0852, 26.1596, true
+	case CapitalBOLaPaz:
+		return -16.4897, -68.1193, true
+	case CapitalNLTheHague:
+		return 52.0705, 4.3007, true
+	case CapitalMYPutrajaya:
+		return 2.9264, 101.6964, true
+	case CapitalLKKotte:
+		return 6.8868, 79.9187, true
+	case CapitalBJCotonou:
+		return 6.3703, 2.3912, true
+	case CapitalCIAbidjan:
+		return 5.36, -4.0083, true
+	case CapitalTZDarEsSalaam:
+		return -6.7924, 39.2083, true
+	case CapitalSZLobamba:
+		return -26.4465, 31.207, true
+	case CapitalCLValparaiso:
+		return -33.0472, -71.6127, true
+	case CapitalBIGitega:
+		return -3.4271, 29.9246, true
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,208 @@
+package countries
+
+import (
+	"time"
+)
+
+//go:generate go run gen_conventions.go
+
+// ClockFormat - 时间格式（12 或 24 小时制）
+type ClockFormat int64 // int64 for database/sql/driver.Valuer compatibility
+
+// DateOrder - 日期顺序（日月年、月日年、年月日）
+type DateOrder int64 // int64 for database/sql/driver.Valuer compatibility
+
+// MeasurementSystem - 度量衡制度（公制、英制）
+type MeasurementSystem int64 // int64 for database/sql/driver.Valuer compatibility
+
+// PaperSize - 纸张尺寸（A4、Letter）
+type PaperSize int64 // int64 for database/sql/driver.Valuer compatibility
+
+// DrivingSide - 行车方向（靠右、靠左）
+type DrivingSide int64 // int64 for database/sql/driver.Valuer compatibility
+
+// PlugType - 电源插头类型（IEC 字母 A-O）
+type PlugType int64 // int64 for database/sql/driver.Valuer compatibility
+
+// Conventions - 国家的区域惯例
+type Conventions struct {
+	FirstDayOfWeek    time.Weekday      `json:"firstDayOfWeek"`    // 每周第一天
+	Weekend           []time.Weekday    `json:"weekend"`           // 周末
+	Clock             ClockFormat       `json:"clock"`             // 时间格式
+	DateOrder         DateOrder         `json:"dateOrder"`         // 日期顺序
+	MeasurementSystem MeasurementSystem `json:"measurementSystem"` // 度量衡制度
+	PaperSize         PaperSize         `json:"paperSize"`         // 纸张尺寸
+	DecimalSeparator  string            `json:"decimalSeparator"`  // 小数分隔符
+	GroupingSeparator string            `json:"groupingSeparator"` // 千位分隔符
+	DrivingSide       DrivingSide       `json:"drivingSide"`       // 行车方向
+	PlugTypes         []PlugType        `json:"plugTypes"`         // 电源插头类型
+}
+
+// Conventions - returns locale conventions of the country: first day of week, weekend days, clock, date order,
+// measurement system, paper size, number separators, driving side and plug types, example: USA.Conventions().PaperSize == PaperSizeLetter;
+// nil for unknown codes and uninhabited territories
+func (c CountryCode) Conventions() *Conventions {
+	conventions, ok := conventionsTable[c]
+	if !ok {
+		return nil
+	}
+	conventions.Weekend = append([]time.Weekday(nil), conventions.Weekend...)
+	conventions.PlugTypes = append([]PlugType(nil), conventions.PlugTypes...)
+	return &conventions
+}
+
+// Type implements Typer interface
+func (_ *Conventions) Type() string {
+	return TypeConventions
+}
+
+// IsWeekend - returns true, if the day is a weekend day in the country, example: SAU.Conventions().IsWeekend(time.Friday) == true
+func (c *Conventions) IsWeekend(day time.Weekday) bool {
+	for _, weekend := range c.Weekend {
+		if weekend == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Type implements Typer interface
+func (_ ClockFormat) Type() string {
+	return TypeClockFormat
+}
+
+// String - implements fmt.Stringer, returns a clock format in english
+func (f ClockFormat) String() string {
+	switch f {
+	case Clock24:
+		return "24-hour"
+	case Clock12:
+		return "12-hour"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (f ClockFormat) IsValid() bool {
+	return f.String() != UnknownMsg
+}
+
+// Type implements Typer interface
+func (_ DateOrder) Type() string {
+	return TypeDateOrder
+}
+
+// String - implements fmt.Stringer, returns a date order, example: "DMY"
+func (o DateOrder) String() string {
+	switch o {
+	case DateOrderDMY:
+		return "DMY"
+	case DateOrderMDY:
+		return "MDY"
+	case DateOrderYMD:
+		return "YMD"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (o DateOrder) IsValid() bool {
+	return o.String() != UnknownMsg
+}
+
+// Layout - returns a time.Format layout of a numeric date in the order with the separator,
+// example: DateOrderDMY.Layout(".") == "02.01.2006"; an empty string for an unknown order
+func (o DateOrder) Layout(separator string) string {
+	switch o {
+	case DateOrderDMY:
+		return "02" + separator + "01" + separator + "2006"
+	case DateOrderMDY:
+		return "01" + separator + "02" + separator + "2006"
+	case DateOrderYMD:
+		return "2006" + separator + "01" + separator + "02"
+	}
+	return ""
+}
+
+// Type implements Typer interface
+func (_ MeasurementSystem) Type() string {
+	return TypeMeasurementSystem
+}
+
+// String - implements fmt.Stringer, returns a measurement system in english
+func (m MeasurementSystem) String() string {
+	switch m {
+	case MeasurementSystemMetric:
+		return "Metric"
+	case MeasurementSystemImperial:
+		return "Imperial"
+	case MeasurementSystemMixed:
+		return "Mixed"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (m MeasurementSystem) IsValid() bool {
+	return m.String() != UnknownMsg
+}
+
+// Type implements Typer interface
+func (_ PaperSize) Type() string {
+	return TypePaperSize
+}
+
+// String - implements fmt.Stringer, returns a paper size name
+func (p PaperSize) String() string {
+	switch p {
+	case PaperSizeA4:
+		return "A4"
+	case PaperSizeLetter:
+		return "Letter"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (p PaperSize) IsValid() bool {
+	return p.String() != UnknownMsg
+}
+
+// Type implements Typer interface
+func (_ DrivingSide) Type() string {
+	return TypeDrivingSide
+}
+
+// String - implements fmt.Stringer, returns a driving side in english
+func (d DrivingSide) String() string {
+	switch d {
+	case DrivingSideRight:
+		return "Right"
+	case DrivingSideLeft:
+		return "Left"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (d DrivingSide) IsValid() bool {
+	return d.String() != UnknownMsg
+}
+
+// Type implements Typer interface
+func (_ PlugType) Type() string {
+	return TypePlugType
+}
+
+// String - implements fmt.Stringer, returns the IEC letter of the plug type, example: PlugTypeF.String() == "F"
+func (p PlugType) String() string {
+	if p >= PlugTypeA && p <= PlugTypeO {
+		return string(rune('A' + p - PlugTypeA))
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (p PlugType) IsValid() bool {
+	return p.String() != UnknownMsg
+}
@@ -0,0 +1,74 @@
+package countries
+
+// TypeConventions for Typer interface
+const TypeConventions string = "countries.Conventions"
+
+// TypeClockFormat for Typer interface
+const TypeClockFormat string = "countries.ClockFormat"
+
+// TypeDateOrder for Typer interface
+const TypeDateOrder string = "countries.DateOrder"
+
+// TypeMeasurementSystem for Typer interface
+const TypeMeasurementSystem string = "countries.MeasurementSystem"
+
+// TypePaperSize for Typer interface
+const TypePaperSize string = "countries.PaperSize"
+
+// TypeDrivingSide for Typer interface
+const TypeDrivingSide string = "countries.DrivingSide"
+
+// TypePlugType for Typer interface
+const TypePlugType string = "countries.PlugType"
+
+const (
+	ClockUnknown ClockFormat = 0
+	Clock24      ClockFormat = 1 // 24-hour clock, example 18:30
+	Clock12      ClockFormat = 2 // 12-hour clock with AM/PM, example 6:30 PM
+)
+
+const (
+	DateOrderUnknown DateOrder = 0
+	DateOrderDMY     DateOrder = 1 // day, month, year, example 31.12.2024
+	DateOrderMDY     DateOrder = 2 // month, day, year, example 12/31/2024
+	DateOrderYMD     DateOrder = 3 // year, month, day, example 2024-12-31
+)
+
+const (
+	MeasurementSystemUnknown  MeasurementSystem = 0
+	MeasurementSystemMetric   MeasurementSystem = 1 // SI units
+	MeasurementSystemImperial MeasurementSystem = 2 // US customary units: miles, pounds, Fahrenheit
+	MeasurementSystemMixed    MeasurementSystem = 3 // metric with imperial units for roads and beverages, example GBR
+)
+
+const (
+	PaperSizeUnknown PaperSize = 0
+	PaperSizeA4      PaperSize = 1 // ISO 216 A4, 210 × 297 mm
+	PaperSizeLetter  PaperSize = 2 // US Letter, 8.5 × 11 in
+)
+
+const (
+	DrivingSideUnknown DrivingSide = 0
+	DrivingSideRight   DrivingSide = 1
+	DrivingSideLeft    DrivingSide = 2
+)
+
+// Plug types (IEC World Plugs letters)
+const (
+	PlugTypeUnknown PlugType = 0
+	PlugTypeA       PlugType = 1  // two flat pins, NEMA 1-15
+	PlugTypeB       PlugType = 2  // two flat pins and ground, NEMA 5-15
+	PlugTypeC       PlugType = 3  // two round pins, Europlug
+	PlugTypeD       PlugType = 4  // three large round pins, BS 546 5 A
+	PlugTypeE       PlugType = 5  // two round pins and female ground, French
+	PlugTypeF       PlugType = 6  // two round pins and ground clips, Schuko
+	PlugTypeG       PlugType = 7  // three rectangular pins, BS 1363
+	PlugTypeH       PlugType = 8  // three flat or round pins in a V, Israeli
+	PlugTypeI       PlugType = 9  // two flat pins in a V and ground, AS/NZS 3112
+	PlugTypeJ       PlugType = 10 // three round pins, Swiss
+	PlugTypeK       PlugType = 11 // two round pins and ground pin, Danish
+	PlugTypeL       PlugType = 12 // three round pins in a row, Italian
+	PlugTypeM       PlugType = 13 // three large round pins, BS 546 15 A
+	PlugTypeN       PlugType = 14 // three round pins, Brazilian and South African
+	PlugTypeO       PlugType = 15 // three round pins, Thai
+)
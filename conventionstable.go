@@ -0,0 +1,256 @@
+// Code generated by gen_conventions.go; DO NOT EDIT.
+
+package countries
+
+import "time"
+
+// conventionsTable - locale conventions of countries
+var conventionsTable = map[CountryCode]Conventions{
+	AU: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	AT: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	AZ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	AL: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	DZ: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	AS: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemImperial, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	AI: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	AO: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC}},
+	AD: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	AG: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	AE: {time.Saturday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD, PlugTypeG}},
+	AR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeI}},
+	AM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	AW: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB, PlugTypeF}},
+	AF: {time.Saturday, []time.Weekday{time.Thursday, time.Friday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	BS: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	BD: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeD, PlugTypeG, PlugTypeK}},
+	BB: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	BH: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeG}},
+	BY: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	BZ: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemMetric, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB, PlugTypeG}},
+	BE: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	BJ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	BM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	BG: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	BO: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeC}},
+	BA: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	BW: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeD, PlugTypeG, PlugTypeM}},
+	BR: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeN}},
+	IO: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	BN: {time.Monday, []time.Weekday{time.Friday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	BF: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	BI: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	BT: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeD, PlugTypeF, PlugTypeG, PlugTypeM}},
+	VU: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeI}},
+	VA: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF, PlugTypeL}},
+	GB: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMixed, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	HU: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	VE: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeLetter, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	VG: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	VI: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemImperial, PaperSizeLetter, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	TL: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeE, PlugTypeF, PlugTypeI}},
+	VN: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeC}},
+	GA: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC}},
+	HT: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	GY: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB, PlugTypeD, PlugTypeG}},
+	GM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeG}},
+	GH: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeD, PlugTypeG}},
+	GP: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	GT: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	GN: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF, PlugTypeK}},
+	GW: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC}},
+	DE: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	GI: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeG}},
+	HN: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	HK: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	GD: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	GL: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE, PlugTypeF, PlugTypeK}},
+	GR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	GE: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	GU: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemImperial, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	DK: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE, PlugTypeF, PlugTypeK}},
+	CD: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD, PlugTypeE}},
+	DJ: {time.Saturday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	DM: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeD, PlugTypeG}},
+	DO: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	EG: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	ZM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeD, PlugTypeG}},
+	EH: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	ZW: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeD, PlugTypeG}},
+	IL: {time.Sunday, []time.Weekday{time.Friday, time.Saturday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeH, PlugTypeM}},
+	IN: {time.Sunday, []time.Weekday{time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeD, PlugTypeM}},
+	ID: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	JO: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeB, PlugTypeC, PlugTypeD, PlugTypeF, PlugTypeG, PlugTypeJ}},
+	IQ: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD, PlugTypeG}},
+	IR: {time.Saturday, []time.Weekday{time.Friday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	IE: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	IS: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	ES: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	IT: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF, PlugTypeL}},
+	YE: {time.Sunday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeD, PlugTypeG}},
+	KZ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	KY: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	KH: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeC, PlugTypeG}},
+	CM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	CA: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderYMD, MeasurementSystemMetric, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	QA: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeD, PlugTypeG}},
+	KE: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	CY: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideLeft, []PlugType{PlugTypeG}},
+	KI: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	CN: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeC, PlugTypeI}},
+	CC: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	CO: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeLetter, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	KM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	CG: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	KP: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeC}},
+	KR: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	CR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeLetter, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	CI: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	CU: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB, PlugTypeC, PlugTypeL}},
+	KW: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeG}},
+	KG: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	LA: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB, PlugTypeC, PlugTypeE, PlugTypeF}},
+	LV: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	LS: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeM}},
+	LR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemImperial, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	LB: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB, PlugTypeC, PlugTypeD, PlugTypeG}},
+	LY: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeL}},
+	LT: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	LI: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", "’", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeJ}},
+	LU: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	MU: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeG}},
+	MR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC}},
+	MG: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD, PlugTypeE, PlugTypeJ, PlugTypeK}},
+	YT: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	MO: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeD, PlugTypeG}},
+	MK: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	MW: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	MY: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	ML: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	MV: {time.Friday, []time.Weekday{time.Friday, time.Saturday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeD, PlugTypeG, PlugTypeJ, PlugTypeK, PlugTypeL}},
+	MT: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	MP: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemImperial, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	MA: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	MQ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	MH: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	MX: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	FM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	MZ: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeF, PlugTypeM}},
+	MD: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	MC: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD, PlugTypeE, PlugTypeF}},
+	MN: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	MS: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	MM: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemImperial, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD, PlugTypeF, PlugTypeG}},
+	NA: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeD, PlugTypeM}},
+	NR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	NP: {time.Sunday, []time.Weekday{time.Saturday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeD, PlugTypeM}},
+	NE: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB, PlugTypeC, PlugTypeD, PlugTypeE, PlugTypeF}},
+	NG: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeD, PlugTypeG}},
+	NL: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	NI: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	NU: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	NZ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	NC: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	NO: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	OM: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeG}},
+	IM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	NF: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	PN: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	CX: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	SH: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	WF: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	CV: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	CK: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	WS: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	SJ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	TC: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	UM: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemImperial, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	PK: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeD}},
+	PW: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	PS: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeH}},
+	PA: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	PG: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	PY: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC}},
+	PE: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeC}},
+	PL: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	PT: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	PR: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemImperial, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	RE: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	RU: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	RW: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeJ}},
+	RO: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	SV: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	SM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF, PlugTypeL}},
+	ST: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	SA: {time.Sunday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeG}},
+	SZ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeM}},
+	SC: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	SN: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD, PlugTypeE, PlugTypeK}},
+	PM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	VC: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeC, PlugTypeE, PlugTypeG, PlugTypeI, PlugTypeK}},
+	KN: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeD, PlugTypeG}},
+	LC: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	SG: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	SY: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE, PlugTypeL}},
+	SK: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	SI: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	US: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemImperial, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	SB: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG, PlugTypeI}},
+	SO: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC}},
+	SD: {time.Saturday, []time.Weekday{time.Friday, time.Saturday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD}},
+	SR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeF}},
+	SL: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeD, PlugTypeG}},
+	TJ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	TW: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	TH: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB, PlugTypeC, PlugTypeO}},
+	TZ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeD, PlugTypeG}},
+	TG: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC}},
+	TK: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	TO: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	TT: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	TV: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	TN: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	TM: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	TR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	UG: {time.Monday, []time.Weekday{time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	UZ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	UA: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	UY: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF, PlugTypeI, PlugTypeL}},
+	FO: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE, PlugTypeF, PlugTypeK}},
+	FJ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeI}},
+	PH: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderMDY, MeasurementSystemMetric, PaperSizeLetter, ".", ",", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB, PlugTypeC}},
+	FI: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	FK: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	FR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	GF: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	PF: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	TF: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	HR: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	CF: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	TD: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD, PlugTypeE, PlugTypeF}},
+	CZ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	CL: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeLetter, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeL}},
+	CH: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", "’", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeJ}},
+	SE: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	LK: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeD, PlugTypeG}},
+	EC: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	GQ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC}},
+	ER: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeL}},
+	EE: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	ET: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE, PlugTypeF, PlugTypeL}},
+	ZA: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideLeft, []PlugType{PlugTypeC, PlugTypeD, PlugTypeM, PlugTypeN}},
+	GS: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	JM: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	ME: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	BL: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	SX: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	RS: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	AX: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+	BQ: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	GG: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	JE: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeG}},
+	CW: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", ".", DrivingSideRight, []PlugType{PlugTypeA, PlugTypeB}},
+	MF: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeE}},
+	SS: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock12, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeD}},
+	JP: {time.Sunday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderYMD, MeasurementSystemMetric, PaperSizeA4, ".", ",", DrivingSideLeft, []PlugType{PlugTypeA, PlugTypeB}},
+	XK: {time.Monday, []time.Weekday{time.Saturday, time.Sunday}, Clock24, DateOrderDMY, MeasurementSystemMetric, PaperSizeA4, ",", "\u00a0", DrivingSideRight, []PlugType{PlugTypeC, PlugTypeF}},
+}
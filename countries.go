@@ -16,6 +16,17 @@ type Country struct {
 	FIPS         string            `json:"fips"`             // FIPS 代码
 	IOC          string            `json:"ioc"`              // IOC 代码
 	FIFA         string            `json:"fifa"`             // FIFA 代码
+	ITU          string            `json:"itu"`              // ITU 代码
+	ICAO         string            `json:"icao"`             // ICAO 航空器国籍标志
+	IVR          string            `json:"ivr"`              // 国际车辆识别代码
+	WMO          string            `json:"wmo"`              // WMO 代码
+	GAUL         int               `json:"gaul"`             // FAO GAUL 代码
+	MARC         string            `json:"marc"`             // MARC 代码
+	STANAG       string            `json:"stanag"`           // STANAG 1059 代码
+	UNDP         string            `json:"undp"`             // UNDP 代码
+	WikidataID   string            `json:"wikidataId"`       // Wikidata ID
+	GeoNamesID   int               `json:"geonamesId"`       // GeoNames ID
+	OSMRelation  int               `json:"osmRelationId"`    // OpenStreetMap 关系 ID
 	Emoji        string            `json:"emoji"`            // Emoji 表情
 	Code         CountryCode       `json:"code"`             // 国家代码
 	Currency     CurrencyCode      `json:"currency"`         // 货币代码
@@ -24,6 +35,13 @@ type Country struct {
 	Domain       DomainCode        `json:"domain"`           // 域名代码
 	Region       RegionCode        `json:"region"`           // 地区代码
 	Subdivisions []SubdivisionCode `json:"subdivisionCodes"` // 子区域代码
+	AreaKm2      float64           `json:"area"`             // 面积（平方公里）
+	Landlocked   bool              `json:"landlocked"`       // 是否为内陆国
+	Neighbors    []CountryCode     `json:"borders"`          // 陆地邻国
+	TimeZones    []string          `json:"timezones"`        // IANA 时区
+	Languages    []*Language       `json:"languages"`        // 语言
+	Conventions  *Conventions      `json:"conventions"`      // 区域惯例
+	PostalCode   *PostalCodeFormat `json:"postalCode"`       // 邮政编码格式
 }
 
 // Typer - typer interface, provide a name of type
@@ -1315,7 +1333,7 @@ func (c CountryCode) FIPS() string {
 	case 328:
 		return "GY"
 	case 332:
-		return "GA"
+		return "HA"
 	case 334:
 		return "HM"
 	case 340:
@@ -2740,25 +2758,154 @@ func (c CountryCode) Alpha3() string { //nolint:gocyclo
 	return UnknownMsg
 }
 
-// FIFA - returns a FIFA (AFC, CAF, CONCACAF, CONMEBOL, OFC and UEFA) three-letter country code
-func (c CountryCode) FIFA() string {
+// FIFA - returns a FIFA (AFC, CAF, CONCACAF, CONMEBOL, OFC and UEFA) three-letter country code,
+// Alpha-3 for territories without a FIFA member association
+//
+//nolint:gocyclo
+func (c CountryCode) FIFA() string { //nolint:gocyclo
 	switch c {
 	case GBR:
 		return "ENG"
-	case VNM:
-		return "VIE"
+	case DZA:
+		return "ALG"
+	case ASM:
+		return "ASA"
+	case AGO:
+		return "ANG"
+	case ABW:
+		return "ARU"
+	case BHS:
+		return "BAH"
+	case BGD:
+		return "BAN"
+	case BMU:
+		return "BER"
+	case BTN:
+		return "BHU"
+	case BWA:
+		return "BOT"
+	case BRN:
+		return "BRU"
+	case BGR:
+		return "BUL"
+	case KHM:
+		return "CAM"
+	case CYM:
+		return "CAY"
+	case CAF:
+		return "CTA"
+	case TCD:
+		return "CHA"
+	case CHL:
+		return "CHI"
+	case TWN:
+		return "TPE"
+	case COG:
+		return "CGO"
+	case CRI:
+		return "CRC"
+	case HRV:
+		return "CRO"
+	case DNK:
+		return "DEN"
+	case GNQ:
+		return "EQG"
+	case FJI:
+		return "FIJ"
+	case GMB:
+		return "GAM"
 	case DEU:
 		return "GER"
+	case GRC:
+		return "GRE"
+	case GRD:
+		return "GRN"
+	case GTM:
+		return "GUA"
+	case GIN:
+		return "GUI"
+	case HTI:
+		return "HAI"
+	case HND:
+		return "HON"
+	case XKX:
+		return "KVX"
+	case KWT:
+		return "KUW"
+	case LSO:
+		return "LES"
+	case MDG:
+		return "MAD"
+	case MYS:
+		return "MAS"
+	case MRT:
+		return "MTN"
+	case MUS:
+		return "MRI"
+	case MMR:
+		return "MYA"
+	case NPL:
+		return "NEP"
 	case NLD:
 		return "NED"
+	case NIC:
+		return "NCA"
+	case NER:
+		return "NIG"
+	case OMN:
+		return "OMA"
 	case PSE:
 		return "PLE"
-	case TWN:
-		return "TPE"
-	case HRV:
-		return "CRO"
-	case CAF:
-		return "CTA"
+	case PRY:
+		return "PAR"
+	case PHL:
+		return "PHI"
+	case PRT:
+		return "POR"
+	case PRI:
+		return "PUR"
+	case KNA:
+		return "SKN"
+	case VCT:
+		return "VIN"
+	case WSM:
+		return "SAM"
+	case SAU:
+		return "KSA"
+	case SYC:
+		return "SEY"
+	case SGP:
+		return "SIN"
+	case SLB:
+		return "SOL"
+	case ZAF:
+		return "RSA"
+	case LKA:
+		return "SRI"
+	case CHE:
+		return "SUI"
+	case PYF:
+		return "TAH"
+	case TZA:
+		return "TAN"
+	case TGO:
+		return "TOG"
+	case TON:
+		return "TGA"
+	case TTO:
+		return "TRI"
+	case ARE:
+		return "UAE"
+	case URY:
+		return "URU"
+	case VUT:
+		return "VAN"
+	case VNM:
+		return "VIE"
+	case ZMB:
+		return "ZAM"
+	case ZWE:
+		return "ZIM"
 	}
 	return c.Alpha3()
 }
@@ -2856,8 +3003,6 @@ func (c CountryCode) IOC() string { //nolint:gocyclo
 		return `LES`
 	case LVA:
 		return `LAT`
-	case LBN:
-		return `LIB`
 	case LBY:
 		return `LBA`
 	case MDG:
@@ -5320,6 +5465,17 @@ func (c CountryCode) Info() *Country {
 		FIPS:         c.FIPS(),
 		IOC:          c.IOC(),
 		FIFA:         c.FIFA(),
+		ITU:          c.ITU(),
+		ICAO:         c.ICAO(),
+		IVR:          c.IVR(),
+		WMO:          c.WMO(),
+		GAUL:         c.GAUL(),
+		MARC:         c.MARC(),
+		STANAG:       c.STANAG(),
+		UNDP:         c.UNDP(),
+		WikidataID:   c.WikidataID(),
+		GeoNamesID:   c.GeoNamesID(),
+		OSMRelation:  c.OSMRelationID(),
 		Emoji:        c.Emoji(),
 		Code:         c,
 		Capital:      c.Capital(),
@@ -5328,6 +5484,13 @@ func (c CountryCode) Info() *Country {
 		Domain:       c.Domain(),
 		Region:       c.Region(),
 		Subdivisions: c.Subdivisions(),
+		AreaKm2:      c.AreaKm2(),
+		Landlocked:   c.Landlocked(),
+		Neighbors:    c.Neighbors(),
+		TimeZones:    c.TimeZones(),
+		Languages:    c.Languages(),
+		Conventions:  c.Conventions(),
+		PostalCode:   c.PostalCode(),
 	}
 }
 
@@ -5373,14 +5536,31 @@ func AllInfo() []*Country {
 }
 
 // ByName - return CountryCode by country Alpha-2 / Alpha-3 / name, case-insensitive, example: rus := ByName("Ru") OR rus := ByName("russia"),
+// names in any of NameLanguages are accepted too, example: deu := ByName("Deutschland") OR jpn := ByName("日本"),
+// as well as pinyin with or without tones and transliterations, example: jpn := ByName("Rìběn") OR deu := ByName("Germaniya"),
+// diacritics are ignored, the whole name and the exact localized name are tried before the name without brackets and a name in brackets
+// is an alternate (never a code), example: hkg := ByName("RAE de Hong Kong (China)") OR sxm := ByName("Saint-Martin (partie néerlandaise)"),
+// civ := ByName("Cote d'Ivoire") OR civ := ByName("Elfenbeinküste (Ivory Coast)") OR vir := ByName("Virgin Islands (U.S.)"),
 // returns countries.Unknown, if country name not found or not valid
-//
-//nolint:misspell,gocyclo
-func ByName(name string) CountryCode { //nolint:misspell,gocyclo
-	switch textPrepare(name) {
+func ByName(name string) CountryCode {
+	code := Unknown
+	found := func(c CountryCode) bool {
+		code = c
+		return code != Unknown
+	}
+	if textLookup(name, func(key string) bool { return found(countryByKey(key)) },
+		func(name string) bool { return found(countryByExactName(name)) }) {
+		return code
+	}
+	return countryByLocalizedName(name)
+}
+
+// countryByKey - returns CountryCode by a key of textPrepare, returns Unknown, if the key not found
+func countryByKey(key string) CountryCode { //nolint:misspell,gocyclo
+	switch key {
 	case "AU", "AUS", "AUSTRALIA", "AVSTRALIA", "AVSTRALIYA", "AUSTRALIYA", "AUSTRALIEN":
 		return AUS
-	case "AT", "AUT", "AUSTRIA", "AVSTRIA", "AUSTRIYA", "AVSTRIYA", "ÖSTERREICH", "OESTERREICH":
+	case "AT", "AUT", "AUSTRIA", "AVSTRIA", "AUSTRIYA", "AVSTRIYA", "OSTERREICH", "OESTERREICH":
 		return AUT
 	case "AZ", "AZE", "AZERBAIJAN", "AYZERBAIJAN", "AZERBAIDJAN", "AYZERBAIDJAN", "ASERBAIDSCHAN":
 		return AZE
@@ -5402,7 +5582,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return ATA
 	case "AG", "ATG", "ANTIGUAANDBARBUDA", "ANTIGUABARBUDA", "ANTIGUA", "ANTIGUAUNDBARBUDA":
 		return ATG
-	case "AN", "ANT", "AHO", "ANHH", "NETHERLANDSANTILLES", "NETHERLSANTILLES", "NETHERLANDSANTILES", "NETHERLSANTILES", "NIEDERLAENDISCHEANTILLEN", "NIEDERLÄNDISCHANTILLEN":
+	case "AN", "ANT", "AHO", "ANHH", "NETHERLANDSANTILLES", "NETHERLSANTILLES", "NETHERLANDSANTILES", "NETHERLSANTILES", "NIEDERLAENDISCHEANTILLEN", "NIEDERLANDISCHANTILLEN":
 		return ANT
 	case "AE", "ARE", "UAE", "UNITEDARABEMIRATES", "ARABEMIRATES", "UNITEDEMIRATES", "VEREINIGTEARABISCHEEMIRATE":
 		return ARE
@@ -5456,7 +5636,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return VUT
 	case "VA", "VAT", "HOLYSEEVATICAN", "HOLYSEE", "VATICAN", "VATICANCITYSTATE", "VATICANSTATE", "HOLYSEEVATIKAN", "VATIKAN", "VATIKANCITYSTATE", "VATIKANSTATE", "HOLYSEEVATIKANCITYSTATE", "VATIKANSTADT", "VATICANCITY", "CITYVATICAN":
 		return VAT
-	case "GB", "DG", "GBR", "ADN", "DGA", "UNITEDKINGDOM", "UNITEDKINDOM", "UK", "GREATBRITAN", "GREATBRITAIN", "NORTHERNIRELAND", "BRITAN", "BRITAIN", "GROSSBRITANNIEN", "VEREINIGTESKÖNIGREICH", "VEREINIGTESKOENIGREICH": //nolint
+	case "GB", "DG", "GBR", "ADN", "DGA", "UNITEDKINGDOM", "UNITEDKINDOM", "UK", "GREATBRITAN", "GREATBRITAIN", "NORTHERNIRELAND", "BRITAN", "BRITAIN", "GROSSBRITANNIEN", "VEREINIGTESKONIGREICH", "VEREINIGTESKOENIGREICH": //nolint
 		return GBR
 	case "HU", "HUN", "HUNGARY", "HUNGAR", "HUNGARI", "VENGRIYA", "VENGRIA", "UNGARN":
 		return HUN
@@ -5468,7 +5648,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return VIR
 	case "TL", "TP", "TLS", "TMP", "TPTL", "TIMORLESTE", "EASTTIMOR", "TIMOR", "TIMORELESTE", "EASTTIMORE", "TIMORE", "TIMORLESTEEASTTIMORE", "OSTTIMOR":
 		return TLS
-	case "VN", "VNM", "VIE", "VDR", "VD", "VIETNAM", "VETNAM", "VIETNAME", "VETNAME", "VDVN", "VIỆTNAM", "CỘNGHÒAXÃHỘICHỦNGHĨAVIỆTNAM", "CHỦNGHĨAVIỆTNAM", "NGHĨAVIỆTNAM":
+	case "VN", "VNM", "VIE", "VDR", "VD", "VIETNAM", "VETNAM", "VIETNAME", "VETNAME", "VDVN", "CONGHOAXAHOICHUNGHIAVIETNAM", "CHUNGHIAVIETNAM", "NGHIAVIETNAM":
 		return VNM
 	case "GA", "GAB", "GABON", "GABUN":
 		return GAB
@@ -5498,7 +5678,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return HKG
 	case "GD", "GRD", "GRENADA", "GRINADA", "WG":
 		return GRD
-	case "GL", "GRL", "GREENLAND", "GRÖNLAND", "GROENLAND":
+	case "GL", "GRL", "GREENLAND", "GRONLAND", "GROENLAND":
 		return GRL
 	case "GR", "GRC", "GREECE", "GRECE", "GRIECHENLAND", "GRECIYA":
 		return GRC
@@ -5506,7 +5686,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return GEO
 	case "GU", "GUM", "GUAM":
 		return GUM
-	case "DK", "DNK", "DENMARK", "DANMARK", "DÄNEMARK", "DAENEMARK", "KONGERIGETDANMARK", "DANMARKKONGERIGET", "DANIYA":
+	case "DK", "DNK", "DENMARK", "DANMARK", "DANEMARK", "DAENEMARK", "KONGERIGETDANMARK", "DANMARKKONGERIGET", "DANIYA":
 		return DNK
 	case "CD", "COD", "ZRE", "ZAR", "ZR", "ZRCD", "CONGODEMOCRATICREPUBLIC", "DEMOCRATICREPUBLICOFTHECONGO", "CONGODEMOCRATICREP", "CONGODEMOCRATIC", "CONGOTHEDEMOCRATICREPUBLICOF", "CONGOTHEDEMOCRATICREPUBLIC", "KONGODEMOCRACTICREPUBLIC", "KONGODEMOCRATICREP", "KONGODEMOCRATIC", "KONGOTHEDEMOCRATICREPUBLICOF", "ZAIRE", "ZAIR", "DEMOKRATISCHEREPUBLIKKONGO", "CONGOREPUBLIC", "KONGOREPUBLIC", "REPUBLICOFCONGO", "REPUBLICOFKONGO", "CONGOTHEDEMOCRATICREPUBLICOFTHE", "DRCONGO":
 		return COD
@@ -5516,7 +5696,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return DMA
 	case "DO", "DOM", "DOMINICANREPUBLIC", "DOMINICANA", "DOMINIKANA", "DOMINIKANISCHEREPUBLIK":
 		return DOM
-	case "EG", "EGY", "EGYPT", "ÄGYPTEN", "AEGYPTEN":
+	case "EG", "EGY", "EGYPT", "AGYPTEN", "AEGYPTEN":
 		return EGY
 	case "ZM", "ZMB", "RNR", "ZAMBIA", "SAMBIA":
 		return ZMB
@@ -5576,11 +5756,11 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return COG
 	case "KP", "PRK", "DEMOCRATICPEOPLESREPUBLICOFKOREA", "KOREADEMOCRATICPEOPLESREPUBLICOF", "KOREADEMOCRATICPEOPLESREPUBLIC", "KOREANORTH", "NORTHKOREA", "NORDKOREA":
 		return PRK
-	case "KR", "KOR", "ROK", "KOREA", "KOREYA", "SOUTHKOREA", "KOREAREPUBLICOF", "KOREAREPUBLIC", "REPUBLICOFKOREA", "KOREAREPOF", "SÜDKOREA", "SUEDKOREA":
+	case "KR", "KOR", "ROK", "KOREA", "KOREYA", "SOUTHKOREA", "KOREAREPUBLICOF", "KOREAREPUBLIC", "REPUBLICOFKOREA", "KOREAREPOF", "SUDKOREA", "SUEDKOREA":
 		return KOR
 	case "CR", "CRI", "COSTARICA", "KOSTARIKA", "KOSTARICA", "COSTARIKA":
 		return CRI
-	case "CI", "CIV", "COTEDIVOIRE", "CÔTEDIVOIRE", "IVORYCOAST", "ELFENBEINKÜSTE", "ELFENBEINKUESTE":
+	case "CI", "CIV", "COTEDIVOIRE", "IVORYCOAST", "ELFENBEINKUSTE", "ELFENBEINKUESTE":
 		return CIV
 	case "CU", "CUB", "CUBA", "CUBAREPUBLIC", "REPUBLICCUBA", "KUBA":
 		return CUB
@@ -5628,7 +5808,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return MDV
 	case "MT", "MLT", "MALTA":
 		return MLT
-	case "MP", "MNP", "NORTHERNMARIANAISLANDS", "NORTHERNMARIANAIS", "MARIANAISLANDS", "NÖRDLICHEMARIANEN", "NOERDLICHEMARIANEN":
+	case "MP", "MNP", "NORTHERNMARIANAISLANDS", "NORTHERNMARIANAIS", "MARIANAISLANDS", "NORDLICHEMARIANEN", "NOERDLICHEMARIANEN":
 		return MNP
 	case "MA", "MAR", "MOROCCO", "MOROCO", "MOROKO", "MAROKKO":
 		return MAR
@@ -5708,7 +5888,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return PAK
 	case "PW", "PLW", "PALAU":
 		return PLW
-	case "PS", "PSE", "PLE", "PALESTINE", "PALESTINA", "PALESTINIAN", "PALESTINIANTERRITORY", "PALÄSTINA", "PALAESTINA", "OCCUPIEDPALESTINIANTERRITORY":
+	case "PS", "PSE", "PLE", "PALESTINE", "PALESTINA", "PALESTINIAN", "PALESTINIANTERRITORY", "PALASTINA", "PALAESTINA", "OCCUPIEDPALESTINIANTERRITORY":
 		return PSE
 	case "PA", "PAN", "PCZ", "PANAMA", "PANAMIAN", "PANAM", "PZ", "PZPA":
 		return PAN
@@ -5724,19 +5904,19 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return PRT
 	case "PR", "PRI", "PUERTORICO", "PUERTORIKO":
 		return PRI
-	case "RE", "REU", "REUNION", "RÉUNION":
+	case "RE", "REU", "REUNION":
 		return REU
 	case "RU", "RUS", "SUN", "RUSSIA", "RUSSO", "RUSSISH", "RUSSLAND", "RUSLAND", "RUSIA", "ROSSIA", "ROSSIYA", "RUSSIAN", "RUSSIANFEDERATION", "USSR":
 		return RUS
 	case "RW", "RWA", "RWANDA", "RUANDA", "RUWANDA":
 		return RWA
-	case "RO", "ROU", "ROM", "ROMANIA", "RUMINIA", "RUMINIYA", "RUMÄNIEN", "RUMAENIEN":
+	case "RO", "ROU", "ROM", "ROMANIA", "RUMINIA", "RUMINIYA", "RUMANIEN", "RUMAENIEN":
 		return ROU
 	case "SV", "SLV", "ESA", "ELSALVADOR":
 		return SLV
 	case "SM", "SMR", "RSM", "SANMARINO":
 		return SMR
-	case "ST", "STP", "SAOTOMEANDPRINCIPE", "SAOTOME", "SAOTOMEUNDPRINCIPE", "SÃOTOMÉANDPRÍNCIPE":
+	case "ST", "STP", "SAOTOMEANDPRINCIPE", "SAOTOME", "SAOTOMEUNDPRINCIPE":
 		return STP
 	case "SA", "SAU", "SAUDIARABIA", "SAUDI", "SAUDIARABIEN":
 		return SAU
@@ -5768,7 +5948,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return SLB
 	case "SO", "SOM", "SOMALIA", "SOMALI":
 		return SOM
-	case "SD", "SDN", "SUDAN", "SUDANE", "UMHŪRIYYATASSŪDĀN", "SŪDĀN", "جمهوريةالسودان", "السودان":
+	case "SD", "SDN", "SUDAN", "SUDANE", "UMHURIYYATASSUDAN", "جمهوريةالسودان", "السودان":
 		return SDN
 	case "SR", "SUR", "SME", "SURINAME", "SURINAM":
 		return SUR
@@ -5796,7 +5976,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return TUN
 	case "TM", "TKM", "TMN", "TURKMENISTAN", "TURKMENISTON", "TURKMENI", "TURKMENIA", "TURKMENIYA":
 		return TKM
-	case "TR", "TUR", "TURKEY", "TURCIA", "TURKISH", "TÜRKEI", "TUERKEI", "TÜRKIYE", "REPUBLICOFTÜRKIYE", "TÜRKIYEREPUBLICOF", "TÜRKIYEREPUBLIC", "REPUBLICTÜRKIYE":
+	case "TR", "TUR", "TURKEY", "TURCIA", "TURKISH", "TURKEI", "TUERKEI", "TURKIYE", "REPUBLICOFTURKIYE", "TURKIYEREPUBLICOF", "TURKIYEREPUBLIC", "REPUBLICTURKIYE":
 		return TUR
 	case "UG", "UGA", "EAU", "UGANDA":
 		return UGA
@@ -5808,7 +5988,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return URY
 	case "XW", "XWA", "WALES":
 		return XWA
-	case "FO", "FRO", "FAROEISLANDS", "FAROE", "FÄRÖER", "FAEROERER":
+	case "FO", "FRO", "FAROEISLANDS", "FAROE", "FAROER", "FAEROERER":
 		return FRO
 	case "FJ", "FJI", "FIJI", "FIDSCHI":
 		return FJI
@@ -5820,11 +6000,11 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return FLK
 	case "FR", "CP", "FX", "FRA", "FXX", "CPT", "FXFR", "FRANCE", "FRENCH", "FRANKREICH":
 		return FRA
-	case "GF", "GUF", "FRENCHGUIANA", "GUIANA", "FRANZÖSISCHGUYANA", "FRANZOESISCHGUYANA":
+	case "GF", "GUF", "FRENCHGUIANA", "FRANZOSISCHGUYANA", "FRANZOESISCHGUYANA":
 		return GUF
-	case "PF", "PYF", "FRENCHPOLYNESIA", "POLYNESIA", "FRANZÖSISCHPOLYNESIEN", "FRANZOESISCHPOLYNESIEN":
+	case "PF", "PYF", "FRENCHPOLYNESIA", "POLYNESIA", "FRANZOSISCHPOLYNESIEN", "FRANZOESISCHPOLYNESIEN":
 		return PYF
-	case "TF", "ATF", "FRENCHSOUTHERNTERRITORIES", "SOUTHERNTERRITORIESFRENCH", "FRANZÖSISCHESÜDUNDANTARKTISGEBIETE", "FRANZOESISCHESUEDUNDANTARKTISGEBIETE":
+	case "TF", "ATF", "FRENCHSOUTHERNTERRITORIES", "SOUTHERNTERRITORIESFRENCH", "FRANZOSISCHESUDUNDANTARKTISGEBIETE", "FRANZOESISCHESUEDUNDANTARKTISGEBIETE":
 		return ATF
 	case "HR", "HRV", "CRO", "CROATIA", "KROATIA", "KROATIEN":
 		return HRV
@@ -5846,31 +6026,31 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return LKA
 	case "EC", "ECU", "ECUADOR":
 		return ECU
-	case "GQ", "GNQ", "EQG", "GEQ", "EQUATORIALGUINEA", "ÄQUATORIALGUINEA", "AEQUATORIALGUINEA":
+	case "GQ", "GNQ", "EQG", "GEQ", "EQUATORIALGUINEA", "AQUATORIALGUINEA", "AEQUATORIALGUINEA":
 		return GNQ
 	case "ER", "ERI", "ERITREA":
 		return ERI
 	case "EE", "EST", "ESTONIA", "EW", "ESTLAND":
 		return EST
-	case "ET", "ETH", "ETHIOPIA", "ÄTHOPIEN", "AETHOPIEN":
+	case "ET", "ETH", "ETHIOPIA", "ATHOPIEN", "AETHOPIEN":
 		return ETH
-	case "ZA", "ZAF", "SOUTHAFRICA", "SÜDAFRIKA", "SUEDAFRIKA":
+	case "ZA", "ZAF", "SOUTHAFRICA", "SUDAFRIKA", "SUEDAFRIKA":
 		return ZAF
 	case "YU", "YUG", "YUGOSLAVIA", "UGOSLAVIA", "YUGOSLAVIYA", "UGOSLAVIYA", "SERBIAANDMONTENEGRO", "CS", "SCG", "JUGOSLAWIEN":
 		return YUG
-	case "GS", "SGS", "SOUTHGEORGIAANDTHESOUTHSANDWICHISLANDS", "SOUTHGEORGIAANDTHESOUTHSANDWICH", "SOUTHGEORGIATHESOUTHSWICHISLANDS", "SOUTHGEORGIA", "SÜDGEORGIEN", "SUEDGEORGIEN":
+	case "GS", "SGS", "SOUTHGEORGIAANDTHESOUTHSANDWICHISLANDS", "SOUTHGEORGIAANDTHESOUTHSANDWICH", "SOUTHGEORGIATHESOUTHSWICHISLANDS", "SOUTHGEORGIA", "SUDGEORGIEN", "SUEDGEORGIEN":
 		return SGS
 	case "JM", "JAM", "JAMAICA", "JAMAIKA", "YAMAICA", "YAMAIKA", "JA":
 		return JAM
 	case "ME", "MNE", "MONTENEGRO":
 		return MNE
-	case "BL", "BLM", "SAINTBARTHELEMY", "STBARTHELEMY", "SAINTBARTHÉLEMY", "STBARTHÉLEMY":
+	case "BL", "BLM", "SAINTBARTHELEMY", "STBARTHELEMY":
 		return BLM
 	case "SX", "SXM", "SINTMAARTENDUTCH", "SAINTMAARTEN", "SINTMAARTEN", "STMAARTEN":
 		return SXM
 	case "RS", "SRB", "CSXX", "SERBIA", "SERBIYA", "SERBIEN":
 		return SRB
-	case "AX", "ALA", "ALANDISLANDS", "ISLANDSALAND", "ALAND", "ÅLANDISLANDS", "ÅLAND", "ISLANDSÅLAND":
+	case "AX", "ALA", "ALANDISLANDS", "ISLANDSALAND", "ALAND":
 		return ALA
 	case "BQ", "BES", "BONAIRE", "BONAIR", "BONEIRU", "BONAIRESINTEUSTATIUSANDSABA", "BONAIRESINTEUSTATIUSSABA", "BONAIRESTEUSTANDSABA", "BONAIRESTEUSTSABA", "SINTEUSTATIUSANDSABA", "SINTEUSTATIUS", "CARIBBEANNETHERLANDS":
 		return BES
@@ -5878,15 +6058,15 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return GGY
 	case "JE", "JEY", "GBJ", "JERSEY", "JERSIEY":
 		return JEY
-	case "CW", "CUW", "CURACAO", "CURAÇAO", "CURAQAO", "CURAKAO", "KURACAO", "KURAKAO":
+	case "CW", "CUW", "CURACAO", "CURAQAO", "CURAKAO", "KURACAO", "KURAKAO":
 		return CUW
 	case "MF", "MAF", "SAINTMARTINFRENCH", "STMARTINFRENCH", "SANKTMARTIN", "SAINTMARTIN":
 		return MAF
-	case "SS", "SSD", "SOUTHSUDAN", "SOUTHSUDANE", "REPUBLICOFSOUTHSUDAN", "SOUTHSUDANREPUBLICOF", "SOUTHSUDANREPUBLIC", "PAGUOTTHUDÄN", "SÜDSUDAN", "SUEDSUDAN":
+	case "SS", "SSD", "SOUTHSUDAN", "SOUTHSUDANE", "REPUBLICOFSOUTHSUDAN", "SOUTHSUDANREPUBLICOF", "SOUTHSUDANREPUBLIC", "PAGUOTTHUDAN", "SUDSUDAN", "SUEDSUDAN":
 		return SSD
 	case "JP", "JPN", "JAPAN":
 		return JPN
-	case "XK", "XKX", "XKS", "KOS", "KOSOVO", "COSOVO", "КОСОВО", "KOSOVËS", "РЕПУБЛИКАКОСОВО", "REPUBLIKAKOSOVO", "REPUBLIKACOSOVO", "REPUBLIKAKOSOVËS", "REPUBLICAKOSOVO", "REPUBLICACOSOVO", "REPUBLICAKOSOVËS", "KOSOVOREPUBLIC", "COSOVOREPUBLIC", "KOSOVËSREPUBLIC":
+	case "XK", "XKX", "XKS", "KOS", "KOSOVO", "COSOVO", "КОСОВО", "KOSOVES", "РЕПУБЛИКАКОСОВО", "REPUBLIKAKOSOVO", "REPUBLIKACOSOVO", "REPUBLIKAKOSOVES", "REPUBLICAKOSOVO", "REPUBLICACOSOVO", "REPUBLICAKOSOVES", "KOSOVOREPUBLIC", "COSOVOREPUBLIC", "KOSOVESREPUBLIC":
 		return XKX
 	case "XX", "NONE", "NON", "NICHT", "NICHTS":
 		return None
@@ -5910,7 +6090,7 @@ func ByName(name string) CountryCode { //nolint:misspell,gocyclo
 		return NonCountryDisasterRelief
 	case "IPRS", "INTERNATIONALPREMIUMRATESERVICE", "PREMIUMRATESERVICE", "INTERNATIONALPREMIUMRATESERVICES", "PREMIUMRATESERVICES":
 		return NonCountryInternationalPremiumRateService
-	case "ITPCS", "INTERNATIONALTELECOMMUNICATIONSPUBLICCORRESPONDENCESERVICETRIAL", "INTERNATIONALTELECOMMUNICATIONSPUBLICCORRESPONDENCESERVICE", "InternationalTELECOMMUNICATIONSPUBLICCORRESPONDENCESERVICES", "InternationalTELECOMMUNICATIONSCORRESPONDENCESERVICE", "InternationalTELECOMMUNICATIONSCORRESPONDENCESERVICES":
+	case "ITPCS", "INTERNATIONALTELECOMMUNICATIONSPUBLICCORRESPONDENCESERVICETRIAL", "INTERNATIONALTELECOMMUNICATIONSPUBLICCORRESPONDENCESERVICE", "INTERNATIONALTELECOMMUNICATIONSPUBLICCORRESPONDENCESERVICES", "INTERNATIONALTELECOMMUNICATIONSCORRESPONDENCESERVICE", "INTERNATIONALTELECOMMUNICATIONSCORRESPONDENCESERVICES":
 		return NonCountryInternationalTelecommunicationsCorrespondenceService
 	}
 	return Unknown
@@ -5925,6 +6105,18 @@ func ByNumeric(numeric int) CountryCode {
 	return Unknown
 }
 
+// Successors - returns countries, which replaced the withdrawn country code, nil if the code is not withdrawn,
+// example: ANT.Successors() == []CountryCode{CUW, SXM, BES}
+func (c CountryCode) Successors() []CountryCode {
+	switch c {
+	case ANT:
+		return []CountryCode{CUW, SXM, BES}
+	case YUG:
+		return []CountryCode{SRB, MNE}
+	}
+	return nil
+}
+
 // IsValid - returns true, if code is correct
 func (c CountryCode) IsValid() bool {
 	return c.Alpha2() != UnknownMsg
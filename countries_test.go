@@ -3,7 +3,15 @@ package countries
 
 import (
 	"encoding/json"
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"golang.org/x/text/language"
 )
 
 func getAllCountries(t *testing.T) []CountryCode {
@@ -495,7 +503,7 @@ func TestDomainsCount(t *testing.T) {
 func TestDomainsCode(t *testing.T) {
 	for _, c := range AllDomains() {
 		info := c.Info()
-		if info.Country == Unknown {
+		if (info.Country == Unknown) != (info.Kind != DomainKindCountryCode && info.Kind != DomainKindGeographic && c != DomainArpa) {
 			t.Errorf("Test info.Code err, c: %v", *info)
 		}
 	}
@@ -518,6 +526,9 @@ func TestDomainsIsValid(t *testing.T) {
 //nolint:gocyclo
 func TestDomainsCountry(t *testing.T) {
 	for _, c := range AllDomains() {
+		if c.Kind() != DomainKindCountryCode {
+			continue
+		}
 		if c.Country() != CountryCode(c) {
 			t.Errorf("Test DomainCode.Country() err")
 		}
@@ -528,6 +539,51 @@ func TestDomainsCountry(t *testing.T) {
 	}
 }
 
+//nolint:gocyclo
+func TestDomainsKind(t *testing.T) {
+	for _, c := range AllDomains() {
+		kind := c.Kind()
+		if !kind.IsValid() || kind.Type() != TypeDomainKind {
+			t.Errorf("Test DomainCode.Kind() err, domain %v, kind %v", c, kind)
+		}
+		if kind == DomainKindGeographic && !c.Country().IsValid() {
+			t.Errorf("Test DomainCode.Country() err, domain %v, country %v", c, c.Country())
+		}
+		if s := c.Subdivision(); s != SubdivisionUnknown && s.Country() != c.Country() {
+			t.Errorf("Test DomainCode.Subdivision() err, domain %v, subdivision %v, country %v", c, s, c.Country())
+		}
+	}
+	tests := []struct {
+		domain      DomainCode
+		kind        DomainKind
+		country     CountryCode
+		subdivision SubdivisionCode
+	}{
+		{DomainJP, DomainKindCountryCode, JPN, SubdivisionUnknown},
+		{DomainCom, DomainKindGeneric, Unknown, SubdivisionUnknown},
+		{DomainEdu, DomainKindSponsored, Unknown, SubdivisionUnknown},
+		{DomainArpa, DomainKindInfrastructure, International, SubdivisionUnknown},
+		{DomainGoogle, DomainKindBrand, Unknown, SubdivisionUnknown},
+		{DomainTest, DomainKindReserved, Unknown, SubdivisionUnknown},
+		{DomainNYC, DomainKindGeographic, USA, SubdivisionUSNY},
+		{DomainBerlin, DomainKindGeographic, DEU, SubdivisionDEBE},
+		{DomainScot, DomainKindGeographic, GBR, SubdivisionGBSCT},
+		{DomainSwiss, DomainKindGeographic, CHE, SubdivisionUnknown},
+		{DomainCat, DomainKindSponsored, Unknown, SubdivisionUnknown},
+	}
+	for _, test := range tests {
+		if out := test.domain.Kind(); out != test.kind {
+			t.Errorf("Test DomainCode.Kind() err, domain %v, want %v, got %v", test.domain, test.kind, out)
+		}
+		if out := test.domain.Country(); out != test.country {
+			t.Errorf("Test DomainCode.Country() err, domain %v, want %v, got %v", test.domain, test.country, out)
+		}
+		if out := test.domain.Subdivision(); out != test.subdivision {
+			t.Errorf("Test DomainCode.Subdivision() err, domain %v, want %v, got %v", test.domain, test.subdivision, out)
+		}
+	}
+}
+
 //nolint:gocyclo
 func TestDomainsString(t *testing.T) {
 	for _, c := range AllDomains() {
@@ -621,6 +677,19 @@ func TestDomainCodeByName(t *testing.T) {
 	if out != DomainXX {
 		t.Errorf("Test DomainCodeByName() err, want %v, got %v", DomainXX, out)
 	}
+	for _, c := range AllDomains() {
+		if c.Kind() == DomainKindCountryCode {
+			continue
+		}
+		out = DomainCodeByName(c.String())
+		if out != c {
+			t.Errorf("Test DomainCodeByName() err, want %v, got %v", c, out)
+		}
+	}
+	out = DomainCodeByName("nyc")
+	if out != DomainNYC {
+		t.Errorf("Test DomainCodeByName() err, want %v, got %v", DomainNYC, out)
+	}
 }
 
 // Test Regions
@@ -806,6 +875,1205 @@ func TestCountriesCount(t *testing.T) {
 	}
 }
 
+//nolint:gocyclo
+func TestCountriesName(t *testing.T) {
+	for _, c := range getAllCountries(t) {
+		for _, lang := range NameLanguages() {
+			if c.Name(lang) == "" {
+				t.Errorf("Test Name() err, country %v, lang %v", c, lang)
+			}
+		}
+	}
+	tests := []struct {
+		country CountryCode
+		lang    language.Tag
+		form    NameForm
+		want    string
+	}{
+		{DEU, language.German, NameFormDefault, "Deutschland"},
+		{JPN, language.SimplifiedChinese, NameFormDefault, "日本"},
+		{RUS, language.Russian, NameFormDefault, "Россия"},
+		{GBR, language.English, NameFormShort, "UK"},
+		{CZE, language.English, NameFormVariant, "Czech Republic"},
+		{BOL, language.English, NameFormVariant, "Bolivia, Plurinational State of"},
+		{DEU, language.English, NameFormOfficial, "Federal Republic of Germany"},
+		{DEU, language.German, NameFormOfficial, "Deutschland"},
+		{ANT, language.German, NameFormDefault, ANT.String()},
+	}
+	for _, test := range tests {
+		if out := test.country.NameIn(test.lang, test.form); out != test.want {
+			t.Errorf("Test NameIn() err, want %v, got %v", test.want, out)
+		}
+	}
+}
+
+//nolint:gocyclo
+func TestCountriesByNameLocalized(t *testing.T) {
+	// vietnamese names, which are codes of other countries: "Áo" (Austria) is AO (Angola), "Bỉ" (Belgium) is BI (Burundi)
+	// and "Nga" (Russia) is NGA (Nigeria)
+	exceptions := map[string]CountryCode{
+		"Áo":  AGO,
+		"Bỉ":  BDI,
+		"Nga": NGA,
+	}
+	for _, lang := range NameLanguages() {
+		for _, c := range All() {
+			name := c.Name(lang)
+			want, ok := exceptions[name]
+			if !ok {
+				want = c
+			}
+			if out := ByName(name); out != want {
+				t.Errorf("Test ByName() err, lang %v, name %v, want %v, got %v", lang, name, want, out)
+			}
+		}
+	}
+	tests := map[string]CountryCode{
+		"Deutschland":                        DEU,
+		"日本":                                 JPN,
+		"Elfenbenskusten":                    CIV,
+		"Vereinigte Staaten":                 USA,
+		"Czech Republic":                     CZE,
+		"Svatý Martin (Francie)":             MAF,
+		"टोंगा":                              TON,
+		"Federal Republic of Germany":        DEU,
+		"RAE de Hong Kong (China)":           HKG,
+		"RAE de Macao (China)":               MAC,
+		"Saint-Martin (partie néerlandaise)": SXM,
+		"Saint-Martin (partie française)":    MAF,
+	}
+	for name, want := range tests {
+		if out := ByName(name); out != want {
+			t.Errorf("Test ByName() err, name %v, want %v, got %v", name, want, out)
+		}
+	}
+}
+
+//nolint:gocyclo
+func TestCountriesByNameTransliterated(t *testing.T) {
+	for _, c := range All() {
+		p := pinyin(c.StringCn())
+		if p == "" {
+			continue
+		}
+		if out := ByName(p); out != c {
+			t.Errorf("Test ByName() err, pinyin %v, want %v, got %v", p, c, out)
+		}
+	}
+	tests := map[string]CountryCode{
+		"riben":     JPN,
+		"Rìběn":     JPN,
+		"ri4ben3":   JPN,
+		"Éluósī":    RUS,
+		"Zhongguo":  CHN,
+		"Germaniya": DEU,
+		"Rossiya":   RUS,
+		"Frantsiya": FRA,
+		"almanya":   DEU,
+	}
+	for name, want := range tests {
+		if out := ByName(name); out != want {
+			t.Errorf("Test ByName() err, name %v, want %v, got %v", name, want, out)
+		}
+	}
+}
+
+//nolint:gocyclo
+func TestCapitalCodeByNameLocalized(t *testing.T) {
+	tests := map[string]CapitalCode{
+		"东京":       CapitalJP,
+		"dongjing": CapitalJP,
+		"Dōngjīng": CapitalJP,
+		"北京":       CapitalCN,
+		"Tokyo":    CapitalJP,
+		"莫斯科":      CapitalRU,
+	}
+	for name, want := range tests {
+		if out := CapitalCodeByName(name); out != want {
+			t.Errorf("Test CapitalCodeByName() err, name %v, want %v, got %v", name, want, out)
+		}
+	}
+	if out := CapitalCodeByName("Atlantis"); out != CapitalUnknown {
+		t.Errorf("Test CapitalCodeByName() err, want %v, got %v", CapitalUnknown, out)
+	}
+}
+
+//nolint:gocyclo
+func TestTextPrepare(t *testing.T) {
+	tests := []struct {
+		text, key, outside, alternate string
+	}{
+		{"Côte d'Ivoire", "COTEDIVOIRE", "COTEDIVOIRE", ""},
+		{"Cote d'Ivoire", "COTEDIVOIRE", "COTEDIVOIRE", ""},
+		{"Côte d'Ivoire (Ivory Coast)", "COTEDIVOIREIVORYCOAST", "COTEDIVOIRE", "IVORYCOAST"},
+		{"Großbritannien", "GROSSBRITANNIEN", "GROSSBRITANNIEN", ""},
+		{"Færøerne", "FAEROERNE", "FAEROERNE", ""},
+		{"Ｊａｐａｎ", "JAPAN", "JAPAN", ""},
+		{"Việt Nam", "VIETNAM", "VIETNAM", ""},
+		{"Korea [South] (ROK)", "KOREASOUTHROK", "KOREA", "SOUTHROK"},
+		{"Bolivia (Plurinational State of) Republic", "BOLIVIAPLURINATIONALSTATEOFREPUBLIC", "BOLIVIAREPUBLIC", "PLURINATIONALSTATEOF"},
+		{"(Germany)", "GERMANY", "", "GERMANY"},
+		{"Россия", "РОССИЯ", "РОССИЯ", ""},
+		{"RUSSIA", "RUSSIA", "RUSSIA", ""},
+		{"", "", "", ""},
+	}
+	for _, test := range tests {
+		key, outside, alternate := textPrepareAlternate(test.text)
+		if key != test.key || outside != test.outside || alternate != test.alternate {
+			t.Errorf("Test textPrepareAlternate() err, text %v, want %v %v %v, got %v %v %v",
+				test.text, test.key, test.outside, test.alternate, key, outside, alternate)
+		}
+		if key := textPrepare(test.text); key != test.outside {
+			t.Errorf("Test textPrepare() err, text %v, want %v, got %v", test.text, test.outside, key)
+		}
+	}
+	if allocs := testing.AllocsPerRun(100, func() { textPrepare("RUSSIA") }); allocs != 0 {
+		t.Errorf("Test textPrepare() err, want 0 allocs, got %v", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { textPrepare("Côte d'Ivoire") }); allocs > 1 {
+		t.Errorf("Test textPrepare() err, want 1 alloc, got %v", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { textPrepareAlternate("Côte d'Ivoire (Ivory Coast)") }); allocs > 1 {
+		t.Errorf("Test textPrepareAlternate() err, want 1 alloc, got %v", allocs)
+	}
+	tests2 := map[string]CountryCode{
+		"Cote d'Ivoire":                           CIV,
+		"CÔTE D’IVOIRE":                           CIV,
+		"Elfenbeinküste (Ivory Coast)":            CIV,
+		"Atlantis (Germany)":                      DEU,
+		"Österreich":                              AUT,
+		"Curaçao":                                 CUW,
+		"Réunion":                                 REU,
+		"Virgin Islands (U.S.)":                   VIR,
+		"Virgin Islands (British)":                VGB,
+		"Korea (Democratic People's Republic of)": PRK,
+		"Korea (Republic of)":                     KOR,
+		"Atlantis (US)":                           Unknown,
+		"(DE)":                                    Unknown,
+	}
+	for name, want := range tests2 {
+		if out := ByName(name); out != want {
+			t.Errorf("Test ByName() err, name %v, want %v, got %v", name, want, out)
+		}
+	}
+	if out := CurrencyCodeByName("Pa’anga"); out != CurrencyTOP {
+		t.Errorf("Test CurrencyCodeByName() err, want %v, got %v", CurrencyTOP, out)
+	}
+	if out := CapitalCodeByName("Prishtinë"); out != CapitalXK {
+		t.Errorf("Test CapitalCodeByName() err, want %v, got %v", CapitalXK, out)
+	}
+	if out := RegionCodeByName("Europe (EU)"); out != RegionEU {
+		t.Errorf("Test RegionCodeByName() err, want %v, got %v", RegionEU, out)
+	}
+}
+
+//nolint:gocyclo
+func TestParseCountry(t *testing.T) {
+	if c, err := ParseCountry("Germany"); c != DEU || err != nil {
+		t.Errorf("Test ParseCountry() err, want %v, got %v, %v", DEU, c, err)
+	}
+	if c, err := ParseCountry("Atlantis"); c != Unknown || !errors.Is(err, ErrNotFound) {
+		t.Errorf("Test ParseCountry() err, want %v, got %v, %v", ErrNotFound, c, err)
+	}
+	var ambiguous *AmbiguousError
+	if _, err := ParseCountry("Korea"); !errors.Is(err, ErrAmbiguous) || !errors.As(err, &ambiguous) || len(ambiguous.Candidates) != 2 {
+		t.Errorf("Test ParseCountry() err, want %v with 2 candidates, got %v", ErrAmbiguous, err)
+	}
+	if _, err := ParseCountry("Virgin Islands"); !errors.Is(err, ErrAmbiguous) {
+		t.Errorf("Test ParseCountry() err, want %v, got %v", ErrAmbiguous, err)
+	}
+	tests := map[string]CountryCode{
+		"Congo":               COG,
+		"Korea (Republic of)": KOR,
+		"Korea (Democratic People's Republic of)": PRK,
+		"Virgin Islands (U.S.)":                   VIR,
+		"Virgin Islands (British)":                VGB,
+		"Saint Martin (French part)":              MAF,
+		"Sint Maarten (Dutch part)":               SXM,
+		"Saint-Martin (partie néerlandaise)":      SXM,
+	}
+	for name, want := range tests {
+		if c, err := ParseCountry(name); c != want || err != nil {
+			t.Errorf("Test ParseCountry() err, name %v, want %v, got %v, %v", name, want, c, err)
+		}
+	}
+	for _, c := range All() {
+		if out, _ := ParseCountry(c.String()); out != c {
+			t.Errorf("Test ParseCountry() err, name %v, want %v, got %v", c.String(), c, out)
+		}
+	}
+	if _, err := ParseCountry("Islands"); !errors.Is(err, ErrAmbiguous) {
+		t.Errorf("Test ParseCountry() err, want %v, got %v", ErrAmbiguous, err)
+	}
+	var withdrawn *WithdrawnError
+	c, err := ParseCountry("Netherlands Antilles")
+	if c != ANT || !errors.Is(err, ErrWithdrawn) || !errors.As(err, &withdrawn) || len(withdrawn.Successors) != 3 || withdrawn.Successors[0] != CUW {
+		t.Errorf("Test ParseCountry() err, want %v, got %v, %v", ErrWithdrawn, c, err)
+	}
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrAmbiguous) {
+		t.Errorf("Test ParseCountry() err, unexpected error kind %v", err)
+	}
+}
+
+//nolint:gocyclo
+func TestParseCurrency(t *testing.T) {
+	if c, err := ParseCurrency("usd"); c != CurrencyUSD || err != nil {
+		t.Errorf("Test ParseCurrency() err, want %v, got %v, %v", CurrencyUSD, c, err)
+	}
+	if c, err := ParseCurrency("Doubloon"); c != CurrencyUnknown || !errors.Is(err, ErrNotFound) {
+		t.Errorf("Test ParseCurrency() err, want %v, got %v, %v", ErrNotFound, c, err)
+	}
+	var ambiguous *AmbiguousError
+	if _, err := ParseCurrency("Dollar"); !errors.As(err, &ambiguous) || len(ambiguous.Candidates) < 10 {
+		t.Errorf("Test ParseCurrency() err, want %v, got %v", ErrAmbiguous, err)
+	}
+	var withdrawn *WithdrawnError
+	if c, err := ParseCurrency("HRK"); c != CurrencyHRK || !errors.As(err, &withdrawn) || withdrawn.Successors[0] != CurrencyEUR {
+		t.Errorf("Test ParseCurrency() err, want %v, got %v, %v", ErrWithdrawn, c, err)
+	}
+	for _, c := range AllCurrencies() {
+		for _, successor := range c.Successors() {
+			if !successor.IsValid() || len(successor.Successors()) > 0 {
+				t.Errorf("Test Successors() err, currency %v, successor %v", c, successor)
+			}
+		}
+	}
+	for _, c := range All() {
+		for _, successor := range c.Successors() {
+			if !successor.IsValid() || len(successor.Successors()) > 0 {
+				t.Errorf("Test Successors() err, country %v, successor %v", c, successor)
+			}
+		}
+	}
+}
+
+//nolint:gocyclo
+func TestByCodeFormats(t *testing.T) {
+	for _, c := range All() {
+		if out := ByAlpha2(c.Alpha2()); out != c {
+			t.Errorf("Test ByAlpha2() err, want %v, got %v", c, out)
+		}
+		if out := ByAlpha3(strings.ToLower(c.Alpha3())); out != c {
+			t.Errorf("Test ByAlpha3() err, want %v, got %v", c, out)
+		}
+	}
+	tests := []struct {
+		code   string
+		want   CountryCode
+		format CodeFormat
+	}{
+		{"AS", ASM, CodeFormatAlpha2},
+		{"CAN", CAN, CodeFormatAlpha3},
+		{"040", AUT, CodeFormatNumeric},
+		{"BUMM", MMR, CodeFormatAlpha4},
+		{"40", Unknown, CodeFormatUnknown},
+		{"UK", Unknown, CodeFormatUnknown},
+		{"Austria", Unknown, CodeFormatUnknown},
+		{"SUHH", Unknown, CodeFormatUnknown},
+		{" AT", Unknown, CodeFormatUnknown},
+	}
+	for _, test := range tests {
+		c, format, err := ParseAny(test.code)
+		if c != test.want || format != test.format || (err == nil) != (test.want != Unknown) {
+			t.Errorf("Test ParseAny() err, code %v, want %v %v, got %v %v %v", test.code, test.want, test.format, c, format, err)
+		}
+		if test.want == Unknown && !errors.Is(err, ErrNotFound) {
+			t.Errorf("Test ParseAny() err, code %v, want %v, got %v", test.code, ErrNotFound, err)
+		}
+	}
+	if c, format, err := ParseAny("ANHH"); c != ANT || format != CodeFormatAlpha4 || !errors.Is(err, ErrWithdrawn) {
+		t.Errorf("Test ParseAny() err, want %v, got %v %v %v", ANT, c, format, err)
+	}
+	if out := ByNumericString("04a"); out != Unknown {
+		t.Errorf("Test ByNumericString() err, want %v, got %v", Unknown, out)
+	}
+	if out := ByAlpha2("AUT"); out != Unknown {
+		t.Errorf("Test ByAlpha2() err, want %v, got %v", Unknown, out)
+	}
+	if CodeFormatNumeric.String() != "Numeric" || CodeFormat(100).IsValid() || CodeFormatAlpha2.Type() != TypeCodeFormat {
+		t.Errorf("Test CodeFormat err")
+	}
+}
+
+//nolint:gocyclo
+func TestIntlCodes(t *testing.T) {
+	tests := []struct {
+		c                         CountryCode
+		itu, icao, ivr, wmo, marc string
+		gaul                      int
+	}{
+		{DEU, "D", "D", "D", "DL", "gw", 93},
+		{NLD, "HOL", "PH", "NL", "NL", "ne", 177},
+		{USA, "USA", "N", "USA", "US", "xxu", 259},
+		{BMU, "BER", "VP-B", UnknownMsg, "BE", "bm", 30},
+	}
+	for _, test := range tests {
+		if out := test.c.ITU(); out != test.itu {
+			t.Errorf("Test ITU() err, want %v, got %v", test.itu, out)
+		}
+		if out := test.c.ICAO(); out != test.icao {
+			t.Errorf("Test ICAO() err, want %v, got %v", test.icao, out)
+		}
+		if out := test.c.IVR(); out != test.ivr {
+			t.Errorf("Test IVR() err, want %v, got %v", test.ivr, out)
+		}
+		if out := test.c.WMO(); out != test.wmo {
+			t.Errorf("Test WMO() err, want %v, got %v", test.wmo, out)
+		}
+		if out := test.c.MARC(); out != test.marc {
+			t.Errorf("Test MARC() err, want %v, got %v", test.marc, out)
+		}
+		if out := test.c.GAUL(); out != test.gaul {
+			t.Errorf("Test GAUL() err, want %v, got %v", test.gaul, out)
+		}
+		if out := ByITU(test.itu); out != test.c {
+			t.Errorf("Test ByITU() err, want %v, got %v", test.c, out)
+		}
+		if out := ByICAO(test.icao); out != test.c {
+			t.Errorf("Test ByICAO() err, want %v, got %v", test.c, out)
+		}
+		if out := ByWMO(strings.ToLower(test.wmo)); out != test.c {
+			t.Errorf("Test ByWMO() err, want %v, got %v", test.c, out)
+		}
+		if out := ByMARC(strings.ToUpper(test.marc)); out != test.c {
+			t.Errorf("Test ByMARC() err, want %v, got %v", test.c, out)
+		}
+		if out := ByGAUL(test.gaul); out != test.c {
+			t.Errorf("Test ByGAUL() err, want %v, got %v", test.c, out)
+		}
+	}
+	registrations := map[string]CountryCode{
+		"D-AIMA": DEU, "N12345": USA, "VP-BAA": BMU, "B-HNK": HKG, "b-18701": CHN, "HB-JNA": CHE, "XX-123": Unknown,
+	}
+	for registration, want := range registrations {
+		if out := ByICAO(registration); out != want {
+			t.Errorf("Test ByICAO() err, registration %v, want %v, got %v", registration, want, out)
+		}
+	}
+	if out := ByIVR("d"); out != DEU {
+		t.Errorf("Test ByIVR() err, want %v, got %v", DEU, out)
+	}
+	if out := ByIVR("CH"); out != CHE {
+		t.Errorf("Test ByIVR() err, want %v, got %v", CHE, out)
+	}
+	if out := ROU.UNDP(); out != "ROM" {
+		t.Errorf("Test UNDP() err, want %v, got %v", "ROM", out)
+	}
+	if out := ByUNDP("zar"); out != COD {
+		t.Errorf("Test ByUNDP() err, want %v, got %v", COD, out)
+	}
+	if out := BySTANAG("DEU"); out != DEU || DEU.STANAG() != "DEU" {
+		t.Errorf("Test BySTANAG() err, want %v, got %v", DEU, out)
+	}
+	if out := Unknown.ITU(); out != UnknownMsg || Unknown.GAUL() != 0 || ByGAUL(0) != Unknown {
+		t.Errorf("Test ITU() err, want %v, got %v", UnknownMsg, out)
+	}
+	if info := DEU.Info(); info.IVR != "D" || info.GAUL != 93 || info.UNDP != "DEU" {
+		t.Errorf("Test Info() err, want %v, got %v", "D", info.IVR)
+	}
+}
+
+//nolint:gocyclo
+func TestExternalIDs(t *testing.T) {
+	for _, c := range All() {
+		if id := c.WikidataID(); id != UnknownMsg && ByWikidataID(id) != c {
+			t.Errorf("Test ByWikidataID() err, want %v, got %v", c, ByWikidataID(id))
+		}
+		if id := c.GeoNamesID(); id != 0 && ByGeoNamesID(id) != c {
+			t.Errorf("Test ByGeoNamesID() err, want %v, got %v", c, ByGeoNamesID(id))
+		}
+		if id := c.OSMRelationID(); id != 0 && ByOSMRelationID(id) != c {
+			t.Errorf("Test ByOSMRelationID() err, want %v, got %v", c, ByOSMRelationID(id))
+		}
+	}
+	if out := DEU.WikidataID(); out != "Q183" {
+		t.Errorf("Test WikidataID() err, want %v, got %v", "Q183", out)
+	}
+	if out := ByWikidataID("http://www.wikidata.org/entity/Q183"); out != DEU {
+		t.Errorf("Test ByWikidataID() err, want %v, got %v", DEU, out)
+	}
+	if out := ByWikidataID("wd:q30"); out != USA {
+		t.Errorf("Test ByWikidataID() err, want %v, got %v", USA, out)
+	}
+	if out := ByGeoNamesID(2921044); out != DEU {
+		t.Errorf("Test ByGeoNamesID() err, want %v, got %v", DEU, out)
+	}
+	if out := ByOSMRelationID(51477); out != DEU {
+		t.Errorf("Test ByOSMRelationID() err, want %v, got %v", DEU, out)
+	}
+	if out := CapitalDE.WikidataID(); out != "Q64" || CapitalCodeByWikidataID("Q64") != CapitalDE {
+		t.Errorf("Test CapitalCode.WikidataID() err, want %v, got %v", "Q64", out)
+	}
+	if out := CapitalCodeByGeoNamesID(2950159); out != CapitalDE || CapitalCodeByOSMRelationID(62422) != CapitalDE {
+		t.Errorf("Test CapitalCodeByGeoNamesID() err, want %v, got %v", CapitalDE, out)
+	}
+	if Unknown.WikidataID() != UnknownMsg || Unknown.GeoNamesID() != 0 || ByWikidataID("Q0") != Unknown || CapitalCodeByGeoNamesID(1) != CapitalUnknown {
+		t.Errorf("Test WikidataID() err, want %v", UnknownMsg)
+	}
+	if info := DEU.Info(); info.WikidataID != "Q183" || info.GeoNamesID != 2921044 || info.OSMRelation != 51477 {
+		t.Errorf("Test Info() err, want %v, got %v", "Q183", info.WikidataID)
+	}
+}
+
+// TestExternalIDsCoverage - the coverage stated in the doc comments of WikidataID, GeoNamesID and OSMRelationID,
+// the dataset is limited on purpose, so a capital or a subdivision added out of this scope fails the test
+//
+//nolint:gocyclo
+func TestExternalIDsCoverage(t *testing.T) {
+	capitals := 0
+	for _, c := range All() {
+		if c.WikidataID() == UnknownMsg {
+			t.Errorf("Test WikidataID() err, country %v has no ID", c)
+		}
+		if c.GeoNamesID() == 0 && c != YUG {
+			t.Errorf("Test GeoNamesID() err, country %v has no ID", c)
+		}
+		capital := c.Capital()
+		if id := capital.WikidataID(); id != UnknownMsg {
+			capitals++
+			if capital.GeoNamesID() == 0 || CapitalCodeByWikidataID(id) != capital || CapitalCodeByGeoNamesID(capital.GeoNamesID()) != capital {
+				t.Errorf("Test CapitalCode.WikidataID() err, capital %v, id %v", capital, id)
+			}
+		}
+	}
+	if capitals != 79 {
+		t.Errorf("Test CapitalCode.WikidataID() err, want %v covered capitals, got %v", 79, capitals)
+	}
+	for _, c := range All() {
+		covered := c == USA || c == DEU || c == CAN
+		for _, s := range c.Subdivisions() {
+			id := s.WikidataID()
+			want := covered && (c != USA || s.SubdivisionType() != SubdivisionTypeOutlyingArea)
+			if (id != UnknownMsg) != want || (s.GeoNamesID() != 0) != want || (s.OSMRelationID() != 0) != want {
+				t.Errorf("Test SubdivisionCode.WikidataID() err, subdivision %v, want covered %v, got %v", s, want, id)
+			}
+			if want && (SubdivisionCodeByWikidataID(id) != s || SubdivisionCodeByGeoNamesID(s.GeoNamesID()) != s ||
+				SubdivisionCodeByOSMRelationID(s.OSMRelationID()) != s) {
+				t.Errorf("Test SubdivisionCodeByWikidataID() err, want %v", s)
+			}
+		}
+	}
+	if out := SubdivisionFRIDF.WikidataID(); out != UnknownMsg {
+		t.Errorf("Test SubdivisionCode.WikidataID() err, want %v, got %v", UnknownMsg, out)
+	}
+}
+
+//nolint:gocyclo
+func TestBySportsAndFIPSCodes(t *testing.T) {
+	for _, c := range All() {
+		if fips := c.FIPS(); len(fips) == 2 && ByFIPS(fips) != c {
+			t.Errorf("Test ByFIPS() err, want %v, got %v", c, ByFIPS(fips))
+		}
+		if out := ByIOC(c.IOC()); out != c {
+			t.Errorf("Test ByIOC() err, want %v, got %v", c, out)
+		}
+		if out := ByFIFA(c.FIFA()); out != c {
+			t.Errorf("Test ByFIFA() err, want %v, got %v", c, out)
+		}
+	}
+	tests := []struct {
+		code string
+		f    func(string) CountryCode
+		want CountryCode
+	}{
+		{"GM", ByFIPS, DEU},
+		{"ga", ByFIPS, GMB},
+		{"HA", ByFIPS, HTI},
+		{"AS", ByFIPS, AUS},
+		{"AU", ByFIPS, AUT},
+		{"DEU", ByFIPS, Unknown},
+		{"ALG", ByIOC, DZA},
+		{"ant", ByIOC, ATG},
+		{"AHO", ByIOC, ANT},
+		{"FRG", ByIOC, DEU},
+		{"LIB", ByIOC, LBN},
+		{"ZAI", ByIOC, COD},
+		{"URS", ByIOC, Unknown},
+		{"GER", ByFIFA, DEU},
+		{"sui", ByFIFA, CHE},
+		{"KVX", ByFIFA, XKX},
+		{"XXX", ByFIFA, Unknown},
+	}
+	for _, test := range tests {
+		if out := test.f(test.code); out != test.want {
+			t.Errorf("Test By*() err, code %v, want %v, got %v", test.code, test.want, out)
+		}
+	}
+}
+
+//nolint:gocyclo
+func TestSportsTeams(t *testing.T) {
+	members := 0
+	for _, team := range AllSportsTeams(FederationFIFA) {
+		if team.Member {
+			members++
+		}
+		if !team.Confederation.IsValid() || team.Confederation.Federation() != FederationFIFA || team.Type() != TypeSportsTeam {
+			t.Errorf("Test AllSportsTeams() err, team %v, confederation %v", team.Code, team.Confederation)
+		}
+		if out, err := SportsTeamByCode(FederationFIFA, team.Code); err != nil || out.Country != team.Country {
+			t.Errorf("Test SportsTeamByCode() err, want %v, got %v %v", team.Country, out, err)
+		}
+	}
+	if members != 211 {
+		t.Errorf("Test AllSportsTeams() err, want %v FIFA members, got %v", 211, members)
+	}
+	if out := len(AllSportsTeams(FederationIOC)); out != 206 {
+		t.Errorf("Test AllSportsTeams() err, want %v NOCs, got %v", 206, out)
+	}
+	team, err := SportsTeamByCode(FederationFIFA, "sco")
+	if err != nil || team.Country != GBR || team.Subdivision != SubdivisionGBSCT || team.Confederation != ConfederationUEFA {
+		t.Errorf("Test SportsTeamByCode() err, want %v, got %v %v", SubdivisionGBSCT, team, err)
+	}
+	if teams := GBR.SportsTeams(FederationFIFA); len(teams) != 4 || teams[0].Code != "ENG" {
+		t.Errorf("Test SportsTeams() err, want 4 home nations, got %v", teams)
+	}
+	if teams := GBR.SportsTeams(FederationIOC); len(teams) != 1 || teams[0].Code != "GBR" || teams[0].Name != "Great Britain" ||
+		teams[0].Confederation != ConfederationEOC {
+		t.Errorf("Test SportsTeams() err, want %v, got %v", "GBR", teams)
+	}
+	if team, err := SportsTeamByCode(FederationIOC, "TPE"); err != nil || team.Name != "Chinese Taipei" || team.Country != TWN {
+		t.Errorf("Test SportsTeamByCode() err, want %v, got %v %v", "Chinese Taipei", team, err)
+	}
+	if team, err := SubdivisionGBWLS.SportsTeam(FederationFIFA); err != nil || team.Code != "WAL" {
+		t.Errorf("Test SubdivisionCode.SportsTeam() err, want %v, got %v %v", "WAL", team, err)
+	}
+	if _, err := SubdivisionGBWLS.SportsTeam(FederationIOC); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Test SubdivisionCode.SportsTeam() err, want %v, got %v", ErrNotFound, err)
+	}
+	if team, err := SportsTeamByCode(FederationFIFA, "KVX"); err != nil || team.Country != XKX || !team.Member {
+		t.Errorf("Test SportsTeamByCode() err, want %v, got %v %v", XKX, team, err)
+	}
+	if team, err := SportsTeamByCode(FederationFIFA, "GLP"); err != nil || team.Member || team.Confederation != ConfederationCONCACAF {
+		t.Errorf("Test SportsTeamByCode() err, want associate member, got %v %v", team, err)
+	}
+	if _, err := SportsTeamByCode(FederationIOC, "ENG"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Test SportsTeamByCode() err, want %v, got %v", ErrNotFound, err)
+	}
+	if ByFIFA("WAL") != GBR || ByFIFA("TAH") != PYF || ByFIFA("ZAN") != TZA {
+		t.Errorf("Test ByFIFA() err, want %v, got %v", GBR, ByFIFA("WAL"))
+	}
+	if DEU.Confederation() != ConfederationUEFA || AUS.Confederation() != ConfederationAFC || AUS.OlympicAssociation() != ConfederationONOC {
+		t.Errorf("Test Confederation() err, want %v, got %v", ConfederationUEFA, DEU.Confederation())
+	}
+	if ATA.Confederation() != ConfederationUnknown || Confederation(100).IsValid() || FederationIOC.String() != "IOC" {
+		t.Errorf("Test Confederation() err, want %v, got %v", ConfederationUnknown, ATA.Confederation())
+	}
+}
+
+//nolint:gocyclo
+func TestCapitalsGeo(t *testing.T) {
+	for _, c := range AllCapitals() {
+		latitude, longitude := c.Coordinates()
+		if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+			t.Errorf("Test Coordinates() err, capital %v, got %v %v", c, latitude, longitude)
+		}
+		if !c.Role().IsValid() || c.Role().Type() != TypeCapitalRole {
+			t.Errorf("Test Role() err, capital %v, got %v", c, c.Role())
+		}
+		if latitude != 0 && c.Distance(c) != 0 {
+			t.Errorf("Test Distance() err, capital %v, want 0, got %v", c, c.Distance(c))
+		}
+	}
+	if out := CapitalDE.Distance(CapitalFR); out < 870 || out > 885 {
+		t.Errorf("Test Distance() err, want about %v, got %v", 878, out)
+	}
+	if out := CapitalUS.Distance(CapitalGB); out < 5880 || out > 5920 {
+		t.Errorf("Test Distance() err, want about %v, got %v", 5900, out)
+	}
+	if out := CapitalAQ.Distance(CapitalDE); out != -1 {
+		t.Errorf("Test Distance() err, want %v, got %v", -1, out)
+	}
+	capitals := ZAF.Capitals()
+	if len(capitals) != 3 || capitals[0].Code != CapitalZA || capitals[0].Role != CapitalRoleExecutive ||
+		capitals[1].Name != "Cape Town" || capitals[1].Role != CapitalRoleLegislative || capitals[2].Role != CapitalRoleJudicial {
+		t.Errorf("Test Capitals() err, want Pretoria, Cape Town, Bloemfontein, got %v", capitals)
+	}
+	if capitals := DEU.Capitals(); len(capitals) != 1 || capitals[0].Role != CapitalRoleFull || capitals[0].Latitude == 0 {
+		t.Errorf("Test Capitals() err, want %v, got %v", CapitalDE, capitals)
+	}
+	if out := CapitalCodeByName("Den Haag"); out != CapitalNLTheHague || out.Country() != NLD {
+		t.Errorf("Test CapitalCodeByName() err, want %v, got %v", CapitalNLTheHague, out)
+	}
+	if out := Localized(CapitalBOLaPaz, language.Chinese); out != "拉巴斯" {
+		t.Errorf("Test Localized() err, want %v, got %v", "拉巴斯", out)
+	}
+	if out := Unknown.Capitals(); len(out) != 0 {
+		t.Errorf("Test Capitals() err, want no capitals, got %v", out)
+	}
+}
+
+//nolint:gocyclo
+func TestCountriesGeo(t *testing.T) {
+	for _, c := range All() {
+		latitude, longitude := c.Centroid()
+		if box := c.BoundingBox(); !box.IsValid() || !box.Contains(latitude, longitude) {
+			t.Errorf("Test BoundingBox() err, country %v, centroid %v %v, got %v", c, latitude, longitude, box)
+		}
+		if c.AreaKm2() <= 0 {
+			t.Errorf("Test AreaKm2() err, country %v, got %v", c, c.AreaKm2())
+		}
+		for _, neighbor := range c.Neighbors() {
+			if !neighbor.IsValid() || !neighbor.IsNeighbor(c) {
+				t.Errorf("Test Neighbors() err, country %v, neighbor %v is not symmetric", c, neighbor)
+			}
+		}
+		if c.Landlocked() && (c.Coastline() != 0 || len(c.Neighbors()) == 0) {
+			t.Errorf("Test Landlocked() err, country %v, coastline %v", c, c.Coastline())
+		}
+	}
+	if out := DEU.AreaKm2(); out != 357588 {
+		t.Errorf("Test AreaKm2() err, want %v, got %v", 357588, out)
+	}
+	if !CHE.Landlocked() || !BOL.Landlocked() || DEU.Landlocked() || Unknown.Landlocked() {
+		t.Errorf("Test Landlocked() err, want CHE and BOL landlocked, got DEU %v, Unknown %v", DEU.Landlocked(), Unknown.Landlocked())
+	}
+	if out := PRT.Neighbors(); len(out) != 1 || out[0] != ESP {
+		t.Errorf("Test Neighbors() err, want %v, got %v", []CountryCode{ESP}, out)
+	}
+	if out := len(CHN.Neighbors()); out != 16 {
+		t.Errorf("Test Neighbors() err, want %v, got %v", 16, out)
+	}
+	if out := JPN.Neighbors(); out != nil || !DEU.IsNeighbor(FRA) || DEU.IsNeighbor(ESP) {
+		t.Errorf("Test Neighbors() err, want nil, got %v", out)
+	}
+	if box := RUS.BoundingBox(); !box.CrossesAntimeridian() || !box.Contains(65, 179) || !box.Contains(65, -175) || box.Contains(65, -100) {
+		t.Errorf("Test BoundingBox() err, want a box crossing the antimeridian, got %v", box)
+	}
+	if box := Unknown.BoundingBox(); box.IsValid() || Unknown.Coastline() != -1 || Unknown.AreaKm2() != 0 {
+		t.Errorf("Test BoundingBox() err, want an empty box, got %v", box)
+	}
+	if out := DEU.Info(); out.AreaKm2 != 357588 || out.Landlocked || len(out.Neighbors) != 9 {
+		t.Errorf("Test Info() err, want geography of %v, got %v", DEU, out)
+	}
+}
+
+//nolint:gocyclo
+func TestTimeZones(t *testing.T) {
+	for _, c := range All() {
+		zones := c.TimeZones()
+		if len(zones) > 0 && (zones[0] != c.TimeZone() || TimeZoneCountry(c.TimeZone()) == Unknown) {
+			t.Errorf("Test TimeZones() err, country %v, got %v", c, zones)
+		}
+	}
+	capitals := map[CountryCode]string{
+		ARG: "America/Argentina/Buenos_Aires", AUS: "Australia/Sydney", BRA: "America/Sao_Paulo", CAN: "America/Toronto",
+		CHL: "America/Santiago", CHN: "Asia/Shanghai", COD: "Africa/Kinshasa", CYP: "Asia/Nicosia", DEU: "Europe/Berlin",
+		ECU: "America/Guayaquil", ESP: "Europe/Madrid", FSM: "Pacific/Pohnpei", GRL: "America/Nuuk", IDN: "Asia/Jakarta",
+		KAZ: "Asia/Almaty", KIR: "Pacific/Tarawa", MEX: "America/Mexico_City", MHL: "Pacific/Majuro", MNG: "Asia/Ulaanbaatar",
+		MYS: "Asia/Kuala_Lumpur", NZL: "Pacific/Auckland", PNG: "Pacific/Port_Moresby", PRT: "Europe/Lisbon", PSE: "Asia/Gaza",
+		PYF: "Pacific/Tahiti", RUS: "Europe/Moscow", UKR: "Europe/Kyiv", USA: "America/New_York", UZB: "Asia/Tashkent",
+	}
+	for _, c := range All() {
+		if len(c.TimeZones()) < 2 || len(c.Capitals()) == 0 {
+			continue
+		}
+		if latitude, longitude := c.Capitals()[0].Code.Coordinates(); (latitude != 0 || longitude != 0) && capitals[c] == "" {
+			t.Errorf("Test TimeZone() err, country %v with several zones, want the zone of its capital in the test", c)
+		}
+	}
+	for c, want := range capitals {
+		if out := c.TimeZone(); out != want {
+			t.Errorf("Test TimeZone() err, country %v, want %v, got %v", c, want, out)
+		}
+	}
+	if out := DEU.TimeZones(); len(out) != 2 || out[0] != "Europe/Berlin" || out[1] != "Europe/Busingen" {
+		t.Errorf("Test TimeZones() err, want %v, got %v", []string{"Europe/Berlin", "Europe/Busingen"}, out)
+	}
+	if out := RUS.TimeZone(); out != "Europe/Moscow" || len(RUS.TimeZones()) != 26 {
+		t.Errorf("Test TimeZone() err, want %v, got %v", "Europe/Moscow", out)
+	}
+	if out := USA.TimeZone(); out != "America/New_York" {
+		t.Errorf("Test TimeZone() err, want %v, got %v", "America/New_York", out)
+	}
+	if _, err := time.LoadLocation(JPN.TimeZone()); err != nil {
+		t.Errorf("Test TimeZone() err, want a zone usable with time.LoadLocation, got %v", err)
+	}
+	if out := Unknown.TimeZone(); out != UnknownMsg || Unknown.TimeZones() != nil {
+		t.Errorf("Test TimeZone() err, want %v, got %v", UnknownMsg, out)
+	}
+	if out := TimeZoneCountry("Europe/Berlin"); out != DEU {
+		t.Errorf("Test TimeZoneCountry() err, want %v, got %v", DEU, out)
+	}
+	if out := TimeZoneCountry("europe/oslo"); out != NOR {
+		t.Errorf("Test TimeZoneCountry() err, want %v, got %v", NOR, out)
+	}
+	if out := TimeZoneCountries("Europe/Zurich"); len(out) != 3 || out[0] != CHE {
+		t.Errorf("Test TimeZoneCountries() err, want %v, got %v", []CountryCode{CHE, DEU, LIE}, out)
+	}
+	links := map[string]CountryCode{
+		"Europe/Kiev":           UKR,
+		"Asia/Calcutta":         IND,
+		"US/Eastern":            USA,
+		"asia/saigon":           VNM,
+		"Iceland":               ISL,
+		"Africa/Asmera":         ERI,
+		"Africa/Timbuktu":       MLI,
+		"Pacific/Yap":           FSM,
+		"Pacific/Ponape":        FSM,
+		"America/Virgin":        VIR,
+		"Atlantic/Jan_Mayen":    NOR,
+		"America/Coral_Harbour": CAN,
+	}
+	for zone, want := range links {
+		if out := TimeZoneCountry(zone); out != want || len(TimeZoneCountries(zone)) == 0 {
+			t.Errorf("Test TimeZoneCountry() err, zone %v, want %v, got %v", zone, want, out)
+		}
+	}
+	if out := TimeZoneCountry("Arctic/Longyearbyen"); out != SJM {
+		t.Errorf("Test TimeZoneCountry() err, want %v, got %v", SJM, out)
+	}
+	if out := TimeZoneCountry("Mars/Olympus_Mons"); out != Unknown || TimeZoneCountries("") != nil {
+		t.Errorf("Test TimeZoneCountry() err, want %v, got %v", Unknown, out)
+	}
+	if out := DEU.Info(); len(out.TimeZones) != 2 {
+		t.Errorf("Test Info() err, want time zones, got %v", out.TimeZones)
+	}
+}
+
+//nolint:gocyclo
+func TestLanguages(t *testing.T) {
+	for _, c := range All() {
+		for _, l := range c.Languages() {
+			if !l.Status.IsValid() || l.PopulationPercent <= 0 || l.PopulationPercent > 100 || l.Tag() == language.Und {
+				t.Errorf("Test Languages() err, country %v, got %v", c, l)
+			}
+		}
+		if len(c.Successors()) == 0 && c.Languages() != nil && FromLocale(c.DefaultLocale()) != c {
+			t.Errorf("Test FromLocale() err, want %v, got %v", c, FromLocale(c.DefaultLocale()))
+		}
+	}
+	if out := CHE.Languages(); len(out) != 6 || out[0].Code != "de" || out[0].Status != LanguageStatusOfficial || out[4].Status != LanguageStatusSpoken {
+		t.Errorf("Test Languages() err, want %v, got %v", "de, fr, it, rm, gsw, en", out)
+	}
+	if out := BEL.OfficialLanguages(); len(out) != 3 || out[0] != "nl" || out[2] != "de" {
+		t.Errorf("Test OfficialLanguages() err, want %v, got %v", []string{"nl", "fr", "de"}, out)
+	}
+	if out := Unknown.Languages(); out != nil || ATA.Languages() != nil {
+		t.Errorf("Test Languages() err, want %v, got %v", nil, out)
+	}
+	if out := LanguageStatus(100); out.IsValid() || out.String() != UnknownMsg || out.Type() != TypeLanguageStatus {
+		t.Errorf("Test LanguageStatus.IsValid() err, want %v, got %v", false, out.IsValid())
+	}
+	if out := CHE.DefaultLocale(); out != language.MustParse("de-CH") {
+		t.Errorf("Test DefaultLocale() err, want %v, got %v", "de-CH", out)
+	}
+	if out := AUS.DefaultLocale(); out != language.MustParse("en-AU") {
+		t.Errorf("Test DefaultLocale() err, want %v, got %v", "en-AU", out)
+	}
+	for c, want := range map[CountryCode]string{ZAF: "en-ZA", KAZ: "ru-KZ", LUX: "fr-LU", ISR: "he-IL"} {
+		if out := c.DefaultLocale(); out != language.MustParse(want) || c.Languages()[0].Tag() != language.Make(want[:2]) {
+			t.Errorf("Test DefaultLocale() err, country %v, want %v, got %v", c, want, out)
+		}
+	}
+	if out := Unknown.DefaultLocale(); out != language.Und || YUG.DefaultLocale() != language.Und {
+		t.Errorf("Test DefaultLocale() err, want %v, got %v", language.Und, out)
+	}
+	locales := []struct {
+		tag  string
+		want CountryCode
+	}{
+		{"de-CH", CHE}, {"fr-CA", CAN}, {"es-419", MEX}, {"pt-419", BRA}, {"en-150", GBR}, {"fr-155", FRA},
+		{"de", DEU}, {"pt", BRA}, {"es-724", ESP}, {"en-001", USA}, {"sr-Latn-XK", XKX}, {"und", Unknown},
+		{"fr-002", COD}, {"fr-011", CIV},
+	}
+	for _, locale := range locales {
+		if out := FromLocale(language.MustParse(locale.tag)); out != locale.want {
+			t.Errorf("Test FromLocale() err, locale %v, want %v, got %v", locale.tag, locale.want, out)
+		}
+	}
+	if out := CHE.Info(); len(out.Languages) != 6 {
+		t.Errorf("Test Info() err, want languages, got %v", out.Languages)
+	}
+}
+
+//nolint:gocyclo
+func TestConventions(t *testing.T) {
+	for _, c := range All() {
+		out := c.Conventions()
+		if out == nil {
+			continue
+		}
+		if len(out.Weekend) == 0 || !out.Clock.IsValid() || !out.DateOrder.IsValid() || !out.MeasurementSystem.IsValid() ||
+			!out.PaperSize.IsValid() || !out.DrivingSide.IsValid() || len(out.PlugTypes) == 0 || out.DecimalSeparator == out.GroupingSeparator {
+			t.Errorf("Test Conventions() err, country %v, got %v", c, out)
+		}
+		for _, plug := range out.PlugTypes {
+			if !plug.IsValid() {
+				t.Errorf("Test Conventions() err, country %v, got plug %v", c, plug)
+			}
+		}
+	}
+	if out := USA.Conventions(); out.FirstDayOfWeek != time.Sunday || out.Clock != Clock12 || out.DateOrder != DateOrderMDY ||
+		out.MeasurementSystem != MeasurementSystemImperial || out.PaperSize != PaperSizeLetter || out.DecimalSeparator != "." ||
+		out.DrivingSide != DrivingSideRight || out.PlugTypes[0] != PlugTypeA {
+		t.Errorf("Test Conventions() err, country %v, got %v", USA, out)
+	}
+	if out := DEU.Conventions(); out.FirstDayOfWeek != time.Monday || out.Clock != Clock24 || out.PaperSize != PaperSizeA4 ||
+		out.DecimalSeparator != "," || out.GroupingSeparator != "." || out.PlugTypes[1] != PlugTypeF || out.IsWeekend(time.Friday) {
+		t.Errorf("Test Conventions() err, country %v, got %v", DEU, out)
+	}
+	if out := SAU.Conventions(); !out.IsWeekend(time.Friday) || out.IsWeekend(time.Sunday) {
+		t.Errorf("Test Conventions().IsWeekend() err, want %v, got %v", []time.Weekday{time.Friday, time.Saturday}, out.Weekend)
+	}
+	if out := GBR.Conventions(); out.DrivingSide != DrivingSideLeft || out.MeasurementSystem != MeasurementSystemMixed || out.PlugTypes[0].String() != "G" {
+		t.Errorf("Test Conventions() err, country %v, got %v", GBR, out)
+	}
+	if out := CHE.Conventions(); out.GroupingSeparator != "’" || out.PlugTypes[1] != PlugTypeJ {
+		t.Errorf("Test Conventions() err, country %v, got %v", CHE, out)
+	}
+	if out := JPN.Conventions().DateOrder.Layout("/"); out != "2006/01/02" || DateOrderDMY.Layout(".") != "02.01.2006" {
+		t.Errorf("Test DateOrder.Layout() err, want %v, got %v", "2006/01/02", out)
+	}
+	if out := Unknown.Conventions(); out != nil || ATA.Conventions() != nil {
+		t.Errorf("Test Conventions() err, want %v, got %v", nil, out)
+	}
+	if out := PlugType(16); out.IsValid() || PlugTypeUnknown.IsValid() || DrivingSide(3).IsValid() || DateOrderUnknown.Layout("-") != "" {
+		t.Errorf("Test PlugType.IsValid() err, want %v, got %v", false, out.IsValid())
+	}
+	if out := FRA.Info(); out.Conventions == nil || out.Conventions.GroupingSeparator != "\u00a0" {
+		t.Errorf("Test Info() err, want conventions, got %v", out.Conventions)
+	}
+}
+
+//nolint:gocyclo
+func TestPostalCode(t *testing.T) {
+	for _, c := range All() {
+		format := c.PostalCode()
+		if format == nil {
+			continue
+		}
+		out, err := ValidatePostalCode(c, format.Example)
+		if err != nil || out != format.Example || !regexp.MustCompile(format.Regexp).MatchString(out) {
+			t.Errorf("Test ValidatePostalCode() err, country %v, want %v, got %v, %v", c, format.Example, out, err)
+		}
+	}
+	if out := USA.PostalCode(); out.Name != "ZIP Code" || !out.Required || out.Regexp != "^(?:[0-9]{5}|[0-9]{5}-[0-9]{4})$" {
+		t.Errorf("Test PostalCode() err, want %v, got %v", "ZIP Code", out)
+	}
+	if out := GBR.PostalCode(); !regexp.MustCompile(out.Regexp).MatchString("GIR 0AA") || regexp.MustCompile(out.Regexp).MatchString("ZZ1 1ZZ") {
+		t.Errorf("Test PostalCode() err, country %v, got %v", GBR, out.Regexp)
+	}
+	if out := IND.PostalCode(); out.Name != "PIN Code" || HKG.PostalCode() != nil || Unknown.PostalCode() != nil {
+		t.Errorf("Test PostalCode() err, want %v, got %v", "PIN Code", out)
+	}
+	codes := []struct {
+		country    CountryCode
+		code, want string
+	}{
+		{GBR, "sw1a1aa", "SW1A 1AA"}, {GBR, " ec1a  1bb ", "EC1A 1BB"}, {CAN, "k1a0b1", "K1A 0B1"}, {USA, "94105-1804", "94105-1804"},
+		{DEU, "DE-10115", "10115"}, {NLD, "1012js", "1012 JS"}, {SWE, "11122", "111 22"}, {POL, "00950", "00-950"},
+		{BRA, "01310100", "01310-100"}, {JPN, "1000001", "100-0001"}, {LVA, "1050", "LV-1050"}, {LVA, "lv 1050", "LV-1050"},
+		{IRL, "d02af30", "D02 AF30"}, {HKG, "", ""}, {IRL, "", ""}, {GBR, "gir0aa", "GIR 0AA"}, {GBR, "W1A 0AX", "W1A 0AX"},
+		{GBR, "m11ae", "M1 1AE"}, {GBR, "CR2 6XH", "CR2 6XH"}, {CAN, "V5K 0A1", "V5K 0A1"},
+	}
+	for _, code := range codes {
+		if out, err := ValidatePostalCode(code.country, code.code); err != nil || out != code.want {
+			t.Errorf("Test ValidatePostalCode() err, country %v, want %v, got %v, %v", code.country, code.want, out, err)
+		}
+	}
+	invalid := []struct {
+		country CountryCode
+		code    string
+	}{
+		{USA, "9410"}, {USA, ""}, {GBR, "SW1A 1A"}, {CAN, "123 456"}, {DEU, "1011"}, {HKG, "999077"}, {LVA, "LT-1050"},
+		{GBR, "ZZ1 1ZZ"}, {GBR, "QA1 1AA"}, {GBR, "SW1A 1CA"}, {GBR, "W1I 1AA"}, {GBR, "GIR 0AB"}, {CAN, "D1A 0B1"}, {CAN, "K1A 0O1"},
+		{CAN, "W1A 0B1"},
+	}
+	for _, code := range invalid {
+		if _, err := ValidatePostalCode(code.country, code.code); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Test ValidatePostalCode() err, country %v, code %q, want %v, got %v", code.country, code.code, ErrInvalidFormat, err)
+		}
+	}
+	if _, err := ValidatePostalCode(Unknown, "10115"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Test ValidatePostalCode() err, want %v, got %v", ErrNotFound, err)
+	}
+	subdivisions := []struct {
+		country CountryCode
+		code    string
+		want    SubdivisionCode
+	}{
+		{USA, "94105", SubdivisionUSCA}, {USA, "10001", SubdivisionUSNY}, {USA, "00901", SubdivisionUSPR}, {USA, "96799", SubdivisionUSAS},
+		{USA, "96813", SubdivisionUSHI}, {USA, "20500", SubdivisionUSDC}, {USA, "09001", SubdivisionUnknown}, {CAN, "H2X 1Y4", SubdivisionCAQC},
+		{CAN, "X0A 0H0", SubdivisionCANU}, {CAN, "X1A 2L9", SubdivisionCANT}, {DEU, "10115", SubdivisionDEBE}, {DEU, "80331", SubdivisionDEBY},
+		{DEU, "27568", SubdivisionDEHB}, {DEU, "89231", SubdivisionDEBY}, {DEU, "89073", SubdivisionDEBW}, {FRA, "75001", SubdivisionUnknown},
+		{USA, "941", SubdivisionUnknown},
+	}
+	for _, s := range subdivisions {
+		if out := PostalCodeSubdivision(s.country, s.code); out != s.want {
+			t.Errorf("Test PostalCodeSubdivision() err, country %v, code %v, want %v, got %v", s.country, s.code, s.want, out)
+		}
+	}
+	if out := GBR.Info(); out.PostalCode == nil || out.PostalCode.Name != "Postcode" {
+		t.Errorf("Test Info() err, want postal code, got %v", out.PostalCode)
+	}
+}
+
+//nolint:gocyclo
+func TestCountryAt(t *testing.T) {
+	points := []struct {
+		latitude, longitude float64
+		want                CountryCode
+	}{
+		{52.52, 13.40, DEU}, {48.85, 2.35, FRA}, {35.68, 139.69, JPN}, {40.71, -74.01, USA},
+		{19.43, -99.13, MEX}, {30.04, 31.24, EGY}, {15.50, 32.56, SDN}, {-33.87, 151.21, AUS}, {55.75, 37.62, RUS},
+		{41.903, 12.453, VAT}, {41.89, 12.49, ITA}, {-25.75, 28.19, ZAF}, {47.37, 8.54, CHE}, {61.22, -149.90, USA},
+		{21.31, -157.86, USA}, {18.47, -66.11, PRI}, {-77.85, 166.67, ATA}, {64.18, -51.72, GRL}, {-90, 0, ATA},
+		{0, -30, Unknown}, {30, -40, Unknown}, {91, 0, Unknown}, {0, 181, Unknown}, {math.NaN(), 0, Unknown},
+	}
+	for _, p := range points {
+		if out := CountryAt(p.latitude, p.longitude); out != p.want {
+			t.Errorf("Test CountryAt(%v, %v) err, want %v, got %v", p.latitude, p.longitude, p.want, out)
+		}
+	}
+}
+
+//nolint:gocyclo
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		code  Localizable
+		match MatchKind
+	}{
+		{"Untied States", USA, MatchKindFuzzy},
+		{"Philipines", PHL, MatchKindAlias},
+		{"Frence", FRA, MatchKindFuzzy},
+		{"Switz", CHE, MatchKindPrefix},
+		{"de", DEU, MatchKindCode},
+		{"EUR", CurrencyEUR, MatchKindCode},
+		{"Deutschland", DEU, MatchKindAlias},
+		{"tokyo", CapitalJP, MatchKindExact},
+		{"Yen", CurrencyJPY, MatchKindExact},
+	}
+	for _, test := range tests {
+		out := Search(test.query, 3)
+		if len(out) == 0 || out[0].Code != test.code || out[0].Match != test.match {
+			t.Errorf("Test Search() err, query %v, want %v %v, got %v", test.query, test.code, test.match, out)
+		}
+	}
+	if out := Search("republic", 0); len(out) < 10 {
+		t.Errorf("Test Search() err, want many candidates, got %v", len(out))
+	}
+	if out := Search("republic", 2); len(out) != 2 || out[0].Score < out[1].Score {
+		t.Errorf("Test Search() err, want 2 ranked candidates, got %v", out)
+	}
+	if out := Search("!!!", 5); len(out) != 0 {
+		t.Errorf("Test Search() err, want no candidates, got %v", out)
+	}
+	if out, ok := BestMatch("Untied States", 0.8); !ok || out.Code != USA {
+		t.Errorf("Test BestMatch() err, want %v, got %v", USA, out.Code)
+	}
+	if out, ok := BestMatch("Zzyzx", 0.8); ok {
+		t.Errorf("Test BestMatch() err, want no match, got %v", out.Code)
+	}
+	for _, out := range Search("DE", 0) {
+		if out.Match == MatchKindFuzzy {
+			t.Errorf("Test Search() err, want no fuzzy candidates of a short query, got %v", out)
+		}
+	}
+	similarities := []struct {
+		a, b string
+		min  float64
+		want float64
+	}{
+		{"FRENCE", "FRANCE", 0, 1 - 1.0/6},
+		{"UNTIEDSTATES", "UNITEDSTATES", 0, 1 - 1.0/12},
+		{"ABC", "ABC", 1, 1},
+		{"GERMANY", "GERMAN", 0.9, 0},
+		{"PERU", "UNITEDKINGDOMOFGREATBRITAIN", 0.5, 0},
+	}
+	for _, test := range similarities {
+		q := &searchQuery{letters: []rune(test.a)}
+		if out := q.editSimilarity([]rune(test.b), test.min); math.Abs(out-test.want) > 1e-9 {
+			t.Errorf("Test editSimilarity(%v, %v) err, want %v, got %v", test.a, test.b, test.want, out)
+		}
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	Search("a", 1)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Search("Untied States", 3)
+	}
+}
+
+//nolint:gocyclo
+func TestAutocomplete(t *testing.T) {
+	countriesOnly := []EntityKind{EntityKindCountry}
+	tests := []struct {
+		prefix string
+		opts   AutocompleteOptions
+		want   Localizable
+	}{
+		{"deu", AutocompleteOptions{Kinds: countriesOnly}, DEU},
+		{"Deutschl", AutocompleteOptions{}, DEU},
+		{"cal", AutocompleteOptions{Country: USA}, SubdivisionUSCA},
+		{"US-C", AutocompleteOptions{}, SubdivisionUSCA},
+		{"tok", AutocompleteOptions{Kinds: []EntityKind{EntityKindCapital}}, CapitalJP},
+		{"日", AutocompleteOptions{Kinds: countriesOnly}, JPN},
+		{"rib", AutocompleteOptions{Kinds: countriesOnly}, JPN},
+		{"Côte", AutocompleteOptions{}, CIV},
+		{"eu", AutocompleteOptions{Kinds: []EntityKind{EntityKindCurrency}}, CurrencyEUR},
+		{"yen", AutocompleteOptions{Country: JPN}, CurrencyJPY},
+	}
+	for _, test := range tests {
+		out := Autocomplete(test.prefix, test.opts)
+		if len(out) == 0 || out[0].Code != test.want {
+			t.Errorf("Test Autocomplete() err, prefix %v, want %v, got %v", test.prefix, test.want, out)
+		}
+	}
+	out := Autocomplete("a", AutocompleteOptions{Kinds: countriesOnly, Limit: 5})
+	if len(out) != 5 {
+		t.Errorf("Test Autocomplete() err, want 5 suggestions, got %v", len(out))
+	}
+	for i, suggestion := range out {
+		if suggestion.Kind != EntityKindCountry {
+			t.Errorf("Test Autocomplete() err, want %v, got %v", EntityKindCountry, suggestion.Kind)
+		}
+		for _, other := range out[:i] {
+			if other.Code == suggestion.Code {
+				t.Errorf("Test Autocomplete() err, duplicate %v", suggestion.Code)
+			}
+		}
+	}
+	if out := Autocomplete("zzzz", AutocompleteOptions{}); len(out) != 0 {
+		t.Errorf("Test Autocomplete() err, want no suggestions, got %v", out)
+	}
+	if out := Autocomplete("", AutocompleteOptions{}); len(out) != 0 {
+		t.Errorf("Test Autocomplete() err, want no suggestions, got %v", out)
+	}
+}
+
+func BenchmarkAutocomplete(b *testing.B) {
+	Autocomplete("a", AutocompleteOptions{})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Autocomplete("ger", AutocompleteOptions{})
+	}
+}
+
+func BenchmarkAutocompleteFiltered(b *testing.B) {
+	Autocomplete("a", AutocompleteOptions{})
+	opts := AutocompleteOptions{Kinds: []EntityKind{EntityKindSubdivision}, Country: USA, Limit: 5}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Autocomplete("new", opts)
+	}
+}
+
+func BenchmarkAutocompleteChinese(b *testing.B) {
+	Autocomplete("a", AutocompleteOptions{})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Autocomplete("北", AutocompleteOptions{})
+	}
+}
+
+func BenchmarkAutocompleteIndex(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		autocompleteOnce = sync.Once{}
+		autocompleteIndexData()
+	}
+}
+
+//nolint:gocyclo
+func TestLocalized(t *testing.T) {
+	tests := []struct {
+		code Localizable
+		lang language.Tag
+		want string
+	}{
+		{CapitalJP, language.Chinese, "东京"},
+		{CurrencyJPY, language.SimplifiedChinese, "日元"},
+		{SubdivisionUSCA, language.Make("zh-CN"), "加利福尼亚州"},
+		{SubdivisionAEDU, language.Chinese, "迪拜"},
+		{JPN, language.Chinese, JPN.StringCn()},
+		{RegionEU, language.Chinese, RegionEU.StringCn()},
+		{DEU, language.German, "Deutschland"},
+		{RegionAS, language.German, "Asien"},
+		{JPN, language.TraditionalChinese, "日本"},
+		{CapitalJP, language.TraditionalChinese, CapitalJP.String()},
+		{SubdivisionUSCA, language.German, SubdivisionUSCA.String()},
+	}
+	for _, test := range tests {
+		if out := Localized(test.code, test.lang); out != test.want {
+			t.Errorf("Test Localized() err, code %v, lang %v, want %v, got %v", test.code, test.lang, test.want, out)
+		}
+	}
+	for _, c := range AllCurrencies() {
+		if out := Localized(c, language.Chinese); out == c.String() {
+			t.Errorf("Test Localized() err, no chinese name of currency %v", c)
+		}
+	}
+	for _, c := range AllCapitals() {
+		if out := Localized(c, language.Chinese); out == c.String() {
+			t.Errorf("Test Localized() err, no chinese name of capital %v", c)
+		}
+	}
+}
+
+//nolint:gocyclo
+func TestLoadCatalog(t *testing.T) {
+	catalog, err := LoadCatalog(strings.NewReader(`{"language": "de", "names": {"capital:JP": "Tokio", "currency:JPY": "Japanischer Yen"}}`))
+	if err != nil {
+		t.Fatalf("Test LoadCatalog() err: %v", err)
+	}
+	localizer := DefaultLocalizer().With(catalog)
+	if out := localizer.Localized(CapitalJP, language.Make("de-CH")); out != "Tokio" {
+		t.Errorf("Test Localizer.Localized() err, want %v, got %v", "Tokio", out)
+	}
+	if out := localizer.Localized(CapitalJP, language.Chinese); out != "东京" {
+		t.Errorf("Test Localizer.Localized() err, want %v, got %v", "东京", out)
+	}
+	if out := NewLocalizer(catalog).Localized(JPN, language.German); out != JPN.String() {
+		t.Errorf("Test Localizer.Localized() err, want %v, got %v", JPN.String(), out)
+	}
+	if _, err = LoadCatalog(strings.NewReader(`{"language": 1}`)); err == nil {
+		t.Errorf("Test LoadCatalog() err, want error")
+	}
+}
+
+//nolint:gocyclo
+func TestSortedBy(t *testing.T) {
+	all := AllSortedBy(language.Swedish)
+	if len(all) != len(All()) {
+		t.Errorf("Test AllSortedBy() err, want %v, got %v", len(All()), len(all))
+	}
+	if all[len(all)-1] != ALA && all[len(all)-2] != ALA && all[len(all)-3] != ALA {
+		t.Errorf("Test AllSortedBy() err, want Åland at the end in swedish, got %v", all[len(all)-3:])
+	}
+	if all = AllSortedBy(language.English); all[0] != AFG {
+		t.Errorf("Test AllSortedBy() err, want %v, got %v", AFG, all[0])
+	}
+	zh := AllSortedBy(language.Chinese)
+	index := map[CountryCode]int{}
+	for i, c := range zh {
+		index[c] = i
+	}
+	// pinyin order: a-fu-han, ba-xi, de-guo, ri-ben, zhong-guo
+	if !(index[AFG] < index[BRA] && index[BRA] < index[DEU] && index[DEU] < index[JPN] && index[JPN] < index[CHN]) {
+		t.Errorf("Test AllSortedBy() err, want pinyin order")
+	}
+	all = AllSortedBy(language.English, USA, CAN, USA)
+	if all[0] != USA || all[1] != CAN || all[2] != AFG || len(all) != len(All()) {
+		t.Errorf("Test AllSortedBy() err, want pinned %v, %v, got %v", USA, CAN, all[:3])
+	}
+	currencies := AllCurrenciesSortedBy(language.English, CurrencyUSD)
+	if currencies[0] != CurrencyUSD || len(currencies) != len(AllCurrencies()) {
+		t.Errorf("Test AllCurrenciesSortedBy() err, want pinned %v, got %v", CurrencyUSD, currencies[0])
+	}
+	subdivisions := SubdivisionsSortedBy(USA, language.English)
+	if subdivisions[0] != SubdivisionUSAL || len(subdivisions) != len(SubdivisionsByCountryCode(USA)) {
+		t.Errorf("Test SubdivisionsSortedBy() err, want %v, got %v", SubdivisionUSAL, subdivisions[0])
+	}
+}
+
 //nolint:gocyclo
 func TestCountriesType(t *testing.T) {
 	for _, c := range getAllCountries(t) {
@@ -1620,6 +1620,22 @@ func (c CurrencyCode) NickelRounding() bool {
 	return false
 }
 
+// Successors - returns currencies, which replaced the withdrawn currency, nil if the currency is not withdrawn,
+// example: CurrencyHRK.Successors() == []CurrencyCode{CurrencyEUR}
+func (c CurrencyCode) Successors() []CurrencyCode {
+	switch c {
+	case CurrencyHRK:
+		return []CurrencyCode{CurrencyEUR}
+	case CurrencyCUC:
+		return []CurrencyCode{CurrencyCUP}
+	case CurrencyVEF:
+		return []CurrencyCode{CurrencyVES}
+	case CurrencyYUD:
+		return []CurrencyCode{CurrencyRSD}
+	}
+	return nil
+}
+
 // Info - return all info about currency as Currency struct
 func (c CurrencyCode) Info() *Currency {
 	return &Currency{
@@ -1670,7 +1686,17 @@ func AllCurrenciesInfo() []*Currency {
 
 // CurrencyCodeByName - return CurrencyCode by currencyCode Alpha name, case-insensitive, example: currencyUSD := CurrencyCodeByName("usd") OR currencyUSD := CurrencyCodeByName("USD")
 func CurrencyCodeByName(name string) CurrencyCode {
-	switch textPrepare(name) {
+	code := CurrencyUnknown
+	textLookup(name, func(key string) bool {
+		code = currencyByKey(key)
+		return code != CurrencyUnknown
+	}, nil)
+	return code
+}
+
+// currencyByKey - returns CurrencyCode by a key of textPrepare, returns CurrencyUnknown, if the key not found
+func currencyByKey(key string) CurrencyCode { //nolint:gocyclo
+	switch key {
 	case "AFN", "AFGHANI", "AFHANI":
 		return CurrencyAFN
 	case "ALL", "LEK":
@@ -1971,7 +1997,7 @@ func CurrencyCodeByName(name string) CurrencyCode {
 		return CurrencyTZS
 	case "THB", "BAHT":
 		return CurrencyTHB
-	case "TOP", "PA’ANGA":
+	case "TOP", "PAANGA":
 		return CurrencyTOP
 	case "TTD", "TRINIDADANDTOBAGODOLLAR", "TRINIDADDOLLAR":
 		return CurrencyTTD
@@ -1997,7 +2023,7 @@ func CurrencyCodeByName(name string) CurrencyCode {
 		return CurrencyUZS
 	case "VUV", "VATU":
 		return CurrencyVUV
-	case "VES", "BOLIVAR", "BOLÍVAR", "BOLÍVARFUERTE", "VENEZUELANBOLÍVARFUERTE", "BOLIVARFUERTE", "VENEZUELANBOLIVARFUERTE", "VENEZUELA", "VENESUELA":
+	case "VES", "BOLIVAR", "BOLIVARFUERTE", "VENEZUELANBOLIVARFUERTE", "VENEZUELA", "VENESUELA":
 		return CurrencyVES
 	case "VEF":
 		return CurrencyVEF
@@ -0,0 +1,330 @@
+package countries
+
+import (
+	_ "embed" // for ISO 3166 data files
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+//go:embed data/iso-codes/data_iso_3166-1.json
+var dataISO31661 []byte
+
+//go:embed data/codes/country_codes.json
+var dataCountryCodes []byte
+
+//go:embed data/ids/external_ids.json
+var dataExternalIDs []byte
+
+//go:embed data/tz/zone.tab
+var dataZoneTab []byte
+
+//go:embed data/tz/zone1970.tab
+var dataZone1970Tab []byte
+
+//go:embed data/tz/backward
+var dataZoneBackward []byte
+
+//go:embed data/languages/languages.tsv
+var dataLanguages []byte
+
+//go:embed data/postal/postalcodes.tsv
+var dataPostalCodes []byte
+
+//go:embed data/postal/subdivisions.tsv
+var dataPostalSubdivisions []byte
+
+// iso31661 - a record of data_iso_3166-1.json
+type iso31661 struct {
+	Alpha2       string `json:"alpha_2"`
+	Alpha3       string `json:"alpha_3"`
+	Numeric      string `json:"numeric"`
+	Name         string `json:"name"`
+	CommonName   string `json:"common_name"`
+	OfficialName string `json:"official_name"`
+}
+
+var (
+	iso31661Once   sync.Once
+	iso31661ByCode map[CountryCode]*iso31661
+)
+
+// iso31661Data - returns the ISO 3166-1 record of the country, parsed once from the embedded data file
+func iso31661Data(c CountryCode) *iso31661 {
+	iso31661Once.Do(func() {
+		var data struct {
+			Records []*iso31661 `json:"3166-1"`
+		}
+		iso31661ByCode = map[CountryCode]*iso31661{}
+		if err := json.Unmarshal(dataISO31661, &data); err != nil {
+			return
+		}
+		byAlpha3 := make(map[string]*iso31661, len(data.Records))
+		for _, record := range data.Records {
+			byAlpha3[record.Alpha3] = record
+		}
+		for _, code := range All() {
+			if record, ok := byAlpha3[code.Alpha3()]; ok {
+				iso31661ByCode[code] = record
+			}
+		}
+	})
+	return iso31661ByCode[c]
+}
+
+// intlCodes - a record of country_codes.json, records of sovereign states go first,
+// so reverse lookups of codes shared with dependent territories return the state
+type intlCodes struct {
+	Alpha2 string `json:"alpha_2"`
+	ITU    string `json:"itu"`
+	ICAO   string `json:"icao"`
+	IVR    string `json:"ivr"`
+	WMO    string `json:"wmo"`
+	MARC   string `json:"marc"`
+	GAUL   int    `json:"gaul"`
+}
+
+var (
+	intlCodesOnce    sync.Once
+	intlCodesByCode  map[CountryCode]*intlCodes
+	intlCodesRecords []*intlCodes
+)
+
+// intlCodesData - returns the international codes record of the country, parsed once from the embedded data file
+func intlCodesData(c CountryCode) *intlCodes {
+	intlCodesOnce.Do(func() {
+		var data struct {
+			Records []*intlCodes `json:"codes"`
+		}
+		intlCodesByCode = map[CountryCode]*intlCodes{}
+		if err := json.Unmarshal(dataCountryCodes, &data); err != nil {
+			return
+		}
+		intlCodesRecords = data.Records
+		byAlpha2 := make(map[string]CountryCode, len(data.Records))
+		for _, code := range All() {
+			byAlpha2[code.Alpha2()] = code
+		}
+		for _, record := range data.Records {
+			if code, ok := byAlpha2[record.Alpha2]; ok {
+				intlCodesByCode[code] = record
+			}
+		}
+	})
+	if record, ok := intlCodesByCode[c]; ok {
+		return record
+	}
+	return &intlCodes{}
+}
+
+// externalIDs - a record of external_ids.json, identifiers of a country, a capital or a subdivision in open knowledge bases;
+// the dataset is limited to all countries, capitals of 79 countries and subdivisions of USA, Germany and Canada,
+// identifiers of other capitals and subdivisions are out of its scope
+type externalIDs struct {
+	Alpha2   string `json:"alpha_2"`
+	Code     string `json:"code"`
+	Wikidata string `json:"wikidata"`
+	GeoNames int    `json:"geonames"`
+	OSM      int    `json:"osm"`
+}
+
+var (
+	externalIDsOnce          sync.Once
+	externalIDsByCountry     map[CountryCode]*externalIDs
+	externalIDsByCapital     map[CapitalCode]*externalIDs
+	externalIDsBySubdivision map[SubdivisionCode]*externalIDs
+)
+
+// externalIDsData - parses external_ids.json once
+func externalIDsData() {
+	externalIDsOnce.Do(func() {
+		var data struct {
+			Countries    []*externalIDs `json:"countries"`
+			Capitals     []*externalIDs `json:"capitals"`
+			Subdivisions []*externalIDs `json:"subdivisions"`
+		}
+		externalIDsByCountry = map[CountryCode]*externalIDs{}
+		externalIDsByCapital = map[CapitalCode]*externalIDs{}
+		externalIDsBySubdivision = map[SubdivisionCode]*externalIDs{}
+		if err := json.Unmarshal(dataExternalIDs, &data); err != nil {
+			return
+		}
+		for _, record := range data.Countries {
+			if code := ByAlpha2(record.Alpha2); code != Unknown {
+				externalIDsByCountry[code] = record
+			}
+		}
+		for _, record := range data.Capitals {
+			if code := ByAlpha2(record.Alpha2); code != Unknown {
+				externalIDsByCapital[code.Capital()] = record
+			}
+		}
+		for _, record := range data.Subdivisions {
+			if code := SubdivisionCode(record.Code); code.IsValid() {
+				externalIDsBySubdivision[code] = record
+			}
+		}
+	})
+}
+
+var (
+	timeZonesOnce      sync.Once
+	timeZonesByCountry map[CountryCode][]string
+	countriesByZone    map[string][]CountryCode
+)
+
+// timeZonesData - parses zone.tab (time zones of every country), zone1970.tab (countries overlapping a zone,
+// the most populous first) and backward (old names linked to zones) of the IANA time zone database once; zones are keyed in lower case
+func timeZonesData() {
+	timeZonesOnce.Do(func() {
+		timeZonesByCountry = map[CountryCode][]string{}
+		countriesByZone = map[string][]CountryCode{}
+		for _, fields := range tabRecords(dataZone1970Tab) {
+			for _, alpha2 := range strings.Split(fields[0], ",") {
+				if code := ByAlpha2(alpha2); code != Unknown {
+					countriesByZone[strings.ToLower(fields[2])] = append(countriesByZone[strings.ToLower(fields[2])], code)
+				}
+			}
+		}
+		for _, fields := range tabRecords(dataZoneTab) {
+			code := ByAlpha2(fields[0])
+			if code == Unknown {
+				continue
+			}
+			timeZonesByCountry[code] = append(timeZonesByCountry[code], fields[2])
+			if _, ok := countriesByZone[strings.ToLower(fields[2])]; !ok {
+				countriesByZone[strings.ToLower(fields[2])] = []CountryCode{code}
+			}
+		}
+		for _, fields := range tabRecords(dataZoneBackward) {
+			// a link inherits the countries of its target, unless it is an alias of a zone of another country ("#= ZONE")
+			target := fields[1]
+			if len(fields) > 3 && strings.HasPrefix(fields[3], "#= ") {
+				target = strings.TrimPrefix(fields[3], "#= ")
+			}
+			codes, ok := countriesByZone[strings.ToLower(target)]
+			if _, found := countriesByZone[strings.ToLower(fields[2])]; ok && !found && fields[0] == "Link" {
+				countriesByZone[strings.ToLower(fields[2])] = codes
+			}
+		}
+	})
+}
+
+// tabRecords - returns tab separated fields of the lines of a tzdb table, comments and short lines are skipped
+func tabRecords(data []byte) [][]string {
+	var records [][]string
+	for _, line := range strings.Split(string(data), "\n") {
+		if fields := strings.Split(line, "\t"); !strings.HasPrefix(line, "#") && len(fields) >= 3 {
+			records = append(records, fields)
+		}
+	}
+	return records
+}
+
+var (
+	languagesOnce      sync.Once
+	languagesByCountry map[CountryCode][]Language
+	languagePopulation map[CountryCode]float64
+)
+
+// languagesData - parses languages.tsv once: population of the country in thousands and its languages
+// as code:status:percent, where status is o (official), r (official regional), d (de facto official) or s (spoken);
+// the CLDR likely language of the country goes first, if the country speaks it
+func languagesData() {
+	languagesOnce.Do(func() {
+		languagesByCountry = map[CountryCode][]Language{}
+		languagePopulation = map[CountryCode]float64{}
+		for _, line := range strings.Split(string(dataLanguages), "\n") {
+			fields := strings.Split(line, "\t")
+			if strings.HasPrefix(line, "#") || len(fields) < 3 {
+				continue
+			}
+			code := ByAlpha2(fields[0])
+			if code == Unknown {
+				continue
+			}
+			languagePopulation[code], _ = strconv.ParseFloat(fields[1], 64)
+			for _, field := range strings.Fields(fields[2]) {
+				parts := strings.Split(field, ":")
+				if len(parts) != 3 {
+					continue
+				}
+				percent, _ := strconv.ParseFloat(parts[2], 64)
+				languagesByCountry[code] = append(languagesByCountry[code], Language{
+					Code: parts[0], Status: languageStatus(parts[1]), PopulationPercent: percent,
+				})
+			}
+			// the CLDR likely language of the region is the language of the default locale, example: en of und-ZA
+			likely, confidence := language.Make("und-" + fields[0]).Base()
+			records := languagesByCountry[code]
+			for i, l := range records {
+				if confidence != language.No && l.Code == likely.String() {
+					copy(records[1:i+1], records[:i])
+					records[0] = l
+					break
+				}
+			}
+		}
+	})
+}
+
+// languageStatus - returns LanguageStatus of a status letter of languages.tsv
+func languageStatus(letter string) LanguageStatus {
+	switch letter {
+	case "o":
+		return LanguageStatusOfficial
+	case "r":
+		return LanguageStatusOfficialRegional
+	case "d":
+		return LanguageStatusDeFactoOfficial
+	case "s":
+		return LanguageStatusSpoken
+	}
+	return LanguageStatusUnknown
+}
+
+// postalPrefix - a range of postal code prefixes of a subdivision, a record of data/postal/subdivisions.tsv
+type postalPrefix struct {
+	first       string
+	last        string
+	subdivision SubdivisionCode
+}
+
+var (
+	postalCodesOnce      sync.Once
+	postalCodesByCountry map[CountryCode]*PostalCodeFormat
+	postalPrefixes       map[CountryCode][]postalPrefix
+)
+
+// postalCodesData - parses postalcodes.tsv (name, whether required, formats and example of postal codes of a country)
+// and subdivisions.tsv (prefixes of postal codes of subdivisions) once
+func postalCodesData() {
+	postalCodesOnce.Do(func() {
+		postalCodesByCountry = map[CountryCode]*PostalCodeFormat{}
+		postalPrefixes = map[CountryCode][]postalPrefix{}
+		for _, line := range strings.Split(string(dataPostalCodes), "\n") {
+			fields := strings.Split(line, "\t")
+			if strings.HasPrefix(line, "#") || len(fields) < 5 {
+				continue
+			}
+			if code := ByAlpha2(fields[0]); code != Unknown {
+				formats := strings.Split(fields[3], "|")
+				postalCodesByCountry[code] = &PostalCodeFormat{
+					Name: fields[1], Regexp: postalCodeRegexp(formats), Example: fields[4], Required: fields[2] == "1", formats: formats,
+				}
+			}
+		}
+		for _, line := range strings.Split(string(dataPostalSubdivisions), "\n") {
+			fields := strings.Split(line, "\t")
+			if strings.HasPrefix(line, "#") || len(fields) < 4 {
+				continue
+			}
+			if code := ByAlpha2(fields[0]); code != Unknown && SubdivisionCode(fields[3]).IsValid() {
+				postalPrefixes[code] = append(postalPrefixes[code], postalPrefix{first: fields[1], last: fields[2], subdivision: SubdivisionCode(fields[3])})
+			}
+		}
+	})
+}
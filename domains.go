@@ -11,11 +11,16 @@ type DomainCode int64 // int64 for database/sql/driver.Valuer compatibility
 
 // Domain - capital info
 type Domain struct {
-	Name    string
-	Code    DomainCode
-	Country CountryCode
+	Name        string
+	Code        DomainCode
+	Kind        DomainKind
+	Country     CountryCode
+	Subdivision SubdivisionCode
 }
 
+// DomainKind - 顶级域名类型（国家、通用、赞助、基础设施、品牌、地理）
+type DomainKind int64 // int64 for database/sql/driver.Valuer compatibility
+
 // Type implements Typer interface
 func (_ DomainCode) Type() string {
 	return TypeDomainCode
@@ -48,6 +53,152 @@ func (c DomainCode) String() string { //nolint:gocyclo
 		return ".info"
 	case DomainName:
 		return ".name"
+	case DomainApp:
+		return ".app"
+	case DomainDev:
+		return ".dev"
+	case DomainXyz:
+		return ".xyz"
+	case DomainOnline:
+		return ".online"
+	case DomainShop:
+		return ".shop"
+	case DomainInt:
+		return ".int"
+	case DomainAero:
+		return ".aero"
+	case DomainAsia:
+		return ".asia"
+	case DomainCat:
+		return ".cat"
+	case DomainCoop:
+		return ".coop"
+	case DomainJobs:
+		return ".jobs"
+	case DomainMobi:
+		return ".mobi"
+	case DomainMuseum:
+		return ".museum"
+	case DomainPost:
+		return ".post"
+	case DomainTel:
+		return ".tel"
+	case DomainTravel:
+		return ".travel"
+	case DomainGoogle:
+		return ".google"
+	case DomainApple:
+		return ".apple"
+	case DomainAmazon:
+		return ".amazon"
+	case DomainMicrosoft:
+		return ".microsoft"
+	case DomainBMW:
+		return ".bmw"
+	case DomainBerlin:
+		return ".berlin"
+	case DomainHamburg:
+		return ".hamburg"
+	case DomainBayern:
+		return ".bayern"
+	case DomainNRW:
+		return ".nrw"
+	case DomainKoeln:
+		return ".koeln"
+	case DomainRuhr:
+		return ".ruhr"
+	case DomainSaarland:
+		return ".saarland"
+	case DomainWien:
+		return ".wien"
+	case DomainTirol:
+		return ".tirol"
+	case DomainBrussels:
+		return ".brussels"
+	case DomainVlaanderen:
+		return ".vlaanderen"
+	case DomainGent:
+		return ".gent"
+	case DomainZuerich:
+		return ".zuerich"
+	case DomainSwiss:
+		return ".swiss"
+	case DomainAmsterdam:
+		return ".amsterdam"
+	case DomainParis:
+		return ".paris"
+	case DomainAlsace:
+		return ".alsace"
+	case DomainCorsica:
+		return ".corsica"
+	case DomainBZH:
+		return ".bzh"
+	case DomainLondon:
+		return ".london"
+	case DomainScot:
+		return ".scot"
+	case DomainWales:
+		return ".wales"
+	case DomainCymru:
+		return ".cymru"
+	case DomainBarcelona:
+		return ".barcelona"
+	case DomainMadrid:
+		return ".madrid"
+	case DomainEus:
+		return ".eus"
+	case DomainGal:
+		return ".gal"
+	case DomainIstanbul:
+		return ".istanbul"
+	case DomainMoscow:
+		return ".moscow"
+	case DomainMoskva:
+		return ".moskva"
+	case DomainTokyo:
+		return ".tokyo"
+	case DomainOsaka:
+		return ".osaka"
+	case DomainKyoto:
+		return ".kyoto"
+	case DomainNagoya:
+		return ".nagoya"
+	case DomainYokohama:
+		return ".yokohama"
+	case DomainOkinawa:
+		return ".okinawa"
+	case DomainTaipei:
+		return ".taipei"
+	case DomainSydney:
+		return ".sydney"
+	case DomainMelbourne:
+		return ".melbourne"
+	case DomainQuebec:
+		return ".quebec"
+	case DomainNYC:
+		return ".nyc"
+	case DomainMiami:
+		return ".miami"
+	case DomainVegas:
+		return ".vegas"
+	case DomainBoston:
+		return ".boston"
+	case DomainCapetown:
+		return ".capetown"
+	case DomainDurban:
+		return ".durban"
+	case DomainJoburg:
+		return ".joburg"
+	case DomainRio:
+		return ".rio"
+	case DomainHelsinki:
+		return ".helsinki"
+	case DomainStockholm:
+		return ".stockholm"
+	case DomainBudapest:
+		return ".budapest"
+	case DomainKiwi:
+		return ".kiwi"
 	case DomainBV, DomainSJ:
 		return ".no"
 	case DomainGB:
@@ -73,20 +224,158 @@ func (c DomainCode) IsValid() bool {
 	return c.String() != UnknownMsg
 }
 
-// Country - returns a country of domain
+// Country - returns a country of domain, for geographic domains returns the country of the place, example: DomainNYC.Country() == USA,
+// International for .arpa; returns Unknown for other gTLDs, they are not bound to a country, even a community one like .cat
 func (c DomainCode) Country() CountryCode {
-	if !c.IsValid() {
-		return Unknown
+	switch c.Kind() {
+	case DomainKindCountryCode:
+		return CountryCode(c)
+	case DomainKindInfrastructure:
+		return International
+	}
+	switch c {
+	case DomainSwiss:
+		return CHE
+	case DomainKiwi:
+		return NZL
 	}
-	return CountryCode(c)
+	return c.Subdivision().Country()
+}
+
+// Kind - returns a kind of the domain: country-code, generic, sponsored, infrastructure, brand or geographic
+func (c DomainCode) Kind() DomainKind { //nolint:gocyclo
+	switch c {
+	case DomainArpa:
+		return DomainKindInfrastructure
+	case DomainCom, DomainOrg, DomainNet, DomainBiz, DomainInfo, DomainName, DomainApp, DomainDev, DomainXyz, DomainOnline, DomainShop:
+		return DomainKindGeneric
+	case DomainEdu, DomainGov, DomainMil, DomainInt, DomainAero, DomainAsia, DomainCat, DomainCoop, DomainJobs, DomainMobi,
+		DomainMuseum, DomainPost, DomainTel, DomainTravel:
+		return DomainKindSponsored
+	case DomainGoogle, DomainApple, DomainAmazon, DomainMicrosoft, DomainBMW:
+		return DomainKindBrand
+	case DomainTest:
+		return DomainKindReserved
+	case DomainXX:
+		return DomainKindUnknown
+	}
+	if c.Subdivision() != SubdivisionUnknown || c == DomainSwiss || c == DomainKiwi {
+		return DomainKindGeographic
+	}
+	if c.IsValid() && c < DomainArpa {
+		return DomainKindCountryCode
+	}
+	return DomainKindUnknown
+}
+
+// Subdivision - returns a subdivision (ISO 3166-2) of the geographic domain, example: DomainNYC.Subdivision() == SubdivisionUSNY,
+// returns SubdivisionUnknown, if domain is not bound to a subdivision, as for the sponsored community gTLD .cat
+func (c DomainCode) Subdivision() SubdivisionCode { //nolint:gocyclo
+	switch c {
+	case DomainBerlin:
+		return SubdivisionDEBE
+	case DomainHamburg:
+		return SubdivisionDEHH
+	case DomainBayern:
+		return SubdivisionDEBY
+	case DomainNRW, DomainKoeln, DomainRuhr:
+		return SubdivisionDENW
+	case DomainSaarland:
+		return SubdivisionDESL
+	case DomainWien:
+		return SubdivisionAT9
+	case DomainTirol:
+		return SubdivisionAT7
+	case DomainBrussels:
+		return SubdivisionBEBRU
+	case DomainVlaanderen:
+		return SubdivisionBEVLG
+	case DomainGent:
+		return SubdivisionBEVOV
+	case DomainZuerich:
+		return SubdivisionCHZH
+	case DomainAmsterdam:
+		return SubdivisionNLNH
+	case DomainParis:
+		return SubdivisionFR75
+	case DomainAlsace:
+		return SubdivisionFRGES
+	case DomainCorsica:
+		return SubdivisionFRCOR
+	case DomainBZH:
+		return SubdivisionFRBRE
+	case DomainLondon:
+		return SubdivisionGBLND
+	case DomainScot:
+		return SubdivisionGBSCT
+	case DomainWales, DomainCymru:
+		return SubdivisionGBWLS
+	case DomainBarcelona:
+		return SubdivisionESB
+	case DomainMadrid:
+		return SubdivisionESMD
+	case DomainEus:
+		return SubdivisionESPV
+	case DomainGal:
+		return SubdivisionESGA
+	case DomainIstanbul:
+		return SubdivisionTR34
+	case DomainMoscow, DomainMoskva:
+		return SubdivisionRUMOW
+	case DomainTokyo:
+		return SubdivisionJP13
+	case DomainOsaka:
+		return SubdivisionJP27
+	case DomainKyoto:
+		return SubdivisionJP26
+	case DomainNagoya:
+		return SubdivisionJP23
+	case DomainYokohama:
+		return SubdivisionJP14
+	case DomainOkinawa:
+		return SubdivisionJP47
+	case DomainTaipei:
+		return SubdivisionTWTPE
+	case DomainSydney:
+		return SubdivisionAUNSW
+	case DomainMelbourne:
+		return SubdivisionAUVIC
+	case DomainQuebec:
+		return SubdivisionCAQC
+	case DomainNYC:
+		return SubdivisionUSNY
+	case DomainMiami:
+		return SubdivisionUSFL
+	case DomainVegas:
+		return SubdivisionUSNV
+	case DomainBoston:
+		return SubdivisionUSMA
+	case DomainCapetown:
+		return SubdivisionZAWC
+	case DomainDurban:
+		return SubdivisionZANL
+	case DomainJoburg:
+		return SubdivisionZAGT
+	case DomainRio:
+		return SubdivisionBRRJ
+	case DomainHelsinki:
+		return SubdivisionFI18
+	case DomainStockholm:
+		return SubdivisionSEAB
+	case DomainBudapest:
+		return SubdivisionHUBU
+	}
+	return SubdivisionUnknown
 }
 
 // Info - returns domain information as Domain
 func (c DomainCode) Info() *Domain {
 	return &Domain{
-		Name:    c.String(),
-		Code:    c,
-		Country: c.Country(),
+		Name:        c.String(),
+		Code:        c,
+		Kind:        c.Kind(),
+		Country:     c.Country(),
+		Subdivision: c.Subdivision(),
 	}
 }
 
@@ -116,15 +405,32 @@ func (c *Domain) Scan(src interface{}) error {
 	return nil
 }
 
-// DomainCodeByName - return DomainCode by name, case-insensitive, example: domainAE := DomainCodeByName(".ae") OR capitalAE := domainAE("ae")
+// DomainCodeByName - return DomainCode by name, case-insensitive, example: domainAE := DomainCodeByName(".ae") OR capitalAE := domainAE("ae"),
+// generic and geographic domains are found by name, example: domainNYC := DomainCodeByName(".nyc"). Note: a name without a dot is
+// looked up as a country first, so DomainCodeByName("com") is Comoros (.km) while DomainCodeByName(".com") is DomainCom
 func DomainCodeByName(name string) DomainCode {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if strings.HasPrefix(name, ".") {
+		if domain := nonCountryDomainByName(name); domain != DomainUnknown {
+			return domain
+		}
+	}
 	country := ByName(name)
 	if country == Unknown {
-		return DomainUnknown
+		return nonCountryDomainByName("." + name)
 	}
 	return DomainCode(country)
 }
 
+func nonCountryDomainByName(name string) DomainCode {
+	for _, domain := range AllDomains() {
+		if domain >= DomainArpa && domain.String() == name {
+			return domain
+		}
+	}
+	return DomainUnknown
+}
+
 // AllDomains - returns all domains codes
 func AllDomains() []DomainCode {
 	return []DomainCode{
@@ -139,6 +445,79 @@ func AllDomains() []DomainCode {
 		DomainBiz,
 		DomainInfo,
 		DomainName,
+		DomainApp,
+		DomainDev,
+		DomainXyz,
+		DomainOnline,
+		DomainShop,
+		DomainInt,
+		DomainAero,
+		DomainAsia,
+		DomainCat,
+		DomainCoop,
+		DomainJobs,
+		DomainMobi,
+		DomainMuseum,
+		DomainPost,
+		DomainTel,
+		DomainTravel,
+		DomainGoogle,
+		DomainApple,
+		DomainAmazon,
+		DomainMicrosoft,
+		DomainBMW,
+		DomainBerlin,
+		DomainHamburg,
+		DomainBayern,
+		DomainNRW,
+		DomainKoeln,
+		DomainRuhr,
+		DomainSaarland,
+		DomainWien,
+		DomainTirol,
+		DomainBrussels,
+		DomainVlaanderen,
+		DomainGent,
+		DomainZuerich,
+		DomainSwiss,
+		DomainAmsterdam,
+		DomainParis,
+		DomainAlsace,
+		DomainCorsica,
+		DomainBZH,
+		DomainLondon,
+		DomainScot,
+		DomainWales,
+		DomainCymru,
+		DomainBarcelona,
+		DomainMadrid,
+		DomainEus,
+		DomainGal,
+		DomainIstanbul,
+		DomainMoscow,
+		DomainMoskva,
+		DomainTokyo,
+		DomainOsaka,
+		DomainKyoto,
+		DomainNagoya,
+		DomainYokohama,
+		DomainOkinawa,
+		DomainTaipei,
+		DomainSydney,
+		DomainMelbourne,
+		DomainQuebec,
+		DomainNYC,
+		DomainMiami,
+		DomainVegas,
+		DomainBoston,
+		DomainCapetown,
+		DomainDurban,
+		DomainJoburg,
+		DomainRio,
+		DomainHelsinki,
+		DomainStockholm,
+		DomainBudapest,
+		DomainKiwi,
 		DomainAU,
 		DomainAT,
 		DomainAZ,
@@ -406,5 +785,36 @@ func AllDomainsInfo() []*Domain {
 
 // TotalDomains - returns number of domains in the package, countries.TotalDomains() == len(countries.AllDomains()) but static value for performance
 func TotalDomains() int {
-	return 263
+	return 336
+}
+
+// Type implements Typer interface
+func (_ DomainKind) Type() string {
+	return TypeDomainKind
+}
+
+// String - implements fmt.Stringer, returns a domain kind name in english
+func (k DomainKind) String() string {
+	switch k {
+	case DomainKindCountryCode:
+		return "Country-code"
+	case DomainKindGeneric:
+		return "Generic"
+	case DomainKindSponsored:
+		return "Sponsored"
+	case DomainKindInfrastructure:
+		return "Infrastructure"
+	case DomainKindBrand:
+		return "Brand"
+	case DomainKindGeographic:
+		return "Geographic"
+	case DomainKindReserved:
+		return "Reserved"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (k DomainKind) IsValid() bool {
+	return k.String() != UnknownMsg
 }
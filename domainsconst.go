@@ -274,3 +274,95 @@ const (
 	DomainJP      DomainCode = DomainCode(JP)
 	DomainXX      DomainCode = DomainCode(XX)
 )
+
+// Generic, sponsored, brand and geographic top-level domains
+const (
+	DomainApp        DomainCode = DomainCode(International + 11)
+	DomainDev        DomainCode = DomainCode(International + 12)
+	DomainXyz        DomainCode = DomainCode(International + 13)
+	DomainOnline     DomainCode = DomainCode(International + 14)
+	DomainShop       DomainCode = DomainCode(International + 15)
+	DomainInt        DomainCode = DomainCode(International + 16)
+	DomainAero       DomainCode = DomainCode(International + 17)
+	DomainAsia       DomainCode = DomainCode(International + 18)
+	DomainCat        DomainCode = DomainCode(International + 19)
+	DomainCoop       DomainCode = DomainCode(International + 20)
+	DomainJobs       DomainCode = DomainCode(International + 21)
+	DomainMobi       DomainCode = DomainCode(International + 22)
+	DomainMuseum     DomainCode = DomainCode(International + 23)
+	DomainPost       DomainCode = DomainCode(International + 24)
+	DomainTel        DomainCode = DomainCode(International + 25)
+	DomainTravel     DomainCode = DomainCode(International + 26)
+	DomainGoogle     DomainCode = DomainCode(International + 27)
+	DomainApple      DomainCode = DomainCode(International + 28)
+	DomainAmazon     DomainCode = DomainCode(International + 29)
+	DomainMicrosoft  DomainCode = DomainCode(International + 30)
+	DomainBMW        DomainCode = DomainCode(International + 31)
+	DomainBerlin     DomainCode = DomainCode(International + 32)
+	DomainHamburg    DomainCode = DomainCode(International + 33)
+	DomainBayern     DomainCode = DomainCode(International + 34)
+	DomainNRW        DomainCode = DomainCode(International + 35)
+	DomainKoeln      DomainCode = DomainCode(International + 36)
+	DomainRuhr       DomainCode = DomainCode(International + 37)
+	DomainSaarland   DomainCode = DomainCode(International + 38)
+	DomainWien       DomainCode = DomainCode(International + 39)
+	DomainTirol      DomainCode = DomainCode(International + 40)
+	DomainBrussels   DomainCode = DomainCode(International + 41)
+	DomainVlaanderen DomainCode = DomainCode(International + 42)
+	DomainGent       DomainCode = DomainCode(International + 43)
+	DomainZuerich    DomainCode = DomainCode(International + 44)
+	DomainSwiss      DomainCode = DomainCode(International + 45)
+	DomainAmsterdam  DomainCode = DomainCode(International + 46)
+	DomainParis      DomainCode = DomainCode(International + 47)
+	DomainAlsace     DomainCode = DomainCode(International + 48)
+	DomainCorsica    DomainCode = DomainCode(International + 49)
+	DomainBZH        DomainCode = DomainCode(International + 50)
+	DomainLondon     DomainCode = DomainCode(International + 51)
+	DomainScot       DomainCode = DomainCode(International + 52)
+	DomainWales      DomainCode = DomainCode(International + 53)
+	DomainCymru      DomainCode = DomainCode(International + 54)
+	DomainBarcelona  DomainCode = DomainCode(International + 55)
+	DomainMadrid     DomainCode = DomainCode(International + 56)
+	DomainEus        DomainCode = DomainCode(International + 57)
+	DomainGal        DomainCode = DomainCode(International + 58)
+	DomainIstanbul   DomainCode = DomainCode(International + 59)
+	DomainMoscow     DomainCode = DomainCode(International + 60)
+	DomainMoskva     DomainCode = DomainCode(International + 61)
+	DomainTokyo      DomainCode = DomainCode(International + 62)
+	DomainOsaka      DomainCode = DomainCode(International + 63)
+	DomainKyoto      DomainCode = DomainCode(International + 64)
+	DomainNagoya     DomainCode = DomainCode(International + 65)
+	DomainYokohama   DomainCode = DomainCode(International + 66)
+	DomainOkinawa    DomainCode = DomainCode(International + 67)
+	DomainTaipei     DomainCode = DomainCode(International + 68)
+	DomainSydney     DomainCode = DomainCode(International + 69)
+	DomainMelbourne  DomainCode = DomainCode(International + 70)
+	DomainQuebec     DomainCode = DomainCode(International + 71)
+	DomainNYC        DomainCode = DomainCode(International + 72)
+	DomainMiami      DomainCode = DomainCode(International + 73)
+	DomainVegas      DomainCode = DomainCode(International + 74)
+	DomainBoston     DomainCode = DomainCode(International + 75)
+	DomainCapetown   DomainCode = DomainCode(International + 76)
+	DomainDurban     DomainCode = DomainCode(International + 77)
+	DomainJoburg     DomainCode = DomainCode(International + 78)
+	DomainRio        DomainCode = DomainCode(International + 79)
+	DomainHelsinki   DomainCode = DomainCode(International + 80)
+	DomainStockholm  DomainCode = DomainCode(International + 81)
+	DomainBudapest   DomainCode = DomainCode(International + 82)
+	DomainKiwi       DomainCode = DomainCode(International + 83)
+)
+
+// TypeDomainKind for Typer interface
+const TypeDomainKind string = "countries.DomainKind"
+
+// Domain kinds
+const (
+	DomainKindUnknown        DomainKind = 0
+	DomainKindCountryCode    DomainKind = 1 // ccTLD, example ".jp"
+	DomainKindGeneric        DomainKind = 2 // gTLD, example ".com"
+	DomainKindSponsored      DomainKind = 3 // sTLD, example ".edu"
+	DomainKindInfrastructure DomainKind = 4 // ".arpa"
+	DomainKindBrand          DomainKind = 5 // brand gTLD, example ".google"
+	DomainKindGeographic     DomainKind = 6 // geographic gTLD, example ".nyc"
+	DomainKindReserved       DomainKind = 7 // special-use names (RFC 2606), example ".test"
+)
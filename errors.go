@@ -0,0 +1,51 @@
+package countries
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Lookup errors of ParseCountry, ParseCurrency and ParseSubdivision, use errors.Is to check them
+var (
+	ErrNotFound  = errors.New("not found")
+	ErrAmbiguous = errors.New("ambiguous")
+	ErrWithdrawn = errors.New("withdrawn")
+)
+
+// ErrInvalidFormat - a value does not match the format of the country, returned by ValidatePostalCode, use errors.Is to check it
+var ErrInvalidFormat = errors.New("invalid format")
+
+// AmbiguousError - the name matches several codes, errors.Is(err, ErrAmbiguous) is true,
+// example: "Congo" is CountryCode COG or COD
+type AmbiguousError struct {
+	Name       string
+	Candidates []Localizable
+}
+
+// Error implements error interface
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("%q is %v, candidates: %v", e.Name, ErrAmbiguous, e.Candidates)
+}
+
+// Is - returns true for ErrAmbiguous
+func (e *AmbiguousError) Is(target error) bool {
+	return target == ErrAmbiguous
+}
+
+// WithdrawnError - the code is withdrawn from the standard, errors.Is(err, ErrWithdrawn) is true,
+// example: Netherlands Antilles (ANT) is succeeded by CUW, SXM and BES
+type WithdrawnError struct {
+	Name       string
+	Code       Localizable
+	Successors []Localizable
+}
+
+// Error implements error interface
+func (e *WithdrawnError) Error() string {
+	return fmt.Sprintf("%q (%v) is %v, successors: %v", e.Name, e.Code, ErrWithdrawn, e.Successors)
+}
+
+// Is - returns true for ErrWithdrawn
+func (e *WithdrawnError) Is(target error) bool {
+	return target == ErrWithdrawn
+}
@@ -0,0 +1,259 @@
+package countries
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	externalIDsIndexOnce       sync.Once
+	countryByWikidataID        map[string]CountryCode
+	countryByGeoNamesID        map[int]CountryCode
+	countryByOSMRelationID     map[int]CountryCode
+	capitalByWikidataID        map[string]CapitalCode
+	capitalByGeoNamesID        map[int]CapitalCode
+	capitalByOSMRelationID     map[int]CapitalCode
+	subdivisionByWikidataID    map[string]SubdivisionCode
+	subdivisionByGeoNamesID    map[int]SubdivisionCode
+	subdivisionByOSMRelationID map[int]SubdivisionCode
+)
+
+// WikidataID - returns a Wikidata item ID of the country, example "Q183" for Germany; known for every country
+func (c CountryCode) WikidataID() string {
+	externalIDsData()
+	if record, ok := externalIDsByCountry[c]; ok {
+		return intlCodeOrUnknown(record.Wikidata)
+	}
+	return UnknownMsg
+}
+
+// GeoNamesID - returns a GeoNames ID of the country, example 2921044 for Germany, 0 if not known (the withdrawn YU)
+func (c CountryCode) GeoNamesID() int {
+	externalIDsData()
+	if record, ok := externalIDsByCountry[c]; ok {
+		return record.GeoNames
+	}
+	return 0
+}
+
+// OSMRelationID - returns an OpenStreetMap relation ID of the country boundary, example 51477 for Germany,
+// 0 if not known (SJ, UM and withdrawn codes have no boundary relation)
+func (c CountryCode) OSMRelationID() int {
+	externalIDsData()
+	if record, ok := externalIDsByCountry[c]; ok {
+		return record.OSM
+	}
+	return 0
+}
+
+// WikidataID - returns a Wikidata item ID of the capital, example "Q64" for Berlin; the dataset covers capitals of
+// 79 countries only (Europe, the G20 and the most populous ones), UnknownMsg for other capitals
+func (c CapitalCode) WikidataID() string {
+	externalIDsData()
+	if record, ok := externalIDsByCapital[c]; ok {
+		return intlCodeOrUnknown(record.Wikidata)
+	}
+	return UnknownMsg
+}
+
+// GeoNamesID - returns a GeoNames ID of the capital, example 2950159 for Berlin, 0 if not known;
+// covers the same 79 capitals as WikidataID
+func (c CapitalCode) GeoNamesID() int {
+	externalIDsData()
+	if record, ok := externalIDsByCapital[c]; ok {
+		return record.GeoNames
+	}
+	return 0
+}
+
+// OSMRelationID - returns an OpenStreetMap relation ID of the capital boundary, example 62422 for Berlin, 0 if not known;
+// known for a few capitals with a city boundary relation only
+func (c CapitalCode) OSMRelationID() int {
+	externalIDsData()
+	if record, ok := externalIDsByCapital[c]; ok {
+		return record.OSM
+	}
+	return 0
+}
+
+// WikidataID - returns a Wikidata item ID of the subdivision, example "Q99" for California; the dataset covers
+// the states of USA (with DC, without the outlying areas), Germany and Canada only, UnknownMsg for other subdivisions
+func (s SubdivisionCode) WikidataID() string {
+	externalIDsData()
+	if record, ok := externalIDsBySubdivision[s]; ok {
+		return intlCodeOrUnknown(record.Wikidata)
+	}
+	return UnknownMsg
+}
+
+// GeoNamesID - returns a GeoNames ID of the subdivision, example 5332921 for California, 0 if not known;
+// covers the same subdivisions of USA, Germany and Canada as WikidataID
+func (s SubdivisionCode) GeoNamesID() int {
+	externalIDsData()
+	if record, ok := externalIDsBySubdivision[s]; ok {
+		return record.GeoNames
+	}
+	return 0
+}
+
+// OSMRelationID - returns an OpenStreetMap relation ID of the subdivision boundary, example 165475 for California, 0 if not known;
+// covers the same subdivisions of USA, Germany and Canada as WikidataID
+func (s SubdivisionCode) OSMRelationID() int {
+	externalIDsData()
+	if record, ok := externalIDsBySubdivision[s]; ok {
+		return record.OSM
+	}
+	return 0
+}
+
+// ByWikidataID - returns CountryCode by Wikidata item ID or entity URI, case-insensitive,
+// example: deu := ByWikidataID("Q183") OR deu := ByWikidataID("http://www.wikidata.org/entity/Q183"),
+// returns countries.Unknown, if the ID not found
+func ByWikidataID(id string) CountryCode {
+	externalIDsIndex()
+	if c, ok := countryByWikidataID[wikidataKey(id)]; ok {
+		return c
+	}
+	return Unknown
+}
+
+// ByGeoNamesID - returns CountryCode by GeoNames ID, example: deu := ByGeoNamesID(2921044),
+// returns countries.Unknown, if the ID not found
+func ByGeoNamesID(id int) CountryCode {
+	externalIDsIndex()
+	if c, ok := countryByGeoNamesID[id]; ok {
+		return c
+	}
+	return Unknown
+}
+
+// ByOSMRelationID - returns CountryCode by OpenStreetMap relation ID, example: deu := ByOSMRelationID(51477),
+// returns countries.Unknown, if the ID not found
+func ByOSMRelationID(id int) CountryCode {
+	externalIDsIndex()
+	if c, ok := countryByOSMRelationID[id]; ok {
+		return c
+	}
+	return Unknown
+}
+
+// CapitalCodeByWikidataID - returns CapitalCode by Wikidata item ID or entity URI, case-insensitive,
+// example: CapitalCodeByWikidataID("Q64") == CapitalDE, returns CapitalUnknown, if the ID not found or the capital
+// is not covered (see CapitalCode.WikidataID)
+func CapitalCodeByWikidataID(id string) CapitalCode {
+	externalIDsIndex()
+	if c, ok := capitalByWikidataID[wikidataKey(id)]; ok {
+		return c
+	}
+	return CapitalUnknown
+}
+
+// CapitalCodeByGeoNamesID - returns CapitalCode by GeoNames ID, example: CapitalCodeByGeoNamesID(2950159) == CapitalDE,
+// returns CapitalUnknown, if the ID not found or the capital is not covered (see CapitalCode.WikidataID)
+func CapitalCodeByGeoNamesID(id int) CapitalCode {
+	externalIDsIndex()
+	if c, ok := capitalByGeoNamesID[id]; ok {
+		return c
+	}
+	return CapitalUnknown
+}
+
+// CapitalCodeByOSMRelationID - returns CapitalCode by OpenStreetMap relation ID, example: CapitalCodeByOSMRelationID(62422) == CapitalDE,
+// returns CapitalUnknown, if the ID not found or the capital is not covered (see CapitalCode.OSMRelationID)
+func CapitalCodeByOSMRelationID(id int) CapitalCode {
+	externalIDsIndex()
+	if c, ok := capitalByOSMRelationID[id]; ok {
+		return c
+	}
+	return CapitalUnknown
+}
+
+// SubdivisionCodeByWikidataID - returns SubdivisionCode by Wikidata item ID or entity URI, case-insensitive,
+// example: SubdivisionCodeByWikidataID("Q99") == SubdivisionUSCA, returns SubdivisionUnknown, if the ID not found;
+// only subdivisions of USA, Germany and Canada are covered
+func SubdivisionCodeByWikidataID(id string) SubdivisionCode {
+	externalIDsIndex()
+	if s, ok := subdivisionByWikidataID[wikidataKey(id)]; ok {
+		return s
+	}
+	return SubdivisionUnknown
+}
+
+// SubdivisionCodeByGeoNamesID - returns SubdivisionCode by GeoNames ID, example: SubdivisionCodeByGeoNamesID(5332921) == SubdivisionUSCA,
+// returns SubdivisionUnknown, if the ID not found; only subdivisions of USA, Germany and Canada are covered
+func SubdivisionCodeByGeoNamesID(id int) SubdivisionCode {
+	externalIDsIndex()
+	if s, ok := subdivisionByGeoNamesID[id]; ok {
+		return s
+	}
+	return SubdivisionUnknown
+}
+
+// SubdivisionCodeByOSMRelationID - returns SubdivisionCode by OpenStreetMap relation ID,
+// example: SubdivisionCodeByOSMRelationID(165475) == SubdivisionUSCA, returns SubdivisionUnknown, if the ID not found;
+// only subdivisions of USA, Germany and Canada are covered
+func SubdivisionCodeByOSMRelationID(id int) SubdivisionCode {
+	externalIDsIndex()
+	if s, ok := subdivisionByOSMRelationID[id]; ok {
+		return s
+	}
+	return SubdivisionUnknown
+}
+
+// externalIDsIndex - builds reverse maps of the external identifiers once
+func externalIDsIndex() {
+	externalIDsIndexOnce.Do(func() {
+		externalIDsData()
+		countryByWikidataID = map[string]CountryCode{}
+		countryByGeoNamesID = map[int]CountryCode{}
+		countryByOSMRelationID = map[int]CountryCode{}
+		for c, record := range externalIDsByCountry {
+			if record.Wikidata != "" {
+				countryByWikidataID[record.Wikidata] = c
+			}
+			if record.GeoNames != 0 {
+				countryByGeoNamesID[record.GeoNames] = c
+			}
+			if record.OSM != 0 {
+				countryByOSMRelationID[record.OSM] = c
+			}
+		}
+		capitalByWikidataID = map[string]CapitalCode{}
+		capitalByGeoNamesID = map[int]CapitalCode{}
+		capitalByOSMRelationID = map[int]CapitalCode{}
+		for c, record := range externalIDsByCapital {
+			if record.Wikidata != "" {
+				capitalByWikidataID[record.Wikidata] = c
+			}
+			if record.GeoNames != 0 {
+				capitalByGeoNamesID[record.GeoNames] = c
+			}
+			if record.OSM != 0 {
+				capitalByOSMRelationID[record.OSM] = c
+			}
+		}
+		subdivisionByWikidataID = map[string]SubdivisionCode{}
+		subdivisionByGeoNamesID = map[int]SubdivisionCode{}
+		subdivisionByOSMRelationID = map[int]SubdivisionCode{}
+		for s, record := range externalIDsBySubdivision {
+			if record.Wikidata != "" {
+				subdivisionByWikidataID[record.Wikidata] = s
+			}
+			if record.GeoNames != 0 {
+				subdivisionByGeoNamesID[record.GeoNames] = s
+			}
+			if record.OSM != 0 {
+				subdivisionByOSMRelationID[record.OSM] = s
+			}
+		}
+	})
+}
+
+// wikidataKey - returns the item ID of a Wikidata ID, a prefixed ID or an entity URI, example: wikidataKey("wd:q183") == "Q183"
+func wikidataKey(id string) string {
+	id = strings.TrimSpace(id)
+	if i := strings.LastIndexAny(id, "/:"); i >= 0 {
+		id = id[i+1:]
+	}
+	return strings.ToUpper(id)
+}
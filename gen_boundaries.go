@@ -0,0 +1,737 @@
+//go:build ignore
+
+// gen_boundaries generates data/geo/boundaries.txt and data/geo/boundaries_lite.txt (countries_geolite tag): boundaries of
+// Natural Earth 1:110m admin 0 countries (public domain, https://www.naturalearthdata.com) by ISO 3166-1 Alpha-3, the hand
+// maintained rings of data/geo/boundaries_manual.txt for territories, islands and borders below that scale and approximate
+// boundaries of ISO 3166-2 subdivisions: the cells of the hand maintained points of data/geo/subdivision_points.txt
+// in the Voronoi diagram of the points of their country, clipped by the boundary of the country.
+// Run: go run gen_boundaries.go ne_110m_admin_0_countries.geojson
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// liteTolerance - the Douglas-Peucker tolerance of boundaries_lite.txt in degrees
+	liteTolerance = 0.3
+	header        = `# Simplified boundary polygons generated by gen_boundaries.go; DO NOT EDIT, edit data/geo/boundaries_manual.txt instead.
+# Natural Earth 1:110m admin 0 countries (public domain, https://www.naturalearthdata.com)%s and hand maintained rings of
+# territories, islands and borders below that scale. Subdivisions are approximate: cells of the points of
+# data/geo/subdivision_points.txt in the Voronoi diagram of the points of their country clipped by the boundary of the country,
+# so their borders may be off by tens of kilometres. A line is CODE followed by the vertices of one ring as latitude,longitude
+# pairs (WGS 84 degrees): ISO 3166-1 Alpha-3 for countries and ISO 3166-2 for subdivisions; several lines of a code are parts
+# of one multipolygon (even-odd rule, so a ring inside another ring is a hole). Rings never cross the 180th meridian.
+`
+)
+
+// neAliases - Natural Earth ADM0_A3 codes of regions without ISO_A3 merged into ISO 3166-1 countries
+var neAliases = map[string]string{"CYN": "CYP", "KOS": "XKX", "PSX": "PSE", "SAH": "ESH", "SDS": "SSD", "SOL": "SOM"}
+
+type ring [][2]float64
+
+type feature struct {
+	Properties map[string]any `json:"properties"`
+	Geometry   struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: go run gen_boundaries.go ne_110m_admin_0_countries.geojson")
+	}
+	countries := readNaturalEarth(os.Args[1])
+	manual, err := os.ReadFile("data/geo/boundaries_manual.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	full := merge(countries, manual, 0)
+	subdivisions := subdivisionRings(full, readPoints("data/geo/subdivision_points.txt"))
+	lite := merge(countries, manual, liteTolerance)
+	for code, rings := range subdivisions {
+		full[code] = rings
+		for _, r := range rings {
+			if simplified := clean(simplify(r, liteTolerance)); len(simplified) >= 3 && math.Abs(area(simplified)) > 0 {
+				r = simplified
+			}
+			lite[code] = append(lite[code], r)
+		}
+	}
+	write("data/geo/boundaries.txt", "", full)
+	write("data/geo/boundaries_lite.txt", fmt.Sprintf(" simplified to %g°", liteTolerance), lite)
+}
+
+// readNaturalEarth - returns rings of a Natural Earth admin 0 GeoJSON by ISO 3166-1 Alpha-3, French Guiana and Svalbard
+// are split from France and Norway
+func readNaturalEarth(name string) map[string][]ring {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var collection struct {
+		Features []feature `json:"features"`
+	}
+	if err = json.Unmarshal(data, &collection); err != nil {
+		log.Fatal(err)
+	}
+	countries := map[string][]ring{}
+	for _, f := range collection.Features {
+		code := property(f, "ISO_A3")
+		if alias, ok := neAliases[property(f, "ADM0_A3")]; ok {
+			code = alias
+		} else if code == "" || code == "-99" {
+			code = property(f, "ADM0_A3")
+		}
+		var polygons [][][][]float64
+		switch f.Geometry.Type {
+		case "Polygon":
+			var polygon [][][]float64
+			err = json.Unmarshal(f.Geometry.Coordinates, &polygon)
+			polygons = append(polygons, polygon)
+		case "MultiPolygon":
+			err = json.Unmarshal(f.Geometry.Coordinates, &polygons)
+		}
+		if err != nil {
+			log.Fatalf("%s: %v", code, err)
+		}
+		for _, polygon := range polygons {
+			part := code
+			for i, coordinates := range polygon {
+				r := make(ring, 0, len(coordinates))
+				for _, c := range coordinates {
+					r = append(r, [2]float64{round(c[1], 2), round(c[0], 2)})
+				}
+				if i == 0 {
+					part = split(code, r)
+				}
+				if r = clean(r); len(r) >= 3 {
+					countries[part] = append(countries[part], r)
+				}
+			}
+		}
+	}
+	return countries
+}
+
+// property - returns a string property of the feature, names are case insensitive
+func property(f feature, name string) string {
+	for key, value := range f.Properties {
+		if s, ok := value.(string); ok && strings.EqualFold(key, name) {
+			return s
+		}
+	}
+	return ""
+}
+
+// split - returns the code of a polygon of Natural Earth country by its outer ring
+func split(code string, outer ring) string {
+	lat, lng := center(outer)
+	switch {
+	case code == "FRA" && lng < -30:
+		return "GUF"
+	case code == "NOR" && lat > 74:
+		return "SJM"
+	}
+	return code
+}
+
+// merge - returns Natural Earth rings simplified with the tolerance (0 keeps them) and the manual rings by code
+func merge(countries map[string][]ring, manual []byte, tolerance float64) map[string][]ring {
+	out := make(map[string][]ring, len(countries))
+	for code, rings := range countries {
+		for _, r := range rings {
+			if tolerance > 0 {
+				if simplified := clean(simplify(r, tolerance)); len(simplified) >= 3 {
+					r = simplified
+				}
+			}
+			out[code] = append(out[code], r)
+		}
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(manual))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		r := make(ring, 0, len(fields)-1)
+		for _, field := range fields[1:] {
+			lat, lng, _ := strings.Cut(field, ",")
+			latitude, errLat := strconv.ParseFloat(lat, 64)
+			longitude, errLng := strconv.ParseFloat(lng, 64)
+			if errLat != nil || errLng != nil {
+				log.Fatalf("boundaries_manual.txt: %q", scanner.Text())
+			}
+			r = append(r, [2]float64{latitude, longitude})
+		}
+		gain, lose, ok := strings.Cut(fields[0], "<")
+		if !ok {
+			out[gain] = append(out[gain], r)
+			continue
+		}
+		if len(out[lose]) == 0 {
+			log.Fatalf("boundaries_manual.txt: no rings of %s", lose)
+		}
+		for _, from := range out[lose] {
+			if part := clean(clip(from, r)); len(part) >= 3 && math.Abs(area(part)) > 0 {
+				out[gain] = append(out[gain], part)
+				out[lose] = append(out[lose], part)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return out
+}
+
+// clip - returns the part of the ring inside the convex ring (Sutherland-Hodgman), new vertices are rounded to 3 decimals
+func clip(subject, convex ring) ring {
+	return cut(subject, convex, func(v float64) float64 { return round(v, 3) })
+}
+
+// cut - returns the part of the ring inside the convex ring (Sutherland-Hodgman), new vertices are rounded by precision
+func cut(subject, convex ring, precision func(v float64) float64) ring {
+	orientation := math.Copysign(1, area(convex))
+	out := subject
+	for i := range convex {
+		a, b := convex[i], convex[(i+1)%len(convex)]
+		inside := func(p [2]float64) bool { return orientation*cross(a, b, p) >= 0 }
+		in := out
+		out = nil
+		for j := range in {
+			p, q := in[j], in[(j+1)%len(in)]
+			if inside(p) {
+				out = append(out, p)
+			}
+			if inside(p) != inside(q) {
+				t := cross(a, b, p) / (cross(a, b, p) - cross(a, b, q))
+				out = append(out, [2]float64{precision(p[0] + t*(q[0]-p[0])), precision(p[1] + t*(q[1]-p[1]))})
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+	}
+	return out
+}
+
+// simplify - returns the ring simplified by Douglas-Peucker, the first vertex and the farthest one from it are kept
+func simplify(r ring, tolerance float64) ring {
+	if len(r) < 4 {
+		return r
+	}
+	far := 0
+	for i := range r {
+		if distance(r[0], r[i]) > distance(r[0], r[far]) {
+			far = i
+		}
+	}
+	closed := append(append(ring{}, r...), r[0])
+	first := douglasPeucker(closed[:far+1], tolerance)
+	second := douglasPeucker(closed[far:], tolerance)
+	return append(first[:len(first)-1], second[:len(second)-1]...)
+}
+
+// douglasPeucker - returns the vertices of the line kept by Douglas-Peucker, the ends are kept
+func douglasPeucker(line ring, tolerance float64) ring {
+	if len(line) < 3 {
+		return line
+	}
+	index, farthest := 0, 0.0
+	for i := 1; i < len(line)-1; i++ {
+		if d := segmentDistance(line[i], line[0], line[len(line)-1]); d > farthest {
+			index, farthest = i, d
+		}
+	}
+	if farthest <= tolerance {
+		return ring{line[0], line[len(line)-1]}
+	}
+	left := douglasPeucker(line[:index+1], tolerance)
+	return append(left[:len(left)-1], douglasPeucker(line[index:], tolerance)...)
+}
+
+// clean - returns the ring without repeated vertices and without the closing vertex
+func clean(r ring) ring {
+	out := make(ring, 0, len(r))
+	for _, p := range r {
+		if len(out) == 0 || out[len(out)-1] != p {
+			out = append(out, p)
+		}
+	}
+	for len(out) > 1 && out[0] == out[len(out)-1] {
+		out = out[:len(out)-1]
+	}
+	return out
+}
+
+// write - writes rings sorted by code in the boundaries.txt format
+func write(name, simplified string, rings map[string][]ring) {
+	codes := make([]string, 0, len(rings))
+	for code := range rings {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, header, simplified)
+	for _, code := range codes {
+		for _, r := range rings[code] {
+			buf.WriteString(code)
+			for _, p := range r {
+				fmt.Fprintf(&buf, " %s,%s", coordinate(p[0]), coordinate(p[1]))
+			}
+			buf.WriteByte('\n')
+		}
+	}
+	if err := os.WriteFile(name, buf.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// coordinate - formats a coordinate with two to three decimals
+func coordinate(v float64) string {
+	if s := strconv.FormatFloat(v, 'f', 2, 64); round(v, 2) == round(v, 3) {
+		return s
+	}
+	return strconv.FormatFloat(v, 'f', 3, 64)
+}
+
+func round(v float64, decimals int) float64 {
+	scale := math.Pow(10, float64(decimals))
+	return math.Round(v*scale) / scale
+}
+
+// area - returns the signed area of the ring in square degrees, positive for counterclockwise latitude, longitude rings
+func area(r ring) float64 {
+	sum := 0.0
+	for i := range r {
+		p, q := r[i], r[(i+1)%len(r)]
+		sum += p[0]*q[1] - q[0]*p[1]
+	}
+	return sum / 2
+}
+
+func center(r ring) (lat, lng float64) {
+	for _, p := range r {
+		lat, lng = lat+p[0], lng+p[1]
+	}
+	return lat / float64(len(r)), lng / float64(len(r))
+}
+
+func cross(a, b, p [2]float64) float64 {
+	return (b[0]-a[0])*(p[1]-a[1]) - (b[1]-a[1])*(p[0]-a[0])
+}
+
+func distance(a, b [2]float64) float64 {
+	return math.Hypot(a[0]-b[0], a[1]-b[1])
+}
+
+// segmentDistance - returns the distance of the point p from the segment ab in degrees
+func segmentDistance(p, a, b [2]float64) float64 {
+	length := (b[0]-a[0])*(b[0]-a[0]) + (b[1]-a[1])*(b[1]-a[1])
+	if length == 0 {
+		return distance(p, a)
+	}
+	t := math.Max(0, math.Min(1, ((p[0]-a[0])*(b[0]-a[0])+(p[1]-a[1])*(b[1]-a[1]))/length))
+	return distance(p, [2]float64{a[0] + t*(b[0]-a[0]), a[1] + t*(b[1]-a[1])})
+}
+
+// isoSubdivision - an ISO 3166-2 subdivision of data/iso-codes/data_iso_3166-2.json
+type isoSubdivision struct {
+	code    string
+	parent  string // the ISO 3166-2 code of the parent subdivision, empty for top level subdivisions
+	country string // ISO 3166-1 Alpha-3
+}
+
+// readISO - returns ISO 3166-2 subdivisions of data/iso-codes with ISO 3166-1 Alpha-3 of their countries
+func readISO() []isoSubdivision {
+	var countries map[string][]struct {
+		Alpha2 string `json:"alpha_2"`
+		Alpha3 string `json:"alpha_3"`
+	}
+	var subdivisions map[string][]struct {
+		Code   string `json:"code"`
+		Parent string `json:"parent"`
+	}
+	for name, v := range map[string]any{"data_iso_3166-1.json": &countries, "data_iso_3166-2.json": &subdivisions} {
+		data, err := os.ReadFile("data/iso-codes/" + name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = json.Unmarshal(data, v); err != nil {
+			log.Fatal(err)
+		}
+	}
+	alpha3 := map[string]string{}
+	for _, c := range countries["3166-1"] {
+		alpha3[c.Alpha2] = c.Alpha3
+	}
+	out := make([]isoSubdivision, 0, len(subdivisions["3166-2"]))
+	for _, s := range subdivisions["3166-2"] {
+		country, _, _ := strings.Cut(s.Code, "-")
+		sub := isoSubdivision{code: s.Code, country: alpha3[country]}
+		if s.Parent != "" {
+			sub.parent = country + "-" + s.Parent
+		}
+		out = append(out, sub)
+	}
+	return out
+}
+
+// readPoints - returns the tokens of data/geo/subdivision_points.txt by ISO 3166-2 code
+func readPoints(name string) map[string][]string {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	points := map[string][]string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 || points[fields[0]] != nil {
+			log.Fatalf("%s: %q", name, line)
+		}
+		points[fields[0]] = fields[1:]
+	}
+	return points
+}
+
+// site - a point of a subdivision in the Voronoi diagram of its country
+type site struct {
+	code string
+	p    [2]float64 // latitude, projected longitude
+}
+
+// subdivisionRings - returns rings of the ISO 3166-2 subdivisions: a subdivision is the part of its country nearest to its
+// points, the rings of an ISO 3166-1 Alpha-3 code or the union of the subdivisions listed; a subdivision not listed is
+// the union of its children, a first point outside the country is an octagon of 5 km, if it does not touch the part
+func subdivisionRings(boundaries map[string][]ring, points map[string][]string) map[string][]ring { //nolint:gocyclo
+	iso := readISO()
+	known := map[string]bool{}
+	children := map[string][]string{}
+	for _, s := range iso {
+		known[s.code] = true
+		if s.parent != "" {
+			children[s.parent] = append(children[s.parent], s.code)
+		}
+	}
+	for code := range points {
+		if !known[code] {
+			log.Fatalf("subdivision_points.txt: unknown subdivision %s", code)
+		}
+	}
+	pieces := map[string][]ring{}
+	sites := map[string][]site{}
+	for _, s := range iso {
+		tokens := points[s.code]
+		if tokens == nil || !strings.Contains(tokens[0], ",") {
+			continue
+		}
+		for _, token := range tokens {
+			lat, lng, _ := strings.Cut(token, ",")
+			latitude, errLat := strconv.ParseFloat(lat, 64)
+			longitude, errLng := strconv.ParseFloat(lng, 64)
+			if errLat != nil || errLng != nil {
+				log.Fatalf("subdivision_points.txt: %s %q", s.code, token)
+			}
+			sites[s.country] = append(sites[s.country], site{code: s.code, p: [2]float64{latitude, longitude}})
+		}
+	}
+	for country, list := range sites {
+		rings := boundaries[country]
+		if len(rings) == 0 {
+			log.Fatalf("subdivision_points.txt: no rings of %s", country)
+		}
+		project, unproject := projection(rings)
+		for i := range list {
+			list[i].p = project(list[i].p)
+		}
+		box := [4]float64{90, math.Inf(1), -90, math.Inf(-1)}
+		projected := make([]ring, len(rings))
+		for i, r := range rings {
+			projected[i] = make(ring, len(r))
+			for j, p := range r {
+				projected[i][j] = project(p)
+			}
+			for _, p := range projected[i] {
+				box = [4]float64{math.Min(box[0], p[0]), math.Min(box[1], p[1]), math.Max(box[2], p[0]), math.Max(box[3], p[1])}
+			}
+		}
+		for _, s := range list {
+			box = [4]float64{math.Min(box[0], s.p[0]), math.Min(box[1], s.p[1]), math.Max(box[2], s.p[0]), math.Max(box[3], s.p[1])}
+		}
+		bounds := ring{{box[0] - 1, box[1] - 1}, {box[0] - 1, box[3] + 1}, {box[2] + 1, box[3] + 1}, {box[2] + 1, box[1] - 1}}
+		for i, s := range list {
+			cell := bounds
+			for j, other := range list {
+				if i != j && other.p == s.p {
+					log.Fatalf("subdivision_points.txt: %s and %s share a point", s.code, other.code)
+				}
+				if i != j {
+					cell = bisect(cell, s.p, other.p)
+				}
+			}
+			for _, r := range projected {
+				part := cut(r, cell, func(v float64) float64 { return v })
+				if len(part) < 3 {
+					continue
+				}
+				out := make(ring, len(part))
+				for k, p := range part {
+					out[k] = unproject(p)
+				}
+				if east := out.east(); east > 180 {
+					for k := range out {
+						out[k][1] -= 360
+					}
+				}
+				for k, p := range out {
+					out[k] = [2]float64{round(p[0], 3), round(p[1], 3)}
+				}
+				if part = clean(out); len(part) >= 3 {
+					pieces[s.code] = append(pieces[s.code], part)
+				}
+			}
+		}
+	}
+	out := map[string][]ring{}
+	var resolve func(code string) []ring
+	resolve = func(code string) []ring {
+		if rings, ok := out[code]; ok {
+			return rings
+		}
+		var raw []ring
+		tokens := points[code]
+		switch {
+		case tokens == nil && len(children[code]) == 0:
+			log.Fatalf("subdivision_points.txt: no points of %s", code)
+		case tokens == nil:
+			for _, child := range children[code] {
+				raw = append(raw, resolve(child)...)
+			}
+		case strings.Contains(tokens[0], ","):
+			raw = pieces[code]
+			lat, lng, _ := strings.Cut(tokens[0], ",")
+			latitude, _ := strconv.ParseFloat(lat, 64)
+			longitude, _ := strconv.ParseFloat(lng, 64)
+			if around := octagon([2]float64{latitude, longitude}, 5); !touches(raw, around) {
+				raw = append(raw, around)
+			}
+		default:
+			for _, token := range tokens {
+				if known[token] {
+					raw = append(raw, resolve(token)...)
+				} else if rings := boundaries[token]; len(rings) > 0 {
+					raw = append(raw, rings...)
+				} else {
+					log.Fatalf("subdivision_points.txt: %s: unknown code %s", code, token)
+				}
+			}
+		}
+		out[code] = dissolve(raw)
+		return out[code]
+	}
+	for _, s := range iso {
+		resolve(s.code)
+	}
+	return out
+}
+
+// projection - returns an equirectangular projection of latitude, longitude points for the rings and its inverse,
+// longitudes west of the 180th meridian are shifted by 360° for rings on both sides of it
+func projection(rings []ring) (project, unproject func(p [2]float64) [2]float64) {
+	south, north, west, east := 90.0, -90.0, false, false
+	for _, r := range rings {
+		for _, p := range r {
+			south, north = math.Min(south, p[0]), math.Max(north, p[0])
+			west, east = west || p[1] < -170, east || p[1] > 170
+		}
+	}
+	scale := math.Cos((south + north) / 2 * math.Pi / 180)
+	shift := west && east
+	project = func(p [2]float64) [2]float64 {
+		if shift && p[1] < 0 {
+			p[1] += 360
+		}
+		return [2]float64{p[0], p[1] * scale}
+	}
+	unproject = func(p [2]float64) [2]float64 {
+		return [2]float64{p[0], p[1] / scale}
+	}
+	return project, unproject
+}
+
+// bisect - returns the part of the convex ring nearer to the point than to the other point
+func bisect(convex ring, point, other [2]float64) ring {
+	mid := [2]float64{(point[0] + other[0]) / 2, (point[1] + other[1]) / 2}
+	side := func(p [2]float64) float64 {
+		return (p[0]-mid[0])*(other[0]-point[0]) + (p[1]-mid[1])*(other[1]-point[1])
+	}
+	var out ring
+	for i := range convex {
+		p, q := convex[i], convex[(i+1)%len(convex)]
+		if side(p) <= 0 {
+			out = append(out, p)
+		}
+		if (side(p) <= 0) != (side(q) <= 0) {
+			t := side(p) / (side(p) - side(q))
+			out = append(out, [2]float64{p[0] + t*(q[0]-p[0]), p[1] + t*(q[1]-p[1])})
+		}
+	}
+	return out
+}
+
+// dissolve - returns rings of the union of disjoint rings (even-odd rule): edges shared by two rings are removed
+// and the remaining edges are joined into rings
+func dissolve(rings []ring) []ring { //nolint:gocyclo
+	type edge struct{ a, b [2]float64 }
+	var vertices [][2]float64
+	seen := map[[2]float64]bool{}
+	for _, r := range rings {
+		for _, p := range r {
+			if !seen[p] {
+				seen[p] = true
+				vertices = append(vertices, p)
+			}
+		}
+	}
+	sort.Slice(vertices, func(i, j int) bool { return vertices[i][0] < vertices[j][0] })
+	count := map[edge]int{}
+	var order []edge
+	add := func(a, b [2]float64) {
+		if b[0] < a[0] || b[0] == a[0] && b[1] < a[1] {
+			a, b = b, a
+		}
+		e := edge{a, b}
+		if count[e] == 0 {
+			order = append(order, e)
+		}
+		count[e]++
+	}
+	for _, r := range rings {
+		for i := range r {
+			a, b := r[i], r[(i+1)%len(r)]
+			// split the edge at vertices of other rings on it
+			south, north := math.Min(a[0], b[0])-1e-3, math.Max(a[0], b[0])+1e-3
+			from := sort.Search(len(vertices), func(k int) bool { return vertices[k][0] >= south })
+			var splits [][2]float64
+			for k := from; k < len(vertices) && vertices[k][0] <= north; k++ {
+				if p := vertices[k]; p != a && p != b && segmentDistance(p, a, b) < 1e-3 {
+					splits = append(splits, p)
+				}
+			}
+			sort.Slice(splits, func(i, j int) bool { return distance(a, splits[i]) < distance(a, splits[j]) })
+			for _, p := range splits {
+				add(a, p)
+				a = p
+			}
+			add(a, b)
+		}
+	}
+	next := map[[2]float64][]edge{}
+	for _, e := range order {
+		if count[e]%2 == 1 {
+			next[e.a] = append(next[e.a], e)
+			next[e.b] = append(next[e.b], e)
+		}
+	}
+	used := map[edge]bool{}
+	var out []ring
+	for _, start := range order {
+		if count[start]%2 == 0 || used[start] {
+			continue
+		}
+		used[start] = true
+		r := ring{start.a}
+		for p := start.b; p != start.a; {
+			r = append(r, p)
+			found := false
+			for _, e := range next[p] {
+				if !used[e] {
+					used[e], found = true, true
+					if e.a == p {
+						p = e.b
+					} else {
+						p = e.a
+					}
+					break
+				}
+			}
+			if !found {
+				break
+			}
+		}
+		if r = clean(r); len(r) >= 3 && math.Abs(area(r)) > 1e-7 {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// octagon - returns an octagon around the point with the radius in kilometres
+func octagon(p [2]float64, km float64) ring {
+	out := make(ring, 8)
+	for i := range out {
+		angle := float64(i) * math.Pi / 4
+		out[i] = [2]float64{
+			round(p[0]+km/111.2*math.Sin(angle), 3),
+			round(p[1]+km/111.2/math.Cos(p[0]*math.Pi/180)*math.Cos(angle), 3),
+		}
+	}
+	return out
+}
+
+// contains - returns true, if the rings contain the point (even-odd rule)
+func contains(rings []ring, p [2]float64) bool {
+	in := false
+	for _, r := range rings {
+		for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+			a, b := r[i], r[j]
+			if (a[0] > p[0]) != (b[0] > p[0]) && p[1] < (b[1]-a[1])*(p[0]-a[0])/(b[0]-a[0])+a[1] {
+				in = !in
+			}
+		}
+	}
+	return in
+}
+
+// touches - returns true, if a vertex of the rings is inside the ring or a vertex of the ring is inside the rings
+func touches(rings []ring, r ring) bool {
+	for _, p := range r {
+		if contains(rings, p) {
+			return true
+		}
+	}
+	for _, other := range rings {
+		for _, p := range other {
+			if contains([]ring{r}, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// east - returns the largest longitude of the ring
+func (r ring) east() float64 {
+	east := math.Inf(-1)
+	for _, p := range r {
+		east = math.Max(east, p[1])
+	}
+	return east
+}
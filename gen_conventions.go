@@ -0,0 +1,98 @@
+//go:build ignore
+
+// gen_conventions generates conventionstable.go: first day of week, weekend, clock, date order, measurement system,
+// paper size, number separators, driving side and plug types of countries (data/conventions/conventions.tsv).
+// Run: go run gen_conventions.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+var weekdays = map[string]string{
+	"mon": "time.Monday", "tue": "time.Tuesday", "wed": "time.Wednesday", "thu": "time.Thursday",
+	"fri": "time.Friday", "sat": "time.Saturday", "sun": "time.Sunday",
+}
+
+var constants = map[string]string{
+	"12": "Clock12", "24": "Clock24",
+	"DMY": "DateOrderDMY", "MDY": "DateOrderMDY", "YMD": "DateOrderYMD",
+	"metric": "MeasurementSystemMetric", "imperial": "MeasurementSystemImperial", "mixed": "MeasurementSystemMixed",
+	"A4": "PaperSizeA4", "Letter": "PaperSizeLetter",
+	"right": "DrivingSideRight", "left": "DrivingSideLeft",
+}
+
+var separators = map[string]string{".": `"."`, ",": `","`, "nbsp": `"\u00a0"`, "'": `"’"`}
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_conventions.go; DO NOT EDIT.\n\npackage countries\n\nimport \"time\"\n\n")
+	buf.WriteString("// conventionsTable - locale conventions of countries\n")
+	buf.WriteString("var conventionsTable = map[CountryCode]Conventions{\n")
+	for _, fields := range readTSV("data/conventions/conventions.tsv", 11) {
+		if fields[1] == "" {
+			continue
+		}
+		weekend := make([]string, 0, 2)
+		for _, day := range strings.Split(fields[2], ",") {
+			weekend = append(weekend, lookup(weekdays, day))
+		}
+		plugs := make([]string, 0, 4)
+		for _, plug := range strings.Split(fields[10], ",") {
+			plugs = append(plugs, "PlugType"+plug)
+		}
+		fmt.Fprintf(&buf, "\t%s: {%s, []time.Weekday{%s}, %s, %s, %s, %s, %s, %s, %s, []PlugType{%s}},\n",
+			fields[0], lookup(weekdays, fields[1]), strings.Join(weekend, ", "), lookup(constants, fields[3]), lookup(constants, fields[4]),
+			lookup(constants, fields[5]), lookup(constants, fields[6]), lookup(separators, fields[7]), lookup(separators, fields[8]),
+			lookup(constants, fields[9]), strings.Join(plugs, ", "))
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile("conventionstable.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// lookup - returns the Go expression of a value of the table, stops on unknown values
+func lookup(values map[string]string, value string) string {
+	expression, ok := values[value]
+	if !ok {
+		log.Fatalf("unknown value %q", value)
+	}
+	return expression
+}
+
+// readTSV - returns rows of a tab separated file, lines starting with # are comments
+func readTSV(name string, columns int) [][]string {
+	file, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+	var rows [][]string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != columns {
+			log.Fatalf("%s: want %d columns, got %d: %q", name, columns, len(fields), line)
+		}
+		rows = append(rows, fields)
+	}
+	if err = scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return rows
+}
@@ -0,0 +1,389 @@
+//go:build ignore
+
+// gen_geo generates geotable.go: area, coastline, centroid, bounding box and land neighbors of countries
+// (data/geo/countries.tsv, "-" is an unknown coastline) and centroid and bounding box of subdivisions (data/geo/subdivisions.tsv,
+// subdivisions missing there by their rings of data/geo/boundaries.txt); a centroid outside the boundary of its subdivision,
+// like the one of DE-BB around DE-BE or of the islands of US-HI, is moved to the middle of the widest chord of the boundary.
+// Run: go run gen_geo.go; go run gen_geo.go ne_10m_admin_1_states_provinces.geojson rewrites subdivisions.tsv first with
+// all ISO 3166-2 subdivisions of the Natural Earth admin 1 file (public domain, https://www.naturalearthdata.com)
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	if len(os.Args) > 1 {
+		importSubdivisions(os.Args[1])
+	}
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_geo.go; DO NOT EDIT.\n\npackage countries\n\n")
+	buf.WriteString("// countryGeoTable - area (km²), coastline (km), centroid, bounding box (south, west, north, east) and land neighbors of countries\n")
+	buf.WriteString("var countryGeoTable = map[CountryCode]countryGeo{\n")
+	for _, fields := range readTSV("data/geo/countries.tsv", 10) {
+		neighbors := "nil"
+		if fields[9] != "" {
+			neighbors = "[]CountryCode{" + strings.ReplaceAll(fields[9], ",", ", ") + "}"
+		}
+		coastline := fields[2]
+		if coastline == "-" {
+			coastline = "-1"
+		}
+		fmt.Fprintf(&buf, "\t%s: {%s, %s, %s, %s},\n", fields[0], fields[1], coastline, point(fields[3:9]), neighbors)
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// subdivisionGeoTable - centroid and bounding box (south, west, north, east) of subdivisions\n")
+	buf.WriteString("var subdivisionGeoTable = map[SubdivisionCode]subdivisionGeo{\n")
+	for _, fields := range subdivisionRows() {
+		fmt.Fprintf(&buf, "\t%q: {%s},\n", fields[0], point(fields[1:7]))
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile("geotable.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// point - returns a centroid and a bounding box literal of latitude, longitude, south, west, north and east fields
+func point(fields []string) string {
+	return fmt.Sprintf("%s, %s, BoundingBox{%s}", fields[0], fields[1], strings.Join(fields[2:], ", "))
+}
+
+// readTSV - returns rows of a tab separated file, lines starting with # are comments
+func readTSV(name string, columns int) [][]string {
+	file, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+	var rows [][]string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != columns {
+			log.Fatalf("%s: want %d columns, got %d: %q", name, columns, len(fields), line)
+		}
+		rows = append(rows, fields)
+	}
+	if err = scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return rows
+}
+
+// importSubdivisions - rewrites data/geo/subdivisions.tsv with centroids and bounding boxes of the ISO 3166-2 subdivisions
+// of a Natural Earth admin 1 GeoJSON (property iso_3166_2), rows of codes missing in the file are kept
+func importSubdivisions(name string) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var collection struct {
+		Features []struct {
+			Properties map[string]any `json:"properties"`
+			Geometry   struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+		} `json:"features"`
+	}
+	if err = json.Unmarshal(data, &collection); err != nil {
+		log.Fatal(err)
+	}
+	codes := isoSubdivisions()
+	rings := map[string][][][]float64{}
+	for _, f := range collection.Features {
+		code := ""
+		for key, value := range f.Properties {
+			if s, ok := value.(string); ok && strings.EqualFold(key, "iso_3166_2") {
+				code = s
+			}
+		}
+		if !codes[code] {
+			continue
+		}
+		var polygons [][][][]float64
+		switch f.Geometry.Type {
+		case "Polygon":
+			var polygon [][][]float64
+			err = json.Unmarshal(f.Geometry.Coordinates, &polygon)
+			polygons = append(polygons, polygon)
+		case "MultiPolygon":
+			err = json.Unmarshal(f.Geometry.Coordinates, &polygons)
+		}
+		if err != nil {
+			log.Fatalf("%s: %v", code, err)
+		}
+		for _, polygon := range polygons {
+			if len(polygon) > 0 {
+				rings[code] = append(rings[code], polygon[0])
+			}
+		}
+	}
+	rows := map[string][]string{}
+	for _, row := range readTSV("data/geo/subdivisions.tsv", 7) {
+		rows[row[0]] = row
+	}
+	for code, outer := range rings {
+		rows[code] = subdivisionRow(code, outer, nil)
+	}
+	keys := make([]string, 0, len(rows))
+	for code := range rows {
+		keys = append(keys, code)
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	buf.WriteString("# code\tlat\tlng\tsouth\twest\tnorth\teast\n")
+	for _, code := range keys {
+		buf.WriteString(strings.Join(rows[code], "\t") + "\n")
+	}
+	if err = os.WriteFile("data/geo/subdivisions.tsv", buf.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("subdivisions.tsv: %d of %d ISO 3166-2 subdivisions, %d from %s", len(rows), len(codes), len(rings), name)
+}
+
+// isoSubdivisions - returns the ISO 3166-2 codes of data/iso-codes/data_iso_3166-2.json
+func isoSubdivisions() map[string]bool {
+	data, err := os.ReadFile("data/iso-codes/data_iso_3166-2.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var file map[string][]struct {
+		Code string `json:"code"`
+	}
+	if err = json.Unmarshal(data, &file); err != nil {
+		log.Fatal(err)
+	}
+	codes := map[string]bool{}
+	for _, subdivisions := range file {
+		for _, s := range subdivisions {
+			codes[s.Code] = true
+		}
+	}
+	return codes
+}
+
+// subdivisionRow - returns the row of subdivisions.tsv of the outer rings and the holes (longitude, latitude positions): the area
+// weighted centroid and the bounding box rounded outwards, which crosses the 180th meridian, if the largest gap between the rings is not around it
+func subdivisionRow(code string, outer, holes [][][]float64) []string {
+	type span struct{ west, east float64 }
+	spans := make([]span, 0, len(outer))
+	south, north := 90.0, -90.0
+	for _, ring := range outer {
+		s := span{180, -180}
+		for _, p := range ring {
+			s.west, s.east = math.Min(s.west, p[0]), math.Max(s.east, p[0])
+			south, north = math.Min(south, p[1]), math.Max(north, p[1])
+		}
+		spans = append(spans, s)
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].west < spans[j].west })
+	end := spans[0].east
+	for _, s := range spans[1:] {
+		end = math.Max(end, s.east)
+	}
+	gap, west, east := spans[0].west+360-end, spans[0].west, end
+	reach := spans[0].east
+	for _, s := range spans[1:] {
+		if s.west-reach > gap {
+			gap, west, east = s.west-reach, s.west, reach
+		}
+		reach = math.Max(reach, s.east)
+	}
+	sum, lat, lng := 0.0, 0.0, 0.0
+	for k, ring := range append(outer[:len(outer):len(outer)], holes...) {
+		area, y, x := 0.0, 0.0, 0.0
+		for i, j := len(ring)-1, 0; j < len(ring); i, j = j, j+1 {
+			x0, y0, x1, y1 := ring[i][0], ring[i][1], ring[j][0], ring[j][1]
+			if west > east && x0 < 0 {
+				x0 += 360
+			}
+			if west > east && x1 < 0 {
+				x1 += 360
+			}
+			cross := x0*y1 - x1*y0
+			area, x, y = area+cross, x+(x0+x1)*cross, y+(y0+y1)*cross
+		}
+		if area < 0 {
+			area, x, y = -area, -x, -y
+		}
+		if k >= len(outer) {
+			area, x, y = -area, -x, -y
+		}
+		sum, lat, lng = sum+area, lat+y, lng+x
+	}
+	if sum == 0 {
+		log.Fatalf("%s: empty geometry", code)
+	}
+	lat, lng = lat/(3*sum), lng/(3*sum)
+	if lng > 180 {
+		lng -= 360
+	}
+	return []string{
+		code, coordinate(lat), coordinate(lng),
+		coordinate(math.Floor(south*1000) / 1000), coordinate(math.Floor(west*1000) / 1000),
+		coordinate(math.Ceil(north*1000) / 1000), coordinate(math.Ceil(east*1000) / 1000),
+	}
+}
+
+// subdivisionRows - returns the rows of data/geo/subdivisions.tsv and of the subdivisions missing there with rings
+// in data/geo/boundaries.txt sorted by code, centroids outside the boundary of their subdivision moved inside
+func subdivisionRows() [][]string {
+	boundaries := readBoundaries("data/geo/boundaries.txt")
+	rows := readTSV("data/geo/subdivisions.tsv", 7)
+	known := map[string]bool{}
+	for _, row := range rows {
+		known[row[0]] = true
+	}
+	for code, rings := range boundaries {
+		if known[code] || !strings.Contains(code, "-") {
+			continue
+		}
+		var outer, holes [][][]float64
+		for i, ring := range rings {
+			if depth(rings, i)%2 == 0 {
+				outer = append(outer, ring)
+			} else {
+				holes = append(holes, ring)
+			}
+		}
+		rows = append(rows, subdivisionRow(code, outer, holes))
+	}
+	for _, row := range rows {
+		rings := boundaries[row[0]]
+		lat, lng := parseCoordinate(row[1]), parseCoordinate(row[2])
+		if len(rings) == 0 || contains(rings, lng, lat) {
+			continue
+		}
+		lat, lng = interior(rings, lat)
+		row[1], row[2] = coordinate(lat), coordinate(lng)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i][0] < rows[j][0] })
+	return rows
+}
+
+// readBoundaries - returns the rings (longitude, latitude positions) of a boundaries.txt file by code
+func readBoundaries(name string) map[string][][][]float64 {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	boundaries := map[string][][][]float64{}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		ring := make([][]float64, 0, len(fields)-1)
+		for _, field := range fields[1:] {
+			lat, lng, ok := strings.Cut(field, ",")
+			if !ok {
+				log.Fatalf("%s: malformed vertex %q of %s", name, field, fields[0])
+			}
+			ring = append(ring, []float64{parseCoordinate(lng), parseCoordinate(lat)})
+		}
+		boundaries[fields[0]] = append(boundaries[fields[0]], ring)
+	}
+	return boundaries
+}
+
+// depth - returns the number of the other rings containing the first vertex of the ring k, odd for a hole
+func depth(rings [][][]float64, k int) int {
+	n := 0
+	for i, ring := range rings {
+		if i != k && contains([][][]float64{ring}, rings[k][0][0], rings[k][0][1]) {
+			n++
+		}
+	}
+	return n
+}
+
+// contains - returns true, if the point is inside the rings (longitude, latitude positions, even-odd rule)
+func contains(rings [][][]float64, x, y float64) bool {
+	in := false
+	for _, ring := range rings {
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			a, b := ring[i], ring[j]
+			if (a[1] > y) != (b[1] > y) && x < (b[0]-a[0])*(y-a[1])/(b[1]-a[1])+a[0] {
+				in = !in
+			}
+		}
+	}
+	return in
+}
+
+// interior - returns the middle of the widest chord of the rings along the parallel, along the middle parallel
+// of the tallest ring, if the parallel misses the rings
+func interior(rings [][][]float64, latitude float64) (float64, float64) {
+	tallest, height := rings[0], 0.0
+	for _, ring := range rings {
+		if south, north := extent(ring); north-south > height {
+			tallest, height = ring, north-south
+		}
+	}
+	south, north := extent(tallest)
+	for _, y := range []float64{latitude, (south + north) / 2} {
+		var xs []float64
+		for _, ring := range rings {
+			for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+				a, b := ring[i], ring[j]
+				if (a[1] > y) != (b[1] > y) {
+					xs = append(xs, (b[0]-a[0])*(y-a[1])/(b[1]-a[1])+a[0])
+				}
+			}
+		}
+		sort.Float64s(xs)
+		width, x := 0.0, math.NaN()
+		for i := 0; i+1 < len(xs); i += 2 {
+			if xs[i+1]-xs[i] > width {
+				width, x = xs[i+1]-xs[i], (xs[i]+xs[i+1])/2
+			}
+		}
+		if !math.IsNaN(x) {
+			return y, x
+		}
+	}
+	log.Fatalf("no interior point of the rings at %v", tallest[0])
+	return 0, 0
+}
+
+// extent - returns the southernmost and the northernmost latitude of the ring
+func extent(ring [][]float64) (south, north float64) {
+	south, north = 90, -90
+	for _, p := range ring {
+		south, north = math.Min(south, p[1]), math.Max(north, p[1])
+	}
+	return south, north
+}
+
+// parseCoordinate - parses a coordinate of a data file
+func parseCoordinate(s string) float64 {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return v
+}
+
+// coordinate - formats a coordinate with three decimals, the precision of data/geo/boundaries.txt
+func coordinate(v float64) string {
+	return fmt.Sprintf("%.3f", v)
+}
@@ -0,0 +1,114 @@
+//go:build ignore
+
+// gen_pinyin generates pinyintable.go: toneless pinyin of every Han character used in chinese names of the package
+// (StringCn, data/i18n catalogs and CLDR chinese region names). Run: go run gen_pinyin.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gosimple/unidecode"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// readings - place name readings of polyphonic characters, unidecode returns the most common reading
+var readings = map[rune]string{
+	'藏': "zang",  // 西藏 Xizang
+	'重': "chong", // 重庆 Chongqing
+	'厦': "xia",   // 厦门 Xiamen
+	'卡': "ka",    // 卡塔尔 Kataer
+	'朝': "chao",  // 朝鲜 Chaoxian
+	'广': "guang", // 广东 Guangdong
+	'喀': "ka",    // 喀麦隆 Kamailong
+	'宁': "ning",  // 辽宁 Liaoning
+	'挝': "wo",    // 老挝 Laowo
+	'汉': "han",   // 汉堡 Hanbao
+	'万': "wan",   // 万象 Wanxiang
+	'叶': "ye",    // 千叶 Qianye
+	'娜': "na",    // 圣卡塔琳娜 Shengkatalinna
+	'秘': "bi",    // 秘鲁 Bilu
+	'埔': "pu",    // 柬埔寨 Jianpuzhai
+	'的': "di",    // 的黎波里 Diliboli
+	'栃': "li",    // 栃木 Limu
+}
+
+func main() {
+	runes := map[rune]bool{}
+	collect := func(text string) {
+		for _, r := range text {
+			if unicode.Is(unicode.Han, r) {
+				runes[r] = true
+			}
+		}
+	}
+	files := []string{"countries.go", "regions.go"}
+	catalogs, err := filepath.Glob("data/i18n/*.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, name := range append(files, catalogs...) {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		collect(string(data))
+	}
+	for _, lang := range []language.Tag{language.SimplifiedChinese, language.TraditionalChinese} {
+		namer := display.Regions(lang)
+		for a := 'A'; a <= 'Z'; a++ {
+			for b := 'A'; b <= 'Z'; b++ {
+				if region, err := language.ParseRegion(string([]rune{a, b})); err == nil {
+					collect(namer.Name(region))
+				}
+			}
+		}
+	}
+
+	byReading := map[string][]rune{}
+	for r := range runes {
+		reading, ok := readings[r]
+		if !ok {
+			reading = strings.ToLower(strings.TrimSpace(unidecode.Unidecode(string(r))))
+		}
+		if reading == "" || strings.ContainsAny(reading, " []") {
+			continue
+		}
+		byReading[reading] = append(byReading[reading], r)
+	}
+	keys := make([]string, 0, len(byReading))
+	for reading := range byReading {
+		keys = append(keys, reading)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_pinyin.go; DO NOT EDIT.\n\npackage countries\n\n")
+	buf.WriteString("// hanPinyin - returns toneless pinyin of a Han character used in chinese names of the package, \"\" for other runes\n")
+	buf.WriteString("//\n//nolint:gocyclo,funlen\nfunc hanPinyin(r rune) string { //nolint:gocyclo\n\tswitch r {\n")
+	for _, reading := range keys {
+		rs := byReading[reading]
+		sort.Slice(rs, func(i, j int) bool { return rs[i] < rs[j] })
+		cases := make([]string, len(rs))
+		for i, r := range rs {
+			cases[i] = fmt.Sprintf("'%c'", r)
+		}
+		fmt.Fprintf(&buf, "\tcase %s:\n\t\treturn %q\n", strings.Join(cases, ", "), reading)
+	}
+	buf.WriteString("\t}\n\treturn \"\"\n}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile("pinyintable.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
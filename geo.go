@@ -0,0 +1,121 @@
+package countries
+
+//go:generate go run gen_geo.go
+
+// BoundingBox - 边界框（WGS 84 坐标，单位为度），West > East 表示跨越 180 度经线
+type BoundingBox struct {
+	South float64 `json:"south"` // 南纬度
+	West  float64 `json:"west"`  // 西经度
+	North float64 `json:"north"` // 北纬度
+	East  float64 `json:"east"`  // 东经度
+}
+
+// countryGeo - geography of a country, a row of countryGeoTable
+type countryGeo struct {
+	area      float64
+	coastline float64
+	latitude  float64
+	longitude float64
+	bbox      BoundingBox
+	neighbors []CountryCode
+}
+
+// subdivisionGeo - geography of a subdivision, a row of subdivisionGeoTable
+type subdivisionGeo struct {
+	latitude  float64
+	longitude float64
+	bbox      BoundingBox
+}
+
+// IsValid - returns true, if the bounding box is not empty
+func (b BoundingBox) IsValid() bool {
+	return b.South < b.North && b.West != b.East
+}
+
+// CrossesAntimeridian - returns true, if the bounding box crosses the 180th meridian, example: RUS.BoundingBox(), FJI.BoundingBox()
+func (b BoundingBox) CrossesAntimeridian() bool {
+	return b.West > b.East
+}
+
+// Contains - returns true, if the point (latitude, longitude in degrees) is inside the bounding box
+func (b BoundingBox) Contains(latitude, longitude float64) bool {
+	if !b.IsValid() || latitude < b.South || latitude > b.North {
+		return false
+	}
+	if b.CrossesAntimeridian() {
+		return longitude >= b.West || longitude <= b.East
+	}
+	return longitude >= b.West && longitude <= b.East
+}
+
+// Centroid - returns latitude and longitude of the geographic centre of the country in degrees (WGS 84),
+// zeros for unknown codes
+func (c CountryCode) Centroid() (latitude, longitude float64) {
+	if geo, ok := countryGeoTable[c]; ok {
+		return geo.latitude, geo.longitude
+	}
+	return 0, 0
+}
+
+// BoundingBox - returns the bounding box of the country, example: DEU.BoundingBox() is {47.27 5.87 55.06 15.04},
+// the box of a country crossing the 180th meridian has West > East; an empty box for unknown codes
+func (c CountryCode) BoundingBox() BoundingBox {
+	return countryGeoTable[c].bbox
+}
+
+// AreaKm2 - returns the total area of the country in square kilometres, example: DEU.AreaKm2() == 357588, 0 if not known
+func (c CountryCode) AreaKm2() float64 {
+	return countryGeoTable[c].area
+}
+
+// Coastline - returns the length of the coastline of the country in kilometres, 0 for landlocked countries,
+// -1 if not known
+func (c CountryCode) Coastline() float64 {
+	if geo, ok := countryGeoTable[c]; ok {
+		return geo.coastline
+	}
+	return -1
+}
+
+// Landlocked - returns true, if the country has no coastline, example: CHE.Landlocked() == true
+func (c CountryCode) Landlocked() bool {
+	geo, ok := countryGeoTable[c]
+	return ok && geo.coastline == 0
+}
+
+// Neighbors - returns countries sharing a land border with the country, example: PRT.Neighbors() returns []CountryCode{ESP},
+// nil for island countries and unknown codes
+func (c CountryCode) Neighbors() []CountryCode {
+	neighbors := countryGeoTable[c].neighbors
+	if len(neighbors) == 0 {
+		return nil
+	}
+	return append([]CountryCode(nil), neighbors...)
+}
+
+// IsNeighbor - returns true, if the countries share a land border, example: DEU.IsNeighbor(FRA) == true
+func (c CountryCode) IsNeighbor(other CountryCode) bool {
+	for _, neighbor := range countryGeoTable[c].neighbors {
+		if neighbor == other {
+			return true
+		}
+	}
+	return false
+}
+
+// Centroid - returns latitude and longitude of the geographic centre of the subdivision in degrees (WGS 84), a point inside
+// the subdivision if the centre is outside it (DE-BB around DE-BE, the islands of US-HI); subdivisions of USA, Canada and
+// Germany by data/geo/subdivisions.tsv (Natural Earth admin 1), the others by the approximate boundaries of
+// data/geo/boundaries.txt; zeros for SubdivisionUnknown
+func (s SubdivisionCode) Centroid() (latitude, longitude float64) {
+	if geo, ok := subdivisionGeoTable[s]; ok {
+		return geo.latitude, geo.longitude
+	}
+	return 0, 0
+}
+
+// BoundingBox - returns the bounding box of the subdivision, example: SubdivisionUSCA.BoundingBox() is {32.53 -124.41 42.01 -114.13},
+// an empty box for SubdivisionUnknown (see Centroid())
+func (s SubdivisionCode) BoundingBox() BoundingBox {
+	return subdivisionGeoTable[s].bbox
+}
@@ -0,0 +1,21 @@
+//go:build !countries_geolite
+
+package countries
+
+import (
+	_ "embed" // for boundary polygons
+)
+
+const (
+	// geoIndexCellDeg - the cell size of the reverse geocoding grid index in degrees
+	geoIndexCellDeg = 2
+	// geoCoastKm - the distance from a boundary within which CountryAt resolves a point outside every boundary
+	// to the nearest country, it absorbs coastlines simplified at the 1:110m scale
+	geoCoastKm = 15.0
+)
+
+// dataBoundaries - simplified boundary polygons for CountryAt and SubdivisionAt,
+// build with the countries_geolite tag to embed coarser ones (data/geo/boundaries_lite.txt)
+//
+//go:embed data/geo/boundaries.txt
+var dataBoundaries []byte
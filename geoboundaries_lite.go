@@ -0,0 +1,21 @@
+//go:build countries_geolite
+
+package countries
+
+import (
+	_ "embed" // for boundary polygons
+)
+
+const (
+	// geoIndexCellDeg - the cell size of the reverse geocoding grid index in degrees, coarse cells keep the index small
+	geoIndexCellDeg = 10
+	// geoCoastKm - the distance from a boundary within which CountryAt resolves a point outside every boundary
+	// to the nearest country, wider for the coarser boundaries
+	geoCoastKm = 30.0
+)
+
+// dataBoundaries - boundary polygons of data/geo/boundaries.txt simplified to 0.3° by the countries_geolite tag,
+// results of CountryAt and SubdivisionAt within about 30 km of a border are approximate
+//
+//go:embed data/geo/boundaries_lite.txt
+var dataBoundaries []byte
@@ -0,0 +1,95 @@
+//go:build !countries_geolite
+
+package countries
+
+import (
+	"testing"
+)
+
+//nolint:gocyclo
+func TestCountryAtBoundaries(t *testing.T) {
+	points := []struct {
+		latitude, longitude float64
+		want                CountryCode
+	}{
+		// borders: Strasbourg, Kehl, Geneva, Annecy, Maastricht, Liège, Aachen, Bratislava, Vienna, Johor Bahru, Singapore
+		{48.5734, 7.7521, FRA}, {48.57, 7.82, DEU}, {46.2044, 6.1432, CHE}, {45.90, 6.13, FRA}, {50.8514, 5.6910, NLD},
+		{50.63, 5.57, BEL}, {50.776, 6.084, DEU}, {48.1486, 17.1077, SVK}, {48.21, 16.37, AUT}, {1.4927, 103.7414, MYS},
+		{1.29, 103.85, SGP},
+		// Gaza, Khan Yunis, Tel Aviv, Ashkelon, Jerusalem, Ramallah, El Aaiún, Tarfaya, Neum, Maseru, Pretoria
+		{31.5017, 34.4668, PSE}, {31.34, 34.31, PSE}, {32.0853, 34.7818, ISR}, {31.67, 34.57, ISR}, {31.7683, 35.2137, ISR},
+		{31.90, 35.20, PSE}, {27.15, -13.20, ESH}, {27.94, -12.93, MAR}, {42.92, 17.62, BIH}, {-29.31, 27.48, LSO},
+		{-25.75, 28.19, ZAF},
+		// 49th parallel, San Diego, Tijuana, Detroit, Toronto, Egypt and Sudan, Saint-Pierre
+		{48.9, -100, USA}, {49.1, -100, CAN}, {32.72, -117.16, USA}, {32.51, -117.04, MEX}, {42.33, -83.05, USA},
+		{43.65, -79.38, CAN}, {21.9, 30, SDN}, {22.1, 30, EGY}, {46.78, -56.17, SPM},
+		// microstates: Vaduz, Monaco, San Marino, Andorra la Vella, Luxembourg, Valletta, Gibraltar, Hong Kong, Shenzhen, Macau
+		{47.14, 9.52, LIE}, {43.73, 7.42, MCO}, {43.94, 12.45, SMR}, {42.51, 1.52, AND}, {49.61, 6.13, LUX},
+		{35.90, 14.51, MLT}, {36.14, -5.35, GIB}, {22.30, 114.17, HKG}, {22.54, 114.06, CHN}, {22.20, 113.55, MAC},
+		// islands: Tenerife, Mallorca, Madeira, Galápagos, Okinawa, Zanzibar, Bornholm, Jersey, Nassau, Port Vila, Malabo, Kinmen
+		{28.29, -16.63, ESP}, {39.60, 2.90, ESP}, {32.75, -16.95, PRT}, {-0.70, -90.40, ECU}, {26.50, 127.95, JPN},
+		{-6.10, 39.30, TZA}, {55.10, 14.90, DNK}, {49.21, -2.13, JEY}, {25.05, -77.35, BHS}, {-17.73, 168.32, VUT},
+		{3.75, 8.78, GNQ}, {24.45, 118.35, TWN},
+		// coasts simplified at the 1:110m scale: Djibouti, Montevideo, Freetown, Istanbul; the open sea
+		{11.59, 43.15, DJI}, {-34.90, -56.19, URY}, {8.48, -13.23, SLE}, {41.01, 28.97, TUR}, {36.0, 33.5, Unknown},
+	}
+	for _, p := range points {
+		if out := CountryAt(p.latitude, p.longitude); out != p.want {
+			t.Errorf("Test CountryAt(%v, %v) err, want %v, got %v", p.latitude, p.longitude, p.want, out)
+		}
+	}
+	for _, c := range All() {
+		if _, ok := countryGeoTable[c]; !ok || len(c.Successors()) > 0 {
+			continue
+		}
+		if len(c.Boundary()) == 0 {
+			t.Errorf("Test Boundary() err, country %v, got no boundary", c)
+		}
+		for _, capital := range c.Capitals() {
+			if latitude, longitude := capital.Code.Coordinates(); (latitude != 0 || longitude != 0) && CountryAt(latitude, longitude) != c {
+				t.Errorf("Test CountryAt() err, capital %v, want %v, got %v", capital.Code, c, CountryAt(latitude, longitude))
+			}
+		}
+	}
+}
+
+func TestSubdivisionAtBoundaries(t *testing.T) {
+	points := []struct {
+		latitude, longitude float64
+		want                SubdivisionCode
+	}{
+		{40.9, -108, SubdivisionUSCO}, {41.1, -108, SubdivisionUSWY}, {36.9, -105, SubdivisionUSNM}, {37.1, -105, SubdivisionUSCO},
+		{40.71, -74.01, SubdivisionUSNY}, {41.88, -87.63, SubdivisionUSIL}, {45.50, -73.57, SubdivisionCAQC},
+		{48.5734, 7.7521, SubdivisionFR67}, {48.57, 7.82, SubdivisionDEBW}, {46.2044, 6.1432, SubdivisionCHGE},
+		{59.94, 30.31, SubdivisionRUSPE}, {51.51, -0.09, SubdivisionGBLND}, {25.05, 121.53, SubdivisionTWTPE},
+	}
+	for _, p := range points {
+		if out := SubdivisionAt(p.latitude, p.longitude); out != p.want {
+			t.Errorf("Test SubdivisionAt(%v, %v) err, want %v, got %v", p.latitude, p.longitude, p.want, out)
+		}
+	}
+}
+
+func TestSubdivisionAtCentroids(t *testing.T) {
+	for _, s := range AllSubdivisions() {
+		if s == SubdivisionUnknown {
+			continue
+		}
+		latitude, longitude := s.Centroid()
+		boundary := newGeoRegion(geoRegion{rings: s.Boundary()})
+		if inside, _ := boundary.locate(latitude, longitude); !inside {
+			t.Errorf("Test Centroid() err, subdivision %v, want inside the boundary, got %v %v", s, latitude, longitude)
+			continue
+		}
+		// territories like FR-GF resolve to their own country, a parent like FR-IDF to its subdivision containing the centroid
+		if CountryAt(latitude, longitude) != s.Country() {
+			continue
+		}
+		if out := SubdivisionAt(latitude, longitude); out != s {
+			outLatitude, outLongitude := out.Centroid()
+			if inside, _ := boundary.locate(outLatitude, outLongitude); !inside || out.Country() != s.Country() {
+				t.Errorf("Test SubdivisionAt() err, centroid of %v, want %v, got %v", s, s, out)
+			}
+		}
+	}
+}
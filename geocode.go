@@ -0,0 +1,327 @@
+package countries
+
+import (
+	"bufio"
+	"bytes"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// geoRegion - a country or a subdivision of the reverse geocoding index
+type geoRegion struct {
+	country     CountryCode
+	subdivision SubdivisionCode
+	latitude    float64
+	longitude   float64
+	bbox        BoundingBox
+	area        float64
+	rings       [][][2]float64 // latitude, longitude vertices of the simplified boundary, nil if not known
+	ringBoxes   []BoundingBox  // bounding boxes of rings
+	ringAreas   []float64      // areas of rings in square degrees
+}
+
+// geoCell - a cell of the grid index, latitude and longitude divided by geoIndexCellDeg
+type geoCell struct {
+	lat int
+	lng int
+}
+
+// geoIndex - a grid spatial index, a cell lists the regions whose rings (widened by geoCoastKm) or bounding box overlap the cell
+type geoIndex map[geoCell][]*geoRegion
+
+var (
+	geoIndexOnce        sync.Once
+	countryGeoIndex     geoIndex
+	subdivisionGeoIndex geoIndex
+	geoBoundariesByCode map[string][][][2]float64
+)
+
+// CountryAt - returns CountryCode of the point (latitude, longitude in degrees, WGS 84) without calling an external service,
+// example: deu := CountryAt(52.52, 13.40); countries are resolved by simplified boundaries of data/geo/boundaries.txt
+// (Natural Earth 1:110m with hand maintained territories, islands and borders below that scale, and islands known only
+// by the boundary of their subdivision), so results within
+// about 10 km of a border are approximate and a point within geoCoastKm of a coast belongs to the nearest country;
+// returns countries.Unknown for the open sea and invalid coordinates
+func CountryAt(latitude, longitude float64) CountryCode {
+	geoIndexBuild()
+	region := countryGeoIndex.find(latitude, longitude, nil)
+	if region == nil {
+		region = subdivisionGeoIndex.find(latitude, longitude, nil)
+	}
+	if region == nil {
+		region = countryGeoIndex.nearest(latitude, longitude, nil)
+	}
+	if region != nil {
+		return region.country
+	}
+	return Unknown
+}
+
+// SubdivisionAt - returns ISO 3166-2 SubdivisionCode of the point (latitude, longitude in degrees, WGS 84),
+// example: SubdivisionAt(39.74, -104.99) == SubdivisionUSCO; the subdivision of the country CountryAt(latitude, longitude)
+// is resolved by the approximate boundaries of data/geo/boundaries.txt, so results within tens of kilometres of a border
+// of subdivisions are approximate, the smallest subdivision containing the point wins (FR-75 over FR-IDF);
+// returns SubdivisionUnknown for the open sea and invalid coordinates
+func SubdivisionAt(latitude, longitude float64) SubdivisionCode {
+	c := CountryAt(latitude, longitude)
+	if c == Unknown {
+		return SubdivisionUnknown
+	}
+	accept := func(region *geoRegion) bool { return region.country == c }
+	region := subdivisionGeoIndex.find(latitude, longitude, accept)
+	if region == nil {
+		region = subdivisionGeoIndex.nearest(latitude, longitude, accept)
+	}
+	if region != nil {
+		return region.subdivision
+	}
+	return SubdivisionUnknown
+}
+
+// Boundary - returns rings of the simplified boundary of the country as latitude, longitude pairs in degrees (WGS 84),
+// a ring inside another ring is a hole; nil for withdrawn countries, use BoundingBox() then
+func (c CountryCode) Boundary() [][][2]float64 {
+	geoIndexBuild()
+	return copyRings(geoBoundariesByCode[c.Alpha3()])
+}
+
+// Boundary - returns rings of the simplified boundary of the subdivision as latitude, longitude pairs in degrees (WGS 84),
+// a ring inside another ring is a hole, borders between subdivisions are approximate; nil for SubdivisionUnknown
+func (s SubdivisionCode) Boundary() [][][2]float64 {
+	geoIndexBuild()
+	return copyRings(geoBoundariesByCode[string(s)])
+}
+
+// geoIndexBuild - builds the grid indexes of countries and subdivisions once
+func geoIndexBuild() {
+	geoIndexOnce.Do(func() {
+		geoBoundariesByCode = geoBoundaries()
+		boundaries := geoBoundariesByCode
+		countryGeoIndex = geoIndex{}
+		for _, c := range All() {
+			geo, ok := countryGeoTable[c]
+			if !ok || len(c.Successors()) > 0 {
+				continue
+			}
+			countryGeoIndex.add(newGeoRegion(geoRegion{
+				country: c, latitude: geo.latitude, longitude: geo.longitude, bbox: geo.bbox, area: geo.area, rings: boundaries[c.Alpha3()],
+			}))
+		}
+		codes := make([]SubdivisionCode, 0, len(subdivisionGeoTable))
+		for s := range subdivisionGeoTable {
+			codes = append(codes, s)
+		}
+		sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+		subdivisionGeoIndex = geoIndex{}
+		for _, s := range codes {
+			geo := subdivisionGeoTable[s]
+			subdivisionGeoIndex.add(newGeoRegion(geoRegion{
+				country: s.Country(), subdivision: s, latitude: geo.latitude, longitude: geo.longitude, bbox: geo.bbox, area: geo.bbox.areaKm2(),
+				rings: boundaries[string(s)],
+			}))
+		}
+	})
+}
+
+// newGeoRegion - returns the region with bounding boxes and areas of its rings
+func newGeoRegion(region geoRegion) *geoRegion {
+	for _, ring := range region.rings {
+		box := BoundingBox{South: 90, West: 180, North: -90, East: -180}
+		area := 0.0
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			box.South, box.North = math.Min(box.South, ring[i][0]), math.Max(box.North, ring[i][0])
+			box.West, box.East = math.Min(box.West, ring[i][1]), math.Max(box.East, ring[i][1])
+			area += ring[j][0]*ring[i][1] - ring[i][0]*ring[j][1]
+		}
+		region.ringBoxes = append(region.ringBoxes, box)
+		region.ringAreas = append(region.ringAreas, math.Abs(area)/2)
+	}
+	return &region
+}
+
+// add - adds the region to every cell overlapped by its rings widened by geoCoastKm, or by its bounding box without rings
+func (index geoIndex) add(region *geoRegion) {
+	if len(region.rings) == 0 {
+		index.addBox(region, region.bbox)
+		return
+	}
+	for _, box := range region.ringBoxes {
+		index.addBox(region, box.widen(geoCoastKm))
+	}
+}
+
+// addBox - adds the region to every cell overlapped by the bounding box, once
+func (index geoIndex) addBox(region *geoRegion, box BoundingBox) {
+	spans := [][2]float64{{box.West, box.East}}
+	if box.CrossesAntimeridian() {
+		spans = [][2]float64{{box.West, 180}, {-180, box.East}}
+	}
+	south, north := geoCellOf(box.South, 0).lat, geoCellOf(box.North, 0).lat
+	for _, span := range spans {
+		west, east := geoCellOf(0, span[0]).lng, geoCellOf(0, span[1]).lng
+		for lat := south; lat <= north; lat++ {
+			for lng := west; lng <= east; lng++ {
+				cell := geoCell{lat: lat, lng: lng}
+				if n := len(index[cell]); n == 0 || index[cell][n-1] != region {
+					index[cell] = append(index[cell], region)
+				}
+			}
+		}
+	}
+}
+
+// find - returns the region of the point accepted by the filter (nil accepts all regions) whose boundary contains the point,
+// the smallest ring containing the point wins, so an enclave like VAT wins over ITA, then the smaller region, so a subdivision
+// wins over its parent of the same ring; nil if no boundary contains the point
+func (index geoIndex) find(latitude, longitude float64, accept func(*geoRegion) bool) *geoRegion {
+	if !validPoint(latitude, longitude) {
+		return nil
+	}
+	var found *geoRegion
+	smallest := math.Inf(1)
+	for _, region := range index[geoCellOf(latitude, longitude)] {
+		if accept != nil && !accept(region) {
+			continue
+		}
+		if inside, area := region.locate(latitude, longitude); inside && (area < smallest || area == smallest && region.area < found.area) {
+			found, smallest = region, area
+		}
+	}
+	return found
+}
+
+// nearest - returns the region accepted by the filter (nil accepts all regions) with the boundary nearest to the point
+// within geoCoastKm, it absorbs coastlines and borders simplified at the scale of the boundaries; nil for the open sea
+func (index geoIndex) nearest(latitude, longitude float64, accept func(*geoRegion) bool) *geoRegion {
+	if !validPoint(latitude, longitude) {
+		return nil
+	}
+	var found *geoRegion
+	nearest := geoCoastKm
+	for _, region := range index[geoCellOf(latitude, longitude)] {
+		if accept != nil && !accept(region) {
+			continue
+		}
+		for k, ring := range region.rings {
+			if !region.ringBoxes[k].widen(geoCoastKm).Contains(latitude, longitude) {
+				continue
+			}
+			for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+				if distance := edgeDistanceKm(latitude, longitude, ring[j], ring[i]); distance < nearest {
+					found, nearest = region, distance
+				}
+			}
+		}
+	}
+	return found
+}
+
+// locate - returns true and the area of the smallest ring containing the point, if the point is inside the boundary
+// of the region (ray casting, even-odd rule)
+func (r *geoRegion) locate(latitude, longitude float64) (bool, float64) {
+	inside, smallest := false, math.Inf(1)
+	for k, ring := range r.rings {
+		if !r.ringBoxes[k].Contains(latitude, longitude) {
+			continue
+		}
+		in := false
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			a, b := ring[i], ring[j]
+			if (a[0] > latitude) != (b[0] > latitude) && longitude < (b[1]-a[1])*(latitude-a[0])/(b[0]-a[0])+a[1] {
+				in = !in
+			}
+		}
+		if in {
+			inside, smallest = !inside, math.Min(smallest, r.ringAreas[k])
+		}
+	}
+	return inside, smallest
+}
+
+// edgeDistanceKm - returns the distance of the point from the segment ab in kilometres, equirectangular near the point
+func edgeDistanceKm(latitude, longitude float64, a, b [2]float64) float64 {
+	scale := math.Cos(latitude * math.Pi / 180)
+	ax, ay := (a[1]-longitude)*scale, a[0]-latitude
+	dx, dy := (b[1]-a[1])*scale, b[0]-a[0]
+	t := 0.0
+	if length := dx*dx + dy*dy; length > 0 {
+		t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/length))
+	}
+	return math.Hypot(ax+t*dx, ay+t*dy) * earthRadiusKm * math.Pi / 180
+}
+
+// validPoint - returns true for latitude in [-90, 90] and longitude in [-180, 180] degrees
+func validPoint(latitude, longitude float64) bool {
+	return !math.IsNaN(latitude) && !math.IsNaN(longitude) && latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
+}
+
+// widen - returns the bounding box widened by the distance in kilometres, clamped to the poles and the 180th meridian
+func (b BoundingBox) widen(km float64) BoundingBox {
+	margin := km / earthRadiusKm * 180 / math.Pi
+	out := BoundingBox{South: math.Max(b.South-margin, -90), North: math.Min(b.North+margin, 90)}
+	margin /= math.Max(math.Cos(math.Max(math.Abs(out.South), math.Abs(out.North))*math.Pi/180), 0.01)
+	out.West, out.East = math.Max(b.West-margin, -180), math.Min(b.East+margin, 180)
+	return out
+}
+
+// geoCellOf - returns the grid cell of the point, the 90th parallel and the 180th meridian belong to the last cells
+func geoCellOf(latitude, longitude float64) geoCell {
+	return geoCell{
+		lat: int(math.Floor(math.Min(latitude, 89.999999) / geoIndexCellDeg)),
+		lng: int(math.Floor(math.Min(longitude, 179.999999) / geoIndexCellDeg)),
+	}
+}
+
+// areaKm2 - returns an approximate area of the bounding box in square kilometres
+func (b BoundingBox) areaKm2() float64 {
+	width := b.East - b.West
+	if b.CrossesAntimeridian() {
+		width += 360
+	}
+	radians := math.Pi / 180
+	return (b.North - b.South) * radians * width * radians * earthRadiusKm * earthRadiusKm * math.Cos((b.North+b.South)/2*radians)
+}
+
+// copyRings - returns a deep copy of the rings, nil for no rings
+func copyRings(rings [][][2]float64) [][][2]float64 {
+	if len(rings) == 0 {
+		return nil
+	}
+	out := make([][][2]float64, len(rings))
+	for i, ring := range rings {
+		out[i] = append([][2]float64(nil), ring...)
+	}
+	return out
+}
+
+// geoBoundaries - parses the embedded boundary polygons, returns rings by ISO 3166-1 Alpha-3 or ISO 3166-2 code,
+// malformed rings are skipped
+func geoBoundaries() map[string][][][2]float64 {
+	boundaries := map[string][][][2]float64{}
+	scanner := bufio.NewScanner(bytes.NewReader(dataBoundaries))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		ring := make([][2]float64, 0, len(fields)-1)
+		for _, field := range fields[1:] {
+			lat, lng, ok := strings.Cut(field, ",")
+			latitude, errLat := strconv.ParseFloat(lat, 64)
+			longitude, errLng := strconv.ParseFloat(lng, 64)
+			if !ok || errLat != nil || errLng != nil {
+				ring = nil
+				break
+			}
+			ring = append(ring, [2]float64{latitude, longitude})
+		}
+		if ring != nil {
+			boundaries[fields[0]] = append(boundaries[fields[0]], ring)
+		}
+	}
+	return boundaries
+}
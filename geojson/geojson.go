@@ -0,0 +1,252 @@
+// Package geojson exports boundaries of countries and subdivisions as GeoJSON (RFC 7946) keyed by the codes of package countries.
+package geojson
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/xianb/countries"
+)
+
+// Geometry types
+const (
+	TypePolygon      = "Polygon"
+	TypeMultiPolygon = "MultiPolygon"
+)
+
+// FeatureCollection - GeoJSON 要素集合
+type FeatureCollection struct {
+	Type     string     `json:"type"`     // 类型，总是 "FeatureCollection"
+	Features []*Feature `json:"features"` // 要素
+}
+
+// Feature - GeoJSON 要素，国家或子区域
+type Feature struct {
+	Type       string                 `json:"type"`           // 类型，总是 "Feature"
+	ID         string                 `json:"id"`             // 国家 Alpha-2 代码或 ISO 3166-2 子区域代码
+	BBox       []float64              `json:"bbox,omitempty"` // 边界框：西、南、东、北
+	Geometry   *Geometry              `json:"geometry"`       // 几何，边界未知时为 null
+	Properties map[string]interface{} `json:"properties"`     // 属性
+}
+
+// Geometry - GeoJSON 几何，Polygon 或 MultiPolygon，坐标为经度、纬度
+type Geometry struct {
+	Type        string      `json:"type"`        // 类型
+	Coordinates interface{} `json:"coordinates"` // [][][2]float64 或 [][][][2]float64
+}
+
+// Options - simplification of the exported geometry
+type Options struct {
+	Tolerance float64 // Douglas-Peucker tolerance in degrees, 0 keeps all vertices
+	Precision int     // decimal digits of coordinates, 0 keeps coordinates as is
+}
+
+// Countries - returns a FeatureCollection of all countries in use (withdrawn codes are skipped),
+// example: geojson.Countries(geojson.Options{Tolerance: 0.1, Precision: 3})
+func Countries(opts Options) *FeatureCollection {
+	collection := &FeatureCollection{Type: "FeatureCollection", Features: []*Feature{}}
+	for _, c := range countries.All() {
+		if len(c.Successors()) > 0 {
+			continue
+		}
+		if feature, err := Country(c, opts); err == nil {
+			collection.Features = append(collection.Features, feature)
+		}
+	}
+	return collection
+}
+
+// Subdivisions - returns a FeatureCollection of all subdivisions of the country,
+// example: geojson.Subdivisions(countries.USA, geojson.Options{}); an empty collection, if the country has no subdivisions
+func Subdivisions(c countries.CountryCode, opts Options) *FeatureCollection {
+	collection := &FeatureCollection{Type: "FeatureCollection", Features: []*Feature{}}
+	for _, s := range c.Subdivisions() {
+		if feature, err := Subdivision(s, opts); err == nil {
+			collection.Features = append(collection.Features, feature)
+		}
+	}
+	return collection
+}
+
+// Country - returns a Feature of the country with properties of Info(): code, name, cca2, cca3, ccn3, region and regionCode,
+// the geometry is the simplified boundary, null for withdrawn codes without one (the bbox member is set anyway);
+// returns countries.ErrNotFound, if the country has no geographic data
+func Country(c countries.CountryCode, opts Options) (*Feature, error) {
+	box := c.BoundingBox()
+	if !box.IsValid() {
+		return nil, fmt.Errorf("geojson::Country: country %v err: %w", c, countries.ErrNotFound)
+	}
+	info := c.Info()
+	return &Feature{
+		Type:     "Feature",
+		ID:       info.Alpha2,
+		BBox:     []float64{box.West, box.South, box.East, box.North},
+		Geometry: geometry(c.Boundary(), opts),
+		Properties: map[string]interface{}{
+			"code":       info.Alpha2,
+			"name":       info.Name,
+			"cca2":       info.Alpha2,
+			"cca3":       info.Alpha3,
+			"ccn3":       fmt.Sprintf("%03d", int64(c)),
+			"region":     info.Region.String(),
+			"regionCode": int64(info.Region),
+		},
+	}, nil
+}
+
+// Subdivision - returns a Feature of the subdivision with properties of Info(): code, name, countryCode and type,
+// the geometry is the simplified approximate boundary (see countries.SubdivisionCode.Boundary());
+// returns countries.ErrNotFound for countries.SubdivisionUnknown
+func Subdivision(s countries.SubdivisionCode, opts Options) (*Feature, error) {
+	box := s.BoundingBox()
+	if !box.IsValid() {
+		return nil, fmt.Errorf("geojson::Subdivision: subdivision %v err: %w", string(s), countries.ErrNotFound)
+	}
+	info := s.Info()
+	return &Feature{
+		Type:     "Feature",
+		ID:       string(info.Code),
+		BBox:     []float64{box.West, box.South, box.East, box.North},
+		Geometry: geometry(s.Boundary(), opts),
+		Properties: map[string]interface{}{
+			"code":        string(info.Code),
+			"name":        info.Name,
+			"countryCode": info.Country.Alpha2(),
+			"type":        string(s.SubdivisionType()),
+		},
+	}, nil
+}
+
+// geometry - returns a Polygon or a MultiPolygon of the rings (latitude, longitude pairs, even-odd rule: a ring inside
+// an odd number of rings is a hole of the innermost one), nil if there are no rings
+func geometry(rings [][][2]float64, opts Options) *Geometry {
+	if len(rings) == 0 {
+		return nil
+	}
+	parents := make([][]int, len(rings))
+	for i, ring := range rings {
+		for j, other := range rings {
+			if i != j && len(ring) > 0 && inside(interior(ring), other) {
+				parents[i] = append(parents[i], j)
+			}
+		}
+	}
+	var polygons [][][][2]float64
+	index := map[int]int{}
+	for i, ring := range rings {
+		if len(parents[i])%2 == 0 {
+			index[i] = len(polygons)
+			polygons = append(polygons, [][][2]float64{position(ring, true, opts)})
+		}
+	}
+	for i, ring := range rings {
+		for _, j := range parents[i] {
+			if p, ok := index[j]; ok && len(parents[i]) == len(parents[j])+1 {
+				polygons[p] = append(polygons[p], position(ring, false, opts))
+			}
+		}
+	}
+	if len(polygons) == 1 {
+		return &Geometry{Type: TypePolygon, Coordinates: polygons[0]}
+	}
+	return &Geometry{Type: TypeMultiPolygon, Coordinates: polygons}
+}
+
+// position - returns the ring as a closed GeoJSON linear ring of longitude, latitude positions, simplified and rounded
+// by the options, counterclockwise for exterior rings and clockwise for holes (the right-hand rule)
+func position(ring [][2]float64, exterior bool, opts Options) [][2]float64 {
+	points := make([][2]float64, 0, len(ring)+1)
+	for _, vertex := range ring {
+		points = append(points, [2]float64{round(vertex[1], opts.Precision), round(vertex[0], opts.Precision)})
+	}
+	points = append(points, points[0])
+	if opts.Tolerance > 0 {
+		if simplified := simplify(points, opts.Tolerance); len(simplified) >= 4 {
+			points = simplified
+		}
+	}
+	if (area(points) > 0) != exterior {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+	return points
+}
+
+// simplify - returns the polyline simplified by the Douglas-Peucker algorithm, the first and the last points are kept
+func simplify(points [][2]float64, tolerance float64) [][2]float64 {
+	if len(points) < 3 {
+		return points
+	}
+	first, last := points[0], points[len(points)-1]
+	farthest, distance := 0, 0.0
+	for i := 1; i < len(points)-1; i++ {
+		if d := segmentDistance(points[i], first, last); d > distance {
+			farthest, distance = i, d
+		}
+	}
+	if distance <= tolerance {
+		return [][2]float64{first, last}
+	}
+	left := simplify(points[:farthest+1], tolerance)
+	right := simplify(points[farthest:], tolerance)
+	return append(left[:len(left)-1:len(left)-1], right...)
+}
+
+// segmentDistance - returns the distance of the point p from the segment a-b in degrees
+func segmentDistance(p, a, b [2]float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	t := 0.0
+	if length := dx*dx + dy*dy; length > 0 {
+		t = math.Max(0, math.Min(1, ((p[0]-a[0])*dx+(p[1]-a[1])*dy)/length))
+	}
+	return math.Hypot(p[0]-a[0]-t*dx, p[1]-a[1]-t*dy)
+}
+
+// area - returns the signed area of the closed ring (shoelace formula), positive for counterclockwise rings
+func area(points [][2]float64) float64 {
+	sum := 0.0
+	for i := 0; i+1 < len(points); i++ {
+		sum += points[i][0]*points[i+1][1] - points[i+1][0]*points[i][1]
+	}
+	return sum / 2
+}
+
+// interior - returns a point just inside the ring next to the middle of its longest edge, unlike a vertex it is not
+// on an edge shared with a neighbouring ring
+func interior(ring [][2]float64) [2]float64 {
+	longest, length, signed := 0, -1.0, 0.0
+	for i, j := len(ring)-1, 0; j < len(ring); i, j = j, j+1 {
+		if d := math.Hypot(ring[j][0]-ring[i][0], ring[j][1]-ring[i][1]); d > length {
+			longest, length = i, d
+		}
+		signed += ring[i][0]*ring[j][1] - ring[j][0]*ring[i][1]
+	}
+	a, b := ring[longest], ring[(longest+1)%len(ring)]
+	if length <= 0 {
+		return a
+	}
+	offset := math.Copysign(1e-6, signed) / length
+	return [2]float64{(a[0]+b[0])/2 - (b[1]-a[1])*offset, (a[1]+b[1])/2 + (b[0]-a[0])*offset}
+}
+
+// inside - returns true, if the vertex (latitude, longitude) is inside the ring (ray casting)
+func inside(vertex [2]float64, ring [][2]float64) bool {
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a[0] > vertex[0]) != (b[0] > vertex[0]) && vertex[1] < (b[1]-a[1])*(vertex[0]-a[0])/(b[0]-a[0])+a[1] {
+			in = !in
+		}
+	}
+	return in
+}
+
+// round - returns the value rounded to the decimal digits, the value as is for 0 digits
+func round(value float64, digits int) float64 {
+	if digits <= 0 {
+		return value
+	}
+	scale := math.Pow(10, float64(digits))
+	return math.Round(value*scale) / scale
+}
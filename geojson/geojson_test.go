@@ -0,0 +1,111 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/xianb/countries"
+)
+
+//nolint:gocyclo
+func TestCountries(t *testing.T) {
+	collection := Countries(Options{})
+	if collection.Type != "FeatureCollection" || len(collection.Features) < 240 {
+		t.Errorf("Test Countries() err, want a FeatureCollection of countries, got %v features", len(collection.Features))
+	}
+	for _, feature := range collection.Features {
+		if feature.Type != "Feature" || feature.ID == "" || feature.Properties["cca3"] == "" || len(feature.BBox) != 4 || feature.Geometry == nil {
+			t.Errorf("Test Countries() err, feature %v, got %v", feature.ID, feature.Properties)
+		}
+		if _, err := json.Marshal(feature); err != nil {
+			t.Errorf("Test Countries() err, feature %v, got %v", feature.ID, err)
+		}
+	}
+	feature, err := Country(countries.USA, Options{})
+	if err != nil || feature.ID != "US" || feature.Properties["cca3"] != "USA" || feature.Properties["region"] != countries.USA.Region().String() {
+		t.Errorf("Test Country() err, want %v, got %v, %v", "US", feature, err)
+	}
+	if len(countries.USA.Boundary()) > 0 && feature.Geometry.Type != TypeMultiPolygon {
+		t.Errorf("Test Country() err, want %v, got %v", TypeMultiPolygon, feature.Geometry.Type)
+	}
+	feature, _ = Country(countries.AUT, Options{Precision: 1})
+	polygon, ok := feature.Geometry.Coordinates.([][][2]float64)
+	if !ok || feature.Geometry.Type != TypePolygon || len(polygon) != 1 || len(polygon[0]) < 10 || polygon[0][0] != polygon[0][len(polygon[0])-1] {
+		t.Errorf("Test Country() err, want a closed ring, got %v", feature.Geometry)
+	} else if !countries.AUT.BoundingBox().Contains(polygon[0][0][1], polygon[0][0][0]) || area(polygon[0]) <= 0 {
+		t.Errorf("Test Country() err, want a counterclockwise ring of longitude, latitude, got %v", polygon[0])
+	}
+	feature, _ = Country(countries.FRA, Options{})
+	if polygons, ok := feature.Geometry.Coordinates.([][][][2]float64); !ok || len(polygons) != 2 || len(polygons[0])+len(polygons[1]) != 3 {
+		t.Errorf("Test Country() err, want the mainland with the Geneva hole and Corsica, got %v", feature.Geometry)
+	}
+	feature, _ = Country(countries.FJI, Options{})
+	polygons, _ := feature.Geometry.Coordinates.([][][][2]float64)
+	east, west := 0, 0
+	for _, p := range polygons {
+		for _, position := range p[0] {
+			if position[0] > 0 {
+				east++
+			} else if position[0] < 0 {
+				west++
+			}
+		}
+	}
+	if feature.Geometry.Type != TypeMultiPolygon || len(polygons) != 3 || east == 0 || west == 0 {
+		t.Errorf("Test Country() err, want islands split at the antimeridian, got %v", feature.Geometry)
+	}
+	if _, err = Country(countries.Unknown, Options{}); !errors.Is(err, countries.ErrNotFound) {
+		t.Errorf("Test Country() err, want %v, got %v", countries.ErrNotFound, err)
+	}
+}
+
+func TestSubdivisions(t *testing.T) {
+	collection := Subdivisions(countries.DEU, Options{})
+	if len(collection.Features) != 16 || collection.Features[0].Properties["countryCode"] != "DE" {
+		t.Errorf("Test Subdivisions() err, want %v, got %v", 16, len(collection.Features))
+	}
+	collection = Subdivisions(countries.FRA, Options{})
+	if len(collection.Features) != len(countries.FRA.Subdivisions()) || len(collection.Features) == 0 {
+		t.Errorf("Test Subdivisions() err, want %v, got %v", len(countries.FRA.Subdivisions()), len(collection.Features))
+	}
+	for _, feature := range collection.Features {
+		if feature.Geometry == nil || len(feature.BBox) != 4 {
+			t.Errorf("Test Subdivisions() err, want a geometry with a bbox, got %v", feature.ID)
+		}
+	}
+	feature, err := Subdivision(countries.SubdivisionUSCO, Options{})
+	if err != nil || feature.ID != "US-CO" || feature.Properties["name"] != "Colorado" {
+		t.Errorf("Test Subdivision() err, want %v, got %v, %v", "US-CO", feature, err)
+	}
+	if feature.Geometry == nil || feature.Geometry.Type != TypePolygon {
+		t.Errorf("Test Subdivision() err, want %v, got %v", TypePolygon, feature.Geometry)
+	}
+	feature, err = Subdivision(countries.SubdivisionDEBY, Options{})
+	if err != nil || feature.Geometry == nil || feature.Geometry.Type != TypePolygon {
+		t.Errorf("Test Subdivision() err, want %v, got %v, %v", TypePolygon, feature, err)
+	}
+	if geometry(nil, Options{}) != nil {
+		t.Errorf("Test geometry() err, want nil, got a geometry")
+	}
+	if _, err = Subdivision(countries.SubdivisionUnknown, Options{}); !errors.Is(err, countries.ErrNotFound) {
+		t.Errorf("Test Subdivision() err, want %v, got %v", countries.ErrNotFound, err)
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	ring := [][2]float64{{0, 0}, {1, 0}, {1, 0.01}, {1, 1}, {0.5, 1.001}, {0, 1}}
+	if out := position(ring, true, Options{}); len(out) != 7 {
+		t.Errorf("Test position() err, want %v, got %v", 7, len(out))
+	}
+	if out := position(ring, true, Options{Tolerance: 0.1}); len(out) != 5 || area(out) <= 0 {
+		t.Errorf("Test position() err, want %v, got %v", 5, out)
+	}
+	if out := position(ring, false, Options{Tolerance: 10}); len(out) != 7 || area(out) >= 0 {
+		t.Errorf("Test position() err, want the clockwise ring as is, got %v", out)
+	}
+	holes := geometry([][][2]float64{{{0, 0}, {0, 10}, {10, 10}, {10, 0}}, {{2, 2}, {2, 8}, {8, 8}, {8, 2}}, {{4, 4}, {4, 6}, {6, 6}, {6, 4}}}, Options{})
+	if polygons, ok := holes.Coordinates.([][][][2]float64); !ok || len(polygons) != 2 || len(polygons[0])+len(polygons[1]) != 3 {
+		t.Errorf("Test geometry() err, want a polygon with a hole and an island, got %v", holes.Coordinates)
+	}
+}
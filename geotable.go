@@ -0,0 +1,5147 @@
+// Code generated by gen_geo.go; DO NOT EDIT.
+
+package countries
+
+// countryGeoTable - area (km²), coastline (km), centroid, bounding box (south, west, north, east) and land neighbors of countries
+var countryGeoTable = map[CountryCode]countryGeo{
+	AUS: {7741220, 25760, -25.73, 134.49, BoundingBox{-43.64, 113.16, -10.67, 153.64}, nil},
+	AUT: {83871, 0, 47.59, 14.14, BoundingBox{46.37, 9.53, 49.02, 17.16}, []CountryCode{CZE, DEU, HUN, ITA, LIE, SVK, SVN, CHE}},
+	AZE: {86600, 0, 40.29, 47.55, BoundingBox{38.39, 44.77, 41.91, 50.39}, []CountryCode{ARM, GEO, IRN, RUS, TUR}},
+	ALB: {28748, 362, 41.14, 20.05, BoundingBox{39.64, 19.26, 42.66, 21.06}, []CountryCode{GRC, MNE, MKD, XKX}},
+	DZA: {2381741, 998, 28.16, 2.62, BoundingBox{18.96, -8.67, 37.09, 11.98}, []CountryCode{LBY, MLI, MRT, MAR, NER, TUN, ESH}},
+	ASM: {199, 116, -14.30, -170.70, BoundingBox{-14.38, -170.84, -14.16, -169.42}, nil},
+	AIA: {91, 61, 18.22, -63.06, BoundingBox{18.15, -63.43, 18.29, -62.92}, nil},
+	AGO: {1246700, 1600, -12.29, 17.54, BoundingBox{-18.04, 11.64, -4.38, 24.08}, []CountryCode{COG, COD, NAM, ZMB}},
+	AND: {468, 0, 42.54, 1.57, BoundingBox{42.43, 1.41, 42.66, 1.79}, []CountryCode{FRA, ESP}},
+	ATA: {14000000, 17968, -90.00, 0.00, BoundingBox{-90.00, -180.00, -60.00, 180.00}, nil},
+	ATG: {443, 153, 17.08, -61.80, BoundingBox{16.99, -61.91, 17.73, -61.67}, nil},
+	ANT: {800, 364, 12.17, -68.98, BoundingBox{12.02, -69.17, 18.07, -62.94}, nil},
+	ARE: {83600, 1318, 23.91, 54.30, BoundingBox{22.63, 51.58, 26.08, 56.38}, []CountryCode{OMN, SAU}},
+	ARG: {2780400, 4989, -35.38, -65.17, BoundingBox{-55.06, -73.58, -21.78, -53.59}, []CountryCode{BOL, BRA, CHL, PRY, URY}},
+	ARM: {29743, 0, 40.29, 44.93, BoundingBox{38.84, 43.45, 41.30, 46.63}, []CountryCode{AZE, GEO, IRN, TUR}},
+	ABW: {180, 69, 12.52, -69.98, BoundingBox{12.41, -70.06, 12.63, -69.87}, nil},
+	AFG: {652230, 0, 33.84, 66.03, BoundingBox{29.38, 60.48, 38.49, 74.89}, []CountryCode{CHN, IRN, PAK, TJK, TKM, UZB}},
+	BHS: {13880, 3542, 24.29, -76.63, BoundingBox{20.91, -79.60, 27.26, -72.71}, nil},
+	BGD: {147570, 580, 23.87, 90.24, BoundingBox{20.74, 88.01, 26.63, 92.67}, []CountryCode{IND, MMR}},
+	BRB: {430, 97, 13.18, -59.56, BoundingBox{13.04, -59.65, 13.34, -59.42}, nil},
+	BHR: {765, 161, 26.04, 50.54, BoundingBox{25.79, 50.38, 26.29, 50.82}, nil},
+	BLR: {207600, 0, 53.53, 28.03, BoundingBox{51.26, 23.18, 56.17, 32.78}, []CountryCode{LVA, LTU, POL, RUS, UKR}},
+	BLZ: {22966, 386, 17.20, -88.71, BoundingBox{15.89, -89.22, 18.50, -87.49}, []CountryCode{GTM, MEX}},
+	BEL: {30528, 67, 50.64, 4.64, BoundingBox{49.50, 2.55, 51.50, 6.41}, []CountryCode{FRA, DEU, LUX, NLD}},
+	BEN: {114763, 121, 9.64, 2.33, BoundingBox{6.23, 0.77, 12.41, 3.84}, []CountryCode{BFA, NER, NGA, TGO}},
+	BMU: {54, 103, 32.31, -64.75, BoundingBox{32.25, -64.89, 32.39, -64.65}, nil},
+	BGR: {110879, 354, 42.77, 25.22, BoundingBox{41.24, 22.36, 44.22, 28.61}, []CountryCode{GRC, MKD, ROU, SRB, TUR}},
+	BOL: {1098581, 0, -16.71, -64.69, BoundingBox{-22.90, -69.64, -9.68, -57.45}, []CountryCode{ARG, BRA, CHL, PRY, PER}},
+	BIH: {51197, 20, 44.17, 17.79, BoundingBox{42.56, 15.72, 45.28, 19.62}, []CountryCode{HRV, MNE, SRB}},
+	BWA: {581730, 0, -22.18, 23.80, BoundingBox{-26.91, 19.99, -17.78, 29.37}, []CountryCode{NAM, ZAF, ZMB, ZWE}},
+	BRA: {8515767, 7491, -10.79, -53.10, BoundingBox{-33.75, -73.99, 5.27, -34.79}, []CountryCode{ARG, BOL, COL, GUF, GUY, PRY, PER, SUR, URY, VEN}},
+	IOT: {60, 698, -7.33, 72.42, BoundingBox{-7.44, 71.26, -5.25, 72.49}, nil},
+	BRN: {5765, 161, 4.52, 114.72, BoundingBox{4.00, 114.08, 5.05, 115.36}, []CountryCode{MYS}},
+	BFA: {274200, 0, 12.27, -1.75, BoundingBox{9.41, -5.52, 15.08, 2.41}, []CountryCode{BEN, CIV, GHA, MLI, NER, TGO}},
+	BDI: {27834, 0, -3.36, 29.88, BoundingBox{-4.47, 29.00, -2.31, 30.85}, []CountryCode{COD, RWA, TZA}},
+	BTN: {38394, 0, 27.41, 90.40, BoundingBox{26.70, 88.75, 28.33, 92.13}, []CountryCode{CHN, IND}},
+	VUT: {12189, 2528, -15.37, 166.96, BoundingBox{-20.25, 166.52, -13.07, 170.24}, nil},
+	VAT: {0.49, 0, 41.90, 12.45, BoundingBox{41.90, 12.45, 41.91, 12.46}, []CountryCode{ITA}},
+	GBR: {242495, 12429, 54.12, -2.87, BoundingBox{49.96, -8.65, 60.86, 1.77}, []CountryCode{IRL}},
+	HUN: {93028, 0, 47.16, 19.40, BoundingBox{45.74, 16.11, 48.59, 22.90}, []CountryCode{AUT, HRV, ROU, SRB, SVK, SVN, UKR}},
+	VEN: {916445, 2800, 7.12, -66.18, BoundingBox{0.65, -73.35, 12.20, -59.80}, []CountryCode{BRA, COL, GUY}},
+	VGB: {151, 80, 18.43, -64.62, BoundingBox{18.31, -64.84, 18.76, -64.27}, nil},
+	VIR: {346, 188, 17.96, -64.80, BoundingBox{17.67, -65.09, 18.42, -64.56}, nil},
+	TLS: {14874, 706, -8.83, 125.84, BoundingBox{-9.50, 124.04, -8.13, 127.34}, []CountryCode{IDN}},
+	VNM: {331212, 3444, 16.65, 106.30, BoundingBox{8.56, 102.14, 23.39, 109.46}, []CountryCode{KHM, CHN, LAO}},
+	GAB: {267668, 885, -0.59, 11.79, BoundingBox{-3.98, 8.70, 2.32, 14.50}, []CountryCode{CMR, COG, GNQ}},
+	HTI: {27750, 1771, 18.94, -72.69, BoundingBox{18.02, -74.48, 20.09, -71.62}, []CountryCode{DOM}},
+	GUY: {214969, 459, 4.79, -58.97, BoundingBox{1.17, -61.41, 8.56, -56.48}, []CountryCode{BRA, SUR, VEN}},
+	GMB: {11295, 80, 13.45, -15.40, BoundingBox{13.06, -16.82, 13.83, -13.80}, []CountryCode{SEN}},
+	GHA: {238533, 539, 7.95, -1.22, BoundingBox{4.74, -3.26, 11.17, 1.19}, []CountryCode{BFA, CIV, TGO}},
+	GLP: {1628, 306, 16.20, -61.55, BoundingBox{15.83, -61.81, 16.52, -61.00}, nil},
+	GTM: {108889, 400, 15.69, -90.36, BoundingBox{13.74, -92.24, 17.82, -88.22}, []CountryCode{BLZ, SLV, HND, MEX}},
+	GIN: {245857, 320, 10.44, -10.94, BoundingBox{7.19, -15.08, 12.68, -7.64}, []CountryCode{CIV, GNB, LBR, MLI, SEN, SLE}},
+	GNB: {36125, 350, 12.05, -14.95, BoundingBox{10.86, -16.73, 12.69, -13.64}, []CountryCode{GIN, SEN}},
+	DEU: {357588, 2389, 51.11, 10.38, BoundingBox{47.27, 5.87, 55.06, 15.04}, []CountryCode{AUT, BEL, CZE, DNK, FRA, LUX, NLD, POL, CHE}},
+	GIB: {6.8, 12, 36.14, -5.35, BoundingBox{36.11, -5.37, 36.16, -5.34}, []CountryCode{ESP}},
+	HND: {112492, 823, 14.83, -86.61, BoundingBox{12.98, -89.35, 16.51, -83.13}, []CountryCode{SLV, GTM, NIC}},
+	HKG: {1106, 733, 22.40, 114.11, BoundingBox{22.15, 113.84, 22.56, 114.41}, []CountryCode{CHN}},
+	GRD: {344, 121, 12.12, -61.68, BoundingBox{11.98, -61.80, 12.53, -61.38}, nil},
+	GRL: {2166086, 44087, 74.71, -41.34, BoundingBox{59.78, -73.04, 83.63, -11.31}, nil},
+	GRC: {131957, 13676, 39.07, 22.96, BoundingBox{34.80, 19.37, 41.75, 29.65}, []CountryCode{ALB, BGR, MKD, TUR}},
+	GEO: {69700, 310, 42.17, 43.50, BoundingBox{41.05, 40.01, 43.59, 46.74}, []CountryCode{ARM, AZE, RUS, TUR}},
+	GUM: {544, 126, 13.44, 144.79, BoundingBox{13.24, 144.62, 13.65, 144.96}, nil},
+	DNK: {42933, 7314, 55.97, 10.05, BoundingBox{54.56, 8.07, 57.75, 15.20}, []CountryCode{DEU}},
+	COD: {2344858, 37, -2.88, 23.64, BoundingBox{-13.46, 12.20, 5.39, 31.31}, []CountryCode{AGO, BDI, CAF, COG, RWA, SSD, TZA, UGA, ZMB}},
+	DJI: {23200, 314, 11.75, 42.58, BoundingBox{10.91, 41.77, 12.71, 43.42}, []CountryCode{ERI, ETH, SOM}},
+	DMA: {751, 148, 15.44, -61.36, BoundingBox{15.20, -61.48, 15.64, -61.24}, nil},
+	DOM: {48671, 1288, 18.89, -70.51, BoundingBox{17.54, -72.01, 19.93, -68.32}, []CountryCode{HTI}},
+	EGY: {1001450, 2450, 26.50, 29.86, BoundingBox{22.00, 24.70, 31.67, 36.90}, []CountryCode{ISR, LBY, PSE, SDN}},
+	ZMB: {752612, 0, -13.46, 27.80, BoundingBox{-18.08, 21.99, -8.22, 33.71}, []CountryCode{AGO, BWA, COD, MWI, MOZ, NAM, TZA, ZWE}},
+	ESH: {266000, 1110, 24.22, -12.89, BoundingBox{20.77, -17.10, 27.67, -8.67}, []CountryCode{DZA, MRT, MAR}},
+	ZWE: {390757, 0, -19.00, 29.87, BoundingBox{-22.42, 25.24, -15.61, 33.06}, []CountryCode{BWA, MOZ, ZAF, ZMB}},
+	ISR: {20770, 273, 31.05, 34.85, BoundingBox{29.49, 34.27, 33.33, 35.90}, []CountryCode{EGY, JOR, LBN, SYR, PSE}},
+	IND: {3287263, 7000, 22.89, 79.61, BoundingBox{6.75, 68.11, 35.67, 97.40}, []CountryCode{BGD, BTN, CHN, MMR, NPL, PAK}},
+	IDN: {1904569, 54716, -2.22, 117.24, BoundingBox{-11.01, 95.01, 6.08, 141.02}, []CountryCode{MYS, PNG, TLS}},
+	JOR: {89342, 26, 31.25, 36.77, BoundingBox{29.19, 34.96, 33.37, 39.30}, []CountryCode{IRQ, ISR, SAU, SYR, PSE}},
+	IRQ: {438317, 58, 33.04, 43.74, BoundingBox{29.06, 38.79, 37.38, 48.57}, []CountryCode{IRN, JOR, KWT, SAU, SYR, TUR}},
+	IRN: {1648195, 2440, 32.57, 54.27, BoundingBox{25.06, 44.05, 39.78, 63.32}, []CountryCode{AFG, ARM, AZE, IRQ, PAK, TUR, TKM}},
+	IRL: {70273, 1448, 53.18, -8.14, BoundingBox{51.42, -10.48, 55.39, -6.00}, []CountryCode{GBR}},
+	ISL: {103000, 4970, 64.99, -18.57, BoundingBox{63.30, -24.55, 66.57, -13.49}, nil},
+	ESP: {505990, 4964, 40.24, -3.65, BoundingBox{27.64, -18.17, 43.79, 4.33}, []CountryCode{AND, FRA, GIB, PRT, MAR}},
+	ITA: {301340, 7600, 42.80, 12.07, BoundingBox{35.49, 6.63, 47.09, 18.52}, []CountryCode{AUT, FRA, SMR, SVN, CHE, VAT}},
+	YEM: {527968, 1906, 15.91, 47.59, BoundingBox{12.11, 42.55, 19.00, 54.53}, []CountryCode{OMN, SAU}},
+	KAZ: {2724900, 0, 48.16, 67.30, BoundingBox{40.57, 46.49, 55.44, 87.32}, []CountryCode{CHN, KGZ, RUS, TKM, UZB}},
+	CYM: {264, 160, 19.43, -80.91, BoundingBox{19.26, -81.42, 19.76, -79.72}, nil},
+	KHM: {181035, 443, 12.72, 104.91, BoundingBox{10.41, 102.33, 14.69, 107.63}, []CountryCode{LAO, THA, VNM}},
+	CMR: {475442, 402, 5.69, 12.74, BoundingBox{1.65, 8.49, 13.08, 16.19}, []CountryCode{CAF, TCD, COG, GNQ, GAB, NGA}},
+	CAN: {9984670, 202080, 61.36, -98.31, BoundingBox{41.68, -141.00, 83.11, -52.62}, []CountryCode{USA}},
+	QAT: {11586, 563, 25.31, 51.18, BoundingBox{24.47, 50.75, 26.18, 51.64}, []CountryCode{SAU}},
+	KEN: {580367, 536, 0.60, 37.80, BoundingBox{-4.68, 33.91, 5.03, 41.91}, []CountryCode{ETH, SOM, SSD, TZA, UGA}},
+	CYP: {9251, 648, 35.05, 33.22, BoundingBox{34.57, 32.27, 35.70, 34.60}, nil},
+	KIR: {811, 1143, 1.87, -157.36, BoundingBox{-11.45, 169.53, 4.72, -150.21}, nil},
+	CHN: {9596961, 14500, 36.56, 103.82, BoundingBox{18.16, 73.50, 53.56, 134.77}, []CountryCode{AFG, BTN, IND, KAZ, PRK, KGZ, LAO, MNG, MMR, NPL, PAK, RUS, TJK, VNM, HKG, MAC}},
+	CCK: {14, 26, -12.13, 96.87, BoundingBox{-12.21, 96.81, -11.82, 96.93}, nil},
+	COL: {1141748, 3208, 3.91, -73.08, BoundingBox{-4.23, -79.00, 13.39, -66.85}, []CountryCode{BRA, ECU, PAN, PER, VEN}},
+	COM: {2235, 340, -11.88, 43.68, BoundingBox{-12.42, 43.22, -11.36, 44.54}, nil},
+	COG: {342000, 169, -0.84, 15.22, BoundingBox{-5.03, 11.09, 3.71, 18.65}, []CountryCode{AGO, CMR, CAF, COD, GAB}},
+	PRK: {120538, 2495, 40.15, 127.19, BoundingBox{37.67, 124.18, 43.01, 130.70}, []CountryCode{CHN, KOR, RUS}},
+	KOR: {100210, 2413, 36.39, 127.84, BoundingBox{33.11, 124.61, 38.62, 131.87}, []CountryCode{PRK}},
+	CRI: {51100, 1290, 9.98, -84.19, BoundingBox{8.03, -85.95, 11.22, -82.55}, []CountryCode{NIC, PAN}},
+	CIV: {322463, 515, 7.63, -5.57, BoundingBox{4.36, -8.60, 10.74, -2.49}, []CountryCode{BFA, GHA, GIN, LBR, MLI}},
+	CUB: {109884, 3735, 21.62, -79.02, BoundingBox{19.83, -84.96, 23.27, -74.13}, nil},
+	KWT: {17818, 499, 29.33, 47.59, BoundingBox{28.52, 46.55, 30.10, 48.43}, []CountryCode{IRQ, SAU}},
+	KGZ: {199951, 0, 41.46, 74.54, BoundingBox{39.17, 69.25, 43.27, 80.28}, []CountryCode{CHN, KAZ, TJK, UZB}},
+	LAO: {236800, 0, 18.50, 103.74, BoundingBox{13.91, 100.08, 22.50, 107.64}, []CountryCode{KHM, CHN, MMR, THA, VNM}},
+	LVA: {64589, 498, 56.85, 24.91, BoundingBox{55.67, 20.97, 58.08, 28.24}, []CountryCode{BLR, EST, LTU, RUS}},
+	LSO: {30355, 0, -29.58, 28.23, BoundingBox{-30.68, 27.01, -28.57, 29.46}, []CountryCode{ZAF}},
+	LBR: {111369, 579, 6.45, -9.31, BoundingBox{4.36, -11.49, 8.55, -7.37}, []CountryCode{GIN, CIV, SLE}},
+	LBN: {10452, 225, 33.92, 35.88, BoundingBox{33.05, 35.10, 34.69, 36.62}, []CountryCode{ISR, SYR}},
+	LBY: {1759540, 1770, 27.03, 18.01, BoundingBox{19.50, 9.39, 33.17, 25.15}, []CountryCode{DZA, TCD, EGY, NER, SDN, TUN}},
+	LTU: {65300, 90, 55.33, 23.89, BoundingBox{53.90, 20.93, 56.45, 26.84}, []CountryCode{BLR, LVA, POL, RUS}},
+	LIE: {160, 0, 47.14, 9.54, BoundingBox{47.05, 9.47, 47.27, 9.64}, []CountryCode{AUT, CHE}},
+	LUX: {2586, 0, 49.77, 6.07, BoundingBox{49.45, 5.73, 50.18, 6.53}, []CountryCode{BEL, FRA, DEU}},
+	MUS: {2040, 177, -20.28, 57.57, BoundingBox{-20.53, 57.30, -19.97, 57.81}, nil},
+	MRT: {1030700, 754, 20.26, -10.35, BoundingBox{14.72, -17.07, 27.30, -4.83}, []CountryCode{DZA, MLI, SEN, ESH}},
+	MDG: {587041, 4828, -19.37, 46.70, BoundingBox{-25.61, 43.22, -11.95, 50.48}, nil},
+	MYT: {374, 185, -12.82, 45.15, BoundingBox{-13.00, 44.98, -12.64, 45.30}, nil},
+	MAC: {33, 41, 22.16, 113.56, BoundingBox{22.11, 113.53, 22.22, 113.60}, []CountryCode{CHN}},
+	MKD: {25713, 0, 41.60, 21.70, BoundingBox{40.85, 20.45, 42.37, 23.04}, []CountryCode{ALB, BGR, GRC, XKX, SRB}},
+	MWI: {118484, 0, -13.22, 34.29, BoundingBox{-17.13, 32.67, -9.37, 35.92}, []CountryCode{MOZ, TZA, ZMB}},
+	MYS: {330803, 4675, 3.79, 109.70, BoundingBox{0.85, 99.64, 7.36, 119.27}, []CountryCode{BRN, IDN, THA}},
+	MLI: {1240192, 0, 17.35, -3.54, BoundingBox{10.16, -12.24, 25.00, 4.27}, []CountryCode{DZA, BFA, GIN, CIV, MRT, NER, SEN}},
+	MDV: {298, 644, 3.73, 73.46, BoundingBox{-0.69, 72.64, 7.11, 73.76}, nil},
+	MLT: {316, 197, 35.92, 14.41, BoundingBox{35.79, 14.18, 36.08, 14.58}, nil},
+	MNP: {464, 1482, 15.83, 145.62, BoundingBox{14.11, 144.89, 20.55, 145.87}, nil},
+	MAR: {446550, 1835, 31.88, -6.32, BoundingBox{27.67, -13.17, 35.92, -1.00}, []CountryCode{DZA, ESP, ESH}},
+	MTQ: {1128, 350, 14.65, -61.02, BoundingBox{14.39, -61.23, 14.88, -60.81}, nil},
+	MHL: {181, 370, 7.00, 170.34, BoundingBox{4.57, 160.80, 14.62, 172.17}, nil},
+	MEX: {1964375, 9330, 23.95, -102.52, BoundingBox{14.53, -118.40, 32.72, -86.71}, []CountryCode{BLZ, GTM, USA}},
+	FSM: {702, 6112, 7.45, 153.24, BoundingBox{1.03, 137.33, 10.09, 163.04}, nil},
+	MOZ: {801590, 2470, -17.27, 35.53, BoundingBox{-26.87, 30.22, -10.47, 40.84}, []CountryCode{MWI, ZAF, SWZ, TZA, ZMB, ZWE}},
+	MDA: {33846, 0, 47.19, 28.46, BoundingBox{45.47, 26.62, 48.49, 30.13}, []CountryCode{ROU, UKR}},
+	MCO: {2.02, 4, 43.75, 7.41, BoundingBox{43.72, 7.41, 43.75, 7.44}, []CountryCode{FRA}},
+	MNG: {1564116, 0, 46.84, 103.05, BoundingBox{41.58, 87.75, 52.15, 119.93}, []CountryCode{CHN, RUS}},
+	MSR: {102, 40, 16.74, -62.19, BoundingBox{16.67, -62.24, 16.82, -62.14}, nil},
+	MMR: {676578, 1930, 21.19, 96.49, BoundingBox{9.78, 92.17, 28.55, 101.17}, []CountryCode{BGD, CHN, IND, LAO, THA}},
+	NAM: {825615, 1572, -22.13, 17.22, BoundingBox{-28.97, 11.72, -16.96, 25.26}, []CountryCode{AGO, BWA, ZAF, ZMB}},
+	NRU: {21, 30, -0.52, 166.93, BoundingBox{-0.55, 166.91, -0.50, 166.96}, nil},
+	NPL: {147181, 0, 28.25, 83.92, BoundingBox{26.35, 80.06, 30.45, 88.20}, []CountryCode{CHN, IND}},
+	NER: {1267000, 0, 17.42, 9.39, BoundingBox{11.69, 0.17, 23.53, 16.00}, []CountryCode{DZA, BEN, BFA, TCD, LBY, MLI, NGA}},
+	NGA: {923768, 853, 9.59, 8.09, BoundingBox{4.27, 2.67, 13.89, 14.68}, []CountryCode{BEN, CMR, TCD, NER}},
+	NLD: {41850, 451, 52.25, 5.54, BoundingBox{50.75, 3.36, 53.56, 7.23}, []CountryCode{BEL, DEU}},
+	NIC: {130373, 910, 12.85, -85.03, BoundingBox{10.71, -87.69, 15.03, -82.59}, []CountryCode{CRI, HND}},
+	NIU: {260, 64, -19.05, -169.87, BoundingBox{-19.15, -169.95, -18.95, -169.78}, nil},
+	NZL: {268021, 15134, -41.81, 171.48, BoundingBox{-47.29, 166.43, -34.39, 178.55}, nil},
+	NCL: {18575, 2254, -21.30, 165.68, BoundingBox{-22.70, 163.57, -19.54, 168.14}, nil},
+	NOR: {323802, 25148, 64.56, 12.67, BoundingBox{57.96, 4.65, 71.19, 31.08}, []CountryCode{FIN, SWE, RUS}},
+	OMN: {309500, 2092, 20.61, 56.09, BoundingBox{16.65, 52.00, 26.40, 59.84}, []CountryCode{SAU, ARE, YEM}},
+	BVT: {49, 30, -54.42, 3.41, BoundingBox{-54.46, 3.33, -54.38, 3.48}, nil},
+	IMN: {572, 160, 54.23, -4.53, BoundingBox{54.04, -4.80, 54.42, -4.31}, nil},
+	NFK: {36, 32, -29.04, 167.95, BoundingBox{-29.14, 167.91, -28.99, 168.00}, nil},
+	PCN: {47, 51, -24.37, -128.32, BoundingBox{-25.08, -130.75, -23.92, -124.77}, nil},
+	CXR: {135, 139, -10.49, 105.62, BoundingBox{-10.57, 105.53, -10.41, 105.71}, nil},
+	SHN: {394, 60, -15.96, -5.71, BoundingBox{-40.41, -14.42, -7.88, -5.64}, nil},
+	WLF: {142, 129, -13.77, -177.16, BoundingBox{-14.36, -178.21, -13.18, -176.12}, nil},
+	HMD: {412, 102, -53.09, 73.51, BoundingBox{-53.20, 72.60, -52.91, 73.86}, nil},
+	CPV: {4033, 965, 15.96, -23.95, BoundingBox{14.80, -25.36, 17.20, -22.66}, nil},
+	COK: {236, 120, -21.22, -159.78, BoundingBox{-21.96, -165.85, -8.95, -157.31}, nil},
+	WSM: {2842, 403, -13.75, -172.16, BoundingBox{-14.08, -172.80, -13.43, -171.41}, nil},
+	SJM: {61399, 3587, 78.83, 16.39, BoundingBox{70.83, -9.08, 80.83, 33.64}, nil},
+	TCA: {948, 389, 21.69, -71.80, BoundingBox{21.42, -72.48, 21.96, -71.08}, nil},
+	UMI: {34, 19, 5.88, -162.08, BoundingBox{-0.39, 166.60, 28.22, -160.02}, nil},
+	PAK: {881913, 1046, 29.97, 69.35, BoundingBox{23.69, 60.87, 37.08, 77.84}, []CountryCode{AFG, CHN, IND, IRN}},
+	PLW: {459, 1519, 7.51, 134.58, BoundingBox{2.80, 131.12, 8.10, 134.73}, nil},
+	PSE: {6020, 40, 31.95, 35.23, BoundingBox{31.22, 34.22, 32.55, 35.57}, []CountryCode{EGY, ISR, JOR}},
+	PAN: {75417, 2490, 8.53, -80.12, BoundingBox{7.20, -83.05, 9.65, -77.16}, []CountryCode{COL, CRI}},
+	PNG: {462840, 5152, -6.46, 145.21, BoundingBox{-11.66, 140.84, -1.32, 155.97}, []CountryCode{IDN}},
+	PRY: {406752, 0, -23.44, -58.44, BoundingBox{-27.61, -62.65, -19.29, -54.26}, []CountryCode{ARG, BOL, BRA}},
+	PER: {1285216, 2414, -9.19, -75.02, BoundingBox{-18.35, -81.33, -0.04, -68.65}, []CountryCode{BOL, BRA, CHL, COL, ECU}},
+	POL: {312696, 440, 51.92, 19.13, BoundingBox{49.00, 14.12, 54.84, 24.15}, []CountryCode{BLR, CZE, DEU, LTU, RUS, SVK, UKR}},
+	PRT: {92212, 1793, 39.60, -8.00, BoundingBox{36.96, -9.53, 42.15, -6.19}, []CountryCode{ESP}},
+	PRI: {9104, 501, 18.22, -66.59, BoundingBox{17.88, -67.27, 18.52, -65.22}, nil},
+	REU: {2511, 207, -21.12, 55.53, BoundingBox{-21.39, 55.22, -20.87, 55.84}, nil},
+	RUS: {17098246, 37653, 61.52, 105.32, BoundingBox{41.19, 19.64, 81.86, -169.05}, []CountryCode{AZE, BLR, CHN, EST, FIN, GEO, KAZ, PRK, LVA, LTU, MNG, NOR, POL, UKR}},
+	RWA: {26338, 0, -1.94, 29.87, BoundingBox{-2.84, 28.86, -1.05, 30.90}, []CountryCode{BDI, COD, TZA, UGA}},
+	ROU: {238397, 225, 45.94, 24.97, BoundingBox{43.62, 20.26, 48.27, 29.69}, []CountryCode{BGR, HUN, MDA, SRB, UKR}},
+	SLV: {21041, 307, 13.79, -88.90, BoundingBox{13.15, -90.13, 14.45, -87.69}, []CountryCode{GTM, HND}},
+	SMR: {61, 0, 43.94, 12.46, BoundingBox{43.89, 12.40, 43.99, 12.52}, []CountryCode{ITA}},
+	STP: {964, 209, 0.19, 6.61, BoundingBox{0.02, 6.47, 1.70, 7.47}, nil},
+	SAU: {2149690, 2640, 23.89, 45.08, BoundingBox{16.38, 34.50, 32.15, 55.67}, []CountryCode{IRQ, JOR, KWT, OMN, QAT, ARE, YEM}},
+	SWZ: {17364, 0, -26.52, 31.47, BoundingBox{-27.32, 30.79, -25.72, 32.14}, []CountryCode{MOZ, ZAF}},
+	SYC: {459, 491, -4.68, 55.49, BoundingBox{-10.23, 46.20, -3.71, 56.29}, nil},
+	SEN: {196722, 531, 14.50, -14.45, BoundingBox{12.31, -17.54, 16.69, -11.35}, []CountryCode{GMB, GIN, GNB, MLI, MRT}},
+	SPM: {242, 120, 46.89, -56.32, BoundingBox{46.75, -56.41, 47.15, -56.12}, nil},
+	VCT: {389, 84, 13.25, -61.20, BoundingBox{12.58, -61.46, 13.38, -61.11}, nil},
+	KNA: {261, 135, 17.34, -62.78, BoundingBox{17.09, -62.87, 17.42, -62.54}, nil},
+	LCA: {617, 158, 13.91, -60.98, BoundingBox{13.71, -61.08, 14.11, -60.87}, nil},
+	SGP: {728, 193, 1.35, 103.82, BoundingBox{1.16, 103.60, 1.47, 104.09}, nil},
+	SYR: {185180, 193, 34.80, 38.99, BoundingBox{32.31, 35.73, 37.32, 42.38}, []CountryCode{IRQ, ISR, JOR, LBN, TUR}},
+	SVK: {49035, 0, 48.67, 19.70, BoundingBox{47.73, 16.83, 49.61, 22.57}, []CountryCode{AUT, CZE, HUN, POL, UKR}},
+	SVN: {20273, 47, 46.15, 14.99, BoundingBox{45.42, 13.38, 46.88, 16.61}, []CountryCode{AUT, HRV, HUN, ITA}},
+	USA: {9833517, 19924, 39.83, -98.58, BoundingBox{18.91, 172.44, 71.39, -66.95}, []CountryCode{CAN, MEX}},
+	SLB: {28896, 5313, -9.65, 160.16, BoundingBox{-11.86, 155.51, -6.59, 167.91}, nil},
+	SOM: {637657, 3025, 5.15, 46.20, BoundingBox{-1.66, 40.99, 11.99, 51.41}, []CountryCode{DJI, ETH, KEN}},
+	SDN: {1861484, 853, 12.86, 30.22, BoundingBox{8.68, 21.81, 22.23, 38.61}, []CountryCode{CAF, TCD, EGY, ERI, ETH, LBY, SSD}},
+	SUR: {163820, 386, 3.92, -56.03, BoundingBox{1.83, -58.07, 6.01, -53.95}, []CountryCode{BRA, GUF, GUY}},
+	SLE: {71740, 402, 8.46, -11.78, BoundingBox{6.93, -13.30, 10.00, -10.27}, []CountryCode{GIN, LBR}},
+	TJK: {143100, 0, 38.86, 71.28, BoundingBox{36.67, 67.34, 41.04, 75.15}, []CountryCode{AFG, CHN, KGZ, UZB}},
+	TWN: {36193, 1566, 23.70, 120.96, BoundingBox{21.90, 119.31, 25.30, 122.01}, nil},
+	THA: {513120, 3219, 15.87, 100.99, BoundingBox{5.61, 97.34, 20.46, 105.64}, []CountryCode{KHM, LAO, MYS, MMR}},
+	TZA: {947303, 1424, -6.37, 34.89, BoundingBox{-11.75, 29.33, -0.98, 40.44}, []CountryCode{BDI, COD, KEN, MWI, MOZ, RWA, UGA, ZMB}},
+	TGO: {56785, 56, 8.62, 0.82, BoundingBox{6.10, -0.15, 11.14, 1.81}, []CountryCode{BEN, BFA, GHA}},
+	TKL: {12, 101, -9.20, -171.85, BoundingBox{-9.44, -172.52, -8.53, -171.18}, nil},
+	TON: {747, 419, -21.18, -175.20, BoundingBox{-22.35, -176.22, -15.56, -173.70}, nil},
+	TTO: {5130, 362, 10.69, -61.22, BoundingBox{10.04, -61.93, 11.36, -60.49}, nil},
+	TUV: {26, 24, -7.11, 177.65, BoundingBox{-10.80, 176.06, -5.64, 179.87}, nil},
+	TUN: {163610, 1148, 33.89, 9.54, BoundingBox{30.23, 7.52, 37.35, 11.60}, []CountryCode{DZA, LBY}},
+	TKM: {488100, 0, 38.97, 59.56, BoundingBox{35.13, 52.44, 42.80, 66.69}, []CountryCode{AFG, IRN, KAZ, UZB}},
+	TUR: {783562, 7200, 38.96, 35.24, BoundingBox{35.81, 25.66, 42.11, 44.82}, []CountryCode{ARM, AZE, BGR, GEO, GRC, IRN, IRQ, SYR}},
+	UGA: {241550, 0, 1.37, 32.29, BoundingBox{-1.48, 29.57, 4.23, 35.04}, []CountryCode{COD, KEN, RWA, SSD, TZA}},
+	UZB: {448978, 0, 41.38, 64.59, BoundingBox{37.18, 55.99, 45.59, 73.13}, []CountryCode{AFG, KAZ, KGZ, TJK, TKM}},
+	UKR: {603550, 2782, 48.38, 31.17, BoundingBox{44.39, 22.14, 52.38, 40.23}, []CountryCode{BLR, HUN, MDA, POL, ROU, RUS, SVK}},
+	URY: {176215, 660, -32.52, -55.77, BoundingBox{-34.97, -58.44, -30.09, -53.07}, []CountryCode{ARG, BRA}},
+	FRO: {1393, 1117, 61.89, -6.91, BoundingBox{61.39, -7.69, 62.40, -6.25}, nil},
+	FJI: {18274, 1129, -17.71, 178.07, BoundingBox{-20.68, 177.00, -12.48, -178.23}, nil},
+	PHL: {300000, 36289, 12.88, 121.77, BoundingBox{4.59, 116.93, 21.12, 126.60}, nil},
+	FIN: {338424, 1250, 64.95, 26.07, BoundingBox{59.81, 20.55, 70.09, 31.59}, []CountryCode{NOR, SWE, RUS}},
+	FLK: {12173, 1288, -51.80, -59.52, BoundingBox{-52.36, -61.35, -51.24, -57.71}, nil},
+	FRA: {551695, 3427, 46.23, 2.21, BoundingBox{41.33, -5.14, 51.09, 9.56}, []CountryCode{AND, BEL, DEU, ITA, LUX, MCO, ESP, CHE}},
+	GUF: {83534, 378, 3.93, -53.13, BoundingBox{2.11, -54.60, 5.78, -51.61}, []CountryCode{BRA, SUR}},
+	PYF: {4167, 2525, -17.68, -149.41, BoundingBox{-27.65, -154.73, -7.88, -134.93}, nil},
+	ATF: {7747, 1232, -49.28, 69.35, BoundingBox{-49.73, 68.72, -48.60, 70.56}, nil},
+	HRV: {56594, 5835, 45.10, 15.20, BoundingBox{42.39, 13.49, 46.55, 19.45}, []CountryCode{BIH, HUN, MNE, SRB, SVN}},
+	CAF: {622984, 0, 6.61, 20.94, BoundingBox{2.22, 14.42, 11.00, 27.46}, []CountryCode{CMR, TCD, COD, COG, SSD, SDN}},
+	TCD: {1284000, 0, 15.45, 18.73, BoundingBox{7.44, 13.47, 23.45, 24.00}, []CountryCode{CMR, CAF, LBY, NER, NGA, SDN}},
+	CZE: {78871, 0, 49.82, 15.47, BoundingBox{48.55, 12.09, 51.06, 18.86}, []CountryCode{AUT, DEU, POL, SVK}},
+	CHL: {756102, 6435, -35.68, -71.54, BoundingBox{-55.98, -75.64, -17.50, -66.42}, []CountryCode{ARG, BOL, PER}},
+	CHE: {41285, 0, 46.82, 8.23, BoundingBox{45.82, 5.96, 47.81, 10.49}, []CountryCode{AUT, FRA, DEU, ITA, LIE}},
+	SWE: {450295, 3218, 60.13, 18.64, BoundingBox{55.34, 11.11, 69.06, 24.17}, []CountryCode{FIN, NOR}},
+	LKA: {65610, 1340, 7.87, 80.77, BoundingBox{5.92, 79.52, 9.84, 81.88}, nil},
+	ECU: {283561, 2237, -1.83, -78.18, BoundingBox{-5.01, -81.08, 1.44, -75.19}, []CountryCode{COL, PER}},
+	GNQ: {28051, 296, 1.65, 10.27, BoundingBox{-1.47, 5.61, 3.79, 11.34}, []CountryCode{CMR, GAB}},
+	ERI: {117600, 2234, 15.18, 39.78, BoundingBox{12.36, 36.43, 18.00, 43.13}, []CountryCode{DJI, ETH, SDN}},
+	EST: {45339, 3794, 58.60, 25.01, BoundingBox{57.52, 21.76, 59.68, 28.21}, []CountryCode{LVA, RUS}},
+	ETH: {1104300, 0, 9.15, 40.49, BoundingBox{3.40, 32.99, 14.89, 47.99}, []CountryCode{DJI, ERI, KEN, SOM, SSD, SDN}},
+	ZAF: {1221037, 2798, -30.56, 22.94, BoundingBox{-34.84, 16.45, -22.13, 32.89}, []CountryCode{BWA, LSO, MOZ, NAM, SWZ, ZWE}},
+	YUG: {102350, 199, 44.02, 20.91, BoundingBox{41.85, 18.45, 46.19, 23.01}, nil},
+	SGS: {3903, -1, -54.43, -36.59, BoundingBox{-59.48, -38.03, -53.97, -26.24}, nil},
+	JAM: {10991, 1022, 18.11, -77.30, BoundingBox{17.70, -78.37, 18.53, -76.18}, nil},
+	MNE: {13812, 294, 42.71, 19.37, BoundingBox{41.85, 18.43, 43.56, 20.36}, []CountryCode{ALB, BIH, HRV, XKX, SRB}},
+	BLM: {21, 20, 17.90, -62.83, BoundingBox{17.87, -62.88, 17.93, -62.79}, nil},
+	SXM: {34, 59, 18.04, -63.06, BoundingBox{18.01, -63.14, 18.07, -63.01}, []CountryCode{MAF}},
+	SRB: {77474, 0, 44.02, 20.91, BoundingBox{42.23, 18.82, 46.19, 23.01}, []CountryCode{BIH, BGR, HRV, HUN, XKX, MKD, MNE, ROU}},
+	ALA: {1580, -1, 60.18, 19.92, BoundingBox{59.74, 19.26, 60.49, 21.35}, nil},
+	BES: {322, -1, 12.18, -68.24, BoundingBox{12.02, -68.42, 17.65, -62.94}, nil},
+	GGY: {65, 50, 49.46, -2.59, BoundingBox{49.40, -2.68, 49.74, -2.16}, nil},
+	JEY: {116, 70, 49.21, -2.13, BoundingBox{49.16, -2.26, 49.27, -2.01}, nil},
+	CUW: {444, -1, 12.17, -68.99, BoundingBox{12.03, -69.16, 12.39, -68.74}, nil},
+	MAF: {53, -1, 18.08, -63.05, BoundingBox{18.05, -63.15, 18.13, -62.97}, []CountryCode{SXM}},
+	SSD: {619745, 0, 6.88, 31.31, BoundingBox{3.49, 23.44, 12.24, 35.95}, []CountryCode{CAF, COD, ETH, KEN, SDN, UGA}},
+	JPN: {377975, 29751, 36.20, 138.25, BoundingBox{24.25, 122.93, 45.52, 145.82}, nil},
+	XKX: {10887, 0, 42.60, 20.90, BoundingBox{41.86, 20.01, 43.27, 21.79}, []CountryCode{ALB, MKD, MNE, SRB}},
+}
+
+// subdivisionGeoTable - centroid and bounding box (south, west, north, east) of subdivisions
+var subdivisionGeoTable = map[SubdivisionCode]subdivisionGeo{
+	"AD-02":  {42.600, 1.679, BoundingBox{42.548, 1.586, 42.654, 1.790}},
+	"AD-03":  {42.527, 1.691, BoundingBox{42.478, 1.604, 42.568, 1.782}},
+	"AD-04":  {42.560, 1.479, BoundingBox{42.523, 1.417, 42.617, 1.562}},
+	"AD-05":  {42.616, 1.533, BoundingBox{42.552, 1.443, 42.660, 1.613}},
+	"AD-06":  {42.458, 1.486, BoundingBox{42.430, 1.424, 42.475, 1.561}},
+	"AD-07":  {42.502, 1.480, BoundingBox{42.475, 1.410, 42.534, 1.540}},
+	"AD-08":  {42.505, 1.584, BoundingBox{42.457, 1.540, 42.558, 1.648}},
+	"AE-AJ":  {25.333, 55.459, BoundingBox{25.171, 55.257, 25.517, 55.624}},
+	"AE-AZ":  {23.561, 53.867, BoundingBox{22.500, 51.580, 24.725, 55.980}},
+	"AE-DU":  {24.754, 55.074, BoundingBox{24.279, 54.386, 25.284, 55.702}},
+	"AE-FU":  {25.225, 56.179, BoundingBox{24.920, 55.949, 25.615, 56.400}},
+	"AE-RK":  {25.720, 56.017, BoundingBox{25.420, 55.735, 26.060, 56.277}},
+	"AE-SH":  {25.093, 55.772, BoundingBox{24.725, 55.464, 25.420, 56.058}},
+	"AE-UQ":  {25.517, 55.722, BoundingBox{25.367, 55.518, 25.731, 55.948}},
+	"AF-BAL": {36.780, 67.134, BoundingBox{36.024, 66.500, 37.362, 67.976}},
+	"AF-BAM": {34.781, 67.267, BoundingBox{33.936, 66.227, 35.499, 68.203}},
+	"AF-BDG": {35.115, 63.677, BoundingBox{34.063, 62.611, 35.987, 64.761}},
+	"AF-BDS": {37.144, 73.960, BoundingBox{36.015, 70.271, 38.490, 75.160}},
+	"AF-BGL": {35.824, 68.774, BoundingBox{35.339, 68.137, 36.290, 69.490}},
+	"AF-DAY": {33.821, 66.044, BoundingBox{33.060, 64.706, 34.753, 67.084}},
+	"AF-FRA": {32.502, 62.156, BoundingBox{31.405, 60.540, 33.443, 64.008}},
+	"AF-FYB": {35.864, 64.854, BoundingBox{35.096, 63.856, 36.915, 65.529}},
+	"AF-GHA": {33.378, 67.837, BoundingBox{32.654, 66.979, 34.018, 68.808}},
+	"AF-GHO": {34.038, 64.629, BoundingBox{32.791, 63.496, 35.125, 66.047}},
+	"AF-HEL": {30.853, 63.985, BoundingBox{29.320, 62.370, 32.834, 65.404}},
+	"AF-HER": {34.304, 62.031, BoundingBox{33.354, 60.530, 35.650, 63.535}},
+	"AF-JOW": {36.844, 65.745, BoundingBox{36.130, 64.700, 37.660, 66.500}},
+	"AF-KAB": {34.511, 69.175, BoundingBox{34.251, 68.639, 34.856, 69.740}},
+	"AF-KAN": {30.963, 65.714, BoundingBox{29.584, 64.950, 32.253, 66.888}},
+	"AF-KAP": {34.926, 69.631, BoundingBox{34.497, 69.238, 35.175, 70.112}},
+	"AF-KDZ": {36.794, 68.704, BoundingBox{36.265, 67.960, 37.552, 69.480}},
+	"AF-KHO": {33.330, 69.814, BoundingBox{32.810, 69.268, 33.843, 70.320}},
+	"AF-KNR": {34.876, 71.061, BoundingBox{34.347, 70.598, 35.299, 71.610}},
+	"AF-LAG": {34.725, 70.188, BoundingBox{34.232, 69.702, 35.250, 70.703}},
+	"AF-LOG": {33.978, 69.151, BoundingBox{33.491, 68.559, 34.310, 69.866}},
+	"AF-NAN": {34.256, 70.514, BoundingBox{33.964, 69.820, 34.637, 71.158}},
+	"AF-NIM": {30.469, 62.202, BoundingBox{29.375, 60.870, 31.501, 63.256}},
+	"AF-NUR": {35.570, 70.851, BoundingBox{34.962, 70.239, 36.152, 71.577}},
+	"AF-PAN": {35.574, 69.753, BoundingBox{35.175, 69.068, 36.000, 70.323}},
+	"AF-PAR": {35.061, 68.705, BoundingBox{34.627, 68.113, 35.419, 69.271}},
+	"AF-PIA": {33.521, 69.368, BoundingBox{32.998, 68.752, 34.067, 70.034}},
+	"AF-PKA": {32.383, 68.699, BoundingBox{31.620, 67.917, 33.200, 69.479}},
+	"AF-SAM": {35.952, 67.733, BoundingBox{35.320, 66.977, 36.712, 68.413}},
+	"AF-SAR": {35.585, 66.204, BoundingBox{34.742, 65.425, 36.353, 67.073}},
+	"AF-TAK": {36.750, 69.859, BoundingBox{36.000, 69.106, 37.745, 70.544}},
+	"AF-URU": {32.752, 65.818, BoundingBox{32.009, 64.285, 33.451, 67.160}},
+	"AF-WAR": {34.265, 68.210, BoundingBox{33.756, 67.417, 34.884, 68.799}},
+	"AF-ZAB": {32.062, 67.236, BoundingBox{31.248, 66.151, 33.002, 68.191}},
+	"AG-03":  {17.144, -61.800, BoundingBox{17.071, -61.856, 17.188, -61.744}},
+	"AG-04":  {17.113, -61.862, BoundingBox{17.060, -61.913, 17.180, -61.808}},
+	"AG-05":  {17.038, -61.868, BoundingBox{16.972, -61.913, 17.094, -61.820}},
+	"AG-06":  {17.017, -61.783, BoundingBox{16.972, -61.842, 17.075, -61.722}},
+	"AG-07":  {17.113, -61.740, BoundingBox{17.057, -61.790, 17.180, -61.687}},
+	"AG-08":  {17.057, -61.710, BoundingBox{17.001, -61.745, 17.123, -61.687}},
+	"AG-10":  {17.630, -61.800, BoundingBox{17.547, -61.887, 17.713, -61.713}},
+	"AG-11":  {16.940, -62.350, BoundingBox{16.895, -62.397, 16.985, -62.303}},
+	"AL-01":  {40.638, 20.116, BoundingBox{40.365, 19.715, 40.968, 20.533}},
+	"AL-02":  {41.427, 19.652, BoundingBox{41.229, 19.379, 41.622, 20.012}},
+	"AL-03":  {41.058, 20.184, BoundingBox{40.694, 19.594, 41.389, 20.603}},
+	"AL-04":  {40.700, 19.617, BoundingBox{40.357, 19.320, 41.037, 20.000}},
+	"AL-05":  {40.209, 20.181, BoundingBox{39.937, 19.785, 40.493, 20.621}},
+	"AL-06":  {40.702, 20.668, BoundingBox{40.114, 20.377, 41.110, 21.020}},
+	"AL-07":  {42.193, 20.221, BoundingBox{41.850, 19.850, 42.590, 20.590}},
+	"AL-08":  {41.773, 19.810, BoundingBox{41.509, 19.376, 41.970, 20.283}},
+	"AL-09":  {41.587, 20.252, BoundingBox{41.317, 19.874, 41.850, 20.586}},
+	"AL-10":  {42.184, 19.691, BoundingBox{41.874, 19.300, 42.690, 20.150}},
+	"AL-11":  {41.215, 19.721, BoundingBox{40.930, 19.344, 41.427, 20.124}},
+	"AL-12":  {40.067, 19.884, BoundingBox{39.620, 19.360, 40.515, 20.454}},
+	"AL-BR":  {40.666, 19.979, BoundingBox{40.512, 19.775, 40.788, 20.161}},
+	"AL-BU":  {41.455, 20.263, BoundingBox{41.317, 20.034, 41.633, 20.506}},
+	"AL-DI":  {41.710, 20.388, BoundingBox{41.493, 20.200, 41.850, 20.586}},
+	"AL-DL":  {39.963, 20.102, BoundingBox{39.875, 19.742, 40.089, 20.454}},
+	"AL-DR":  {41.373, 19.524, BoundingBox{41.229, 19.379, 41.551, 19.689}},
+	"AL-DV":  {40.660, 20.895, BoundingBox{40.472, 20.712, 40.875, 21.020}},
+	"AL-EL":  {41.061, 20.131, BoundingBox{40.928, 19.950, 41.241, 20.398}},
+	"AL-ER":  {40.362, 20.578, BoundingBox{40.114, 20.420, 40.500, 20.762}},
+	"AL-FR":  {40.705, 19.511, BoundingBox{40.508, 19.320, 40.895, 19.775}},
+	"AL-GJ":  {40.101, 20.121, BoundingBox{39.973, 19.785, 40.233, 20.400}},
+	"AL-GR":  {40.836, 20.235, BoundingBox{40.694, 20.020, 40.979, 20.423}},
+	"AL-HA":  {42.196, 20.264, BoundingBox{42.049, 20.043, 42.338, 20.526}},
+	"AL-KA":  {41.125, 19.512, BoundingBox{40.930, 19.344, 41.269, 19.730}},
+	"AL-KB":  {41.635, 19.674, BoundingBox{41.509, 19.464, 41.753, 19.889}},
+	"AL-KC":  {40.832, 19.896, BoundingBox{40.707, 19.715, 40.968, 20.061}},
+	"AL-KO":  {40.676, 20.638, BoundingBox{40.500, 20.390, 40.841, 20.912}},
+	"AL-KR":  {41.488, 19.797, BoundingBox{41.381, 19.576, 41.622, 20.012}},
+	"AL-KU":  {41.993, 20.387, BoundingBox{41.850, 20.150, 42.191, 20.590}},
+	"AL-LB":  {41.214, 20.372, BoundingBox{41.013, 20.097, 41.389, 20.603}},
+	"AL-LE":  {41.829, 19.636, BoundingBox{41.698, 19.376, 41.953, 19.825}},
+	"AL-LU":  {40.924, 19.620, BoundingBox{40.810, 19.339, 41.037, 19.900}},
+	"AL-MK":  {40.515, 19.756, BoundingBox{40.357, 19.468, 40.682, 20.000}},
+	"AL-MM":  {42.389, 19.654, BoundingBox{42.213, 19.340, 42.690, 19.850}},
+	"AL-MR":  {41.822, 20.037, BoundingBox{41.681, 19.825, 41.970, 20.283}},
+	"AL-MT":  {41.599, 20.048, BoundingBox{41.418, 19.874, 41.725, 20.223}},
+	"AL-PG":  {40.921, 20.618, BoundingBox{40.771, 20.377, 41.110, 20.963}},
+	"AL-PQ":  {41.070, 19.803, BoundingBox{40.950, 19.594, 41.191, 19.950}},
+	"AL-PR":  {40.179, 20.413, BoundingBox{39.937, 20.216, 40.398, 20.621}},
+	"AL-PU":  {42.078, 19.913, BoundingBox{41.897, 19.725, 42.274, 20.150}},
+	"AL-SH":  {42.074, 19.517, BoundingBox{41.874, 19.300, 42.244, 19.725}},
+	"AL-SK":  {40.541, 20.277, BoundingBox{40.365, 20.000, 40.716, 20.533}},
+	"AL-SR":  {39.777, 20.140, BoundingBox{39.620, 19.964, 39.875, 20.395}},
+	"AL-TE":  {40.306, 20.008, BoundingBox{40.115, 19.800, 40.493, 20.283}},
+	"AL-TP":  {42.393, 20.025, BoundingBox{42.211, 19.850, 42.590, 20.266}},
+	"AL-TR":  {41.290, 19.893, BoundingBox{41.141, 19.661, 41.427, 20.124}},
+	"AL-VL":  {40.297, 19.597, BoundingBox{40.051, 19.360, 40.515, 19.800}},
+	"AM-AG":  {40.436, 44.169, BoundingBox{40.168, 43.701, 40.677, 44.479}},
+	"AM-AR":  {39.933, 44.848, BoundingBox{39.710, 44.467, 40.163, 45.145}},
+	"AM-AV":  {40.234, 43.956, BoundingBox{40.039, 43.660, 40.436, 44.310}},
+	"AM-ER":  {40.152, 44.525, BoundingBox{39.958, 44.272, 40.354, 44.865}},
+	"AM-GR":  {40.279, 45.356, BoundingBox{40.003, 44.947, 40.645, 45.890}},
+	"AM-KT":  {40.459, 44.751, BoundingBox{40.158, 44.426, 40.721, 45.063}},
+	"AM-LO":  {40.944, 44.465, BoundingBox{40.635, 44.080, 41.232, 44.815}},
+	"AM-SH":  {40.838, 43.907, BoundingBox{40.435, 43.580, 41.148, 44.213}},
+	"AM-SU":  {39.215, 46.154, BoundingBox{38.740, 45.722, 39.712, 46.510}},
+	"AM-TV":  {40.862, 45.076, BoundingBox{40.573, 44.748, 41.250, 45.560}},
+	"AM-VD":  {39.749, 45.449, BoundingBox{39.470, 45.031, 40.068, 45.902}},
+	"AO-BGO": {-8.273, 14.006, BoundingBox{-9.554, 13.174, -7.444, 14.792}},
+	"AO-BGU": {-12.957, 13.855, BoundingBox{-14.107, 12.443, -11.404, 14.971}},
+	"AO-BIE": {-12.380, 17.761, BoundingBox{-14.269, 16.412, -10.813, 19.422}},
+	"AO-CAB": {-5.073, 12.375, BoundingBox{-6.252, 11.910, -4.440, 13.000}},
+	"AO-CCU": {-15.795, 19.545, BoundingBox{-17.930, 16.504, -13.050, 23.220}},
+	"AO-CNN": {-16.433, 15.755, BoundingBox{-17.420, 13.605, -14.795, 17.750}},
+	"AO-CNO": {-9.050, 15.060, BoundingBox{-9.852, 13.988, -8.045, 16.092}},
+	"AO-CUS": {-10.711, 14.869, BoundingBox{-11.811, 13.401, -9.748, 16.416}},
+	"AO-HUA": {-12.712, 15.745, BoundingBox{-14.381, 14.655, -11.099, 16.618}},
+	"AO-HUI": {-14.800, 14.738, BoundingBox{-16.371, 13.265, -13.638, 16.515}},
+	"AO-LNO": {-8.333, 19.939, BoundingBox{-9.830, 17.790, -6.940, 21.950}},
+	"AO-LSU": {-10.461, 20.317, BoundingBox{-12.253, 18.457, -8.668, 22.181}},
+	"AO-LUA": {-9.205, 13.416, BoundingBox{-10.383, 12.880, -7.664, 14.008}},
+	"AO-MAL": {-9.579, 17.188, BoundingBox{-11.094, 15.717, -7.763, 18.690}},
+	"AO-MOX": {-12.573, 21.680, BoundingBox{-15.038, 19.363, -9.857, 24.080}},
+	"AO-NAM": {-15.696, 12.735, BoundingBox{-17.300, 11.640, -13.711, 13.884}},
+	"AO-UIG": {-7.159, 15.672, BoundingBox{-8.487, 14.212, -5.870, 17.245}},
+	"AO-ZAI": {-6.642, 13.572, BoundingBox{-7.682, 12.230, -5.860, 14.901}},
+	"AR-A":   {-24.313, -64.215, BoundingBox{-26.045, -68.415, -21.990, -61.287}},
+	"AR-B":   {-36.922, -60.860, BoundingBox{-41.170, -65.036, -33.051, -56.740}},
+	"AR-C":   {-34.612, -58.443, BoundingBox{-34.751, -58.600, -34.467, -58.213}},
+	"AR-D":   {-33.884, -65.659, BoundingBox{-35.552, -68.071, -31.715, -62.677}},
+	"AR-E":   {-32.122, -59.475, BoundingBox{-33.562, -61.479, -30.319, -57.745}},
+	"AR-G":   {-28.303, -63.260, BoundingBox{-29.958, -65.647, -25.940, -60.675}},
+	"AR-H":   {-26.659, -60.745, BoundingBox{-28.603, -63.112, -25.032, -58.142}},
+	"AR-J":   {-30.767, -68.347, BoundingBox{-32.768, -70.540, -28.480, -65.666}},
+	"AR-K":   {-27.580, -67.394, BoundingBox{-29.809, -69.668, -24.869, -65.534}},
+	"AR-L":   {-36.940, -65.420, BoundingBox{-39.176, -68.246, -35.150, -62.786}},
+	"AR-M":   {-34.828, -68.694, BoundingBox{-36.826, -70.699, -32.667, -66.620}},
+	"AR-N":   {-26.978, -54.769, BoundingBox{-28.353, -56.491, -25.550, -53.630}},
+	"AR-P":   {-25.025, -59.939, BoundingBox{-26.387, -62.294, -23.492, -57.630}},
+	"AR-Q":   {-38.737, -69.997, BoundingBox{-41.651, -71.920, -36.300, -67.598}},
+	"AR-R":   {-40.470, -67.084, BoundingBox{-42.608, -70.728, -38.041, -63.460}},
+	"AR-S":   {-30.449, -61.133, BoundingBox{-32.640, -63.444, -28.602, -58.803}},
+	"AR-T":   {-27.039, -65.164, BoundingBox{-29.287, -66.544, -25.784, -63.414}},
+	"AR-U":   {-43.823, -68.655, BoundingBox{-46.707, -72.150, -41.461, -63.602}},
+	"AR-V":   {-53.897, -67.730, BoundingBox{-55.250, -70.868, -51.559, -65.050}},
+	"AR-W":   {-28.708, -58.164, BoundingBox{-30.602, -60.612, -27.040, -55.711}},
+	"AR-X":   {-32.038, -63.811, BoundingBox{-34.710, -66.460, -29.801, -61.446}},
+	"AR-Y":   {-23.249, -66.075, BoundingBox{-24.820, -68.420, -21.830, -64.456}},
+	"AR-Z":   {-48.828, -69.991, BoundingBox{-52.066, -73.420, -45.862, -65.640}},
+	"AT-1":   {47.438, 16.220, BoundingBox{46.680, 15.652, 48.122, 16.980}},
+	"AT-2":   {46.823, 13.972, BoundingBox{46.430, 13.147, 47.380, 14.869}},
+	"AT-3":   {48.337, 15.722, BoundingBox{47.642, 14.607, 49.040, 16.979}},
+	"AT-4":   {48.165, 13.924, BoundingBox{47.454, 12.880, 48.880, 14.829}},
+	"AT-5":   {47.432, 13.130, BoundingBox{46.929, 12.146, 48.041, 14.073}},
+	"AT-6":   {47.219, 15.064, BoundingBox{46.538, 14.060, 47.897, 16.006}},
+	"AT-7":   {47.166, 11.583, BoundingBox{46.631, 10.124, 47.700, 13.196}},
+	"AT-8":   {47.242, 9.907, BoundingBox{46.909, 9.480, 47.580, 10.328}},
+	"AT-9":   {48.209, 16.348, BoundingBox{48.092, 16.128, 48.287, 16.502}},
+	"AU-ACT": {-35.440, 149.045, BoundingBox{-35.706, 148.720, -35.149, 149.375}},
+	"AU-NSW": {-32.033, 147.239, BoundingBox{-37.692, 140.526, -28.023, 153.560}},
+	"AU-NT":  {-19.415, 133.206, BoundingBox{-26.391, 128.211, -11.130, 138.000}},
+	"AU-QLD": {-22.517, 144.404, BoundingBox{-29.534, 136.622, -10.670, 153.570}},
+	"AU-SA":  {-30.106, 135.974, BoundingBox{-38.128, 129.000, -24.762, 141.947}},
+	"AU-TAS": {-42.023, 146.654, BoundingBox{-43.630, 144.720, -40.700, 148.360}},
+	"AU-VIC": {-36.777, 144.267, BoundingBox{-39.040, 141.000, -34.000, 149.553}},
+	"AU-WA":  {-25.445, 122.163, BoundingBox{-35.060, 113.340, -13.820, 129.000}},
+	"AZ-ABS": {40.397, 49.456, BoundingBox{40.048, 49.222, 40.718, 49.749}},
+	"AZ-AGA": {41.218, 45.469, BoundingBox{41.052, 45.137, 41.410, 45.878}},
+	"AZ-AGC": {40.043, 47.402, BoundingBox{39.826, 47.157, 40.285, 47.747}},
+	"AZ-AGM": {40.035, 46.959, BoundingBox{39.873, 46.703, 40.186, 47.193}},
+	"AZ-AGS": {40.689, 47.452, BoundingBox{40.455, 47.294, 40.862, 47.610}},
+	"AZ-AGU": {40.527, 48.405, BoundingBox{40.293, 48.117, 40.789, 48.636}},
+	"AZ-AST": {38.451, 48.672, BoundingBox{38.270, 48.374, 38.616, 48.880}},
+	"AZ-BA":  {40.354, 49.975, BoundingBox{40.187, 49.638, 40.549, 50.390}},
+	"AZ-BAB": {39.109, 45.460, BoundingBox{38.943, 45.215, 39.248, 45.728}},
+	"AZ-BAL": {41.697, 46.398, BoundingBox{41.454, 46.150, 41.860, 46.651}},
+	"AZ-BAR": {40.330, 47.225, BoundingBox{40.140, 47.028, 40.496, 47.434}},
+	"AZ-BEY": {39.714, 47.634, BoundingBox{39.421, 47.298, 39.985, 47.942}},
+	"AZ-BIL": {39.490, 48.486, BoundingBox{39.287, 48.095, 39.713, 48.854}},
+	"AZ-CAB": {39.347, 47.056, BoundingBox{39.102, 46.781, 39.564, 47.464}},
+	"AZ-CAL": {39.198, 48.473, BoundingBox{39.024, 48.226, 39.345, 48.800}},
+	"AZ-CUL": {39.000, 45.665, BoundingBox{38.808, 45.381, 39.223, 45.865}},
+	"AZ-DAS": {40.473, 46.073, BoundingBox{40.302, 45.871, 40.685, 46.259}},
+	"AZ-FUZ": {39.586, 47.255, BoundingBox{39.368, 46.908, 39.840, 47.548}},
+	"AZ-GA":  {40.673, 46.347, BoundingBox{40.605, 46.137, 40.771, 46.589}},
+	"AZ-GAD": {40.554, 45.703, BoundingBox{40.290, 45.360, 40.777, 45.977}},
+	"AZ-GOR": {40.660, 46.757, BoundingBox{40.526, 46.569, 40.857, 46.978}},
+	"AZ-GOY": {40.672, 47.797, BoundingBox{40.491, 47.610, 40.851, 48.026}},
+	"AZ-GYG": {40.445, 46.401, BoundingBox{40.232, 46.198, 40.622, 46.584}},
+	"AZ-HAC": {40.120, 49.089, BoundingBox{39.934, 48.676, 40.297, 49.541}},
+	"AZ-IMI": {39.839, 48.031, BoundingBox{39.546, 47.777, 40.120, 48.248}},
+	"AZ-ISM": {40.821, 48.202, BoundingBox{40.565, 47.902, 41.060, 48.532}},
+	"AZ-KAL": {40.023, 46.038, BoundingBox{39.675, 45.610, 40.318, 46.438}},
+	"AZ-KAN": {39.374, 45.156, BoundingBox{39.180, 44.929, 39.553, 45.360}},
+	"AZ-KUR": {40.326, 48.156, BoundingBox{40.103, 47.880, 40.565, 48.526}},
+	"AZ-LA":  {38.859, 48.885, BoundingBox{38.609, 48.694, 39.042, 49.172}},
+	"AZ-LAC": {39.651, 46.406, BoundingBox{39.481, 45.996, 39.849, 46.815}},
+	"AZ-LAN": {38.699, 48.658, BoundingBox{38.565, 48.488, 38.867, 48.837}},
+	"AZ-LER": {38.714, 48.396, BoundingBox{38.484, 48.137, 38.936, 48.613}},
+	"AZ-MAS": {39.060, 48.756, BoundingBox{38.860, 48.417, 39.298, 49.113}},
+	"AZ-MI":  {40.822, 47.037, BoundingBox{40.645, 46.725, 40.952, 47.336}},
+	"AZ-NA":  {40.460, 46.739, BoundingBox{40.300, 46.552, 40.577, 46.978}},
+	"AZ-NEF": {39.365, 49.197, BoundingBox{39.020, 48.858, 39.790, 49.485}},
+	"AZ-NV":  {39.231, 45.344, BoundingBox{39.096, 45.124, 39.369, 45.605}},
+	"AZ-NX":  {39.223, 45.444, BoundingBox{38.740, 44.790, 39.740, 46.140}},
+	"AZ-OGU": {41.031, 47.464, BoundingBox{40.858, 47.221, 41.218, 47.717}},
+	"AZ-ORD": {38.896, 45.930, BoundingBox{38.740, 45.784, 39.139, 46.140}},
+	"AZ-QAB": {41.022, 47.889, BoundingBox{40.794, 47.594, 41.296, 48.205}},
+	"AZ-QAX": {41.386, 46.860, BoundingBox{41.209, 46.538, 41.626, 47.174}},
+	"AZ-QAZ": {41.127, 45.218, BoundingBox{40.898, 44.970, 41.357, 45.450}},
+	"AZ-QBA": {41.201, 48.405, BoundingBox{40.968, 48.051, 41.457, 48.665}},
+	"AZ-QBI": {39.356, 46.641, BoundingBox{39.196, 46.424, 39.504, 46.829}},
+	"AZ-QOB": {40.483, 48.976, BoundingBox{40.284, 48.633, 40.742, 49.252}},
+	"AZ-QUS": {41.457, 48.264, BoundingBox{41.214, 47.915, 41.754, 48.548}},
+	"AZ-SA":  {41.215, 47.185, BoundingBox{41.009, 46.973, 41.396, 47.382}},
+	"AZ-SAB": {40.066, 48.536, BoundingBox{39.751, 48.231, 40.304, 48.728}},
+	"AZ-SAD": {39.678, 44.913, BoundingBox{39.594, 44.790, 39.740, 45.061}},
+	"AZ-SAH": {39.369, 45.592, BoundingBox{39.214, 45.360, 39.714, 46.008}},
+	"AZ-SAK": {41.074, 46.880, BoundingBox{40.908, 46.546, 41.262, 47.227}},
+	"AZ-SAL": {39.610, 48.975, BoundingBox{39.297, 48.618, 39.784, 49.468}},
+	"AZ-SAR": {39.549, 45.009, BoundingBox{39.389, 44.840, 39.685, 45.208}},
+	"AZ-SAT": {39.846, 48.362, BoundingBox{39.640, 48.166, 40.120, 48.651}},
+	"AZ-SBN": {41.167, 48.827, BoundingBox{40.943, 48.555, 41.372, 49.142}},
+	"AZ-SIY": {41.061, 49.113, BoundingBox{40.944, 48.844, 41.236, 49.352}},
+	"AZ-SKR": {40.898, 46.030, BoundingBox{40.667, 45.755, 41.132, 46.286}},
+	"AZ-SM":  {40.628, 49.566, BoundingBox{40.448, 49.335, 40.827, 49.859}},
+	"AZ-SMI": {40.699, 48.645, BoundingBox{40.327, 48.439, 40.972, 48.880}},
+	"AZ-SMX": {40.885, 46.464, BoundingBox{40.670, 46.202, 41.099, 46.725}},
+	"AZ-SR":  {39.872, 49.061, BoundingBox{39.755, 48.657, 40.011, 49.516}},
+	"AZ-SUS": {39.709, 46.734, BoundingBox{39.546, 46.530, 39.810, 46.852}},
+	"AZ-TAR": {40.287, 46.839, BoundingBox{40.167, 46.527, 40.449, 47.081}},
+	"AZ-TOV": {40.945, 45.650, BoundingBox{40.686, 45.375, 41.152, 45.928}},
+	"AZ-UCA": {40.456, 47.646, BoundingBox{40.329, 47.384, 40.605, 47.934}},
+	"AZ-XA":  {39.845, 46.762, BoundingBox{39.781, 46.553, 39.928, 46.911}},
+	"AZ-XAC": {41.516, 48.704, BoundingBox{41.295, 48.497, 41.810, 49.018}},
+	"AZ-XCI": {40.014, 46.538, BoundingBox{39.803, 46.275, 40.258, 46.791}},
+	"AZ-XIZ": {40.846, 49.075, BoundingBox{40.659, 48.664, 41.003, 49.435}},
+	"AZ-XVD": {39.758, 47.007, BoundingBox{39.554, 46.833, 39.906, 47.261}},
+	"AZ-YAR": {38.898, 48.233, BoundingBox{38.683, 48.010, 39.098, 48.466}},
+	"AZ-YE":  {40.616, 47.180, BoundingBox{40.490, 46.972, 40.771, 47.337}},
+	"AZ-YEV": {40.477, 47.044, BoundingBox{40.373, 46.921, 40.577, 47.212}},
+	"AZ-ZAN": {39.056, 46.702, BoundingBox{38.770, 46.491, 39.248, 47.039}},
+	"AZ-ZAQ": {41.568, 46.640, BoundingBox{41.293, 46.391, 41.834, 46.917}},
+	"AZ-ZAR": {40.205, 47.738, BoundingBox{39.982, 47.413, 40.385, 48.013}},
+	"BA-01":  {44.771, 16.139, BoundingBox{44.270, 15.750, 45.230, 16.492}},
+	"BA-02":  {44.962, 18.355, BoundingBox{44.761, 17.922, 45.080, 18.683}},
+	"BA-03":  {44.491, 18.602, BoundingBox{44.161, 18.282, 44.772, 18.958}},
+	"BA-04":  {44.303, 18.057, BoundingBox{44.007, 17.574, 44.529, 18.490}},
+	"BA-05":  {43.671, 18.987, BoundingBox{43.463, 18.725, 43.969, 19.283}},
+	"BA-06":  {44.096, 17.605, BoundingBox{43.761, 17.198, 44.529, 18.005}},
+	"BA-07":  {43.430, 17.866, BoundingBox{42.896, 17.650, 43.785, 18.269}},
+	"BA-08":  {43.467, 17.481, BoundingBox{42.880, 17.105, 43.779, 17.650}},
+	"BA-09":  {43.893, 18.272, BoundingBox{43.529, 17.822, 44.202, 18.725}},
+	"BA-10":  {43.916, 16.984, BoundingBox{43.563, 16.361, 44.179, 17.522}},
+	"BA-BIH": {44.099, 17.655, BoundingBox{42.880, 15.750, 45.230, 19.283}},
+	"BA-BRC": {44.828, 18.760, BoundingBox{44.664, 18.468, 45.016, 19.029}},
+	"BA-SRP": {44.226, 16.803, BoundingBox{42.650, 16.297, 45.230, 19.600}},
+	"BB-01":  {13.078, -59.531, BoundingBox{13.054, -59.587, 13.113, -59.480}},
+	"BB-02":  {13.248, -59.556, BoundingBox{13.202, -59.606, 13.286, -59.497}},
+	"BB-03":  {13.135, -59.544, BoundingBox{13.100, -59.587, 13.172, -59.506}},
+	"BB-04":  {13.190, -59.638, BoundingBox{13.138, -59.669, 13.234, -59.597}},
+	"BB-05":  {13.185, -59.473, BoundingBox{13.128, -59.525, 13.247, -59.431}},
+	"BB-06":  {13.221, -59.513, BoundingBox{13.165, -59.560, 13.282, -59.461}},
+	"BB-07":  {13.280, -59.581, BoundingBox{13.271, -59.607, 13.286, -59.551}},
+	"BB-08":  {13.109, -59.611, BoundingBox{13.054, -59.669, 13.153, -59.559}},
+	"BB-09":  {13.251, -59.612, BoundingBox{13.227, -59.652, 13.278, -59.578}},
+	"BB-10":  {13.122, -59.467, BoundingBox{13.075, -59.509, 13.170, -59.431}},
+	"BB-11":  {13.176, -59.584, BoundingBox{13.144, -59.620, 13.209, -59.548}},
+	"BD-01":  {21.874, 92.352, BoundingBox{21.320, 91.872, 22.300, 92.670}},
+	"BD-02":  {22.102, 90.062, BoundingBox{21.840, 89.625, 22.388, 90.353}},
+	"BD-03":  {24.834, 89.401, BoundingBox{24.548, 89.135, 25.125, 89.685}},
+	"BD-04":  {23.965, 91.144, BoundingBox{23.685, 90.911, 24.252, 91.457}},
+	"BD-05":  {22.315, 89.712, BoundingBox{21.889, 89.575, 22.732, 89.979}},
+	"BD-06":  {22.752, 90.388, BoundingBox{22.477, 90.129, 22.960, 90.612}},
+	"BD-07":  {22.411, 90.546, BoundingBox{22.305, 90.477, 22.551, 90.749}},
+	"BD-08":  {23.432, 91.100, BoundingBox{23.139, 90.840, 23.729, 91.428}},
+	"BD-09":  {23.262, 90.747, BoundingBox{22.993, 90.545, 23.523, 91.017}},
+	"BD-10":  {22.496, 91.898, BoundingBox{22.080, 91.523, 22.867, 92.280}},
+	"BD-11":  {21.282, 92.197, BoundingBox{20.670, 91.952, 21.772, 92.404}},
+	"BD-12":  {23.524, 88.818, BoundingBox{23.214, 88.585, 23.813, 89.049}},
+	"BD-13":  {23.794, 90.299, BoundingBox{23.574, 90.116, 23.991, 90.539}},
+	"BD-14":  {25.610, 88.706, BoundingBox{25.331, 88.348, 25.880, 88.984}},
+	"BD-15":  {23.500, 89.861, BoundingBox{23.275, 89.602, 23.732, 90.130}},
+	"BD-16":  {22.966, 91.435, BoundingBox{22.622, 91.125, 23.251, 91.726}},
+	"BD-17":  {22.962, 89.836, BoundingBox{22.724, 89.517, 23.282, 90.134}},
+	"BD-18":  {24.178, 90.413, BoundingBox{23.922, 90.138, 24.428, 90.693}},
+	"BD-19":  {25.325, 89.575, BoundingBox{25.034, 89.208, 25.583, 89.906}},
+	"BD-20":  {24.397, 91.402, BoundingBox{24.046, 91.142, 24.727, 91.629}},
+	"BD-21":  {24.855, 89.896, BoundingBox{24.585, 89.639, 25.167, 90.199}},
+	"BD-22":  {23.059, 89.094, BoundingBox{22.747, 88.724, 23.354, 89.375}},
+	"BD-23":  {23.549, 89.159, BoundingBox{23.333, 88.922, 23.791, 89.379}},
+	"BD-24":  {25.176, 89.058, BoundingBox{24.894, 88.710, 25.447, 89.349}},
+	"BD-25":  {22.615, 90.179, BoundingBox{22.382, 90.005, 22.890, 90.391}},
+	"BD-26":  {24.443, 90.894, BoundingBox{24.167, 90.527, 24.727, 91.189}},
+	"BD-27":  {22.396, 89.412, BoundingBox{21.909, 89.176, 22.792, 89.575}},
+	"BD-28":  {25.760, 89.668, BoundingBox{25.513, 89.427, 25.995, 89.889}},
+	"BD-29":  {23.220, 92.000, BoundingBox{22.845, 91.676, 23.630, 92.327}},
+	"BD-30":  {23.956, 88.993, BoundingBox{23.710, 88.720, 24.160, 89.247}},
+	"BD-31":  {22.976, 90.838, BoundingBox{22.792, 90.527, 23.199, 91.125}},
+	"BD-32":  {25.966, 89.271, BoundingBox{25.829, 89.071, 26.169, 89.539}},
+	"BD-33":  {23.868, 89.960, BoundingBox{23.599, 89.703, 24.050, 90.175}},
+	"BD-34":  {24.696, 90.375, BoundingBox{24.422, 90.063, 25.076, 90.665}},
+	"BD-35":  {23.491, 90.406, BoundingBox{23.342, 90.120, 23.647, 90.745}},
+	"BD-36":  {23.174, 90.151, BoundingBox{22.897, 89.911, 23.466, 90.370}},
+	"BD-37":  {23.445, 89.458, BoundingBox{23.265, 89.248, 23.661, 89.676}},
+	"BD-38":  {24.427, 91.826, BoundingBox{24.091, 91.589, 24.737, 92.177}},
+	"BD-39":  {23.810, 88.675, BoundingBox{23.512, 88.530, 24.096, 88.836}},
+	"BD-40":  {23.676, 90.646, BoundingBox{23.497, 90.389, 23.900, 90.903}},
+	"BD-41":  {24.914, 90.771, BoundingBox{24.610, 90.460, 25.184, 91.080}},
+	"BD-42":  {23.956, 90.770, BoundingBox{23.655, 90.529, 24.225, 90.944}},
+	"BD-43":  {23.090, 89.545, BoundingBox{22.768, 89.375, 23.349, 89.819}},
+	"BD-44":  {24.407, 89.040, BoundingBox{24.160, 88.788, 24.671, 89.305}},
+	"BD-45":  {24.685, 88.357, BoundingBox{24.411, 88.080, 24.992, 88.592}},
+	"BD-46":  {25.974, 88.883, BoundingBox{25.675, 88.625, 26.267, 89.115}},
+	"BD-47":  {22.884, 91.117, BoundingBox{22.775, 90.916, 23.085, 91.310}},
+	"BD-48":  {24.840, 88.825, BoundingBox{24.603, 88.515, 25.109, 89.135}},
+	"BD-49":  {24.036, 89.396, BoundingBox{23.758, 89.138, 24.286, 89.716}},
+	"BD-50":  {22.583, 89.922, BoundingBox{22.330, 89.628, 22.795, 90.141}},
+	"BD-51":  {22.282, 90.372, BoundingBox{21.982, 90.183, 22.485, 90.545}},
+	"BD-52":  {26.237, 88.627, BoundingBox{26.041, 88.404, 26.450, 88.893}},
+	"BD-53":  {23.768, 89.584, BoundingBox{23.568, 89.325, 24.012, 89.798}},
+	"BD-54":  {24.430, 88.615, BoundingBox{24.092, 88.284, 24.714, 88.815}},
+	"BD-55":  {25.635, 89.211, BoundingBox{25.384, 88.948, 25.872, 89.500}},
+	"BD-56":  {22.648, 92.294, BoundingBox{22.273, 91.988, 23.089, 92.585}},
+	"BD-57":  {25.116, 90.147, BoundingBox{24.888, 89.801, 25.378, 90.503}},
+	"BD-58":  {22.391, 89.109, BoundingBox{21.992, 88.899, 22.776, 89.326}},
+	"BD-59":  {24.434, 89.584, BoundingBox{24.127, 89.295, 24.711, 89.908}},
+	"BD-60":  {24.886, 91.952, BoundingBox{24.606, 91.603, 25.150, 92.380}},
+	"BD-61":  {24.927, 91.323, BoundingBox{24.662, 90.975, 25.147, 91.641}},
+	"BD-62":  {23.175, 90.425, BoundingBox{22.948, 90.243, 23.387, 90.559}},
+	"BD-63":  {24.292, 89.984, BoundingBox{24.050, 89.674, 24.585, 90.279}},
+	"BD-64":  {25.907, 88.430, BoundingBox{25.669, 88.210, 26.147, 88.625}},
+	"BD-A":   {22.432, 90.180, BoundingBox{21.840, 89.625, 22.960, 90.749}},
+	"BD-B":   {22.708, 91.757, BoundingBox{20.670, 90.527, 24.252, 92.670}},
+	"BD-C":   {24.104, 90.280, BoundingBox{22.724, 89.325, 25.378, 91.189}},
+	"BD-D":   {23.032, 89.221, BoundingBox{21.889, 88.530, 24.160, 89.979}},
+	"BD-E":   {24.613, 89.074, BoundingBox{23.758, 88.080, 25.447, 89.908}},
+	"BD-F":   {25.729, 89.109, BoundingBox{25.034, 88.210, 26.450, 89.906}},
+	"BD-G":   {24.669, 91.634, BoundingBox{24.046, 90.975, 25.150, 92.380}},
+	"BD-H":   {24.873, 90.326, BoundingBox{24.422, 89.639, 25.378, 91.080}},
+	"BE-BRU": {50.845, 4.366, BoundingBox{50.780, 4.270, 50.902, 4.478}},
+	"BE-VAN": {51.244, 4.697, BoundingBox{51.040, 4.089, 51.480, 5.171}},
+	"BE-VBR": {50.874, 4.727, BoundingBox{50.538, 3.782, 51.230, 5.064}},
+	"BE-VLG": {51.012, 4.159, BoundingBox{50.538, 2.510, 51.480, 5.731}},
+	"BE-VLI": {50.950, 5.307, BoundingBox{50.619, 4.966, 51.342, 5.731}},
+	"BE-VOV": {51.034, 3.720, BoundingBox{50.694, 3.425, 51.338, 4.120}},
+	"BE-VWV": {51.015, 3.053, BoundingBox{50.620, 2.510, 51.350, 3.425}},
+	"BE-WAL": {50.311, 4.927, BoundingBox{49.530, 3.308, 50.843, 6.146}},
+	"BE-WBR": {50.586, 4.664, BoundingBox{50.352, 4.234, 50.762, 5.163}},
+	"BE-WHT": {50.421, 3.908, BoundingBox{49.982, 3.308, 50.720, 4.376}},
+	"BE-WLG": {50.479, 5.706, BoundingBox{50.129, 5.154, 50.843, 6.146}},
+	"BE-WLX": {49.950, 5.452, BoundingBox{49.530, 4.929, 50.276, 6.034}},
+	"BE-WNA": {50.221, 4.786, BoundingBox{49.910, 4.183, 50.588, 5.368}},
+	"BF-01":  {12.504, -3.574, BoundingBox{11.404, -4.850, 13.629, -2.548}},
+	"BF-02":  {10.378, -4.574, BoundingBox{9.610, -5.470, 11.084, -3.750}},
+	"BF-03":  {12.295, -1.552, BoundingBox{12.021, -1.844, 12.540, -1.177}},
+	"BF-04":  {11.702, -0.189, BoundingBox{11.005, -0.765, 12.640, 0.573}},
+	"BF-05":  {13.305, -0.974, BoundingBox{12.610, -1.981, 14.016, -0.046}},
+	"BF-06":  {11.772, -2.199, BoundingBox{10.972, -3.052, 12.601, -1.463}},
+	"BF-07":  {11.541, -1.169, BoundingBox{10.940, -1.765, 12.231, -0.618}},
+	"BF-08":  {12.186, 0.915, BoundingBox{11.000, -0.326, 13.455, 2.180}},
+	"BF-09":  {11.392, -4.283, BoundingBox{10.711, -5.386, 12.098, -3.188}},
+	"BF-10":  {13.383, -2.364, BoundingBox{12.535, -3.055, 14.374, -1.788}},
+	"BF-11":  {12.521, -1.153, BoundingBox{11.793, -1.976, 13.032, -0.524}},
+	"BF-12":  {14.169, -0.543, BoundingBox{13.027, -2.114, 15.120, 1.005}},
+	"BF-13":  {10.602, -3.258, BoundingBox{9.640, -3.878, 11.435, -2.516}},
+	"BF-BAL": {11.788, -3.017, BoundingBox{11.404, -3.480, 12.250, -2.548}},
+	"BF-BAM": {13.379, -1.569, BoundingBox{12.976, -1.981, 13.769, -1.203}},
+	"BF-BAN": {12.186, -4.238, BoundingBox{11.747, -4.850, 12.743, -3.701}},
+	"BF-BAZ": {11.900, -1.392, BoundingBox{11.471, -1.765, 12.231, -1.029}},
+	"BF-BGR": {10.806, -3.457, BoundingBox{10.488, -3.878, 11.203, -2.950}},
+	"BF-BLG": {11.402, -0.411, BoundingBox{11.005, -0.765, 11.790, -0.045}},
+	"BF-BLK": {12.240, -2.090, BoundingBox{11.844, -2.420, 12.601, -1.751}},
+	"BF-COM": {10.223, -4.294, BoundingBox{9.610, -4.904, 10.786, -3.750}},
+	"BF-GAN": {12.245, -0.803, BoundingBox{11.793, -1.178, 12.685, -0.524}},
+	"BF-GNA": {12.889, 0.079, BoundingBox{12.400, -0.326, 13.455, 0.479}},
+	"BF-GOU": {12.027, 0.481, BoundingBox{11.608, -0.014, 12.460, 0.950}},
+	"BF-HOU": {11.192, -4.232, BoundingBox{10.711, -4.691, 11.763, -3.741}},
+	"BF-IOB": {11.151, -2.961, BoundingBox{10.682, -3.387, 11.435, -2.516}},
+	"BF-KAD": {12.295, -1.552, BoundingBox{12.021, -1.844, 12.540, -1.177}},
+	"BF-KEN": {11.479, -4.893, BoundingBox{10.952, -5.386, 12.098, -4.479}},
+	"BF-KMD": {12.653, 0.816, BoundingBox{12.186, 0.281, 13.098, 1.479}},
+	"BF-KMP": {11.314, 0.821, BoundingBox{11.000, 0.234, 11.743, 1.357}},
+	"BF-KOP": {11.457, 0.145, BoundingBox{11.015, -0.185, 11.888, 0.573}},
+	"BF-KOS": {13.006, -3.946, BoundingBox{12.538, -4.386, 13.470, -3.536}},
+	"BF-KOT": {12.180, -0.285, BoundingBox{11.763, -0.620, 12.640, 0.093}},
+	"BF-KOW": {12.740, -1.663, BoundingBox{12.443, -1.976, 13.032, -1.372}},
+	"BF-LER": {10.646, -5.057, BoundingBox{10.060, -5.470, 11.084, -4.591}},
+	"BF-LOR": {13.912, -2.306, BoundingBox{13.626, -2.972, 14.374, -1.873}},
+	"BF-MOU": {12.401, -3.501, BoundingBox{11.993, -3.870, 12.856, -3.103}},
+	"BF-NAM": {13.108, -0.473, BoundingBox{12.610, -0.889, 13.659, -0.046}},
+	"BF-NAO": {11.191, -1.122, BoundingBox{10.940, -1.674, 11.425, -0.630}},
+	"BF-NAY": {12.645, -2.927, BoundingBox{12.250, -3.301, 13.031, -2.548}},
+	"BF-NOU": {9.886, -3.081, BoundingBox{9.640, -3.502, 10.122, -2.830}},
+	"BF-OUB": {12.659, -1.143, BoundingBox{12.237, -1.438, 13.004, -0.760}},
+	"BF-OUD": {14.609, -0.435, BoundingBox{14.006, -1.154, 15.120, 0.370}},
+	"BF-PAS": {12.871, -2.165, BoundingBox{12.535, -2.592, 13.319, -1.788}},
+	"BF-PON": {10.258, -3.359, BoundingBox{9.880, -3.750, 10.627, -2.912}},
+	"BF-SEN": {13.928, -0.114, BoundingBox{13.442, -0.840, 14.440, 0.469}},
+	"BF-SIS": {11.324, -2.271, BoundingBox{10.972, -2.702, 11.834, -1.698}},
+	"BF-SMT": {13.441, -0.952, BoundingBox{12.869, -1.284, 14.016, -0.557}},
+	"BF-SNG": {12.154, -2.603, BoundingBox{11.700, -3.052, 12.600, -2.275}},
+	"BF-SOM": {14.254, -1.482, BoundingBox{13.684, -2.114, 14.933, -0.858}},
+	"BF-SOR": {13.150, -3.215, BoundingBox{12.722, -3.612, 13.629, -2.816}},
+	"BF-TAP": {12.109, 1.573, BoundingBox{11.355, 0.950, 12.763, 2.180}},
+	"BF-TUI": {11.557, -3.639, BoundingBox{11.047, -4.108, 12.014, -3.188}},
+	"BF-YAG": {13.417, 0.539, BoundingBox{13.027, 0.030, 13.944, 1.005}},
+	"BF-YAT": {13.542, -2.481, BoundingBox{13.303, -3.055, 13.795, -1.909}},
+	"BF-ZIR": {11.560, -1.819, BoundingBox{10.996, -2.284, 11.994, -1.463}},
+	"BF-ZON": {13.150, -2.535, BoundingBox{12.770, -2.894, 13.406, -2.033}},
+	"BF-ZOU": {11.645, -1.008, BoundingBox{11.425, -1.466, 11.970, -0.618}},
+	"BG-01":  {41.727, 23.411, BoundingBox{41.310, 22.885, 42.253, 24.012}},
+	"BG-02":  {42.466, 27.344, BoundingBox{42.010, 26.782, 42.901, 28.000}},
+	"BG-03":  {43.131, 27.622, BoundingBox{42.737, 27.279, 43.504, 28.069}},
+	"BG-04":  {43.209, 25.657, BoundingBox{42.770, 25.135, 43.594, 26.132}},
+	"BG-05":  {43.836, 22.720, BoundingBox{43.493, 22.410, 44.230, 23.335}},
+	"BG-06":  {43.411, 23.777, BoundingBox{43.016, 23.369, 43.865, 24.150}},
+	"BG-07":  {42.865, 25.242, BoundingBox{42.544, 24.869, 43.297, 25.745}},
+	"BG-08":  {43.623, 27.888, BoundingBox{43.314, 27.328, 43.976, 28.560}},
+	"BG-09":  {41.564, 25.444, BoundingBox{41.230, 25.075, 42.019, 26.085}},
+	"BG-10":  {42.283, 22.823, BoundingBox{41.952, 22.380, 42.616, 23.309}},
+	"BG-11":  {42.937, 24.603, BoundingBox{42.587, 24.088, 43.258, 25.079}},
+	"BG-12":  {43.456, 23.152, BoundingBox{43.051, 22.667, 43.899, 23.499}},
+	"BG-13":  {42.115, 24.112, BoundingBox{41.652, 23.539, 42.562, 24.566}},
+	"BG-14":  {42.717, 22.869, BoundingBox{42.321, 22.458, 43.080, 23.224}},
+	"BG-15":  {43.464, 24.648, BoundingBox{43.133, 24.150, 43.738, 25.266}},
+	"BG-16":  {42.262, 24.860, BoundingBox{41.888, 24.404, 42.635, 25.338}},
+	"BG-17":  {43.620, 26.504, BoundingBox{43.359, 26.046, 44.024, 26.954}},
+	"BG-18":  {43.684, 25.910, BoundingBox{43.384, 25.214, 44.023, 26.477}},
+	"BG-19":  {43.880, 27.103, BoundingBox{43.552, 26.477, 44.180, 27.643}},
+	"BG-20":  {42.735, 26.288, BoundingBox{42.363, 25.776, 43.047, 26.848}},
+	"BG-21":  {41.660, 24.619, BoundingBox{41.292, 23.941, 41.951, 25.075}},
+	"BG-22":  {42.719, 23.344, BoundingBox{42.250, 23.041, 43.091, 23.626}},
+	"BG-23":  {42.669, 23.894, BoundingBox{42.235, 23.478, 43.049, 24.422}},
+	"BG-24":  {42.388, 25.615, BoundingBox{42.015, 25.144, 42.771, 26.099}},
+	"BG-25":  {43.181, 26.467, BoundingBox{42.889, 26.067, 43.422, 26.775}},
+	"BG-26":  {41.872, 25.927, BoundingBox{41.327, 25.376, 42.224, 26.468}},
+	"BG-27":  {43.218, 27.052, BoundingBox{42.839, 26.775, 43.583, 27.365}},
+	"BG-28":  {42.278, 26.574, BoundingBox{41.936, 26.086, 42.679, 27.045}},
+	"BH-13":  {26.216, 50.570, BoundingBox{26.123, 50.467, 26.327, 50.650}},
+	"BH-14":  {25.893, 50.537, BoundingBox{25.780, 50.399, 26.000, 50.650}},
+	"BH-15":  {26.274, 50.599, BoundingBox{26.200, 50.497, 26.323, 50.650}},
+	"BH-16":  {26.057, 50.523, BoundingBox{25.977, 50.380, 26.150, 50.650}},
+	"BH-17":  {26.193, 50.466, BoundingBox{26.083, 50.388, 26.330, 50.555}},
+	"BI-BB":  {-3.081, 29.377, BoundingBox{-3.242, 29.162, -2.888, 29.569}},
+	"BI-BL":  {-3.554, 29.441, BoundingBox{-3.778, 29.293, -3.297, 29.592}},
+	"BI-BM":  {-3.341, 29.359, BoundingBox{-3.558, 29.234, -3.211, 29.497}},
+	"BI-BR":  {-3.968, 29.530, BoundingBox{-4.387, 29.304, -3.702, 29.836}},
+	"BI-CA":  {-3.131, 30.554, BoundingBox{-3.467, 30.330, -2.741, 30.750}},
+	"BI-CI":  {-2.938, 29.190, BoundingBox{-3.085, 29.020, -2.840, 29.388}},
+	"BI-GI":  {-3.460, 29.981, BoundingBox{-3.704, 29.800, -3.193, 30.150}},
+	"BI-KI":  {-2.586, 30.186, BoundingBox{-2.826, 29.803, -2.350, 30.520}},
+	"BI-KR":  {-3.056, 30.181, BoundingBox{-3.332, 29.895, -2.797, 30.439}},
+	"BI-KY":  {-2.982, 29.650, BoundingBox{-3.100, 29.464, -2.771, 29.794}},
+	"BI-MA":  {-4.255, 29.700, BoundingBox{-4.500, 29.334, -3.937, 30.054}},
+	"BI-MU":  {-3.233, 29.684, BoundingBox{-3.392, 29.472, -3.100, 29.905}},
+	"BI-MW":  {-3.564, 29.705, BoundingBox{-3.752, 29.529, -3.350, 29.871}},
+	"BI-NG":  {-2.874, 29.886, BoundingBox{-3.157, 29.711, -2.602, 30.117}},
+	"BI-RT":  {-3.861, 30.060, BoundingBox{-4.154, 29.815, -3.642, 30.354}},
+	"BI-RY":  {-3.492, 30.340, BoundingBox{-3.778, 30.150, -3.229, 30.628}},
+	"BJ-AK":  {10.730, 1.591, BoundingBox{10.097, 0.770, 11.597, 2.462}},
+	"BJ-AL":  {11.356, 2.810, BoundingBox{10.582, 1.705, 12.240, 3.800}},
+	"BJ-AQ":  {6.688, 2.159, BoundingBox{6.301, 1.924, 6.975, 2.381}},
+	"BJ-BO":  {9.912, 2.888, BoundingBox{8.911, 2.207, 10.662, 3.766}},
+	"BJ-CO":  {8.368, 2.224, BoundingBox{7.689, 1.638, 9.072, 2.841}},
+	"BJ-DO":  {9.591, 1.849, BoundingBox{8.815, 1.163, 10.210, 2.313}},
+	"BJ-KO":  {7.063, 1.807, BoundingBox{6.775, 1.620, 7.540, 2.129}},
+	"BJ-LI":  {6.339, 2.361, BoundingBox{6.162, 2.021, 6.564, 2.694}},
+	"BJ-MO":  {6.483, 1.873, BoundingBox{6.140, 1.640, 6.775, 2.063}},
+	"BJ-OU":  {6.653, 2.543, BoundingBox{6.370, 2.342, 6.869, 2.711}},
+	"BJ-PL":  {7.213, 2.562, BoundingBox{6.837, 2.272, 7.728, 2.745}},
+	"BJ-ZO":  {7.466, 2.094, BoundingBox{6.975, 1.632, 7.845, 2.598}},
+	"BN-BE":  {4.346, 114.519, BoundingBox{4.010, 114.200, 4.656, 114.815}},
+	"BN-BM":  {5.030, 115.092, BoundingBox{4.656, 114.662, 5.450, 115.450}},
+	"BN-TE":  {4.615, 115.175, BoundingBox{4.320, 114.900, 5.068, 115.419}},
+	"BN-TU":  {4.611, 114.673, BoundingBox{4.260, 114.336, 4.940, 114.900}},
+	"BO-B":   {-13.568, -65.241, BoundingBox{-15.750, -67.136, -9.760, -62.619}},
+	"BO-C":   {-17.270, -65.259, BoundingBox{-19.144, -66.900, -15.750, -63.500}},
+	"BO-H":   {-19.647, -64.055, BoundingBox{-20.979, -65.790, -18.131, -62.306}},
+	"BO-L":   {-15.404, -68.056, BoundingBox{-18.473, -69.590, -11.993, -66.457}},
+	"BO-N":   {-11.364, -67.358, BoundingBox{-12.884, -69.530, -9.805, -65.689}},
+	"BO-O":   {-18.805, -67.651, BoundingBox{-20.236, -69.061, -17.569, -66.158}},
+	"BO-P":   {-20.878, -66.953, BoundingBox{-22.870, -68.760, -18.997, -65.391}},
+	"BO-S":   {-16.860, -61.129, BoundingBox{-20.488, -64.215, -13.054, -57.500}},
+	"BO-T":   {-21.423, -63.874, BoundingBox{-22.800, -65.561, -20.410, -62.258}},
+	"BQ-BO":  {12.180, -68.270, BoundingBox{11.997, -68.457, 12.363, -68.083}},
+	"BQ-SA":  {17.630, -63.240, BoundingBox{17.597, -63.275, 17.663, -63.205}},
+	"BQ-SE":  {17.490, -63.016, BoundingBox{17.445, -63.017, 17.535, -62.923}},
+	"BR-AC":  {-9.070, -70.085, BoundingBox{-11.120, -73.990, -7.098, -66.386}},
+	"BR-AL":  {-9.437, -36.562, BoundingBox{-10.485, -37.762, -8.344, -35.449}},
+	"BR-AM":  {-4.329, -64.680, BoundingBox{-9.907, -73.720, 2.040, -56.935}},
+	"BR-AP":  {1.143, -52.105, BoundingBox{-1.391, -55.233, 4.200, -49.950}},
+	"BR-BA":  {-12.551, -42.511, BoundingBox{-15.800, -46.754, -9.644, -38.746}},
+	"BR-CE":  {-4.774, -40.118, BoundingBox{-6.961, -42.384, -2.681, -37.790}},
+	"BR-DF":  {-15.812, -47.800, BoundingBox{-16.050, -48.200, -15.575, -47.400}},
+	"BR-ES":  {-18.647, -40.789, BoundingBox{-21.509, -42.778, -15.698, -38.885}},
+	"BR-FN":  {-3.850, -32.420, BoundingBox{-3.895, -32.466, -3.805, -32.375}},
+	"BR-GO":  {-15.143, -49.256, BoundingBox{-18.550, -53.615, -11.921, -44.767}},
+	"BR-MA":  {-4.460, -44.983, BoundingBox{-7.517, -48.046, -1.547, -42.311}},
+	"BR-MG":  {-18.709, -45.473, BoundingBox{-23.983, -51.924, -13.796, -39.525}},
+	"BR-MS":  {-20.232, -54.706, BoundingBox{-23.996, -58.170, -16.714, -51.467}},
+	"BR-MT":  {-13.144, -56.262, BoundingBox{-18.752, -60.720, -8.551, -52.026}},
+	"BR-PA":  {-3.966, -53.300, BoundingBox{-9.896, -58.941, 2.510, -44.917}},
+	"BR-PB":  {-7.093, -36.778, BoundingBox{-8.344, -38.524, -6.336, -34.988}},
+	"BR-PE":  {-8.553, -38.882, BoundingBox{-10.896, -41.875, -6.550, -34.730}},
+	"BR-PI":  {-8.056, -43.334, BoundingBox{-10.783, -46.357, -4.655, -40.703}},
+	"BR-PR":  {-24.290, -52.002, BoundingBox{-26.796, -55.063, -21.421, -48.683}},
+	"BR-RJ":  {-21.828, -42.523, BoundingBox{-23.251, -44.239, -20.079, -40.870}},
+	"BR-RN":  {-5.714, -36.694, BoundingBox{-6.570, -38.234, -4.321, -34.950}},
+	"BR-RO":  {-10.660, -62.836, BoundingBox{-13.666, -66.782, -7.681, -59.450}},
+	"BR-RR":  {1.635, -61.758, BoundingBox{-0.992, -65.455, 5.240, -57.774}},
+	"BR-RS":  {-29.935, -53.555, BoundingBox{-33.770, -57.630, -26.744, -50.176}},
+	"BR-SC":  {-27.359, -50.409, BoundingBox{-30.149, -53.480, -25.063, -48.018}},
+	"BR-SE":  {-11.110, -38.309, BoundingBox{-13.259, -39.858, -9.528, -36.487}},
+	"BR-SP":  {-22.602, -48.560, BoundingBox{-25.318, -51.656, -20.800, -46.056}},
+	"BR-TO":  {-9.037, -48.599, BoundingBox{-12.181, -52.169, -4.234, -45.636}},
+	"BS-AK":  {22.435, -74.064, BoundingBox{22.251, -74.370, 22.670, -73.830}},
+	"BS-BI":  {25.730, -79.270, BoundingBox{25.685, -79.320, 25.775, -79.220}},
+	"BS-BP":  {24.100, -76.400, BoundingBox{24.055, -76.449, 24.145, -76.351}},
+	"BS-BY":  {25.598, -77.828, BoundingBox{25.163, -77.880, 25.645, -77.322}},
+	"BS-CE":  {25.252, -76.407, BoundingBox{25.155, -76.568, 25.392, -76.200}},
+	"BS-CI":  {24.400, -75.500, BoundingBox{24.234, -75.682, 24.566, -75.318}},
+	"BS-CK":  {22.630, -74.172, BoundingBox{22.446, -74.370, 22.749, -73.902}},
+	"BS-CO":  {26.434, -77.187, BoundingBox{26.187, -77.359, 26.682, -77.022}},
+	"BS-CS":  {24.681, -77.862, BoundingBox{24.363, -78.399, 25.164, -77.242}},
+	"BS-EG":  {26.697, -78.176, BoundingBox{26.492, -78.529, 26.870, -77.820}},
+	"BS-EX":  {23.500, -75.800, BoundingBox{23.334, -75.981, 23.666, -75.619}},
+	"BS-FP":  {26.619, -78.644, BoundingBox{26.420, -78.913, 26.867, -78.422}},
+	"BS-GC":  {27.220, -78.320, BoundingBox{27.175, -78.371, 27.265, -78.269}},
+	"BS-HI":  {25.417, -76.543, BoundingBox{25.298, -76.690, 25.545, -76.406}},
+	"BS-HT":  {26.575, -77.019, BoundingBox{26.497, -77.170, 26.686, -76.900}},
+	"BS-IN":  {21.080, -73.350, BoundingBox{20.831, -73.617, 21.329, -73.083}},
+	"BS-LI":  {23.200, -75.100, BoundingBox{22.992, -75.326, 23.408, -74.874}},
+	"BS-MC":  {24.278, -77.791, BoundingBox{24.004, -78.126, 24.507, -77.538}},
+	"BS-MG":  {22.380, -73.000, BoundingBox{22.255, -73.135, 22.505, -72.865}},
+	"BS-MI":  {26.295, -77.550, BoundingBox{26.200, -77.600, 26.423, -77.276}},
+	"BS-NE":  {25.323, -76.637, BoundingBox{25.065, -77.322, 25.495, -76.493}},
+	"BS-NO":  {26.776, -77.487, BoundingBox{26.547, -77.790, 27.040, -77.168}},
+	"BS-NS":  {24.938, -78.078, BoundingBox{24.572, -78.410, 25.210, -77.337}},
+	"BS-RC":  {23.680, -74.850, BoundingBox{23.635, -74.899, 23.725, -74.801}},
+	"BS-RI":  {22.200, -75.730, BoundingBox{22.155, -75.779, 22.245, -75.681}},
+	"BS-SA":  {23.938, -77.684, BoundingBox{23.710, -77.907, 24.210, -77.530}},
+	"BS-SE":  {24.800, -76.200, BoundingBox{24.675, -76.337, 24.925, -76.063}},
+	"BS-SO":  {26.086, -77.235, BoundingBox{25.880, -77.360, 26.253, -77.097}},
+	"BS-SS":  {24.050, -74.480, BoundingBox{23.967, -74.571, 24.133, -74.389}},
+	"BS-SW":  {25.540, -76.750, BoundingBox{25.495, -76.800, 25.585, -76.700}},
+	"BS-WG":  {26.689, -78.858, BoundingBox{26.437, -78.980, 26.841, -78.681}},
+	"BT-11":  {27.557, 89.296, BoundingBox{27.263, 89.014, 27.883, 89.502}},
+	"BT-12":  {27.013, 89.537, BoundingBox{26.720, 89.307, 27.280, 89.763}},
+	"BT-13":  {27.281, 89.104, BoundingBox{27.105, 88.810, 27.525, 89.458}},
+	"BT-14":  {27.051, 89.125, BoundingBox{26.890, 88.831, 27.158, 89.337}},
+	"BT-15":  {27.531, 89.605, BoundingBox{27.270, 89.381, 27.852, 89.862}},
+	"BT-21":  {27.016, 90.138, BoundingBox{26.767, 89.924, 27.230, 90.365}},
+	"BT-22":  {27.062, 89.838, BoundingBox{26.726, 89.648, 27.345, 90.033}},
+	"BT-23":  {27.742, 89.951, BoundingBox{27.445, 89.594, 27.976, 90.291}},
+	"BT-24":  {27.480, 90.146, BoundingBox{27.217, 89.839, 27.850, 90.377}},
+	"BT-31":  {26.978, 90.435, BoundingBox{26.848, 90.246, 27.159, 90.666}},
+	"BT-32":  {27.404, 90.535, BoundingBox{27.153, 90.287, 27.714, 90.879}},
+	"BT-33":  {27.834, 90.647, BoundingBox{27.432, 90.282, 28.189, 90.950}},
+	"BT-34":  {27.058, 90.773, BoundingBox{26.827, 90.439, 27.339, 91.044}},
+	"BT-41":  {27.283, 91.757, BoundingBox{27.048, 91.400, 27.537, 92.100}},
+	"BT-42":  {27.275, 91.140, BoundingBox{26.966, 90.854, 27.506, 91.400}},
+	"BT-43":  {26.955, 91.317, BoundingBox{26.810, 91.019, 27.152, 91.600}},
+	"BT-44":  {27.782, 91.143, BoundingBox{27.482, 90.950, 28.052, 91.414}},
+	"BT-45":  {26.947, 91.798, BoundingBox{26.820, 91.484, 27.093, 92.059}},
+	"BT-GA":  {28.036, 89.874, BoundingBox{27.796, 89.338, 28.300, 90.350}},
+	"BT-TY":  {27.637, 91.558, BoundingBox{27.421, 91.255, 27.945, 91.991}},
+	"BW-CE":  {-21.358, 25.263, BoundingBox{-23.134, 23.026, -19.500, 27.877}},
+	"BW-GH":  {-22.147, 21.870, BoundingBox{-23.643, 19.900, -20.200, 23.985}},
+	"BW-KG":  {-24.854, 21.389, BoundingBox{-26.830, 19.900, -23.236, 22.750}},
+	"BW-KL":  {-23.687, 26.416, BoundingBox{-24.641, 25.495, -22.963, 27.858}},
+	"BW-KW":  {-23.524, 24.450, BoundingBox{-24.861, 22.968, -22.296, 25.661}},
+	"BW-NE":  {-21.349, 27.470, BoundingBox{-22.948, 26.033, -19.433, 29.430}},
+	"BW-NW":  {-19.148, 23.176, BoundingBox{-20.930, 20.894, -17.660, 26.308}},
+	"BW-SE":  {-24.899, 25.474, BoundingBox{-25.700, 24.927, -24.132, 26.344}},
+	"BW-SO":  {-24.783, 23.737, BoundingBox{-25.720, 22.750, -23.518, 25.086}},
+	"BY-BR":  {52.310, 25.583, BoundingBox{51.580, 23.256, 53.160, 27.659}},
+	"BY-HM":  {53.900, 27.562, BoundingBox{53.790, 27.370, 54.010, 27.755}},
+	"BY-HO":  {52.240, 29.489, BoundingBox{51.320, 27.591, 53.185, 31.790}},
+	"BY-HR":  {53.465, 24.735, BoundingBox{52.404, 23.200, 54.619, 25.943}},
+	"BY-MA":  {53.729, 30.586, BoundingBox{52.667, 28.975, 55.107, 32.690}},
+	"BY-MI":  {53.878, 26.656, BoundingBox{52.470, 25.677, 55.130, 29.525}},
+	"BY-VI":  {55.323, 28.720, BoundingBox{54.502, 26.487, 56.170, 30.964}},
+	"BZ-BZ":  {17.440, -88.463, BoundingBox{17.150, -88.823, 17.826, -88.200}},
+	"BZ-CY":  {17.020, -88.913, BoundingBox{16.620, -89.174, 17.434, -88.606}},
+	"BZ-CZL": {18.146, -88.389, BoundingBox{17.809, -88.742, 18.500, -88.110}},
+	"BZ-OW":  {17.721, -88.834, BoundingBox{17.397, -89.150, 18.063, -88.371}},
+	"BZ-SC":  {16.814, -88.481, BoundingBox{16.386, -88.722, 17.150, -88.240}},
+	"BZ-TOL": {16.307, -88.931, BoundingBox{15.890, -89.230, 16.679, -88.465}},
+	"CA-AB":  {55.00, -115.00, BoundingBox{49.00, -120.00, 60.00, -110.00}},
+	"CA-BC":  {53.73, -127.65, BoundingBox{48.31, -139.06, 60.00, -114.05}},
+	"CA-MB":  {55.00, -97.00, BoundingBox{49.00, -102.00, 60.00, -88.99}},
+	"CA-NB":  {46.50, -66.16, BoundingBox{44.60, -69.06, 48.07, -63.77}},
+	"CA-NL":  {52.86, -60.50, BoundingBox{46.61, -67.80, 60.38, -52.62}},
+	"CA-NS":  {45.00, -63.00, BoundingBox{43.42, -66.32, 47.03, -59.68}},
+	"CA-NT":  {64.83, -119.18, BoundingBox{60.00, -136.45, 78.76, -101.98}},
+	"CA-NU":  {70.30, -83.11, BoundingBox{51.65, -120.68, 83.11, -61.09}},
+	"CA-ON":  {50.00, -85.00, BoundingBox{41.68, -95.16, 56.86, -74.34}},
+	"CA-PE":  {46.510, -63.833, BoundingBox{45.95, -64.42, 47.06, -61.97}},
+	"CA-QC":  {52.94, -73.55, BoundingBox{44.99, -79.76, 62.59, -57.10}},
+	"CA-SK":  {55.00, -106.00, BoundingBox{49.00, -110.00, 60.00, -101.36}},
+	"CA-YT":  {64.28, -135.00, BoundingBox{60.00, -141.00, 69.65, -123.82}},
+	"CD-BC":  {-5.365, 13.788, BoundingBox{-6.100, 12.180, -4.500, 15.609}},
+	"CD-BN":  {-4.152, 18.420, BoundingBox{-8.070, 16.046, -0.109, 21.122}},
+	"CD-EQ":  {1.573, 20.587, BoundingBox{-2.408, 17.657, 5.030, 23.758}},
+	"CD-KA":  {-8.800, 26.624, BoundingBox{-13.260, 22.160, -4.949, 30.740}},
+	"CD-KE":  {-5.080, 23.936, BoundingBox{-8.240, 21.982, -2.009, 26.334}},
+	"CD-KN":  {-4.643, 16.112, BoundingBox{-6.296, 14.742, -2.543, 16.996}},
+	"CD-KW":  {-5.753, 21.614, BoundingBox{-9.848, 19.749, -2.193, 23.303}},
+	"CD-MA":  {-2.944, 25.737, BoundingBox{-5.570, 23.190, -0.728, 27.150}},
+	"CD-NK":  {-0.443, 28.294, BoundingBox{-2.061, 26.474, 1.111, 29.920}},
+	"CD-OR":  {1.920, 26.119, BoundingBox{-2.128, 22.250, 5.260, 31.170}},
+	"CD-SK":  {-3.447, 28.169, BoundingBox{-5.062, 27.150, -1.583, 29.428}},
+	"CF-AC":  {6.820, 17.617, BoundingBox{5.529, 16.698, 8.255, 18.537}},
+	"CF-BB":  {8.302, 20.331, BoundingBox{7.209, 18.518, 9.413, 21.802}},
+	"CF-BGF": {4.169, 18.505, BoundingBox{3.680, 18.342, 4.517, 18.782}},
+	"CF-BK":  {4.995, 21.831, BoundingBox{4.030, 20.399, 6.211, 22.856}},
+	"CF-HK":  {7.450, 23.149, BoundingBox{6.053, 21.529, 8.813, 24.918}},
+	"CF-HM":  {6.136, 25.457, BoundingBox{4.900, 24.200, 7.972, 27.370}},
+	"CF-HS":  {4.402, 15.969, BoundingBox{3.578, 14.530, 5.283, 17.104}},
+	"CF-KB":  {7.229, 19.277, BoundingBox{6.399, 18.363, 8.366, 20.530}},
+	"CF-KG":  {5.728, 19.434, BoundingBox{4.627, 18.323, 6.532, 20.405}},
+	"CF-LB":  {4.072, 17.552, BoundingBox{3.500, 16.801, 4.874, 18.473}},
+	"CF-MB":  {5.547, 23.567, BoundingBox{4.610, 22.492, 6.496, 24.756}},
+	"CF-MP":  {5.110, 18.105, BoundingBox{3.883, 16.996, 6.085, 19.370}},
+	"CF-NM":  {5.565, 15.538, BoundingBox{4.675, 14.460, 6.435, 17.054}},
+	"CF-OP":  {6.801, 15.966, BoundingBox{5.892, 14.792, 7.750, 16.808}},
+	"CF-SE":  {3.272, 16.123, BoundingBox{2.270, 15.237, 3.799, 16.938}},
+	"CF-UK":  {6.236, 20.979, BoundingBox{4.644, 20.024, 7.505, 22.126}},
+	"CF-VK":  {9.617, 22.348, BoundingBox{8.258, 20.865, 11.140, 23.631}},
+	"CG-11":  {-4.129, 13.623, BoundingBox{-4.880, 12.887, -3.456, 14.366}},
+	"CG-12":  {-3.683, 14.951, BoundingBox{-4.970, 14.115, -2.683, 16.011}},
+	"CG-13":  {1.452, 14.833, BoundingBox{0.506, 13.000, 2.292, 16.595}},
+	"CG-14":  {-2.033, 15.359, BoundingBox{-3.119, 14.272, -1.121, 16.668}},
+	"CG-15":  {-0.141, 14.645, BoundingBox{-1.419, 13.840, 0.807, 15.414}},
+	"CG-2":   {-2.901, 13.688, BoundingBox{-3.639, 12.812, -2.043, 14.591}},
+	"CG-5":   {-4.231, 12.065, BoundingBox{-5.040, 11.090, -3.613, 13.061}},
+	"CG-7":   {2.242, 17.245, BoundingBox{0.364, 16.023, 3.730, 18.450}},
+	"CG-8":   {-0.260, 16.399, BoundingBox{-1.454, 15.007, 0.978, 17.830}},
+	"CG-9":   {-3.077, 12.383, BoundingBox{-3.934, 11.480, -1.950, 13.108}},
+	"CG-BZV": {-4.256, 15.207, BoundingBox{-4.546, 14.977, -4.086, 15.475}},
+	"CH-AG":  {47.419, 8.156, BoundingBox{47.217, 7.905, 47.614, 8.407}},
+	"CH-AI":  {47.284, 9.448, BoundingBox{47.185, 9.284, 47.411, 9.600}},
+	"CH-AR":  {47.431, 9.409, BoundingBox{47.259, 9.176, 47.594, 9.630}},
+	"CH-BE":  {46.817, 7.596, BoundingBox{46.338, 6.865, 47.310, 8.322}},
+	"CH-BL":  {47.463, 7.769, BoundingBox{47.339, 7.433, 47.615, 7.970}},
+	"CH-BS":  {47.540, 7.552, BoundingBox{47.411, 7.308, 47.620, 7.908}},
+	"CH-FR":  {46.695, 7.136, BoundingBox{46.481, 6.762, 46.949, 7.479}},
+	"CH-GE":  {46.324, 6.118, BoundingBox{46.120, 5.960, 46.582, 6.358}},
+	"CH-GL":  {46.900, 9.086, BoundingBox{46.689, 8.841, 47.156, 9.338}},
+	"CH-GR":  {46.613, 9.705, BoundingBox{46.236, 8.810, 46.954, 10.440}},
+	"CH-JU":  {47.379, 7.050, BoundingBox{47.227, 6.740, 47.540, 7.418}},
+	"CH-LU":  {47.075, 8.042, BoundingBox{46.891, 7.749, 47.237, 8.323}},
+	"CH-NE":  {46.987, 6.722, BoundingBox{46.762, 6.227, 47.278, 7.161}},
+	"CH-NW":  {46.937, 8.424, BoundingBox{46.776, 8.232, 47.075, 8.633}},
+	"CH-OW":  {46.792, 8.157, BoundingBox{46.583, 7.900, 46.969, 8.398}},
+	"CH-SG":  {47.138, 9.297, BoundingBox{46.817, 8.887, 47.534, 9.845}},
+	"CH-SH":  {47.670, 8.608, BoundingBox{47.552, 8.310, 47.830, 8.912}},
+	"CH-SO":  {47.242, 7.654, BoundingBox{47.069, 7.397, 47.413, 7.948}},
+	"CH-SZ":  {47.064, 8.781, BoundingBox{46.868, 8.556, 47.259, 8.976}},
+	"CH-TG":  {47.569, 9.034, BoundingBox{47.413, 8.794, 47.720, 9.361}},
+	"CH-TI":  {46.254, 8.734, BoundingBox{46.010, 8.475, 46.543, 9.073}},
+	"CH-UR":  {46.683, 8.645, BoundingBox{46.463, 8.300, 46.911, 9.037}},
+	"CH-VD":  {46.450, 6.704, BoundingBox{45.905, 6.034, 46.873, 7.382}},
+	"CH-VS":  {46.155, 7.749, BoundingBox{45.780, 7.013, 46.546, 8.475}},
+	"CH-ZG":  {47.178, 8.490, BoundingBox{47.015, 8.251, 47.325, 8.768}},
+	"CH-ZH":  {47.413, 8.649, BoundingBox{47.244, 8.388, 47.565, 8.900}},
+	"CI-01":  {5.426, -4.130, BoundingBox{5.051, -4.906, 5.882, -3.559}},
+	"CI-02":  {7.018, -6.650, BoundingBox{6.218, -7.191, 7.600, -6.200}},
+	"CI-03":  {9.563, -5.635, BoundingBox{8.637, -6.632, 10.520, -4.285}},
+	"CI-04":  {8.248, -4.943, BoundingBox{7.421, -5.833, 9.265, -4.056}},
+	"CI-05":  {6.669, -3.441, BoundingBox{5.935, -4.024, 7.525, -2.920}},
+	"CI-06":  {7.333, -7.823, BoundingBox{6.679, -8.500, 7.927, -7.025}},
+	"CI-07":  {6.976, -4.842, BoundingBox{6.335, -5.300, 7.598, -4.400}},
+	"CI-08":  {8.762, -3.470, BoundingBox{7.500, -4.454, 9.900, -2.560}},
+	"CI-09":  {5.166, -6.927, BoundingBox{4.340, -7.710, 6.035, -5.887}},
+	"CI-10":  {9.638, -7.379, BoundingBox{8.966, -8.310, 10.430, -6.551}},
+	"CI-11":  {7.239, -3.936, BoundingBox{6.463, -4.400, 7.936, -3.160}},
+	"CI-12":  {7.117, -5.757, BoundingBox{6.493, -6.200, 7.841, -5.300}},
+	"CI-13":  {5.482, -3.246, BoundingBox{4.980, -3.644, 6.099, -2.810}},
+	"CI-14":  {8.292, -6.460, BoundingBox{7.600, -7.112, 9.143, -5.732}},
+	"CI-15":  {5.671, -5.330, BoundingBox{4.967, -6.019, 6.531, -4.765}},
+	"CI-16":  {6.063, -4.338, BoundingBox{5.515, -4.885, 6.574, -3.622}},
+	"CI-17":  {8.373, -7.564, BoundingBox{7.704, -8.300, 9.037, -6.969}},
+	"CI-18":  {6.072, -6.107, BoundingBox{5.275, -6.792, 6.631, -5.400}},
+	"CI-19":  {6.381, -7.590, BoundingBox{5.683, -8.600, 7.000, -6.750}},
+	"CL-AI":  {-46.935, -73.569, BoundingBox{-50.025, -75.640, -44.250, -71.220}},
+	"CL-AN":  {-23.801, -69.062, BoundingBox{-25.803, -70.691, -22.050, -66.990}},
+	"CL-AP":  {-18.711, -69.632, BoundingBox{-19.516, -70.370, -17.580, -68.651}},
+	"CL-AR":  {-38.613, -72.193, BoundingBox{-39.479, -73.523, -37.873, -70.810}},
+	"CL-AT":  {-27.181, -69.907, BoundingBox{-29.125, -71.459, -25.523, -68.300}},
+	"CL-BI":  {-37.265, -72.316, BoundingBox{-38.103, -73.590, -36.148, -71.026}},
+	"CL-CO":  {-30.190, -70.668, BoundingBox{-31.650, -109.490, -26.995, -69.916}},
+	"CL-LI":  {-34.468, -71.043, BoundingBox{-35.232, -72.218, -33.743, -69.820}},
+	"CL-LL":  {-42.478, -73.824, BoundingBox{-44.250, -74.381, -40.865, -71.460}},
+	"CL-LR":  {-40.184, -72.711, BoundingBox{-41.119, -73.850, -39.234, -71.580}},
+	"CL-MA":  {-52.866, -71.771, BoundingBox{-55.610, -75.507, -49.684, -66.960}},
+	"CL-ML":  {-35.741, -71.532, BoundingBox{-36.784, -72.796, -34.740, -70.360}},
+	"CL-RM":  {-33.566, -70.635, BoundingBox{-34.171, -71.813, -32.945, -69.810}},
+	"CL-TA":  {-20.775, -69.270, BoundingBox{-22.050, -70.196, -19.239, -68.062}},
+	"CL-VS":  {-32.460, -70.934, BoundingBox{-33.388, -71.713, -31.650, -70.110}},
+	"CM-AD":  {6.728, 13.145, BoundingBox{5.356, 11.585, 8.345, 15.022}},
+	"CM-CE":  {4.585, 12.068, BoundingBox{3.369, 10.854, 5.979, 13.234}},
+	"CM-EN":  {10.900, 14.389, BoundingBox{9.776, 13.265, 12.860, 15.470}},
+	"CM-ES":  {3.567, 14.254, BoundingBox{1.730, 12.814, 5.855, 16.011}},
+	"CM-LT":  {3.889, 10.104, BoundingBox{2.408, 8.918, 4.931, 11.019}},
+	"CM-NO":  {8.411, 13.970, BoundingBox{6.899, 12.266, 9.994, 15.440}},
+	"CM-NW":  {6.381, 10.416, BoundingBox{5.727, 9.102, 7.060, 11.760}},
+	"CM-OU":  {5.477, 10.781, BoundingBox{4.621, 9.698, 6.234, 11.915}},
+	"CM-SU":  {2.823, 11.599, BoundingBox{2.190, 9.650, 3.648, 13.398}},
+	"CM-SW":  {5.062, 9.209, BoundingBox{3.969, 8.490, 6.178, 10.035}},
+	"CN-AH":  {31.694, 116.761, BoundingBox{29.503, 114.580, 34.220, 118.910}},
+	"CN-BJ":  {40.180, 116.435, BoundingBox{39.284, 115.433, 40.990, 117.636}},
+	"CN-CQ":  {29.800, 108.390, BoundingBox{28.042, 106.213, 31.520, 110.355}},
+	"CN-FJ":  {25.797, 117.864, BoundingBox{23.410, 115.380, 28.002, 120.433}},
+	"CN-GD":  {23.310, 112.706, BoundingBox{20.315, 107.384, 25.753, 116.933}},
+	"CN-GS":  {37.547, 100.179, BoundingBox{32.039, 91.983, 42.813, 106.714}},
+	"CN-GX":  {23.925, 108.423, BoundingBox{21.721, 104.896, 26.060, 111.404}},
+	"CN-GZ":  {26.681, 106.351, BoundingBox{24.123, 103.180, 28.623, 109.533}},
+	"CN-HA":  {34.090, 113.605, BoundingBox{31.958, 110.742, 36.138, 116.533}},
+	"CN-HB":  {30.868, 112.366, BoundingBox{29.047, 109.755, 32.931, 115.124}},
+	"CN-HE":  {40.408, 113.575, BoundingBox{36.018, 110.987, 43.301, 121.417}},
+	"CN-HI":  {19.226, 109.707, BoundingBox{18.200, 108.630, 20.375, 111.010}},
+	"CN-HK":  {22.337, 114.143, BoundingBox{22.150, 113.830, 22.560, 114.440}},
+	"CN-HL":  {48.164, 127.714, BoundingBox{42.400, 121.634, 53.460, 135.030}},
+	"CN-HN":  {27.357, 111.551, BoundingBox{25.121, 109.041, 29.447, 113.700}},
+	"CN-JL":  {43.505, 126.246, BoundingBox{40.908, 123.011, 46.073, 129.628}},
+	"CN-JS":  {32.880, 119.542, BoundingBox{31.038, 117.300, 34.779, 121.580}},
+	"CN-JX":  {27.755, 115.546, BoundingBox{25.188, 113.700, 30.011, 118.120}},
+	"CN-LN":  {41.637, 122.452, BoundingBox{38.992, 119.732, 43.710, 125.769}},
+	"CN-MO":  {22.158, 113.556, BoundingBox{22.110, 113.530, 22.220, 113.600}},
+	"CN-NM":  {44.513, 113.900, BoundingBox{38.816, 96.155, 53.341, 125.258}},
+	"CN-NX":  {37.525, 106.057, BoundingBox{35.290, 103.712, 39.052, 107.927}},
+	"CN-QH":  {35.564, 95.902, BoundingBox{32.317, 89.298, 38.242, 102.754}},
+	"CN-SC":  {30.659, 101.429, BoundingBox{27.489, 96.200, 34.191, 107.437}},
+	"CN-SD":  {36.188, 118.328, BoundingBox{34.108, 115.137, 37.961, 122.520}},
+	"CN-SH":  {31.258, 121.430, BoundingBox{29.765, 120.753, 32.064, 122.090}},
+	"CN-SN":  {35.359, 108.967, BoundingBox{31.419, 105.770, 40.734, 111.596}},
+	"CN-SX":  {37.764, 112.354, BoundingBox{35.472, 110.729, 40.700, 114.718}},
+	"CN-TJ":  {38.906, 117.248, BoundingBox{37.695, 116.141, 40.178, 118.567}},
+	"CN-TW":  {23.745, 120.880, BoundingBox{21.970, 118.260, 26.226, 121.950}},
+	"CN-XJ":  {41.206, 85.238, BoundingBox{35.066, 73.680, 49.300, 96.173}},
+	"CN-XZ":  {31.987, 87.609, BoundingBox{27.300, 78.228, 37.052, 97.611}},
+	"CN-YN":  {24.875, 101.302, BoundingBox{21.170, 97.600, 28.039, 105.113}},
+	"CN-ZJ":  {29.253, 119.790, BoundingBox{27.099, 117.290, 31.152, 122.078}},
+	"CO-AMA": {-1.607, -71.348, BoundingBox{-4.300, -73.634, 0.157, -69.423}},
+	"CO-ANT": {6.994, -75.557, BoundingBox{6.100, -77.208, 7.849, -74.438}},
+	"CO-ARA": {6.471, -70.925, BoundingBox{5.635, -72.242, 7.114, -69.622}},
+	"CO-ATL": {10.744, -74.759, BoundingBox{10.210, -75.497, 11.310, -73.951}},
+	"CO-BOL": {8.896, -74.582, BoundingBox{7.520, -75.670, 10.541, -73.529}},
+	"CO-BOY": {5.744, -72.841, BoundingBox{4.650, -73.945, 6.802, -72.018}},
+	"CO-CAL": {5.589, -75.132, BoundingBox{4.726, -75.862, 6.248, -74.432}},
+	"CO-CAQ": {0.507, -73.851, BoundingBox{-1.306, -75.185, 2.327, -72.280}},
+	"CO-CAS": {4.885, -71.414, BoundingBox{3.377, -72.552, 6.244, -70.427}},
+	"CO-CAU": {2.528, -76.911, BoundingBox{1.514, -78.117, 3.411, -76.150}},
+	"CO-CES": {9.421, -73.456, BoundingBox{8.511, -74.258, 10.482, -72.738}},
+	"CO-CHO": {6.064, -76.913, BoundingBox{4.628, -77.880, 7.561, -75.828}},
+	"CO-COR": {8.224, -76.306, BoundingBox{7.368, -77.790, 9.358, -74.949}},
+	"CO-CUN": {5.064, -74.147, BoundingBox{3.983, -75.150, 6.089, -72.897}},
+	"CO-DC":  {4.002, -74.035, BoundingBox{2.847, -74.677, 4.942, -73.058}},
+	"CO-GUA": {2.771, -68.697, BoundingBox{1.130, -70.537, 4.313, -66.880}},
+	"CO-GUV": {2.029, -71.987, BoundingBox{0.174, -73.747, 3.461, -70.437}},
+	"CO-HUI": {2.353, -75.224, BoundingBox{1.268, -76.150, 3.320, -73.979}},
+	"CO-LAG": {11.474, -72.398, BoundingBox{10.411, -73.662, 12.440, -71.140}},
+	"CO-MAG": {10.367, -73.973, BoundingBox{9.444, -74.722, 11.285, -73.067}},
+	"CO-MET": {3.460, -72.832, BoundingBox{2.241, -74.188, 4.650, -71.118}},
+	"CO-NAR": {1.576, -77.752, BoundingBox{0.384, -78.990, 2.674, -76.452}},
+	"CO-NSA": {7.919, -72.936, BoundingBox{6.870, -73.841, 8.905, -72.045}},
+	"CO-PUT": {0.744, -75.978, BoundingBox{-0.150, -77.326, 1.604, -74.991}},
+	"CO-QUI": {4.468, -75.752, BoundingBox{3.902, -76.477, 4.900, -75.150}},
+	"CO-RIS": {5.131, -76.089, BoundingBox{4.575, -76.822, 5.981, -75.499}},
+	"CO-SAN": {6.856, -73.651, BoundingBox{5.954, -74.566, 7.801, -72.236}},
+	"CO-SAP": {12.550, -81.720, BoundingBox{12.505, -81.766, 12.595, -81.674}},
+	"CO-SUC": {9.055, -75.171, BoundingBox{8.208, -76.016, 9.556, -74.481}},
+	"CO-TOL": {3.730, -75.150, BoundingBox{3.080, -75.800, 4.548, -74.495}},
+	"CO-VAC": {3.848, -76.613, BoundingBox{3.012, -77.500, 4.707, -75.703}},
+	"CO-VAU": {0.643, -70.627, BoundingBox{-1.197, -72.281, 2.532, -69.220}},
+	"CO-VID": {4.912, -69.199, BoundingBox{3.163, -70.767, 6.385, -67.340}},
+	"CR-A":   {10.588, -84.577, BoundingBox{9.986, -84.950, 11.080, -84.180}},
+	"CR-C":   {9.797, -83.734, BoundingBox{9.354, -84.209, 10.176, -83.365}},
+	"CR-G":   {10.585, -85.407, BoundingBox{9.890, -85.940, 11.220, -84.950}},
+	"CR-H":   {10.437, -83.912, BoundingBox{10.000, -84.343, 10.940, -83.460}},
+	"CR-L":   {9.861, -83.209, BoundingBox{9.450, -83.664, 10.524, -82.550}},
+	"CR-P":   {9.258, -83.286, BoundingBox{8.230, -85.533, 10.362, -82.720}},
+	"CR-SJ":  {9.587, -84.062, BoundingBox{9.153, -84.512, 10.000, -83.543}},
+	"CU-01":  {22.384, -83.843, BoundingBox{21.800, -84.970, 22.992, -82.981}},
+	"CU-02":  {22.742, -82.218, BoundingBox{22.209, -83.067, 23.084, -81.669}},
+	"CU-03":  {23.015, -82.466, BoundingBox{22.802, -83.182, 23.190, -81.642}},
+	"CU-04":  {22.647, -81.219, BoundingBox{22.093, -81.853, 23.139, -80.549}},
+	"CU-05":  {22.577, -80.005, BoundingBox{22.017, -80.577, 23.084, -79.371}},
+	"CU-06":  {22.224, -80.426, BoundingBox{21.766, -80.978, 22.785, -79.911}},
+	"CU-07":  {21.980, -79.427, BoundingBox{21.560, -79.998, 22.484, -79.050}},
+	"CU-08":  {22.009, -78.566, BoundingBox{21.264, -79.050, 22.510, -77.895}},
+	"CU-09":  {21.385, -77.876, BoundingBox{20.686, -78.579, 22.210, -77.178}},
+	"CU-10":  {21.035, -76.975, BoundingBox{20.650, -77.638, 21.681, -76.327}},
+	"CU-11":  {20.774, -75.963, BoundingBox{20.398, -76.458, 21.220, -75.285}},
+	"CU-12":  {20.255, -76.930, BoundingBox{19.860, -77.760, 20.650, -76.372}},
+	"CU-13":  {20.171, -75.976, BoundingBox{19.870, -76.517, 20.502, -75.469}},
+	"CU-14":  {20.273, -74.951, BoundingBox{19.882, -75.529, 20.714, -74.180}},
+	"CU-99":  {21.700, -82.800, BoundingBox{21.655, -82.848, 21.745, -82.752}},
+	"CV-B":   {16.596, -24.226, BoundingBox{15.934, -25.306, 17.200, -22.627}},
+	"CV-BR":  {14.850, -24.710, BoundingBox{14.792, -24.770, 14.908, -24.650}},
+	"CV-BV":  {16.100, -22.800, BoundingBox{15.934, -22.973, 16.266, -22.627}},
+	"CV-CA":  {15.087, -23.775, BoundingBox{14.972, -23.861, 15.178, -23.649}},
+	"CV-CF":  {14.899, -24.338, BoundingBox{14.795, -24.431, 15.010, -24.251}},
+	"CV-CR":  {15.148, -23.476, BoundingBox{15.080, -23.607, 15.258, -23.379}},
+	"CV-MA":  {15.220, -23.150, BoundingBox{15.120, -23.253, 15.320, -23.047}},
+	"CV-MO":  {15.006, -24.395, BoundingBox{14.951, -24.488, 15.045, -24.291}},
+	"CV-PA":  {17.101, -25.034, BoundingBox{16.986, -25.104, 17.200, -24.994}},
+	"CV-PN":  {17.004, -25.200, BoundingBox{16.900, -25.306, 17.154, -25.052}},
+	"CV-PR":  {14.933, -23.501, BoundingBox{14.847, -23.600, 15.023, -23.379}},
+	"CV-RB":  {16.615, -24.232, BoundingBox{16.475, -24.380, 16.725, -24.120}},
+	"CV-RG":  {17.100, -25.126, BoundingBox{16.960, -25.263, 17.200, -25.005}},
+	"CV-RS":  {14.934, -23.691, BoundingBox{14.847, -23.848, 15.017, -23.580}},
+	"CV-S":   {15.113, -23.771, BoundingBox{14.792, -24.770, 16.650, -23.047}},
+	"CV-SD":  {15.033, -23.495, BoundingBox{14.967, -23.613, 15.080, -23.379}},
+	"CV-SF":  {14.894, -24.462, BoundingBox{14.795, -24.509, 14.992, -24.408}},
+	"CV-SL":  {16.730, -22.930, BoundingBox{16.614, -23.051, 16.846, -22.809}},
+	"CV-SM":  {15.227, -23.579, BoundingBox{15.125, -23.683, 15.313, -23.463}},
+	"CV-SO":  {15.067, -23.605, BoundingBox{15.006, -23.644, 15.125, -23.549}},
+	"CV-SS":  {15.060, -23.673, BoundingBox{15.002, -23.748, 15.139, -23.627}},
+	"CV-SV":  {16.857, -24.957, BoundingBox{16.775, -25.091, 16.988, -24.872}},
+	"CV-TA":  {15.235, -23.726, BoundingBox{15.156, -23.861, 15.313, -23.598}},
+	"CV-TS":  {16.542, -24.320, BoundingBox{16.475, -24.380, 16.650, -24.222}},
+	"CY-01":  {35.127, 33.092, BoundingBox{34.898, 32.802, 35.366, 33.440}},
+	"CY-02":  {34.778, 32.976, BoundingBox{34.570, 32.655, 35.036, 33.306}},
+	"CY-03":  {34.937, 33.481, BoundingBox{34.701, 33.199, 35.186, 33.803}},
+	"CY-04":  {35.263, 33.895, BoundingBox{34.901, 33.559, 35.670, 34.580}},
+	"CY-05":  {34.942, 32.584, BoundingBox{34.656, 32.259, 35.153, 32.868}},
+	"CY-06":  {35.294, 33.342, BoundingBox{35.140, 32.935, 35.390, 33.635}},
+	"CZ-10":  {50.062, 14.473, BoundingBox{49.928, 14.188, 50.199, 14.757}},
+	"CZ-101": {50.088, 14.410, BoundingBox{50.073, 14.385, 50.103, 14.442}},
+	"CZ-102": {50.068, 14.429, BoundingBox{50.052, 14.401, 50.086, 14.456}},
+	"CZ-103": {50.085, 14.463, BoundingBox{50.061, 14.440, 50.102, 14.491}},
+	"CZ-104": {50.037, 14.447, BoundingBox{50.009, 14.402, 50.061, 14.485}},
+	"CZ-105": {50.054, 14.381, BoundingBox{50.022, 14.345, 50.083, 14.416}},
+	"CZ-106": {50.115, 14.350, BoundingBox{50.075, 14.282, 50.157, 14.395}},
+	"CZ-107": {50.124, 14.416, BoundingBox{50.092, 14.386, 50.168, 14.459}},
+	"CZ-108": {50.146, 14.454, BoundingBox{50.100, 14.400, 50.186, 14.498}},
+	"CZ-109": {50.106, 14.510, BoundingBox{50.085, 14.481, 50.129, 14.539}},
+	"CZ-110": {50.069, 14.497, BoundingBox{50.047, 14.459, 50.092, 14.533}},
+	"CZ-111": {49.997, 14.519, BoundingBox{49.928, 14.470, 50.053, 14.555}},
+	"CZ-112": {49.985, 14.443, BoundingBox{49.934, 14.367, 50.030, 14.530}},
+	"CZ-113": {50.029, 14.307, BoundingBox{49.994, 14.211, 50.076, 14.371}},
+	"CZ-114": {50.095, 14.566, BoundingBox{50.071, 14.525, 50.120, 14.604}},
+	"CZ-115": {50.054, 14.555, BoundingBox{50.019, 14.514, 50.077, 14.602}},
+	"CZ-116": {49.984, 14.331, BoundingBox{49.949, 14.225, 50.019, 14.412}},
+	"CZ-117": {50.060, 14.266, BoundingBox{49.995, 14.188, 50.125, 14.341}},
+	"CZ-118": {50.143, 14.516, BoundingBox{50.112, 14.481, 50.174, 14.557}},
+	"CZ-119": {50.160, 14.581, BoundingBox{50.120, 14.513, 50.199, 14.637}},
+	"CZ-120": {50.118, 14.637, BoundingBox{50.077, 14.590, 50.166, 14.700}},
+	"CZ-121": {50.064, 14.684, BoundingBox{50.025, 14.600, 50.107, 14.757}},
+	"CZ-122": {50.013, 14.600, BoundingBox{49.948, 14.555, 50.068, 14.670}},
+	"CZ-20":  {50.012, 15.073, BoundingBox{49.468, 13.308, 50.625, 15.581}},
+	"CZ-201": {49.713, 14.777, BoundingBox{49.560, 14.401, 49.896, 15.136}},
+	"CZ-202": {49.930, 14.002, BoundingBox{49.800, 13.792, 50.075, 14.192}},
+	"CZ-203": {50.222, 14.029, BoundingBox{50.075, 13.843, 50.365, 14.203}},
+	"CZ-204": {50.041, 15.170, BoundingBox{49.877, 14.935, 50.168, 15.450}},
+	"CZ-205": {49.863, 15.296, BoundingBox{49.655, 14.991, 50.069, 15.581}},
+	"CZ-206": {50.394, 14.515, BoundingBox{50.276, 14.241, 50.538, 14.767}},
+	"CZ-207": {50.458, 14.931, BoundingBox{50.287, 14.666, 50.625, 15.190}},
+	"CZ-208": {50.225, 15.125, BoundingBox{50.106, 14.874, 50.358, 15.436}},
+	"CZ-209": {50.094, 14.738, BoundingBox{49.847, 14.377, 50.322, 14.986}},
+	"CZ-20A": {49.988, 14.215, BoundingBox{49.698, 14.005, 50.343, 14.573}},
+	"CZ-20B": {49.631, 14.075, BoundingBox{49.468, 13.736, 49.800, 14.437}},
+	"CZ-20C": {50.079, 13.630, BoundingBox{49.922, 13.308, 50.220, 13.929}},
+	"CZ-31":  {49.124, 14.348, BoundingBox{48.560, 13.451, 49.592, 15.501}},
+	"CZ-311": {48.985, 14.522, BoundingBox{48.738, 14.216, 49.200, 14.844}},
+	"CZ-312": {48.748, 14.247, BoundingBox{48.560, 13.873, 48.933, 14.590}},
+	"CZ-313": {49.101, 15.071, BoundingBox{48.920, 14.687, 49.259, 15.501}},
+	"CZ-314": {49.342, 14.207, BoundingBox{49.126, 13.866, 49.540, 14.463}},
+	"CZ-315": {48.980, 13.887, BoundingBox{48.762, 13.451, 49.160, 14.258}},
+	"CZ-316": {49.260, 13.785, BoundingBox{49.063, 13.529, 49.488, 14.112}},
+	"CZ-317": {49.395, 14.668, BoundingBox{49.159, 14.389, 49.592, 14.974}},
+	"CZ-32":  {49.645, 13.191, BoundingBox{48.993, 12.443, 50.163, 13.916}},
+	"CZ-321": {49.504, 12.932, BoundingBox{49.298, 12.540, 49.683, 13.202}},
+	"CZ-322": {49.256, 13.356, BoundingBox{48.993, 13.046, 49.450, 13.599}},
+	"CZ-323": {49.726, 13.312, BoundingBox{49.632, 13.022, 49.874, 13.553}},
+	"CZ-324": {49.529, 13.445, BoundingBox{49.384, 13.104, 49.652, 13.766}},
+	"CZ-325": {49.940, 13.211, BoundingBox{49.724, 12.920, 50.163, 13.523}},
+	"CZ-326": {49.779, 13.683, BoundingBox{49.569, 13.474, 49.941, 13.916}},
+	"CZ-327": {49.784, 12.725, BoundingBox{49.540, 12.443, 50.010, 13.030}},
+	"CZ-41":  {50.188, 12.756, BoundingBox{49.872, 12.240, 50.469, 13.315}},
+	"CZ-411": {50.050, 12.474, BoundingBox{49.872, 12.241, 50.269, 12.713}},
+	"CZ-412": {50.212, 12.963, BoundingBox{49.975, 12.750, 50.469, 13.315}},
+	"CZ-413": {50.225, 12.579, BoundingBox{50.007, 12.240, 50.417, 12.750}},
+	"CZ-42":  {50.596, 13.836, BoundingBox{50.179, 12.932, 51.120, 14.691}},
+	"CZ-421": {50.906, 14.354, BoundingBox{50.672, 13.998, 51.120, 14.691}},
+	"CZ-422": {50.435, 13.274, BoundingBox{50.183, 12.932, 50.708, 13.557}},
+	"CZ-423": {50.486, 14.160, BoundingBox{50.336, 13.869, 50.643, 14.441}},
+	"CZ-424": {50.311, 13.726, BoundingBox{50.179, 13.410, 50.468, 13.992}},
+	"CZ-425": {50.565, 13.566, BoundingBox{50.383, 13.307, 50.761, 13.869}},
+	"CZ-426": {50.691, 13.758, BoundingBox{50.473, 13.453, 50.876, 13.956}},
+	"CZ-427": {50.726, 14.062, BoundingBox{50.557, 13.864, 50.913, 14.329}},
+	"CZ-51":  {50.755, 14.988, BoundingBox{50.481, 14.327, 51.110, 15.575}},
+	"CZ-511": {50.677, 14.599, BoundingBox{50.511, 14.327, 50.908, 14.861}},
+	"CZ-512": {50.739, 15.218, BoundingBox{50.555, 14.955, 50.942, 15.487}},
+	"CZ-513": {50.888, 14.939, BoundingBox{50.625, 14.667, 51.110, 15.259}},
+	"CZ-514": {50.610, 15.396, BoundingBox{50.481, 15.123, 50.782, 15.575}},
+	"CZ-52":  {50.375, 15.899, BoundingBox{49.999, 15.157, 50.771, 16.748}},
+	"CZ-521": {50.266, 15.760, BoundingBox{50.150, 15.432, 50.430, 16.053}},
+	"CZ-522": {50.382, 15.411, BoundingBox{50.186, 15.157, 50.521, 15.684}},
+	"CZ-523": {50.452, 16.106, BoundingBox{50.264, 15.866, 50.708, 16.404}},
+	"CZ-524": {50.182, 16.319, BoundingBox{49.999, 16.003, 50.337, 16.748}},
+	"CZ-525": {50.607, 15.808, BoundingBox{50.420, 15.575, 50.771, 16.165}},
+	"CZ-53":  {49.883, 16.181, BoundingBox{49.529, 15.450, 50.184, 16.842}},
+	"CZ-531": {49.841, 15.891, BoundingBox{49.677, 15.566, 50.005, 16.172}},
+	"CZ-532": {50.050, 15.740, BoundingBox{49.905, 15.450, 50.150, 16.066}},
+	"CZ-533": {49.729, 16.479, BoundingBox{49.529, 16.126, 49.897, 16.842}},
+	"CZ-534": {49.993, 16.443, BoundingBox{49.854, 16.076, 50.184, 16.742}},
+	"CZ-63":  {49.403, 15.628, BoundingBox{48.920, 14.894, 49.834, 16.355}},
+	"CZ-631": {49.641, 15.498, BoundingBox{49.500, 15.127, 49.834, 15.816}},
+	"CZ-632": {49.327, 15.558, BoundingBox{49.073, 15.350, 49.500, 15.873}},
+	"CZ-633": {49.392, 15.143, BoundingBox{49.183, 14.894, 49.624, 15.350}},
+	"CZ-634": {49.133, 15.810, BoundingBox{48.920, 15.482, 49.358, 16.075}},
+	"CZ-635": {49.529, 16.035, BoundingBox{49.337, 15.722, 49.742, 16.355}},
+	"CZ-64":  {49.077, 16.603, BoundingBox{48.600, 15.553, 49.622, 17.503}},
+	"CZ-641": {49.468, 16.624, BoundingBox{49.319, 16.306, 49.622, 16.878}},
+	"CZ-642": {49.200, 16.612, BoundingBox{49.137, 16.515, 49.263, 16.705}},
+	"CZ-643": {49.164, 16.490, BoundingBox{48.891, 16.075, 49.443, 17.048}},
+	"CZ-644": {48.824, 16.745, BoundingBox{48.600, 16.383, 49.019, 17.009}},
+	"CZ-645": {48.922, 17.150, BoundingBox{48.678, 16.907, 49.108, 17.503}},
+	"CZ-646": {49.260, 17.044, BoundingBox{49.086, 16.815, 49.404, 17.218}},
+	"CZ-647": {48.887, 16.047, BoundingBox{48.730, 15.553, 49.050, 16.403}},
+	"CZ-71":  {49.868, 17.163, BoundingBox{49.326, 16.684, 50.470, 17.786}},
+	"CZ-711": {50.267, 17.144, BoundingBox{50.053, 16.738, 50.470, 17.590}},
+	"CZ-712": {49.689, 17.327, BoundingBox{49.501, 16.963, 49.846, 17.662}},
+	"CZ-713": {49.537, 17.004, BoundingBox{49.374, 16.747, 49.722, 17.282}},
+	"CZ-714": {49.477, 17.551, BoundingBox{49.326, 17.262, 49.656, 17.786}},
+	"CZ-715": {49.942, 16.950, BoundingBox{49.717, 16.684, 50.193, 17.260}},
+	"CZ-72":  {49.180, 17.720, BoundingBox{48.800, 17.187, 49.475, 18.412}},
+	"CZ-721": {49.280, 17.373, BoundingBox{49.136, 17.190, 49.427, 17.627}},
+	"CZ-722": {48.998, 17.540, BoundingBox{48.800, 17.187, 49.189, 17.897}},
+	"CZ-723": {49.345, 18.054, BoundingBox{49.105, 17.736, 49.475, 18.412}},
+	"CZ-724": {49.159, 17.817, BoundingBox{48.936, 17.533, 49.371, 18.120}},
+	"CZ-80":  {49.776, 18.005, BoundingBox{49.335, 17.204, 50.235, 18.850}},
+	"CZ-801": {49.995, 17.455, BoundingBox{49.793, 17.204, 50.235, 17.707}},
+	"CZ-802": {49.565, 18.472, BoundingBox{49.335, 18.225, 49.724, 18.850}},
+	"CZ-803": {49.809, 18.472, BoundingBox{49.698, 18.375, 49.991, 18.664}},
+	"CZ-804": {49.608, 17.962, BoundingBox{49.475, 17.655, 49.767, 18.225}},
+	"CZ-805": {49.879, 17.828, BoundingBox{49.695, 17.556, 50.045, 18.112}},
+	"CZ-806": {49.846, 18.215, BoundingBox{49.670, 18.009, 50.013, 18.375}},
+	"DE-BB":  {52.460, 12.517, BoundingBox{51.36, 11.27, 53.56, 14.77}},
+	"DE-BE":  {52.50, 13.40, BoundingBox{52.34, 13.09, 52.68, 13.76}},
+	"DE-BW":  {48.54, 9.04, BoundingBox{47.53, 7.51, 49.79, 10.50}},
+	"DE-BY":  {48.95, 11.40, BoundingBox{47.27, 8.98, 50.56, 13.84}},
+	"DE-HB":  {53.13, 8.74, BoundingBox{53.01, 8.48, 53.61, 8.99}},
+	"DE-HE":  {50.61, 9.03, BoundingBox{49.40, 7.77, 51.66, 10.24}},
+	"DE-HH":  {53.55, 10.01, BoundingBox{53.40, 8.42, 53.96, 10.33}},
+	"DE-MV":  {53.77, 12.58, BoundingBox{53.11, 10.59, 54.68, 14.41}},
+	"DE-NI":  {52.84, 9.08, BoundingBox{51.30, 6.65, 53.89, 11.60}},
+	"DE-NW":  {51.48, 7.56, BoundingBox{50.32, 5.87, 52.53, 9.46}},
+	"DE-RP":  {49.91, 7.45, BoundingBox{48.97, 6.11, 50.94, 8.51}},
+	"DE-SH":  {54.19, 9.82, BoundingBox{53.36, 7.87, 55.06, 11.31}},
+	"DE-SL":  {49.38, 6.95, BoundingBox{49.11, 6.36, 49.64, 7.40}},
+	"DE-SN":  {51.05, 13.36, BoundingBox{50.17, 11.87, 51.69, 15.04}},
+	"DE-ST":  {52.01, 11.70, BoundingBox{50.94, 10.56, 53.04, 13.19}},
+	"DE-TH":  {50.90, 11.03, BoundingBox{50.20, 9.88, 51.65, 12.65}},
+	"DJ-AR":  {11.457, 42.742, BoundingBox{11.213, 42.366, 11.706, 43.049}},
+	"DJ-AS":  {11.153, 42.721, BoundingBox{10.930, 42.525, 11.337, 42.977}},
+	"DJ-DI":  {11.335, 42.081, BoundingBox{11.030, 41.660, 11.724, 42.525}},
+	"DJ-DJ":  {11.502, 43.059, BoundingBox{11.316, 42.920, 11.865, 43.186}},
+	"DJ-OB":  {12.264, 43.057, BoundingBox{11.858, 42.737, 12.700, 43.320}},
+	"DJ-TA":  {12.001, 42.396, BoundingBox{11.533, 41.728, 12.540, 42.947}},
+	"DK-81":  {57.022, 9.790, BoundingBox{56.361, 8.375, 57.730, 10.910}},
+	"DK-82":  {56.292, 9.209, BoundingBox{55.801, 8.090, 56.933, 10.848}},
+	"DK-83":  {55.395, 9.070, BoundingBox{54.830, 8.109, 55.866, 11.106}},
+	"DK-84":  {55.586, 12.349, BoundingBox{54.947, 11.354, 56.110, 15.240}},
+	"DK-85":  {55.378, 11.716, BoundingBox{54.800, 10.995, 55.882, 12.513}},
+	"DM-01":  {15.517, -61.454, BoundingBox{15.455, -61.557, 15.602, -61.380}},
+	"DM-02":  {15.551, -61.300, BoundingBox{15.472, -61.380, 15.619, -61.173}},
+	"DM-03":  {15.430, -61.228, BoundingBox{15.345, -61.340, 15.532, -61.143}},
+	"DM-04":  {15.302, -61.425, BoundingBox{15.240, -61.543, 15.351, -61.312}},
+	"DM-05":  {15.572, -61.458, BoundingBox{15.493, -61.557, 15.619, -61.375}},
+	"DM-06":  {15.413, -61.458, BoundingBox{15.323, -61.557, 15.493, -61.340}},
+	"DM-07":  {15.247, -61.377, BoundingBox{15.221, -61.455, 15.288, -61.317}},
+	"DM-08":  {15.236, -61.312, BoundingBox{15.221, -61.349, 15.266, -61.269}},
+	"DM-09":  {15.297, -61.247, BoundingBox{15.221, -61.324, 15.345, -61.143}},
+	"DM-10":  {15.366, -61.344, BoundingBox{15.322, -61.422, 15.428, -61.277}},
+	"DO-01":  {18.544, -69.870, BoundingBox{18.356, -70.081, 18.752, -69.636}},
+	"DO-02":  {18.562, -70.775, BoundingBox{18.302, -71.050, 18.851, -70.441}},
+	"DO-03":  {18.478, -71.332, BoundingBox{18.188, -71.574, 18.731, -71.050}},
+	"DO-04":  {18.150, -71.230, BoundingBox{17.842, -71.499, 18.390, -70.952}},
+	"DO-05":  {19.436, -71.564, BoundingBox{19.175, -71.705, 19.681, -71.397}},
+	"DO-06":  {19.180, -70.012, BoundingBox{18.984, -70.246, 19.396, -69.750}},
+	"DO-07":  {18.899, -71.550, BoundingBox{18.645, -71.810, 19.175, -71.372}},
+	"DO-08":  {18.818, -68.998, BoundingBox{18.591, -69.200, 19.015, -68.720}},
+	"DO-09":  {19.563, -70.478, BoundingBox{19.343, -70.716, 19.779, -70.128}},
+	"DO-10":  {18.462, -71.688, BoundingBox{18.134, -71.950, 18.715, -71.511}},
+	"DO-11":  {18.598, -68.613, BoundingBox{18.257, -68.842, 18.912, -68.320}},
+	"DO-12":  {18.450, -68.907, BoundingBox{18.210, -69.158, 18.665, -68.647}},
+	"DO-13":  {19.081, -70.631, BoundingBox{18.825, -70.892, 19.350, -70.344}},
+	"DO-14":  {19.420, -69.945, BoundingBox{19.149, -70.142, 19.650, -69.678}},
+	"DO-15":  {19.736, -71.433, BoundingBox{19.543, -71.710, 19.880, -71.164}},
+	"DO-16":  {17.904, -71.504, BoundingBox{17.600, -71.710, 18.192, -71.257}},
+	"DO-17":  {18.348, -70.401, BoundingBox{18.180, -70.599, 18.596, -70.143}},
+	"DO-18":  {19.733, -70.825, BoundingBox{19.532, -71.121, 19.880, -70.578}},
+	"DO-19":  {19.353, -70.313, BoundingBox{19.149, -70.589, 19.583, -70.118}},
+	"DO-20":  {19.158, -69.467, BoundingBox{18.971, -69.750, 19.310, -69.220}},
+	"DO-21":  {18.513, -70.188, BoundingBox{18.248, -70.434, 18.733, -70.024}},
+	"DO-22":  {18.872, -71.137, BoundingBox{18.589, -71.420, 19.127, -70.822}},
+	"DO-23":  {18.522, -69.398, BoundingBox{18.380, -69.643, 18.650, -69.134}},
+	"DO-24":  {18.957, -70.161, BoundingBox{18.725, -70.368, 19.181, -69.953}},
+	"DO-25":  {19.301, -70.887, BoundingBox{19.015, -71.100, 19.546, -70.625}},
+	"DO-26":  {19.304, -71.297, BoundingBox{19.078, -71.534, 19.578, -71.100}},
+	"DO-27":  {19.625, -71.088, BoundingBox{19.408, -71.290, 19.880, -70.842}},
+	"DO-28":  {18.816, -70.435, BoundingBox{18.595, -70.735, 19.072, -70.175}},
+	"DO-29":  {18.853, -69.759, BoundingBox{18.579, -70.039, 19.140, -69.530}},
+	"DO-30":  {18.824, -69.371, BoundingBox{18.650, -69.574, 19.034, -69.200}},
+	"DZ-01":  {25.672, -0.554, BoundingBox{21.327, -3.881, 30.231, 3.025}},
+	"DZ-02":  {36.231, 1.273, BoundingBox{35.755, 0.646, 36.633, 1.700}},
+	"DZ-03":  {33.330, 2.661, BoundingBox{32.189, 1.373, 34.466, 4.421}},
+	"DZ-04":  {35.825, 7.129, BoundingBox{35.489, 6.526, 36.263, 7.724}},
+	"DZ-05":  {35.448, 6.043, BoundingBox{34.841, 5.257, 35.979, 6.654}},
+	"DZ-06":  {36.582, 4.894, BoundingBox{36.276, 4.460, 36.870, 5.381}},
+	"DZ-07":  {34.121, 5.365, BoundingBox{32.813, 4.460, 35.305, 6.603}},
+	"DZ-08":  {30.447, -2.444, BoundingBox{28.298, -5.361, 32.396, 0.335}},
+	"DZ-09":  {36.401, 2.908, BoundingBox{36.181, 2.536, 36.733, 3.356}},
+	"DZ-10":  {36.107, 3.836, BoundingBox{35.589, 3.339, 36.558, 4.390}},
+	"DZ-11":  {23.361, 4.715, BoundingBox{19.060, 0.712, 28.077, 9.001}},
+	"DZ-12":  {34.971, 7.836, BoundingBox{34.189, 7.307, 35.626, 8.380}},
+	"DZ-13":  {34.551, -1.434, BoundingBox{33.731, -2.170, 35.287, -0.810}},
+	"DZ-14":  {34.907, 1.594, BoundingBox{34.129, 0.850, 35.704, 2.469}},
+	"DZ-15":  {36.638, 4.206, BoundingBox{36.348, 3.850, 36.854, 4.533}},
+	"DZ-16":  {36.635, 3.110, BoundingBox{36.378, 2.693, 36.789, 3.329}},
+	"DZ-17":  {34.320, 3.493, BoundingBox{33.053, 2.152, 35.349, 4.533}},
+	"DZ-18":  {36.716, 5.834, BoundingBox{36.336, 5.339, 37.110, 6.295}},
+	"DZ-19":  {35.993, 5.437, BoundingBox{35.353, 5.055, 36.530, 5.948}},
+	"DZ-20":  {34.416, 0.424, BoundingBox{33.781, -0.423, 35.000, 1.366}},
+	"DZ-21":  {36.856, 6.790, BoundingBox{36.474, 6.278, 37.119, 7.253}},
+	"DZ-22":  {34.722, -0.570, BoundingBox{34.087, -0.974, 35.291, -0.136}},
+	"DZ-23":  {36.813, 7.587, BoundingBox{36.516, 7.229, 37.120, 8.003}},
+	"DZ-24":  {36.408, 7.356, BoundingBox{36.028, 6.992, 36.779, 7.761}},
+	"DZ-25":  {36.263, 6.647, BoundingBox{35.845, 6.343, 36.653, 7.009}},
+	"DZ-26":  {35.720, 2.918, BoundingBox{35.128, 2.330, 36.250, 3.547}},
+	"DZ-27":  {35.901, 0.249, BoundingBox{35.600, -0.260, 36.347, 0.686}},
+	"DZ-28":  {35.224, 4.350, BoundingBox{34.370, 3.431, 35.877, 5.261}},
+	"DZ-29":  {35.274, 0.224, BoundingBox{35.000, -0.323, 35.600, 0.850}},
+	"DZ-30":  {30.140, 6.927, BoundingBox{27.333, 4.511, 32.828, 9.832}},
+	"DZ-31":  {35.578, -0.567, BoundingBox{35.273, -1.124, 35.868, -0.159}},
+	"DZ-32":  {32.470, 0.979, BoundingBox{30.950, -0.129, 34.130, 2.204}},
+	"DZ-33":  {25.461, 8.713, BoundingBox{21.809, 5.786, 29.222, 12.000}},
+	"DZ-34":  {35.987, 4.717, BoundingBox{35.491, 4.212, 36.402, 5.133}},
+	"DZ-35":  {36.594, 3.555, BoundingBox{36.270, 3.309, 36.817, 3.850}},
+	"DZ-36":  {36.692, 8.134, BoundingBox{36.366, 7.859, 36.950, 8.420}},
+	"DZ-37":  {27.455, -5.796, BoundingBox{24.298, -8.680, 29.960, -3.347}},
+	"DZ-38":  {35.587, 1.985, BoundingBox{35.022, 1.300, 36.067, 2.578}},
+	"DZ-39":  {33.175, 6.941, BoundingBox{32.048, 5.066, 34.350, 9.072}},
+	"DZ-40":  {34.969, 6.961, BoundingBox{34.350, 6.332, 35.594, 7.600}},
+	"DZ-41":  {36.021, 7.942, BoundingBox{35.585, 7.510, 36.539, 8.355}},
+	"DZ-42":  {36.526, 2.311, BoundingBox{36.225, 1.751, 36.730, 2.659}},
+	"DZ-43":  {36.342, 6.168, BoundingBox{35.928, 5.786, 36.846, 6.476}},
+	"DZ-44":  {36.196, 2.052, BoundingBox{35.773, 1.700, 36.638, 2.496}},
+	"DZ-45":  {33.092, -0.574, BoundingBox{31.673, -1.669, 34.106, 0.412}},
+	"DZ-46":  {35.335, -1.238, BoundingBox{34.980, -1.962, 35.724, -0.673}},
+	"DZ-47":  {30.159, 2.850, BoundingBox{27.470, 0.028, 33.059, 5.000}},
+	"DZ-48":  {35.669, 0.834, BoundingBox{35.091, 0.414, 36.234, 1.300}},
+	"EC-A":   {-3.102, -79.016, BoundingBox{-3.603, -79.809, -2.628, -78.246}},
+	"EC-B":   {-1.615, -79.088, BoundingBox{-2.084, -79.408, -1.061, -78.728}},
+	"EC-C":   {0.548, -77.904, BoundingBox{-0.069, -78.630, 1.239, -77.261}},
+	"EC-D":   {-0.849, -76.014, BoundingBox{-1.724, -76.979, -0.130, -75.230}},
+	"EC-E":   {0.719, -79.331, BoundingBox{0.192, -80.120, 1.380, -78.612}},
+	"EC-F":   {-2.468, -79.006, BoundingBox{-2.870, -79.660, -2.048, -78.450}},
+	"EC-G":   {-2.034, -79.883, BoundingBox{-2.749, -80.392, -1.492, -79.358}},
+	"EC-H":   {-1.931, -78.469, BoundingBox{-2.433, -79.029, -1.548, -77.811}},
+	"EC-I":   {0.381, -78.444, BoundingBox{-0.064, -78.988, 1.015, -77.684}},
+	"EC-L":   {-4.126, -79.697, BoundingBox{-4.848, -80.470, -3.561, -79.144}},
+	"EC-M":   {-0.916, -80.231, BoundingBox{-1.603, -80.930, 0.048, -79.548}},
+	"EC-N":   {-0.831, -77.547, BoundingBox{-1.725, -78.148, -0.003, -76.942}},
+	"EC-O":   {-3.408, -79.840, BoundingBox{-4.056, -80.465, -2.747, -79.228}},
+	"EC-P":   {-0.228, -78.354, BoundingBox{-0.656, -78.877, 0.153, -77.675}},
+	"EC-R":   {-1.367, -79.547, BoundingBox{-1.983, -80.023, -0.828, -79.178}},
+	"EC-S":   {-2.514, -77.792, BoundingBox{-3.421, -78.450, -1.689, -76.839}},
+	"EC-SD":  {-0.204, -79.434, BoundingBox{-0.878, -80.205, 0.300, -78.836}},
+	"EC-SE":  {-2.086, -80.542, BoundingBox{-2.690, -80.970, -1.448, -80.157}},
+	"EC-T":   {-1.297, -78.354, BoundingBox{-1.689, -78.944, -0.824, -77.811}},
+	"EC-U":   {-0.023, -76.560, BoundingBox{-0.478, -77.411, 0.420, -75.408}},
+	"EC-W":   {-0.755, -90.791, BoundingBox{-1.257, -91.557, -0.125, -89.267}},
+	"EC-X":   {-0.798, -78.749, BoundingBox{-1.178, -79.338, -0.421, -78.132}},
+	"EC-Y":   {-1.804, -76.726, BoundingBox{-2.675, -77.616, -0.955, -75.711}},
+	"EC-Z":   {-4.080, -78.832, BoundingBox{-4.960, -79.300, -3.358, -78.135}},
+	"EE-37":  {59.336, 24.875, BoundingBox{59.150, 23.972, 59.585, 25.635}},
+	"EE-39":  {58.880, 22.600, BoundingBox{58.644, 22.278, 59.046, 22.922}},
+	"EE-44":  {59.171, 27.409, BoundingBox{58.723, 26.850, 59.480, 28.130}},
+	"EE-49":  {58.762, 26.476, BoundingBox{58.462, 25.850, 59.005, 27.255}},
+	"EE-51":  {58.912, 25.538, BoundingBox{58.613, 25.080, 59.313, 26.061}},
+	"EE-57":  {58.929, 23.789, BoundingBox{58.493, 23.340, 59.331, 24.225}},
+	"EE-59":  {59.285, 26.233, BoundingBox{58.957, 25.539, 59.610, 26.850}},
+	"EE-65":  {58.080, 27.181, BoundingBox{57.841, 26.523, 58.389, 27.704}},
+	"EE-67":  {58.333, 24.520, BoundingBox{57.790, 23.641, 58.725, 25.090}},
+	"EE-70":  {58.915, 24.702, BoundingBox{58.619, 24.225, 59.150, 25.202}},
+	"EE-74":  {58.380, 22.450, BoundingBox{58.114, 21.943, 58.646, 22.957}},
+	"EE-78":  {58.432, 26.852, BoundingBox{58.115, 26.161, 58.760, 27.527}},
+	"EE-82":  {57.913, 26.089, BoundingBox{57.521, 25.254, 58.230, 26.620}},
+	"EE-84":  {58.317, 25.534, BoundingBox{57.931, 24.976, 58.632, 26.198}},
+	"EE-86":  {57.692, 26.999, BoundingBox{57.470, 26.364, 57.939, 27.720}},
+	"EG-ALX": {30.610, 29.278, BoundingBox{29.544, 28.460, 31.491, 30.393}},
+	"EG-ASN": {23.622, 32.513, BoundingBox{22.000, 30.920, 25.156, 34.386}},
+	"EG-AST": {27.016, 30.620, BoundingBox{25.989, 29.233, 27.961, 32.049}},
+	"EG-BA":  {24.612, 34.166, BoundingBox{22.000, 31.929, 28.646, 36.870}},
+	"EG-BH":  {30.439, 30.000, BoundingBox{29.627, 28.856, 31.200, 30.667}},
+	"EG-BNS": {28.816, 31.404, BoundingBox{28.292, 30.095, 29.376, 32.379}},
+	"EG-C":   {30.116, 31.430, BoundingBox{29.940, 31.021, 30.252, 31.856}},
+	"EG-DK":  {31.072, 31.506, BoundingBox{30.821, 31.178, 31.385, 31.942}},
+	"EG-DT":  {31.331, 31.595, BoundingBox{31.081, 31.327, 31.496, 31.879}},
+	"EG-FYM": {29.165, 29.822, BoundingBox{28.723, 28.455, 29.646, 31.325}},
+	"EG-GH":  {30.872, 30.960, BoundingBox{30.643, 30.579, 31.093, 31.315}},
+	"EG-GZ":  {29.881, 31.054, BoundingBox{29.590, 30.925, 30.153, 31.275}},
+	"EG-HU":  {29.658, 31.590, BoundingBox{29.159, 31.056, 30.171, 31.984}},
+	"EG-IS":  {30.590, 32.393, BoundingBox{30.157, 31.838, 30.943, 32.975}},
+	"EG-JS":  {28.822, 33.811, BoundingBox{27.705, 32.372, 29.644, 34.920}},
+	"EG-KB":  {30.326, 31.271, BoundingBox{30.143, 30.879, 30.599, 31.711}},
+	"EG-KFS": {31.313, 30.832, BoundingBox{31.040, 30.310, 31.560, 31.345}},
+	"EG-KN":  {25.990, 32.709, BoundingBox{25.061, 31.623, 26.925, 33.870}},
+	"EG-MN":  {28.084, 29.999, BoundingBox{27.126, 28.318, 28.777, 32.040}},
+	"EG-MNF": {30.483, 30.864, BoundingBox{30.205, 30.522, 30.710, 31.275}},
+	"EG-MT":  {29.615, 26.525, BoundingBox{26.794, 24.700, 31.590, 28.723}},
+	"EG-PTS": {31.034, 32.476, BoundingBox{30.882, 31.974, 31.260, 33.053}},
+	"EG-SHG": {26.063, 31.380, BoundingBox{24.830, 30.196, 27.353, 32.442}},
+	"EG-SHR": {30.627, 31.656, BoundingBox{30.198, 31.258, 30.964, 31.982}},
+	"EG-SIN": {30.330, 33.875, BoundingBox{29.591, 32.975, 31.220, 34.865}},
+	"EG-SU":  {29.808, 30.011, BoundingBox{29.373, 28.691, 30.335, 30.925}},
+	"EG-SUZ": {29.665, 32.604, BoundingBox{28.680, 31.871, 30.428, 33.521}},
+	"EG-WAD": {24.699, 27.882, BoundingBox{22.000, 25.000, 28.606, 31.564}},
+	"ER-AN":  {16.385, 37.700, BoundingBox{15.021, 36.802, 17.564, 38.475}},
+	"ER-DK":  {13.442, 41.865, BoundingBox{12.460, 40.706, 14.558, 43.080}},
+	"ER-DU":  {14.875, 38.740, BoundingBox{14.487, 37.708, 15.125, 39.491}},
+	"ER-GB":  {15.156, 37.063, BoundingBox{14.210, 36.320, 16.640, 37.889}},
+	"ER-MA":  {15.458, 38.854, BoundingBox{15.125, 38.001, 15.872, 39.603}},
+	"ER-SK":  {15.919, 39.267, BoundingBox{14.209, 38.020, 18.000, 41.081}},
+	"ES-A":   {38.519, -0.635, BoundingBox{37.712, -1.265, 38.987, 0.110}},
+	"ES-AB":  {38.848, -2.080, BoundingBox{38.182, -2.917, 39.449, -1.145}},
+	"ES-AL":  {37.218, -2.323, BoundingBox{36.664, -2.942, 37.937, -1.546}},
+	"ES-AN":  {37.457, -4.579, BoundingBox{36.061, -7.540, 38.851, -1.546}},
+	"ES-AR":  {41.493, -0.722, BoundingBox{39.988, -1.847, 42.825, 0.668}},
+	"ES-AS":  {43.277, -5.874, BoundingBox{42.942, -6.835, 43.582, -4.924}},
+	"ES-AV":  {40.491, -4.987, BoundingBox{39.778, -5.642, 41.133, -4.306}},
+	"ES-B":   {41.692, 1.908, BoundingBox{41.148, 1.356, 42.379, 2.583}},
+	"ES-BA":  {38.681, -6.280, BoundingBox{37.992, -7.370, 39.305, -5.104}},
+	"ES-BI":  {43.224, -3.007, BoundingBox{42.895, -3.440, 43.458, -2.470}},
+	"ES-BU":  {42.233, -3.559, BoundingBox{41.612, -4.084, 42.869, -3.025}},
+	"ES-C":   {43.152, -8.431, BoundingBox{42.664, -9.390, 43.750, -7.805}},
+	"ES-CA":  {36.614, -5.785, BoundingBox{36.061, -6.490, 37.042, -5.088}},
+	"ES-CB":  {43.122, -4.135, BoundingBox{42.668, -4.974, 43.492, -3.403}},
+	"ES-CC":  {39.686, -6.207, BoundingBox{39.094, -7.500, 40.321, -5.150}},
+	"ES-CE":  {36.137, -5.397, BoundingBox{35.845, -5.904, 36.412, -4.958}},
+	"ES-CL":  {41.603, -4.808, BoundingBox{39.778, -6.870, 43.073, -1.809}},
+	"ES-CM":  {39.563, -3.004, BoundingBox{38.182, -5.177, 41.250, -1.145}},
+	"ES-CN":  {28.411, -14.000, BoundingBox{27.632, -18.142, 29.283, -13.384}},
+	"ES-CO":  {38.031, -4.799, BoundingBox{37.321, -5.639, 38.851, -4.100}},
+	"ES-CR":  {38.938, -3.880, BoundingBox{38.310, -5.140, 39.573, -2.874}},
+	"ES-CS":  {40.248, -0.191, BoundingBox{39.627, -0.989, 40.891, 0.542}},
+	"ES-CT":  {41.739, 1.370, BoundingBox{40.517, -0.154, 42.800, 3.040}},
+	"ES-CU":  {39.861, -2.210, BoundingBox{39.242, -3.164, 40.566, -1.234}},
+	"ES-EX":  {39.180, -6.244, BoundingBox{37.992, -7.500, 40.321, -5.104}},
+	"ES-GA":  {42.773, -7.835, BoundingBox{41.790, -9.390, 43.750, -6.623}},
+	"ES-GC":  {28.460, -14.284, BoundingBox{27.726, -15.854, 29.283, -13.384}},
+	"ES-GI":  {42.095, 2.583, BoundingBox{41.573, 1.911, 42.470, 3.040}},
+	"ES-GR":  {37.192, -3.351, BoundingBox{36.660, -4.132, 37.764, -2.664}},
+	"ES-GU":  {40.795, -2.512, BoundingBox{40.167, -3.322, 41.250, -1.668}},
+	"ES-H":   {37.533, -6.803, BoundingBox{36.880, -7.540, 38.324, -6.217}},
+	"ES-HU":  {42.164, -0.135, BoundingBox{41.326, -0.947, 42.825, 0.668}},
+	"ES-IB":  {39.584, 2.959, BoundingBox{38.607, 1.206, 40.158, 4.351}},
+	"ES-J":   {38.063, -3.350, BoundingBox{37.473, -4.147, 38.675, -2.474}},
+	"ES-L":   {42.097, 1.019, BoundingBox{41.483, 0.368, 42.800, 1.733}},
+	"ES-LE":  {42.586, -5.902, BoundingBox{42.103, -6.772, 43.012, -5.044}},
+	"ES-LO":  {42.256, -2.434, BoundingBox{41.939, -3.025, 42.672, -1.740}},
+	"ES-LU":  {43.094, -7.316, BoundingBox{42.536, -7.994, 43.724, -6.623}},
+	"ES-M":   {40.378, -3.594, BoundingBox{39.730, -4.351, 40.982, -2.929}},
+	"ES-MA":  {36.927, -4.568, BoundingBox{36.344, -5.272, 37.418, -3.709}},
+	"ES-MC":  {37.949, -1.564, BoundingBox{37.325, -2.483, 38.560, -0.657}},
+	"ES-MD":  {40.378, -3.594, BoundingBox{39.730, -4.351, 40.982, -2.929}},
+	"ES-ML":  {35.290, -2.940, BoundingBox{35.257, -2.981, 35.323, -2.899}},
+	"ES-MU":  {37.949, -1.564, BoundingBox{37.325, -2.483, 38.560, -0.657}},
+	"ES-NA":  {42.595, -1.464, BoundingBox{42.030, -2.197, 43.160, -0.660}},
+	"ES-NC":  {42.595, -1.464, BoundingBox{42.030, -2.197, 43.160, -0.660}},
+	"ES-O":   {43.277, -5.874, BoundingBox{42.942, -6.835, 43.582, -4.924}},
+	"ES-OR":  {42.210, -7.445, BoundingBox{41.790, -8.150, 42.634, -6.677}},
+	"ES-P":   {42.426, -4.638, BoundingBox{41.875, -5.308, 43.073, -4.024}},
+	"ES-PM":  {39.584, 2.959, BoundingBox{38.607, 1.206, 40.158, 4.351}},
+	"ES-PO":  {42.459, -8.603, BoundingBox{41.880, -9.274, 42.905, -7.968}},
+	"ES-PV":  {43.042, -2.648, BoundingBox{42.473, -3.440, 43.458, -1.633}},
+	"ES-RI":  {42.256, -2.434, BoundingBox{41.939, -3.025, 42.672, -1.740}},
+	"ES-S":   {43.122, -4.135, BoundingBox{42.668, -4.974, 43.492, -3.403}},
+	"ES-SA":  {40.779, -6.131, BoundingBox{40.211, -6.870, 41.275, -5.310}},
+	"ES-SE":  {37.503, -5.699, BoundingBox{36.969, -6.277, 38.190, -4.941}},
+	"ES-SG":  {41.210, -3.931, BoundingBox{40.658, -4.710, 41.783, -3.224}},
+	"ES-SO":  {41.592, -2.551, BoundingBox{41.197, -3.407, 42.000, -1.809}},
+	"ES-SS":  {43.141, -2.135, BoundingBox{42.713, -2.547, 43.434, -1.633}},
+	"ES-T":   {41.180, 0.615, BoundingBox{40.517, -0.154, 41.646, 1.610}},
+	"ES-TE":  {40.689, -0.902, BoundingBox{39.988, -1.720, 41.291, 0.029}},
+	"ES-TF":  {28.320, -16.600, BoundingBox{27.632, -18.142, 28.863, -16.317}},
+	"ES-TO":  {39.675, -4.317, BoundingBox{39.080, -5.177, 40.289, -3.147}},
+	"ES-V":   {39.395, -0.835, BoundingBox{38.827, -1.555, 39.995, -0.059}},
+	"ES-VA":  {41.583, -4.835, BoundingBox{41.057, -5.448, 42.089, -4.002}},
+	"ES-VC":  {39.398, -0.583, BoundingBox{37.712, -1.555, 40.891, 0.542}},
+	"ES-VI":  {42.788, -2.792, BoundingBox{42.473, -3.414, 43.106, -2.189}},
+	"ES-Z":   {41.590, -1.137, BoundingBox{40.975, -1.847, 42.291, -0.142}},
+	"ES-ZA":  {41.766, -6.016, BoundingBox{41.275, -6.836, 42.263, -5.300}},
+	"ET-AA":  {9.000, 38.750, BoundingBox{8.850, 38.600, 9.150, 38.900}},
+	"ET-AF":  {11.914, 40.635, BoundingBox{8.674, 39.112, 14.524, 42.350}},
+	"ET-AM":  {11.839, 37.854, BoundingBox{9.631, 35.352, 14.420, 40.425}},
+	"ET-BE":  {10.532, 35.442, BoundingBox{9.164, 33.963, 12.156, 36.997}},
+	"ET-DD":  {10.243, 41.863, BoundingBox{9.312, 41.027, 11.110, 42.780}},
+	"ET-GA":  {7.961, 34.639, BoundingBox{6.426, 32.950, 9.589, 35.980}},
+	"ET-HA":  {9.353, 42.422, BoundingBox{9.208, 42.007, 9.488, 42.887}},
+	"ET-OR":  {7.377, 39.273, BoundingBox{3.420, 35.097, 10.525, 43.023}},
+	"ET-SN":  {6.184, 36.736, BoundingBox{4.146, 34.800, 7.891, 38.577}},
+	"ET-SO":  {6.641, 43.830, BoundingBox{3.920, 40.681, 9.900, 47.790}},
+	"ET-TI":  {13.760, 38.606, BoundingBox{12.391, 37.252, 14.960, 39.769}},
+	"FI-01":  {60.200, 19.950, BoundingBox{59.826, 19.198, 60.574, 20.702}},
+	"FI-02":  {61.220, 28.500, BoundingBox{60.498, 27.044, 61.833, 30.135}},
+	"FI-03":  {62.678, 23.099, BoundingBox{62.117, 21.559, 63.349, 24.523}},
+	"FI-04":  {61.831, 27.575, BoundingBox{61.201, 25.756, 62.359, 29.602}},
+	"FI-05":  {64.269, 28.416, BoundingBox{63.548, 26.323, 65.097, 30.440}},
+	"FI-06":  {60.986, 24.113, BoundingBox{60.573, 22.930, 61.433, 25.170}},
+	"FI-07":  {63.636, 24.181, BoundingBox{63.003, 22.262, 64.322, 26.172}},
+	"FI-08":  {62.460, 25.453, BoundingBox{61.741, 24.009, 63.228, 27.073}},
+	"FI-09":  {60.764, 26.991, BoundingBox{60.436, 26.090, 61.214, 28.028}},
+	"FI-10":  {67.895, 26.050, BoundingBox{65.610, 20.650, 70.160, 29.980}},
+	"FI-11":  {61.773, 23.796, BoundingBox{61.199, 22.509, 62.321, 25.273}},
+	"FI-12":  {62.962, 21.887, BoundingBox{62.229, 21.060, 63.695, 22.853}},
+	"FI-13":  {62.717, 29.905, BoundingBox{61.735, 28.490, 63.810, 31.520}},
+	"FI-14":  {65.412, 27.231, BoundingBox{63.727, 23.505, 67.245, 30.220}},
+	"FI-15":  {63.083, 27.419, BoundingBox{62.320, 25.613, 63.772, 29.040}},
+	"FI-16":  {61.245, 25.700, BoundingBox{60.740, 24.755, 61.754, 26.890}},
+	"FI-17":  {61.551, 22.117, BoundingBox{60.921, 21.263, 62.240, 23.115}},
+	"FI-18":  {60.366, 24.455, BoundingBox{59.850, 22.665, 60.821, 26.629}},
+	"FI-19":  {60.678, 22.445, BoundingBox{60.024, 21.320, 61.061, 23.445}},
+	"FJ-C":   {-17.909, 178.216, BoundingBox{-18.290, 177.762, -17.388, 178.622}},
+	"FJ-E":   {-17.698, 178.510, BoundingBox{-19.095, 178.152, -17.340, 178.720}},
+	"FJ-N":   {-16.543, 179.415, BoundingBox{-17.010, 178.600, -16.020, -179.790}},
+	"FJ-R":   {-12.500, 177.070, BoundingBox{-12.545, 177.024, -12.455, 177.116}},
+	"FJ-W":   {-17.798, 177.672, BoundingBox{-18.250, 177.290, -17.380, 178.116}},
+	"FM-KSA": {5.320, 162.980, BoundingBox{5.220, 162.880, 5.420, 163.080}},
+	"FM-PNI": {6.880, 158.220, BoundingBox{6.730, 158.069, 7.030, 158.371}},
+	"FM-TRK": {7.420, 151.780, BoundingBox{7.129, 151.487, 7.711, 152.073}},
+	"FM-YAP": {9.530, 138.120, BoundingBox{9.405, 137.994, 9.655, 138.246}},
+	"FR-01":  {46.092, 5.394, BoundingBox{45.652, 4.781, 46.527, 5.900}},
+	"FR-02":  {49.577, 3.536, BoundingBox{49.009, 2.923, 50.081, 4.119}},
+	"FR-03":  {46.407, 3.182, BoundingBox{46.075, 2.421, 46.821, 3.922}},
+	"FR-04":  {44.091, 6.163, BoundingBox{43.757, 5.615, 44.423, 6.776}},
+	"FR-05":  {44.684, 6.324, BoundingBox{44.329, 5.702, 45.064, 6.950}},
+	"FR-06":  {43.912, 7.056, BoundingBox{43.429, 6.597, 44.430, 7.550}},
+	"FR-07":  {44.776, 4.454, BoundingBox{44.319, 3.913, 45.295, 4.863}},
+	"FR-08":  {49.612, 4.653, BoundingBox{49.178, 4.053, 50.069, 5.348}},
+	"FR-09":  {42.856, 1.480, BoundingBox{42.387, 0.788, 43.327, 2.025}},
+	"FR-10":  {48.293, 4.164, BoundingBox{47.799, 3.458, 48.665, 4.826}},
+	"FR-11":  {43.152, 2.471, BoundingBox{42.809, 1.845, 43.525, 3.167}},
+	"FR-12":  {44.301, 2.646, BoundingBox{43.847, 2.000, 44.675, 3.242}},
+	"FR-13":  {43.540, 5.031, BoundingBox{43.256, 4.209, 43.820, 5.687}},
+	"FR-14":  {49.172, -0.289, BoundingBox{48.642, -0.825, 49.698, 0.325}},
+	"FR-15":  {45.045, 2.673, BoundingBox{44.675, 2.035, 45.533, 3.241}},
+	"FR-16":  {45.703, 0.194, BoundingBox{45.197, -0.318, 46.160, 0.824}},
+	"FR-17":  {45.734, -0.770, BoundingBox{45.235, -1.299, 46.244, -0.237}},
+	"FR-18":  {47.069, 2.486, BoundingBox{46.532, 1.855, 47.528, 3.027}},
+	"FR-19":  {45.357, 1.849, BoundingBox{44.926, 1.197, 45.740, 2.511}},
+	"FR-21":  {47.404, 4.756, BoundingBox{46.956, 4.033, 47.881, 5.520}},
+	"FR-22":  {48.493, -2.884, BoundingBox{48.099, -3.606, 48.900, -2.061}},
+	"FR-23":  {46.126, 2.092, BoundingBox{45.678, 1.433, 46.584, 2.671}},
+	"FR-24":  {45.095, 0.726, BoundingBox{44.660, 0.011, 45.553, 1.394}},
+	"FR-25":  {47.192, 6.229, BoundingBox{46.834, 5.629, 47.466, 6.770}},
+	"FR-26":  {44.676, 5.221, BoundingBox{44.350, 4.744, 45.144, 5.740}},
+	"FR-27":  {49.083, 0.939, BoundingBox{48.655, 0.325, 49.375, 1.585}},
+	"FR-28":  {48.351, 1.258, BoundingBox{47.987, 0.672, 48.780, 1.862}},
+	"FR-29":  {48.306, -3.991, BoundingBox{47.741, -4.590, 48.848, -3.418}},
+	"FR-2A":  {41.843, 9.030, BoundingBox{41.380, 8.547, 42.240, 9.509}},
+	"FR-2B":  {42.455, 9.127, BoundingBox{42.031, 8.540, 43.010, 9.560}},
+	"FR-30":  {43.974, 4.198, BoundingBox{43.404, 3.544, 44.412, 4.675}},
+	"FR-31":  {43.375, 1.196, BoundingBox{42.960, 0.611, 43.716, 1.868}},
+	"FR-32":  {43.702, 0.363, BoundingBox{43.306, -0.268, 44.025, 1.012}},
+	"FR-33":  {44.865, -0.617, BoundingBox{44.383, -1.334, 45.335, 0.167}},
+	"FR-34":  {43.568, 3.404, BoundingBox{43.095, 2.717, 44.061, 4.214}},
+	"FR-35":  {48.195, -1.684, BoundingBox{47.735, -2.306, 48.708, -1.150}},
+	"FR-36":  {46.782, 1.543, BoundingBox{46.345, 0.921, 47.234, 2.213}},
+	"FR-37":  {47.249, 0.617, BoundingBox{46.841, 0.002, 47.718, 1.302}},
+	"FR-38":  {45.284, 5.484, BoundingBox{44.839, 4.730, 45.714, 6.116}},
+	"FR-39":  {46.787, 5.590, BoundingBox{46.328, 5.055, 47.281, 6.175}},
+	"FR-40":  {44.012, -0.827, BoundingBox{43.625, -1.722, 44.502, -0.058}},
+	"FR-41":  {47.614, 1.416, BoundingBox{47.184, 0.743, 48.009, 2.068}},
+	"FR-42":  {45.767, 4.066, BoundingBox{45.283, 3.650, 46.275, 4.611}},
+	"FR-43":  {45.137, 3.775, BoundingBox{44.737, 3.205, 45.509, 4.528}},
+	"FR-44":  {47.350, -1.760, BoundingBox{46.856, -2.551, 47.763, -1.101}},
+	"FR-45":  {47.858, 2.361, BoundingBox{47.438, 1.670, 48.217, 2.967}},
+	"FR-46":  {44.627, 1.623, BoundingBox{44.208, 0.941, 45.028, 2.260}},
+	"FR-47":  {44.385, 0.389, BoundingBox{44.025, -0.277, 44.808, 1.045}},
+	"FR-48":  {44.481, 3.492, BoundingBox{44.045, 2.940, 44.898, 4.092}},
+	"FR-49":  {47.375, -0.546, BoundingBox{46.932, -1.158, 47.792, 0.124}},
+	"FR-50":  {49.103, -1.334, BoundingBox{48.568, -1.930, 49.780, -0.825}},
+	"FR-51":  {48.942, 4.212, BoundingBox{48.579, 3.468, 49.372, 4.837}},
+	"FR-52":  {48.129, 5.203, BoundingBox{47.623, 4.548, 48.585, 5.820}},
+	"FR-53":  {48.184, -0.718, BoundingBox{47.740, -1.150, 48.674, -0.151}},
+	"FR-54":  {48.968, 5.955, BoundingBox{48.364, 5.229, 49.700, 6.807}},
+	"FR-55":  {48.940, 5.252, BoundingBox{48.530, 4.781, 49.428, 5.839}},
+	"FR-56":  {47.807, -2.798, BoundingBox{47.284, -3.649, 48.148, -2.095}},
+	"FR-57":  {48.994, 6.695, BoundingBox{48.586, 6.124, 49.364, 7.312}},
+	"FR-58":  {47.101, 3.547, BoundingBox{46.641, 2.959, 47.469, 4.204}},
+	"FR-59":  {50.521, 2.899, BoundingBox{49.952, 1.618, 51.150, 4.035}},
+	"FR-60":  {49.446, 2.502, BoundingBox{49.158, 1.717, 49.746, 3.086}},
+	"FR-61":  {48.612, 0.153, BoundingBox{48.276, -0.610, 49.044, 0.777}},
+	"FR-62":  {50.482, 2.071, BoundingBox{50.225, 1.390, 50.889, 2.809}},
+	"FR-63":  {45.731, 3.094, BoundingBox{45.296, 2.418, 46.075, 3.650}},
+	"FR-64":  {43.288, -0.963, BoundingBox{42.779, -1.900, 43.625, -0.149}},
+	"FR-65":  {43.009, 0.183, BoundingBox{42.580, -0.473, 43.474, 0.819}},
+	"FR-66":  {42.621, 2.436, BoundingBox{42.340, 1.716, 42.909, 3.069}},
+	"FR-67":  {48.696, 7.435, BoundingBox{48.219, 6.843, 49.119, 8.100}},
+	"FR-68":  {47.967, 7.232, BoundingBox{47.536, 6.792, 48.309, 7.571}},
+	"FR-69":  {45.813, 4.718, BoundingBox{45.284, 4.225, 46.268, 5.242}},
+	"FR-70":  {47.637, 5.955, BoundingBox{47.258, 5.387, 48.037, 6.500}},
+	"FR-71":  {46.637, 4.460, BoundingBox{46.219, 3.780, 47.092, 5.130}},
+	"FR-72":  {47.958, 0.289, BoundingBox{47.521, -0.329, 48.342, 0.930}},
+	"FR-73":  {45.414, 6.446, BoundingBox{45.026, 5.796, 45.750, 7.100}},
+	"FR-74":  {46.011, 6.347, BoundingBox{45.750, 5.843, 46.430, 6.840}},
+	"FR-75":  {48.859, 2.358, BoundingBox{48.820, 2.281, 48.906, 2.445}},
+	"FR-76":  {49.688, 1.004, BoundingBox{49.375, 0.050, 50.265, 1.750}},
+	"FR-77":  {48.582, 3.132, BoundingBox{48.098, 2.600, 49.095, 3.662}},
+	"FR-78":  {48.812, 1.710, BoundingBox{48.485, 1.299, 49.156, 2.057}},
+	"FR-79":  {46.551, -0.347, BoundingBox{46.073, -0.874, 47.035, 0.075}},
+	"FR-80":  {49.950, 2.238, BoundingBox{49.593, 1.445, 50.225, 3.060}},
+	"FR-81":  {43.772, 2.173, BoundingBox{43.380, 1.573, 44.241, 2.824}},
+	"FR-82":  {44.039, 1.299, BoundingBox{43.678, 0.746, 44.431, 1.899}},
+	"FR-83":  {43.473, 6.257, BoundingBox{43.130, 5.608, 43.789, 7.015}},
+	"FR-84":  {44.050, 5.192, BoundingBox{43.713, 4.675, 44.350, 5.733}},
+	"FR-85":  {46.655, -1.273, BoundingBox{46.120, -2.028, 47.109, -0.731}},
+	"FR-86":  {46.525, 0.523, BoundingBox{46.085, 0.075, 46.985, 1.134}},
+	"FR-87":  {45.914, 1.176, BoundingBox{45.441, 0.612, 46.392, 1.770}},
+	"FR-88":  {48.228, 6.422, BoundingBox{47.860, 5.763, 48.591, 7.070}},
+	"FR-89":  {47.793, 3.512, BoundingBox{47.433, 2.871, 48.275, 4.288}},
+	"FR-90":  {47.662, 6.821, BoundingBox{47.326, 6.500, 47.987, 7.331}},
+	"FR-91":  {48.404, 2.232, BoundingBox{48.184, 1.792, 48.652, 2.700}},
+	"FR-92":  {48.815, 2.176, BoundingBox{48.643, 2.004, 49.006, 2.324}},
+	"FR-93":  {49.015, 2.702, BoundingBox{48.832, 2.260, 49.173, 3.184}},
+	"FR-94":  {48.725, 2.536, BoundingBox{48.550, 2.315, 48.846, 2.822}},
+	"FR-95":  {49.200, 1.954, BoundingBox{48.938, 1.577, 49.501, 2.464}},
+	"FR-ARA": {45.524, 4.483, BoundingBox{44.319, 2.035, 46.821, 7.100}},
+	"FR-BFC": {47.243, 4.790, BoundingBox{46.219, 2.871, 48.275, 7.331}},
+	"FR-BL":  {17.900, -62.830, BoundingBox{17.834, -62.900, 17.966, -62.760}},
+	"FR-BRE": {48.215, -2.848, BoundingBox{47.284, -4.590, 48.900, -1.150}},
+	"FR-COR": {42.182, 9.084, BoundingBox{41.380, 8.540, 43.010, 9.560}},
+	"FR-CP":  {10.300, -109.220, BoundingBox{10.255, -109.266, 10.345, -109.174}},
+	"FR-CVL": {47.469, 1.639, BoundingBox{46.345, 0.002, 48.780, 3.027}},
+	"FR-GES": {48.704, 5.551, BoundingBox{47.536, 3.458, 50.069, 8.100}},
+	"FR-GF":  {3.909, -53.238, BoundingBox{2.050, -54.520, 5.760, -51.660}},
+	"FR-GP":  {16.180, -61.529, BoundingBox{15.847, -61.853, 16.491, -61.184}},
+	"FR-GUA": {16.180, -61.529, BoundingBox{15.847, -61.853, 16.491, -61.184}},
+	"FR-HDF": {50.020, 2.726, BoundingBox{49.009, 1.390, 51.150, 4.119}},
+	"FR-IDF": {48.737, 2.490, BoundingBox{48.098, 1.299, 49.501, 3.662}},
+	"FR-LRE": {-21.130, 55.530, BoundingBox{-21.462, 55.174, -20.798, 55.886}},
+	"FR-MAY": {-12.820, 45.150, BoundingBox{-12.986, 44.980, -12.654, 45.320}},
+	"FR-MF":  {18.098, -63.065, BoundingBox{18.055, -63.160, 18.135, -62.960}},
+	"FR-MQ":  {14.640, -61.020, BoundingBox{14.349, -61.321, 14.931, -60.719}},
+	"FR-NAQ": {45.182, 0.169, BoundingBox{42.779, -1.900, 47.035, 2.671}},
+	"FR-NC":  {-21.263, 165.537, BoundingBox{-22.400, 164.030, -20.110, 167.120}},
+	"FR-NOR": {49.165, 0.073, BoundingBox{48.276, -1.930, 50.265, 1.750}},
+	"FR-OCC": {43.663, 2.106, BoundingBox{42.340, -0.473, 45.028, 4.675}},
+	"FR-PAC": {43.977, 6.026, BoundingBox{43.130, 4.209, 45.064, 7.550}},
+	"FR-PDL": {47.484, -0.847, BoundingBox{46.120, -2.551, 48.674, 0.930}},
+	"FR-PF":  {-15.100, -147.650, BoundingBox{-23.453, -151.827, -8.704, -134.880}},
+	"FR-PM":  {46.920, -56.320, BoundingBox{46.804, -56.490, 47.036, -56.150}},
+	"FR-RE":  {-21.130, 55.530, BoundingBox{-21.462, 55.174, -20.798, 55.886}},
+	"FR-TF":  {-49.309, 69.532, BoundingBox{-49.780, 68.720, -48.630, 70.560}},
+	"FR-WF":  {-13.280, -176.200, BoundingBox{-14.373, -178.216, -13.197, -176.115}},
+	"FR-YT":  {-12.820, 45.150, BoundingBox{-12.986, 44.980, -12.654, 45.320}},
+	"GA-1":   {0.405, 9.980, BoundingBox{-0.548, 9.005, 1.070, 11.112}},
+	"GA-2":   {-1.433, 13.680, BoundingBox{-2.470, 12.756, -0.174, 14.430}},
+	"GA-3":   {-0.436, 10.641, BoundingBox{-1.377, 9.310, 0.567, 11.627}},
+	"GA-4":   {-1.759, 11.126, BoundingBox{-2.350, 10.207, -0.822, 12.170}},
+	"GA-5":   {-2.985, 10.975, BoundingBox{-3.980, 9.868, -2.350, 12.267}},
+	"GA-6":   {0.547, 12.917, BoundingBox{-0.434, 11.385, 1.799, 14.280}},
+	"GA-7":   {-1.098, 12.352, BoundingBox{-2.431, 11.457, -0.108, 13.365}},
+	"GA-8":   {-1.523, 9.606, BoundingBox{-2.716, 8.800, -0.526, 10.346}},
+	"GA-9":   {1.542, 11.910, BoundingBox{0.418, 10.768, 2.330, 13.080}},
+	"GB-ABC": {54.251, -6.619, BoundingBox{53.942, -7.073, 54.562, -6.180}},
+	"GB-ABD": {57.234, -2.575, BoundingBox{56.689, -3.305, 57.687, -1.960}},
+	"GB-ABE": {57.167, -2.236, BoundingBox{56.927, -2.424, 57.356, -2.064}},
+	"GB-AGB": {56.258, -5.440, BoundingBox{55.429, -6.150, 56.916, -4.794}},
+	"GB-AGY": {53.241, -4.366, BoundingBox{52.883, -4.758, 53.500, -3.890}},
+	"GB-AND": {54.509, -5.760, BoundingBox{54.387, -5.837, 54.613, -5.660}},
+	"GB-ANN": {54.697, -6.221, BoundingBox{54.574, -6.455, 54.800, -5.921}},
+	"GB-ANS": {56.762, -3.004, BoundingBox{56.546, -3.475, 57.040, -2.401}},
+	"GB-BAS": {51.262, -2.422, BoundingBox{51.069, -2.699, 51.440, -2.164}},
+	"GB-BBD": {53.707, -2.492, BoundingBox{53.635, -2.689, 53.796, -2.310}},
+	"GB-BDF": {52.224, -0.415, BoundingBox{52.086, -0.724, 52.411, -0.048}},
+	"GB-BDG": {51.536, 0.137, BoundingBox{51.497, 0.072, 51.593, 0.202}},
+	"GB-BEN": {51.561, -0.262, BoundingBox{51.523, -0.332, 51.600, -0.208}},
+	"GB-BEX": {51.425, 0.200, BoundingBox{51.332, 0.068, 51.500, 0.324}},
+	"GB-BFS": {54.598, -5.918, BoundingBox{54.490, -6.111, 54.701, -5.769}},
+	"GB-BGE": {51.509, -3.671, BoundingBox{51.223, -3.996, 51.647, -3.427}},
+	"GB-BGW": {51.824, -3.155, BoundingBox{51.698, -3.312, 51.960, -3.005}},
+	"GB-BIR": {52.468, -1.871, BoundingBox{52.322, -2.005, 52.593, -1.680}},
+	"GB-BKM": {51.778, -0.842, BoundingBox{51.626, -1.050, 51.943, -0.547}},
+	"GB-BMH": {50.682, -1.850, BoundingBox{50.591, -1.915, 50.787, -1.763}},
+	"GB-BNE": {51.651, -0.234, BoundingBox{51.580, -0.344, 51.712, -0.139}},
+	"GB-BNH": {50.878, -0.116, BoundingBox{50.770, -0.442, 51.086, 0.169}},
+	"GB-BNS": {53.521, -1.562, BoundingBox{53.460, -1.802, 53.621, -1.324}},
+	"GB-BOL": {53.589, -2.492, BoundingBox{53.500, -2.633, 53.653, -2.383}},
+	"GB-BPL": {53.824, -2.942, BoundingBox{53.772, -3.000, 53.867, -2.848}},
+	"GB-BRC": {51.332, -0.703, BoundingBox{51.151, -0.810, 51.455, -0.521}},
+	"GB-BRD": {53.923, -1.894, BoundingBox{53.736, -2.185, 54.088, -1.668}},
+	"GB-BRY": {51.275, 0.143, BoundingBox{51.132, -0.026, 51.420, 0.335}},
+	"GB-BST": {51.479, -2.626, BoundingBox{51.359, -2.780, 51.611, -2.435}},
+	"GB-BUR": {53.597, -2.302, BoundingBox{53.533, -2.399, 53.674, -2.194}},
+	"GB-CAM": {52.330, 0.187, BoundingBox{52.026, -0.319, 52.662, 0.598}},
+	"GB-CAY": {51.628, -3.218, BoundingBox{51.560, -3.320, 51.704, -3.086}},
+	"GB-CBF": {51.967, -0.374, BoundingBox{51.712, -0.733, 52.105, 0.110}},
+	"GB-CCG": {55.002, -6.718, BoundingBox{54.823, -7.092, 55.170, -6.351}},
+	"GB-CGN": {52.313, -4.035, BoundingBox{52.016, -4.584, 52.575, -3.633}},
+	"GB-CHE": {53.185, -2.346, BoundingBox{53.085, -2.556, 53.288, -2.097}},
+	"GB-CHW": {53.169, -2.730, BoundingBox{53.009, -2.950, 53.286, -2.498}},
+	"GB-CLD": {53.721, -1.961, BoundingBox{53.617, -2.108, 53.826, -1.766}},
+	"GB-CLK": {56.217, -3.714, BoundingBox{56.051, -4.071, 56.386, -3.409}},
+	"GB-CMA": {54.719, -2.967, BoundingBox{54.059, -3.794, 55.245, -2.281}},
+	"GB-CMD": {51.555, -0.175, BoundingBox{51.527, -0.221, 51.587, -0.127}},
+	"GB-CMN": {51.933, -4.255, BoundingBox{51.667, -4.618, 52.193, -3.797}},
+	"GB-CON": {50.403, -5.013, BoundingBox{49.960, -5.674, 50.948, -4.510}},
+	"GB-COV": {52.394, -1.440, BoundingBox{52.319, -1.627, 52.483, -1.213}},
+	"GB-CRF": {51.462, -3.168, BoundingBox{51.297, -3.372, 51.569, -2.995}},
+	"GB-CRY": {51.263, -0.063, BoundingBox{51.112, -0.140, 51.411, 0.031}},
+	"GB-CWY": {53.219, -3.773, BoundingBox{52.964, -4.129, 53.454, -3.482}},
+	"GB-DAL": {54.473, -1.616, BoundingBox{54.312, -1.928, 54.683, -1.354}},
+	"GB-DBY": {53.011, -1.579, BoundingBox{52.695, -1.850, 53.250, -1.250}},
+	"GB-DEN": {53.055, -3.402, BoundingBox{52.875, -3.612, 53.300, -3.146}},
+	"GB-DER": {52.917, -1.472, BoundingBox{52.885, -1.535, 52.950, -1.410}},
+	"GB-DEV": {50.782, -3.926, BoundingBox{50.374, -4.676, 51.169, -2.993}},
+	"GB-DGY": {54.995, -4.067, BoundingBox{54.643, -5.080, 55.353, -3.249}},
+	"GB-DNC": {53.541, -1.025, BoundingBox{53.360, -1.324, 53.706, -0.787}},
+	"GB-DND": {56.469, -3.020, BoundingBox{56.297, -3.451, 56.625, -2.544}},
+	"GB-DOR": {50.812, -2.292, BoundingBox{50.500, -3.016, 51.082, -1.507}},
+	"GB-DRS": {54.910, -7.232, BoundingBox{54.614, -7.570, 55.153, -6.872}},
+	"GB-DUD": {52.430, -2.275, BoundingBox{52.335, -2.594, 52.536, -2.005}},
+	"GB-DUR": {54.708, -2.009, BoundingBox{54.495, -2.420, 54.927, -1.521}},
+	"GB-EAL": {51.518, -0.327, BoundingBox{51.480, -0.390, 51.555, -0.253}},
+	"GB-EAW": {52.496, -1.939, BoundingBox{49.885, -6.370, 55.736, 1.680}},
+	"GB-EAY": {55.434, -4.275, BoundingBox{55.221, -4.695, 55.612, -3.890}},
+	"GB-EDH": {55.967, -3.262, BoundingBox{55.792, -3.450, 56.107, -3.000}},
+	"GB-EDU": {56.005, -4.183, BoundingBox{55.883, -4.350, 56.119, -4.003}},
+	"GB-ELN": {55.832, -2.469, BoundingBox{55.709, -3.000, 55.963, -1.961}},
+	"GB-ELS": {58.048, -6.562, BoundingBox{57.718, -7.228, 58.382, -5.427}},
+	"GB-ENF": {51.724, 0.015, BoundingBox{51.614, -0.200, 51.850, 0.226}},
+	"GB-ENG": {52.532, -1.544, BoundingBox{49.885, -6.370, 55.736, 1.680}},
+	"GB-ERW": {55.717, -4.379, BoundingBox{55.604, -4.531, 55.841, -4.254}},
+	"GB-ERY": {53.867, -0.431, BoundingBox{53.629, -0.846, 54.050, 0.002}},
+	"GB-ESS": {51.869, 0.603, BoundingBox{51.652, 0.175, 52.114, 1.172}},
+	"GB-ESX": {50.964, 0.339, BoundingBox{50.770, -0.069, 51.190, 0.800}},
+	"GB-FAL": {56.010, -3.817, BoundingBox{55.889, -4.050, 56.105, -3.550}},
+	"GB-FIF": {56.226, -3.175, BoundingBox{56.042, -3.475, 56.400, -2.793}},
+	"GB-FLN": {53.216, -3.176, BoundingBox{53.083, -3.491, 53.335, -2.950}},
+	"GB-FMO": {54.312, -7.261, BoundingBox{54.060, -7.570, 54.648, -6.982}},
+	"GB-GAT": {54.905, -1.830, BoundingBox{54.796, -2.254, 54.965, -1.530}},
+	"GB-GBN": {54.199, -2.704, BoundingBox{49.885, -7.228, 60.724, 1.680}},
+	"GB-GLG": {55.863, -4.250, BoundingBox{55.799, -4.381, 55.927, -4.097}},
+	"GB-GLS": {51.859, -2.158, BoundingBox{51.637, -2.591, 52.035, -1.720}},
+	"GB-GRE": {51.470, 0.055, BoundingBox{51.420, -0.013, 51.504, 0.120}},
+	"GB-GWN": {52.818, -4.151, BoundingBox{52.575, -4.770, 53.097, -3.521}},
+	"GB-HAL": {53.325, -2.725, BoundingBox{53.261, -2.911, 53.401, -2.525}},
+	"GB-HAM": {51.022, -1.265, BoundingBox{50.721, -1.835, 51.308, -0.705}},
+	"GB-HAV": {51.632, 0.279, BoundingBox{51.493, 0.154, 51.773, 0.461}},
+	"GB-HCK": {51.554, -0.050, BoundingBox{51.529, -0.090, 51.580, 0.001}},
+	"GB-HEF": {52.134, -2.760, BoundingBox{51.893, -3.146, 52.375, -2.391}},
+	"GB-HIL": {51.555, -0.481, BoundingBox{51.461, -0.589, 51.675, -0.379}},
+	"GB-HLD": {57.681, -4.785, BoundingBox{56.487, -6.112, 58.640, -3.010}},
+	"GB-HMF": {51.488, -0.242, BoundingBox{51.457, -0.293, 51.527, -0.184}},
+	"GB-HNS": {51.435, -0.438, BoundingBox{51.361, -0.541, 51.502, -0.308}},
+	"GB-HPL": {54.701, -1.310, BoundingBox{54.614, -1.525, 54.811, -1.112}},
+	"GB-HRT": {51.853, -0.105, BoundingBox{51.702, -0.493, 52.038, 0.175}},
+	"GB-HRW": {51.644, -0.419, BoundingBox{51.550, -0.593, 51.722, -0.272}},
+	"GB-HRY": {51.595, -0.113, BoundingBox{51.567, -0.177, 51.627, -0.060}},
+	"GB-IOS": {50.074, -5.529, BoundingBox{49.885, -6.370, 50.236, -5.476}},
+	"GB-IOW": {50.714, -1.377, BoundingBox{50.649, -1.552, 50.760, -1.138}},
+	"GB-ISL": {51.547, -0.108, BoundingBox{51.526, -0.137, 51.568, -0.078}},
+	"GB-IVC": {55.941, -4.888, BoundingBox{55.789, -5.236, 56.185, -4.592}},
+	"GB-KEC": {51.505, -0.193, BoundingBox{51.475, -0.238, 51.534, -0.152}},
+	"GB-KEN": {51.197, 0.903, BoundingBox{50.915, 0.328, 51.404, 1.450}},
+	"GB-KHL": {53.698, -0.348, BoundingBox{53.597, -0.518, 53.814, -0.235}},
+	"GB-KIR": {53.626, -1.746, BoundingBox{53.486, -1.931, 53.747, -1.572}},
+	"GB-KTT": {51.368, -0.344, BoundingBox{51.309, -0.504, 51.425, -0.231}},
+	"GB-KWL": {53.437, -2.849, BoundingBox{53.352, -2.932, 53.517, -2.769}},
+	"GB-LAN": {53.918, -2.602, BoundingBox{53.515, -3.034, 54.341, -2.072}},
+	"GB-LBC": {54.480, -6.095, BoundingBox{54.365, -6.409, 54.597, -5.798}},
+	"GB-LBH": {51.451, -0.120, BoundingBox{51.410, -0.164, 51.489, -0.078}},
+	"GB-LCE": {52.643, -1.123, BoundingBox{52.573, -1.225, 52.716, -1.002}},
+	"GB-LDS": {53.848, -1.522, BoundingBox{53.706, -1.692, 54.009, -1.282}},
+	"GB-LEC": {52.638, -1.417, BoundingBox{52.341, -1.680, 52.857, -0.765}},
+	"GB-LEW": {51.429, -0.020, BoundingBox{51.392, -0.094, 51.476, 0.061}},
+	"GB-LIN": {53.071, -0.129, BoundingBox{52.840, -0.638, 53.343, 0.457}},
+	"GB-LIV": {53.392, -2.951, BoundingBox{53.285, -3.073, 53.471, -2.858}},
+	"GB-LND": {51.511, -0.091, BoundingBox{51.489, -0.123, 51.533, -0.063}},
+	"GB-LUT": {51.859, -0.389, BoundingBox{51.747, -0.470, 51.915, -0.226}},
+	"GB-MAN": {53.475, -2.217, BoundingBox{53.407, -2.298, 53.542, -2.141}},
+	"GB-MDB": {54.466, -1.220, BoundingBox{54.323, -1.366, 54.621, -1.115}},
+	"GB-MDW": {51.360, 0.548, BoundingBox{51.198, 0.310, 51.504, 0.908}},
+	"GB-MEA": {54.863, -6.260, BoundingBox{54.800, -6.482, 54.950, -6.022}},
+	"GB-MIK": {52.051, -0.872, BoundingBox{51.922, -1.242, 52.195, -0.569}},
+	"GB-MLN": {55.751, -3.128, BoundingBox{55.558, -3.423, 55.952, -2.795}},
+	"GB-MON": {51.791, -2.791, BoundingBox{51.628, -3.039, 51.950, -2.524}},
+	"GB-MRT": {51.408, -0.182, BoundingBox{51.385, -0.255, 51.430, -0.101}},
+	"GB-MRY": {57.385, -3.453, BoundingBox{57.015, -4.058, 57.889, -2.739}},
+	"GB-MTY": {51.772, -3.432, BoundingBox{51.680, -3.630, 51.854, -3.261}},
+	"GB-MUL": {54.643, -6.782, BoundingBox{54.376, -7.178, 54.886, -6.401}},
+	"GB-NAY": {55.645, -4.677, BoundingBox{55.310, -5.596, 55.856, -4.444}},
+	"GB-NBL": {55.302, -2.114, BoundingBox{54.923, -2.654, 55.736, -1.609}},
+	"GB-NEL": {53.451, -0.123, BoundingBox{53.263, -0.458, 53.660, 0.228}},
+	"GB-NET": {55.045, -1.799, BoundingBox{54.965, -2.159, 55.206, -1.547}},
+	"GB-NFK": {52.647, 0.984, BoundingBox{52.425, 0.336, 52.948, 1.680}},
+	"GB-NGM": {52.949, -1.146, BoundingBox{52.910, -1.225, 52.999, -1.054}},
+	"GB-NIR": {54.536, -6.703, BoundingBox{53.870, -7.570, 55.170, -5.660}},
+	"GB-NLK": {55.847, -3.912, BoundingBox{55.726, -4.102, 55.983, -3.722}},
+	"GB-NLN": {53.543, -0.623, BoundingBox{53.314, -0.889, 53.750, -0.332}},
+	"GB-NMD": {54.147, -6.150, BoundingBox{53.870, -6.471, 54.390, -5.790}},
+	"GB-NSM": {51.359, -2.850, BoundingBox{51.207, -3.118, 51.526, -2.632}},
+	"GB-NTH": {52.298, -0.918, BoundingBox{52.097, -1.225, 52.482, -0.537}},
+	"GB-NTL": {51.739, -3.761, BoundingBox{51.564, -3.978, 51.908, -3.558}},
+	"GB-NTT": {53.085, -0.946, BoundingBox{52.804, -1.384, 53.371, -0.555}},
+	"GB-NTY": {55.113, -1.561, BoundingBox{54.969, -1.693, 55.274, -1.414}},
+	"GB-NWM": {51.526, 0.039, BoundingBox{51.494, -0.012, 51.560, 0.099}},
+	"GB-NWP": {51.571, -2.920, BoundingBox{51.456, -3.123, 51.660, -2.694}},
+	"GB-NYK": {54.185, -1.412, BoundingBox{53.701, -2.622, 54.608, -0.209}},
+	"GB-OLD": {53.560, -2.023, BoundingBox{53.511, -2.200, 53.630, -1.859}},
+	"GB-ORK": {59.000, -3.000, BoundingBox{58.751, -3.484, 59.249, -2.516}},
+	"GB-OXF": {51.847, -1.366, BoundingBox{51.625, -1.750, 52.080, -1.050}},
+	"GB-PEM": {51.852, -4.831, BoundingBox{51.551, -5.270, 52.183, -4.504}},
+	"GB-PKN": {56.733, -4.120, BoundingBox{56.369, -5.008, 57.143, -3.341}},
+	"GB-PLY": {50.371, -4.185, BoundingBox{50.250, -4.517, 50.512, -3.790}},
+	"GB-POL": {50.665, -2.084, BoundingBox{50.530, -2.303, 50.816, -1.915}},
+	"GB-POR": {50.784, -0.964, BoundingBox{50.715, -1.149, 50.845, -0.683}},
+	"GB-POW": {52.360, -3.398, BoundingBox{51.838, -3.908, 52.875, -2.908}},
+	"GB-PTE": {52.642, -0.109, BoundingBox{52.373, -0.553, 52.840, 0.367}},
+	"GB-RCC": {54.458, -0.910, BoundingBox{54.303, -1.115, 54.623, -0.516}},
+	"GB-RCH": {53.637, -2.138, BoundingBox{53.554, -2.265, 53.708, -1.988}},
+	"GB-RCT": {51.626, -3.437, BoundingBox{51.529, -3.589, 51.712, -3.320}},
+	"GB-RDB": {51.644, 0.120, BoundingBox{51.547, 0.020, 51.775, 0.229}},
+	"GB-RDG": {51.504, -1.014, BoundingBox{51.306, -1.135, 51.651, -0.839}},
+	"GB-RFW": {55.836, -4.515, BoundingBox{55.745, -4.662, 55.900, -4.373}},
+	"GB-RIC": {51.437, -0.318, BoundingBox{51.394, -0.415, 51.480, -0.251}},
+	"GB-ROT": {53.366, -1.245, BoundingBox{53.217, -1.425, 53.528, -0.932}},
+	"GB-RUT": {52.679, -0.596, BoundingBox{52.424, -0.805, 52.905, -0.328}},
+	"GB-SAW": {52.507, -2.010, BoundingBox{52.424, -2.099, 52.560, -1.917}},
+	"GB-SAY": {55.234, -4.620, BoundingBox{55.041, -5.011, 55.474, -4.247}},
+	"GB-SCB": {55.489, -2.775, BoundingBox{55.215, -3.340, 55.741, -2.043}},
+	"GB-SCT": {57.110, -4.012, BoundingBox{54.643, -7.228, 60.724, -0.494}},
+	"GB-SFK": {52.201, 1.060, BoundingBox{51.880, 0.447, 52.425, 1.621}},
+	"GB-SFT": {53.535, -2.980, BoundingBox{53.451, -3.078, 53.637, -2.847}},
+	"GB-SGC": {51.578, -2.374, BoundingBox{51.440, -2.699, 51.740, -2.090}},
+	"GB-SHF": {53.353, -1.628, BoundingBox{53.250, -1.840, 53.460, -1.425}},
+	"GB-SHN": {53.463, -2.728, BoundingBox{53.399, -2.829, 53.529, -2.615}},
+	"GB-SHR": {52.595, -2.802, BoundingBox{52.375, -3.050, 52.866, -2.502}},
+	"GB-SKP": {53.335, -2.054, BoundingBox{53.244, -2.274, 53.453, -1.840}},
+	"GB-SLF": {53.498, -2.361, BoundingBox{53.451, -2.483, 53.547, -2.247}},
+	"GB-SLG": {51.556, -0.620, BoundingBox{51.402, -0.782, 51.679, -0.501}},
+	"GB-SLK": {55.566, -3.760, BoundingBox{55.317, -4.286, 55.839, -3.303}},
+	"GB-SND": {54.834, -1.452, BoundingBox{54.725, -1.634, 54.931, -1.255}},
+	"GB-SOL": {52.396, -1.759, BoundingBox{52.257, -1.959, 52.562, -1.610}},
+	"GB-SOM": {51.035, -3.050, BoundingBox{50.711, -3.625, 51.297, -2.505}},
+	"GB-SOS": {51.550, 0.919, BoundingBox{51.383, 0.469, 51.734, 1.362}},
+	"GB-SRY": {51.198, -0.423, BoundingBox{51.082, -0.807, 51.361, -0.034}},
+	"GB-STE": {53.018, -2.179, BoundingBox{52.975, -2.240, 53.069, -2.115}},
+	"GB-STG": {56.284, -4.372, BoundingBox{56.089, -4.824, 56.485, -3.930}},
+	"GB-STH": {50.929, -1.417, BoundingBox{50.861, -1.580, 51.005, -1.270}},
+	"GB-STN": {51.313, -0.200, BoundingBox{51.209, -0.301, 51.385, -0.140}},
+	"GB-STS": {52.964, -2.129, BoundingBox{52.718, -2.650, 53.249, -1.735}},
+	"GB-STT": {54.574, -1.389, BoundingBox{54.413, -1.532, 54.691, -1.262}},
+	"GB-STY": {54.960, -1.444, BoundingBox{54.918, -1.548, 55.019, -1.337}},
+	"GB-SWA": {51.544, -4.158, BoundingBox{51.135, -4.596, 51.788, -3.811}},
+	"GB-SWD": {51.595, -1.778, BoundingBox{51.382, -2.115, 51.827, -1.430}},
+	"GB-SWK": {51.472, -0.066, BoundingBox{51.437, -0.114, 51.495, -0.012}},
+	"GB-TAM": {53.461, -1.955, BoundingBox{53.368, -2.152, 53.525, -1.795}},
+	"GB-TFW": {52.720, -2.457, BoundingBox{52.485, -2.698, 52.900, -2.189}},
+	"GB-THR": {51.508, 0.389, BoundingBox{51.368, 0.232, 51.654, 0.534}},
+	"GB-TOB": {50.408, -3.545, BoundingBox{50.230, -3.830, 50.613, -3.082}},
+	"GB-TOF": {51.696, -3.047, BoundingBox{51.627, -3.177, 51.787, -2.908}},
+	"GB-TRF": {53.374, -2.361, BoundingBox{53.279, -2.481, 53.468, -2.241}},
+	"GB-TWH": {51.510, -0.028, BoundingBox{51.480, -0.062, 51.541, 0.010}},
+	"GB-UKM": {54.215, -2.887, BoundingBox{49.885, -7.570, 60.724, 1.680}},
+	"GB-VGL": {51.286, -3.552, BoundingBox{51.098, -4.050, 51.531, -3.118}},
+	"GB-WAR": {52.272, -1.514, BoundingBox{52.002, -1.853, 52.651, -1.225}},
+	"GB-WBK": {51.448, -1.348, BoundingBox{51.285, -1.630, 51.625, -1.135}},
+	"GB-WDU": {56.030, -4.535, BoundingBox{55.900, -4.792, 56.185, -4.350}},
+	"GB-WFT": {51.603, -0.003, BoundingBox{51.556, -0.060, 51.659, 0.059}},
+	"GB-WGN": {53.530, -2.604, BoundingBox{53.464, -2.739, 53.627, -2.469}},
+	"GB-WIL": {51.249, -1.937, BoundingBox{51.046, -2.275, 51.492, -1.561}},
+	"GB-WKF": {53.662, -1.369, BoundingBox{53.529, -1.587, 53.776, -1.141}},
+	"GB-WLL": {52.640, -1.869, BoundingBox{52.538, -2.050, 52.729, -1.653}},
+	"GB-WLN": {55.866, -3.542, BoundingBox{55.682, -3.751, 56.077, -3.362}},
+	"GB-WLS": {52.325, -3.817, BoundingBox{51.098, -5.270, 53.500, -2.524}},
+	"GB-WLV": {52.618, -2.189, BoundingBox{52.526, -2.416, 52.740, -2.050}},
+	"GB-WND": {51.449, -0.200, BoundingBox{51.430, -0.258, 51.475, -0.144}},
+	"GB-WNM": {51.524, -0.760, BoundingBox{51.417, -0.890, 51.637, -0.578}},
+	"GB-WOK": {51.315, -0.918, BoundingBox{51.153, -1.130, 51.475, -0.810}},
+	"GB-WOR": {52.179, -2.142, BoundingBox{52.004, -2.555, 52.355, -1.723}},
+	"GB-WRL": {53.356, -3.229, BoundingBox{53.263, -3.591, 53.451, -2.915}},
+	"GB-WRT": {53.372, -2.540, BoundingBox{53.254, -2.679, 53.470, -2.430}},
+	"GB-WRX": {52.951, -2.971, BoundingBox{52.785, -3.330, 53.130, -2.640}},
+	"GB-WSM": {51.509, -0.146, BoundingBox{51.482, -0.187, 51.531, -0.114}},
+	"GB-WSX": {50.964, -0.507, BoundingBox{50.770, -0.822, 51.120, -0.088}},
+	"GB-YOR": {53.953, -1.047, BoundingBox{53.910, -1.150, 54.010, -0.873}},
+	"GB-ZET": {60.350, -1.250, BoundingBox{59.976, -2.006, 60.724, -0.494}},
+	"GD-01":  {12.131, -61.626, BoundingBox{12.083, -61.687, 12.184, -61.570}},
+	"GD-02":  {12.056, -61.649, BoundingBox{12.012, -61.717, 12.103, -61.570}},
+	"GD-03":  {12.069, -61.740, BoundingBox{12.012, -61.790, 12.110, -61.688}},
+	"GD-04":  {12.133, -61.738, BoundingBox{12.094, -61.790, 12.180, -61.674}},
+	"GD-05":  {12.191, -61.710, BoundingBox{12.146, -61.775, 12.228, -61.663}},
+	"GD-06":  {12.192, -61.626, BoundingBox{12.148, -61.676, 12.228, -61.570}},
+	"GD-10":  {12.480, -61.450, BoundingBox{12.430, -61.501, 12.530, -61.399}},
+	"GE-AB":  {43.162, 41.017, BoundingBox{42.645, 39.960, 43.550, 41.963}},
+	"GE-AJ":  {41.662, 42.057, BoundingBox{41.540, 41.550, 41.837, 42.711}},
+	"GE-GU":  {42.006, 42.103, BoundingBox{41.680, 41.586, 42.275, 42.655}},
+	"GE-IM":  {42.098, 42.890, BoundingBox{41.633, 42.365, 42.499, 43.437}},
+	"GE-KA":  {41.754, 45.716, BoundingBox{41.060, 44.967, 42.593, 46.640}},
+	"GE-KK":  {41.430, 44.315, BoundingBox{41.123, 43.868, 41.814, 44.994}},
+	"GE-MM":  {42.347, 44.643, BoundingBox{41.953, 44.178, 42.710, 45.056}},
+	"GE-RL":  {42.683, 43.117, BoundingBox{42.274, 42.551, 43.164, 43.848}},
+	"GE-SJ":  {41.524, 43.408, BoundingBox{41.090, 42.699, 41.958, 43.919}},
+	"GE-SK":  {42.141, 43.922, BoundingBox{41.680, 43.412, 42.642, 44.437}},
+	"GE-SZ":  {42.724, 42.079, BoundingBox{42.275, 41.452, 43.266, 42.611}},
+	"GE-TB":  {41.688, 44.892, BoundingBox{41.266, 44.317, 42.007, 45.320}},
+	"GH-AA":  {5.862, 0.107, BoundingBox{5.302, -0.655, 6.349, 1.060}},
+	"GH-AH":  {6.810, -1.543, BoundingBox{6.019, -2.765, 7.795, -0.608}},
+	"GH-BA":  {7.867, -1.988, BoundingBox{6.701, -3.122, 8.992, -0.560}},
+	"GH-CP":  {5.499, -1.277, BoundingBox{4.762, -1.800, 6.171, -0.572}},
+	"GH-EP":  {6.520, -0.525, BoundingBox{5.767, -1.155, 7.511, 0.202}},
+	"GH-NP":  {9.217, -0.736, BoundingBox{8.087, -2.219, 10.167, 0.466}},
+	"GH-TV":  {7.466, 0.142, BoundingBox{6.055, -0.645, 8.672, 0.981}},
+	"GH-UE":  {10.546, -0.701, BoundingBox{10.021, -1.754, 11.100, 0.370}},
+	"GH-UW":  {10.099, -2.296, BoundingBox{8.938, -2.960, 10.994, -1.483}},
+	"GH-WP":  {5.724, -2.454, BoundingBox{4.710, -3.240, 6.764, -1.800}},
+	"GL-KU":  {61.795, -45.421, BoundingBox{60.040, -49.805, 63.473, -41.519}},
+	"GL-QA":  {77.560, -43.311, BoundingBox{68.849, -73.300, 83.650, -12.210}},
+	"GL-QE":  {67.287, -49.027, BoundingBox{65.530, -53.970, 68.939, -43.716}},
+	"GL-SM":  {69.590, -34.971, BoundingBox{62.243, -52.805, 76.464, -19.370}},
+	"GM-B":   {13.415, -16.593, BoundingBox{13.148, -16.840, 13.601, -16.293}},
+	"GM-L":   {13.464, -15.615, BoundingBox{13.130, -16.046, 13.864, -15.268}},
+	"GM-M":   {13.576, -14.882, BoundingBox{13.289, -15.340, 13.880, -14.373}},
+	"GM-N":   {13.472, -16.059, BoundingBox{13.206, -16.308, 13.615, -15.786}},
+	"GM-U":   {13.513, -14.172, BoundingBox{13.280, -14.470, 13.790, -13.840}},
+	"GM-W":   {13.267, -16.339, BoundingBox{13.132, -16.728, 13.517, -16.027}},
+	"GN-B":   {11.198, -13.826, BoundingBox{9.801, -15.130, 12.590, -12.731}},
+	"GN-BE":  {8.755, -8.444, BoundingBox{8.229, -9.070, 9.454, -7.830}},
+	"GN-BF":  {10.290, -14.178, BoundingBox{9.801, -14.663, 10.699, -13.760}},
+	"GN-BK":  {11.122, -14.318, BoundingBox{10.550, -15.130, 11.680, -13.654}},
+	"GN-C":   {9.562, -13.626, BoundingBox{9.154, -13.985, 9.859, -13.380}},
+	"GN-CO":  {9.764, -13.271, BoundingBox{9.475, -13.542, 10.071, -13.006}},
+	"GN-D":   {10.149, -13.072, BoundingBox{8.900, -13.898, 11.327, -12.233}},
+	"GN-DB":  {10.704, -11.123, BoundingBox{10.166, -11.670, 11.235, -10.497}},
+	"GN-DI":  {11.478, -10.607, BoundingBox{10.740, -11.198, 12.210, -9.928}},
+	"GN-DL":  {10.699, -12.255, BoundingBox{10.300, -12.736, 11.031, -11.708}},
+	"GN-DU":  {9.974, -13.541, BoundingBox{9.711, -13.898, 10.182, -13.171}},
+	"GN-F":   {10.445, -10.578, BoundingBox{8.849, -11.670, 12.210, -9.542}},
+	"GN-FA":  {10.025, -10.647, BoundingBox{9.395, -11.424, 10.562, -10.011}},
+	"GN-FO":  {9.370, -13.063, BoundingBox{8.900, -13.440, 9.777, -12.613}},
+	"GN-FR":  {10.495, -13.558, BoundingBox{10.127, -13.974, 10.980, -13.075}},
+	"GN-GA":  {11.729, -13.322, BoundingBox{11.267, -13.905, 12.156, -12.731}},
+	"GN-GU":  {8.654, -10.181, BoundingBox{8.350, -10.608, 8.911, -9.776}},
+	"GN-K":   {10.577, -9.232, BoundingBox{8.785, -10.512, 12.330, -8.029}},
+	"GN-KA":  {10.331, -9.261, BoundingBox{9.718, -9.865, 11.059, -8.720}},
+	"GN-KB":  {11.783, -11.847, BoundingBox{11.250, -12.293, 12.321, -11.432}},
+	"GN-KD":  {10.081, -12.747, BoundingBox{9.587, -13.196, 10.548, -12.233}},
+	"GN-KE":  {9.414, -8.934, BoundingBox{8.785, -9.573, 9.934, -8.121}},
+	"GN-KN":  {12.317, -13.319, BoundingBox{12.024, -13.830, 12.590, -12.749}},
+	"GN-KO":  {10.691, -9.951, BoundingBox{9.907, -10.512, 11.419, -9.371}},
+	"GN-KS":  {9.331, -10.063, BoundingBox{8.849, -10.685, 9.926, -9.542}},
+	"GN-L":   {11.700, -12.024, BoundingBox{10.875, -13.129, 12.470, -11.039}},
+	"GN-LA":  {11.327, -12.226, BoundingBox{11.014, -12.523, 11.700, -11.893}},
+	"GN-LE":  {11.464, -12.740, BoundingBox{11.071, -13.129, 11.878, -12.410}},
+	"GN-LO":  {7.987, -8.473, BoundingBox{7.690, -8.742, 8.279, -8.220}},
+	"GN-M":   {10.562, -12.117, BoundingBox{9.854, -12.798, 11.261, -11.386}},
+	"GN-MC":  {8.541, -9.379, BoundingBox{8.015, -9.800, 9.060, -8.948}},
+	"GN-MD":  {10.502, -8.560, BoundingBox{9.746, -8.971, 11.423, -8.029}},
+	"GN-ML":  {12.127, -12.249, BoundingBox{11.700, -12.843, 12.470, -11.493}},
+	"GN-MM":  {10.327, -11.893, BoundingBox{9.854, -12.434, 10.887, -11.386}},
+	"GN-N":   {8.424, -8.994, BoundingBox{7.310, -10.608, 9.454, -7.830}},
+	"GN-NZ":  {7.893, -8.926, BoundingBox{7.363, -9.253, 8.282, -8.665}},
+	"GN-PI":  {10.990, -12.512, BoundingBox{10.678, -12.798, 11.261, -12.099}},
+	"GN-SI":  {11.650, -9.288, BoundingBox{11.029, -10.066, 12.330, -8.413}},
+	"GN-TE":  {10.916, -13.174, BoundingBox{10.432, -13.703, 11.327, -12.704}},
+	"GN-TO":  {11.501, -11.471, BoundingBox{10.875, -11.945, 12.210, -11.039}},
+	"GN-YO":  {7.613, -9.191, BoundingBox{7.310, -9.400, 8.045, -8.902}},
+	"GQ-AN":  {-1.420, 5.630, BoundingBox{-1.465, 5.585, -1.375, 5.675}},
+	"GQ-BN":  {3.663, 8.793, BoundingBox{3.455, 8.547, 3.853, 9.013}},
+	"GQ-BS":  {3.447, 8.722, BoundingBox{3.305, 8.547, 3.569, 8.945}},
+	"GQ-C":   {1.646, 10.370, BoundingBox{1.010, 9.310, 2.280, 11.290}},
+	"GQ-CS":  {1.556, 10.439, BoundingBox{1.064, 9.956, 2.271, 10.836}},
+	"GQ-I":   {3.460, 8.681, BoundingBox{-1.465, 5.585, 3.853, 9.013}},
+	"GQ-KN":  {2.032, 10.956, BoundingBox{1.750, 10.464, 2.270, 11.284}},
+	"GQ-LI":  {1.673, 9.813, BoundingBox{1.010, 9.310, 2.280, 10.357}},
+	"GQ-WN":  {1.394, 11.036, BoundingBox{1.060, 10.738, 1.750, 11.290}},
+	"GR-01":  {38.575, 21.336, BoundingBox{38.184, 20.977, 38.959, 21.806}},
+	"GR-03":  {38.349, 23.069, BoundingBox{37.963, 22.632, 38.700, 23.512}},
+	"GR-04":  {38.692, 23.257, BoundingBox{38.292, 22.844, 39.228, 23.906}},
+	"GR-05":  {38.927, 21.714, BoundingBox{38.598, 21.303, 39.208, 22.083}},
+	"GR-06":  {38.890, 22.453, BoundingBox{38.572, 22.017, 39.250, 22.866}},
+	"GR-07":  {38.465, 22.253, BoundingBox{38.152, 21.772, 38.756, 22.717}},
+	"GR-11":  {37.559, 22.878, BoundingBox{37.246, 22.510, 37.902, 23.251}},
+	"GR-12":  {37.493, 22.244, BoundingBox{37.142, 21.806, 37.836, 22.645}},
+	"GR-13":  {38.110, 21.831, BoundingBox{37.731, 21.271, 38.471, 22.291}},
+	"GR-14":  {37.746, 21.516, BoundingBox{37.327, 21.158, 38.191, 21.926}},
+	"GR-15":  {37.965, 22.588, BoundingBox{37.672, 22.154, 38.274, 23.087}},
+	"GR-16":  {36.835, 22.590, BoundingBox{36.458, 22.136, 37.259, 23.059}},
+	"GR-17":  {37.069, 21.912, BoundingBox{36.596, 21.445, 37.481, 22.358}},
+	"GR-21":  {37.780, 20.800, BoundingBox{37.655, 20.642, 37.905, 20.958}},
+	"GR-22":  {39.620, 19.850, BoundingBox{39.520, 19.721, 39.720, 19.979}},
+	"GR-23":  {38.200, 20.551, BoundingBox{37.992, 20.286, 38.408, 21.170}},
+	"GR-24":  {38.748, 20.852, BoundingBox{38.478, 20.592, 38.905, 21.022}},
+	"GR-31":  {39.219, 21.115, BoundingBox{38.865, 20.749, 39.526, 21.458}},
+	"GR-32":  {39.517, 20.416, BoundingBox{39.202, 20.150, 39.876, 20.757}},
+	"GR-33":  {39.814, 20.839, BoundingBox{39.432, 20.396, 40.236, 21.256}},
+	"GR-34":  {39.117, 20.685, BoundingBox{38.875, 20.343, 39.421, 21.003}},
+	"GR-41":  {39.295, 21.915, BoundingBox{39.044, 21.439, 39.593, 22.358}},
+	"GR-42":  {39.671, 22.400, BoundingBox{39.250, 21.976, 39.969, 22.855}},
+	"GR-43":  {39.279, 22.851, BoundingBox{38.943, 22.394, 39.655, 23.309}},
+	"GR-44":  {39.626, 21.593, BoundingBox{39.304, 21.176, 39.893, 22.003}},
+	"GR-51":  {40.038, 21.399, BoundingBox{39.770, 20.949, 40.335, 21.859}},
+	"GR-52":  {41.279, 24.186, BoundingBox{40.933, 23.782, 41.580, 24.578}},
+	"GR-53":  {40.570, 22.292, BoundingBox{40.359, 21.839, 40.810, 22.673}},
+	"GR-54":  {40.675, 23.019, BoundingBox{40.323, 22.601, 41.022, 23.480}},
+	"GR-55":  {40.957, 24.321, BoundingBox{40.754, 23.913, 41.217, 24.777}},
+	"GR-56":  {40.423, 21.093, BoundingBox{40.147, 20.640, 40.814, 21.582}},
+	"GR-57":  {41.034, 22.736, BoundingBox{40.697, 22.294, 41.340, 23.130}},
+	"GR-58":  {40.235, 21.849, BoundingBox{39.866, 21.500, 40.579, 22.100}},
+	"GR-59":  {40.883, 22.050, BoundingBox{40.573, 21.709, 41.150, 22.418}},
+	"GR-61":  {40.186, 22.405, BoundingBox{39.936, 22.100, 40.476, 22.751}},
+	"GR-62":  {41.051, 23.526, BoundingBox{40.716, 23.070, 41.341, 23.949}},
+	"GR-63":  {40.727, 21.488, BoundingBox{40.467, 21.018, 40.952, 21.810}},
+	"GR-64":  {40.329, 23.572, BoundingBox{39.960, 22.970, 40.808, 24.135}},
+	"GR-69":  {40.133, 24.118, BoundingBox{39.960, 23.823, 40.344, 24.410}},
+	"GR-71":  {41.264, 26.170, BoundingBox{40.820, 25.800, 41.830, 26.600}},
+	"GR-72":  {41.184, 24.837, BoundingBox{40.908, 24.504, 41.537, 25.189}},
+	"GR-73":  {41.058, 25.502, BoundingBox{40.833, 25.148, 41.296, 25.800}},
+	"GR-81":  {36.337, 27.781, BoundingBox{35.909, 26.963, 37.000, 28.310}},
+	"GR-82":  {36.949, 25.486, BoundingBox{36.337, 25.292, 37.246, 25.708}},
+	"GR-83":  {39.170, 26.250, BoundingBox{38.937, 25.950, 39.403, 26.550}},
+	"GR-84":  {37.730, 26.780, BoundingBox{37.614, 26.633, 37.846, 26.927}},
+	"GR-85":  {38.380, 25.980, BoundingBox{38.230, 25.789, 38.530, 26.171}},
+	"GR-91":  {35.167, 25.114, BoundingBox{34.921, 24.743, 35.420, 25.457}},
+	"GR-92":  {35.143, 25.783, BoundingBox{34.958, 25.404, 35.380, 26.290}},
+	"GR-93":  {35.248, 24.550, BoundingBox{34.920, 24.170, 35.411, 24.887}},
+	"GR-94":  {35.398, 23.864, BoundingBox{35.173, 23.510, 35.710, 24.221}},
+	"GR-A":   {41.174, 25.157, BoundingBox{40.754, 23.782, 41.830, 26.600}},
+	"GR-A1":  {37.699, 23.971, BoundingBox{36.410, 22.398, 38.316, 24.040}},
+	"GR-B":   {40.656, 22.959, BoundingBox{39.936, 21.709, 41.341, 24.135}},
+	"GR-C":   {40.333, 21.438, BoundingBox{39.770, 20.640, 40.952, 22.100}},
+	"GR-D":   {39.574, 20.691, BoundingBox{38.875, 20.150, 40.236, 21.256}},
+	"GR-E":   {39.488, 22.221, BoundingBox{38.943, 21.176, 39.969, 23.309}},
+	"GR-F":   {38.767, 20.817, BoundingBox{37.655, 19.721, 39.720, 21.458}},
+	"GR-G":   {38.164, 21.562, BoundingBox{37.327, 20.977, 38.959, 22.291}},
+	"GR-H":   {38.637, 22.539, BoundingBox{37.963, 21.303, 39.250, 23.906}},
+	"GR-I":   {37.699, 23.971, BoundingBox{36.410, 22.398, 38.316, 24.040}},
+	"GR-J":   {37.321, 22.408, BoundingBox{36.458, 21.445, 38.274, 23.251}},
+	"GR-K":   {39.170, 26.250, BoundingBox{37.614, 25.789, 39.403, 26.927}},
+	"GR-L":   {36.487, 27.950, BoundingBox{35.909, 25.292, 37.246, 28.310}},
+	"GR-M":   {35.231, 24.889, BoundingBox{34.920, 23.510, 35.710, 26.290}},
+	"GT-AV":  {15.798, -90.068, BoundingBox{15.274, -90.676, 16.299, -89.499}},
+	"GT-BV":  {15.128, -90.406, BoundingBox{14.823, -90.762, 15.420, -90.003}},
+	"GT-CM":  {14.801, -90.880, BoundingBox{14.435, -91.090, 15.075, -90.631}},
+	"GT-CQ":  {14.633, -89.447, BoundingBox{14.371, -89.711, 14.870, -89.150}},
+	"GT-ES":  {14.140, -90.881, BoundingBox{13.907, -91.255, 14.436, -90.562}},
+	"GT-GU":  {14.616, -90.448, BoundingBox{14.308, -90.667, 14.907, -90.208}},
+	"GT-HU":  {15.680, -91.555, BoundingBox{15.232, -92.086, 16.070, -91.089}},
+	"GT-IZ":  {15.658, -89.043, BoundingBox{15.109, -89.548, 16.386, -88.230}},
+	"GT-JA":  {14.605, -89.945, BoundingBox{14.384, -90.231, 14.861, -89.678}},
+	"GT-JU":  {14.236, -89.851, BoundingBox{13.894, -90.100, 14.471, -89.530}},
+	"GT-PE":  {17.082, -90.058, BoundingBox{16.164, -91.450, 17.820, -89.140}},
+	"GT-PR":  {14.983, -90.011, BoundingBox{14.689, -90.327, 15.312, -89.769}},
+	"GT-QC":  {15.573, -90.893, BoundingBox{15.075, -91.275, 16.070, -90.526}},
+	"GT-QZ":  {14.787, -91.616, BoundingBox{14.568, -91.887, 15.089, -91.402}},
+	"GT-RE":  {14.453, -91.828, BoundingBox{14.089, -92.230, 14.709, -91.531}},
+	"GT-SA":  {14.507, -90.755, BoundingBox{14.314, -91.029, 14.771, -90.569}},
+	"GT-SM":  {15.064, -91.948, BoundingBox{14.625, -92.230, 15.495, -91.600}},
+	"GT-SO":  {14.744, -91.188, BoundingBox{14.502, -91.419, 15.046, -90.992}},
+	"GT-SR":  {14.096, -90.305, BoundingBox{13.740, -90.601, 14.454, -90.041}},
+	"GT-SU":  {14.303, -91.341, BoundingBox{13.941, -91.595, 14.632, -91.042}},
+	"GT-TO":  {15.072, -91.329, BoundingBox{14.762, -91.614, 15.325, -90.975}},
+	"GT-ZA":  {15.134, -89.503, BoundingBox{14.781, -89.813, 15.576, -89.085}},
+	"GW-BA":  {12.115, -14.624, BoundingBox{11.537, -14.976, 12.616, -14.304}},
+	"GW-BL":  {11.576, -15.788, BoundingBox{11.275, -16.270, 11.758, -15.418}},
+	"GW-BM":  {11.917, -15.878, BoundingBox{11.725, -16.294, 12.265, -15.578}},
+	"GW-BS":  {11.889, -15.523, BoundingBox{11.613, -15.718, 12.230, -15.307}},
+	"GW-CA":  {12.264, -16.111, BoundingBox{11.789, -16.680, 12.620, -15.574}},
+	"GW-GA":  {12.153, -14.057, BoundingBox{11.560, -14.382, 12.605, -13.700}},
+	"GW-L":   {12.134, -14.344, BoundingBox{11.537, -14.976, 12.616, -13.700}},
+	"GW-N":   {12.222, -15.746, BoundingBox{11.725, -16.680, 12.630, -14.904}},
+	"GW-OI":  {12.307, -15.239, BoundingBox{11.925, -15.597, 12.630, -14.904}},
+	"GW-QU":  {11.655, -15.147, BoundingBox{11.298, -15.478, 11.925, -14.687}},
+	"GW-S":   {11.548, -15.268, BoundingBox{11.040, -16.270, 11.925, -14.339}},
+	"GW-TO":  {11.365, -14.987, BoundingBox{11.040, -15.427, 11.648, -14.339}},
+	"GY-BA":  {7.667, -59.792, BoundingBox{6.938, -60.633, 8.370, -58.945}},
+	"GY-CU":  {6.186, -60.307, BoundingBox{5.200, -61.410, 7.439, -59.285}},
+	"GY-DE":  {6.594, -58.066, BoundingBox{6.336, -58.246, 6.819, -57.792}},
+	"GY-EB":  {4.693, -58.174, BoundingBox{2.879, -58.530, 6.242, -57.150}},
+	"GY-ES":  {6.436, -58.712, BoundingBox{6.037, -59.333, 7.025, -58.176}},
+	"GY-MA":  {6.180, -57.882, BoundingBox{5.780, -58.316, 6.548, -57.453}},
+	"GY-PM":  {7.101, -59.027, BoundingBox{6.396, -59.624, 7.838, -58.461}},
+	"GY-PT":  {4.791, -59.247, BoundingBox{3.821, -60.217, 5.953, -58.442}},
+	"GY-UD":  {5.631, -58.467, BoundingBox{4.957, -59.319, 6.128, -57.732}},
+	"GY-UT":  {2.581, -58.545, BoundingBox{1.270, -59.970, 3.872, -56.540}},
+	"HN-AT":  {15.537, -86.956, BoundingBox{15.096, -87.640, 15.880, -86.400}},
+	"HN-CH":  {13.386, -87.081, BoundingBox{12.980, -87.436, 13.812, -86.730}},
+	"HN-CL":  {15.525, -85.584, BoundingBox{14.856, -86.400, 16.011, -84.831}},
+	"HN-CM":  {14.577, -87.702, BoundingBox{14.177, -88.059, 14.936, -87.342}},
+	"HN-CP":  {14.909, -88.893, BoundingBox{14.562, -89.230, 15.302, -88.560}},
+	"HN-CR":  {15.461, -87.949, BoundingBox{14.924, -88.484, 15.879, -87.562}},
+	"HN-EP":  {14.067, -86.407, BoundingBox{13.581, -87.017, 14.524, -85.560}},
+	"HN-FM":  {14.324, -87.099, BoundingBox{13.797, -87.513, 14.808, -86.637}},
+	"HN-GD":  {15.211, -84.354, BoundingBox{14.550, -85.166, 15.954, -83.150}},
+	"HN-IB":  {15.768, -86.408, BoundingBox{15.670, -86.530, 16.395, -86.192}},
+	"HN-IN":  {14.270, -88.241, BoundingBox{13.850, -88.555, 14.715, -87.953}},
+	"HN-LE":  {14.414, -88.613, BoundingBox{13.988, -88.875, 14.783, -88.280}},
+	"HN-LP":  {14.076, -87.790, BoundingBox{13.814, -88.156, 14.383, -87.451}},
+	"HN-OC":  {14.470, -89.061, BoundingBox{14.172, -89.350, 14.737, -88.875}},
+	"HN-OL":  {14.725, -85.946, BoundingBox{14.049, -86.775, 15.434, -85.094}},
+	"HN-SB":  {15.048, -88.345, BoundingBox{14.674, -88.761, 15.515, -87.854}},
+	"HN-VA":  {13.606, -87.504, BoundingBox{13.198, -87.790, 13.924, -87.181}},
+	"HN-YO":  {15.070, -87.276, BoundingBox{14.714, -87.814, 15.550, -86.689}},
+	"HR-01":  {45.670, 16.097, BoundingBox{45.139, 15.320, 46.102, 16.712}},
+	"HR-02":  {46.104, 15.947, BoundingBox{45.946, 15.714, 46.303, 16.233}},
+	"HR-03":  {45.340, 16.552, BoundingBox{45.000, 16.039, 45.622, 17.075}},
+	"HR-04":  {45.276, 15.438, BoundingBox{44.987, 14.975, 45.670, 15.913}},
+	"HR-05":  {46.228, 16.261, BoundingBox{46.059, 15.960, 46.411, 16.529}},
+	"HR-06":  {46.126, 16.892, BoundingBox{45.896, 16.486, 46.389, 17.390}},
+	"HR-07":  {45.736, 17.036, BoundingBox{45.391, 16.667, 46.063, 17.369}},
+	"HR-08":  {45.301, 14.656, BoundingBox{44.800, 13.914, 45.630, 15.115}},
+	"HR-09":  {44.723, 15.441, BoundingBox{44.385, 14.914, 45.043, 16.069}},
+	"HR-10":  {45.755, 17.658, BoundingBox{45.550, 17.291, 46.088, 18.111}},
+	"HR-11":  {45.367, 17.531, BoundingBox{45.123, 17.075, 45.550, 18.072}},
+	"HR-12":  {45.209, 18.041, BoundingBox{45.070, 17.575, 45.439, 18.375}},
+	"HR-13":  {44.232, 15.676, BoundingBox{43.849, 15.170, 44.514, 16.177}},
+	"HR-14":  {45.588, 18.497, BoundingBox{45.251, 18.004, 45.910, 19.048}},
+	"HR-15":  {43.922, 16.111, BoundingBox{43.488, 15.626, 44.410, 16.594}},
+	"HR-16":  {45.208, 18.895, BoundingBox{44.860, 18.375, 45.555, 19.390}},
+	"HR-17":  {43.521, 16.705, BoundingBox{43.158, 16.085, 43.932, 17.249}},
+	"HR-18":  {45.220, 13.933, BoundingBox{44.842, 13.660, 45.500, 14.251}},
+	"HR-19":  {42.938, 17.721, BoundingBox{42.480, 17.013, 43.479, 18.560}},
+	"HR-20":  {46.356, 16.561, BoundingBox{46.168, 16.290, 46.500, 16.855}},
+	"HR-21":  {45.813, 16.008, BoundingBox{45.649, 15.789, 45.986, 16.214}},
+	"HT-AR":  {19.243, -72.542, BoundingBox{18.885, -72.843, 19.634, -72.200}},
+	"HT-CE":  {19.005, -71.969, BoundingBox{18.657, -72.357, 19.307, -71.620}},
+	"HT-GA":  {18.427, -74.144, BoundingBox{18.121, -74.460, 18.660, -73.761}},
+	"HT-ND":  {19.620, -72.323, BoundingBox{19.282, -72.671, 19.871, -71.987}},
+	"HT-NE":  {19.465, -71.870, BoundingBox{19.188, -72.156, 19.761, -71.623}},
+	"HT-NO":  {19.723, -72.981, BoundingBox{19.496, -73.420, 19.920, -72.595}},
+	"HT-OU":  {18.482, -72.223, BoundingBox{18.040, -73.343, 18.945, -71.690}},
+	"HT-SD":  {18.313, -73.605, BoundingBox{18.030, -74.078, 18.574, -73.100}},
+	"HT-SE":  {18.293, -72.623, BoundingBox{18.079, -73.100, 18.512, -71.862}},
+	"HU-BA":  {46.023, 17.816, BoundingBox{45.760, 17.395, 46.381, 18.825}},
+	"HU-BC":  {46.680, 21.090, BoundingBox{46.610, 20.990, 46.750, 21.190}},
+	"HU-BE":  {46.802, 20.985, BoundingBox{46.290, 20.378, 47.167, 21.634}},
+	"HU-BK":  {46.658, 19.556, BoundingBox{46.067, 19.072, 47.212, 20.174}},
+	"HU-BU":  {47.508, 19.098, BoundingBox{47.390, 18.925, 47.610, 19.250}},
+	"HU-BZ":  {48.209, 20.876, BoundingBox{47.599, 20.290, 48.620, 21.450}},
+	"HU-CS":  {46.443, 19.984, BoundingBox{46.130, 19.509, 46.815, 20.892}},
+	"HU-DE":  {47.530, 21.630, BoundingBox{47.460, 21.530, 47.600, 21.730}},
+	"HU-DU":  {46.960, 18.930, BoundingBox{46.890, 18.830, 47.030, 19.030}},
+	"HU-EG":  {47.900, 20.380, BoundingBox{47.830, 20.280, 47.970, 20.480}},
+	"HU-ER":  {47.395, 18.932, BoundingBox{47.314, 18.790, 47.470, 19.073}},
+	"HU-FE":  {47.011, 18.718, BoundingBox{46.580, 18.054, 47.458, 19.347}},
+	"HU-GS":  {47.630, 17.299, BoundingBox{47.267, 16.426, 48.120, 18.107}},
+	"HU-GY":  {47.680, 17.630, BoundingBox{47.610, 17.530, 47.750, 17.730}},
+	"HU-HB":  {47.440, 21.434, BoundingBox{46.996, 20.844, 47.799, 22.221}},
+	"HU-HE":  {47.776, 20.322, BoundingBox{47.487, 19.713, 48.362, 20.895}},
+	"HU-HV":  {46.422, 20.326, BoundingBox{46.350, 20.220, 46.490, 20.420}},
+	"HU-JN":  {47.232, 20.351, BoundingBox{46.809, 19.744, 47.540, 21.014}},
+	"HU-KE":  {47.652, 18.425, BoundingBox{47.368, 17.923, 48.087, 18.876}},
+	"HU-KM":  {46.910, 19.690, BoundingBox{46.840, 19.590, 46.980, 19.790}},
+	"HU-KV":  {46.360, 17.800, BoundingBox{46.290, 17.700, 46.430, 17.900}},
+	"HU-MI":  {48.100, 20.780, BoundingBox{48.030, 20.680, 48.170, 20.880}},
+	"HU-NK":  {46.450, 16.990, BoundingBox{46.380, 16.890, 46.520, 17.090}},
+	"HU-NO":  {48.019, 19.662, BoundingBox{47.699, 18.960, 48.380, 20.290}},
+	"HU-NY":  {47.960, 21.720, BoundingBox{47.890, 21.620, 48.030, 21.820}},
+	"HU-PE":  {47.515, 19.267, BoundingBox{47.055, 18.642, 48.094, 19.873}},
+	"HU-PS":  {46.070, 18.230, BoundingBox{46.000, 18.130, 46.140, 18.330}},
+	"HU-SD":  {46.248, 20.144, BoundingBox{46.180, 20.050, 46.320, 20.250}},
+	"HU-SF":  {47.190, 18.410, BoundingBox{47.120, 18.310, 47.260, 18.510}},
+	"HU-SH":  {47.230, 16.620, BoundingBox{47.160, 16.520, 47.300, 16.720}},
+	"HU-SK":  {47.170, 20.180, BoundingBox{47.100, 20.080, 47.240, 20.280}},
+	"HU-SN":  {47.670, 16.527, BoundingBox{47.615, 16.340, 47.710, 16.690}},
+	"HU-SO":  {46.442, 17.734, BoundingBox{46.085, 17.308, 46.805, 18.255}},
+	"HU-SS":  {46.350, 18.700, BoundingBox{46.280, 18.600, 46.420, 18.800}},
+	"HU-ST":  {48.100, 19.800, BoundingBox{48.030, 19.700, 48.170, 19.900}},
+	"HU-SZ":  {48.041, 21.927, BoundingBox{47.691, 21.150, 48.438, 22.710}},
+	"HU-TB":  {47.570, 18.400, BoundingBox{47.500, 18.300, 47.640, 18.500}},
+	"HU-TO":  {46.376, 18.980, BoundingBox{45.908, 18.151, 46.795, 19.294}},
+	"HU-VA":  {47.204, 16.761, BoundingBox{46.951, 16.251, 47.486, 17.233}},
+	"HU-VE":  {47.049, 17.742, BoundingBox{46.692, 17.175, 47.450, 18.266}},
+	"HU-VM":  {47.090, 17.910, BoundingBox{47.020, 17.810, 47.160, 18.010}},
+	"HU-ZA":  {46.673, 16.933, BoundingBox{46.120, 16.200, 47.041, 17.425}},
+	"HU-ZE":  {46.840, 16.840, BoundingBox{46.770, 16.740, 46.910, 16.940}},
+	"ID-AC":  {4.392, 97.001, BoundingBox{2.827, 95.290, 5.480, 98.603}},
+	"ID-BA":  {-8.283, 114.604, BoundingBox{-8.750, 113.696, -7.748, 116.058}},
+	"ID-BB":  {-2.710, 105.497, BoundingBox{-3.737, 104.975, -1.659, 110.906}},
+	"ID-BE":  {-3.393, 102.523, BoundingBox{-4.815, 101.172, -2.312, 103.557}},
+	"ID-BT":  {-6.372, 106.088, BoundingBox{-7.208, 105.285, -5.498, 106.715}},
+	"ID-GO":  {0.535, 122.007, BoundingBox{-1.080, 120.295, 1.310, 123.599}},
+	"ID-IJ":  {-4.143, 137.595, BoundingBox{-9.120, 130.520, -0.370, 141.030}},
+	"ID-JA":  {-1.594, 102.900, BoundingBox{-2.830, 101.429, -0.134, 104.543}},
+	"ID-JB":  {-6.924, 107.629, BoundingBox{-7.770, 106.394, -5.939, 108.929}},
+	"ID-JI":  {-7.713, 112.581, BoundingBox{-8.436, 111.545, -6.687, 114.231}},
+	"ID-JK":  {-6.117, 106.840, BoundingBox{-6.337, 106.715, -5.927, 107.005}},
+	"ID-JT":  {-7.370, 110.423, BoundingBox{-8.317, 108.738, -6.470, 111.741}},
+	"ID-JW":  {-7.223, 109.683, BoundingBox{-8.436, 105.285, -5.498, 114.231}},
+	"ID-KA":  {-0.240, 114.043, BoundingBox{-4.110, 108.950, 4.310, 119.000}},
+	"ID-KB":  {-0.270, 110.726, BoundingBox{-2.965, 108.950, 2.010, 113.093}},
+	"ID-KI":  {1.391, 116.338, BoundingBox{-1.480, 113.845, 4.310, 119.000}},
+	"ID-KR":  {-0.309, 103.460, BoundingBox{-1.730, 103.089, 1.045, 104.528}},
+	"ID-KS":  {-2.642, 115.332, BoundingBox{-4.110, 113.530, -1.073, 116.573}},
+	"ID-KT":  {-1.250, 113.203, BoundingBox{-3.480, 110.891, 1.431, 115.383}},
+	"ID-LA":  {-4.795, 104.983, BoundingBox{-5.870, 103.517, -3.574, 105.975}},
+	"ID-MA":  {-3.938, 135.188, BoundingBox{-8.410, 125.990, -2.800, 138.077}},
+	"ID-ML":  {0.635, 128.075, BoundingBox{-8.410, 125.990, 2.170, 138.077}},
+	"ID-MU":  {0.778, 128.017, BoundingBox{-0.900, 127.400, 2.170, 128.690}},
+	"ID-NB":  {-8.636, 117.489, BoundingBox{-9.040, 116.025, -8.100, 118.817}},
+	"ID-NT":  {-9.252, 124.577, BoundingBox{-10.360, 118.630, -8.090, 125.090}},
+	"ID-NU":  {-8.938, 117.308, BoundingBox{-10.360, 113.696, -7.748, 125.090}},
+	"ID-PA":  {-4.784, 138.927, BoundingBox{-9.120, 135.682, -1.700, 141.030}},
+	"ID-PB":  {-1.930, 133.000, BoundingBox{-3.858, 130.520, -0.370, 135.032}},
+	"ID-RI":  {0.706, 101.706, BoundingBox{-0.820, 100.095, 2.095, 103.183}},
+	"ID-SA":  {0.846, 124.314, BoundingBox{0.240, 123.487, 1.640, 125.240}},
+	"ID-SB":  {-0.644, 100.707, BoundingBox{-2.457, 99.211, 0.868, 101.927}},
+	"ID-SG":  {-4.013, 122.103, BoundingBox{-5.630, 121.150, -2.505, 123.170}},
+	"ID-SL":  {-2.111, 121.195, BoundingBox{-5.670, 118.770, 1.640, 125.240}},
+	"ID-SM":  {-0.460, 101.718, BoundingBox{-5.870, 95.290, 5.480, 110.906}},
+	"ID-SN":  {-4.296, 120.048, BoundingBox{-5.670, 119.135, -2.819, 121.150}},
+	"ID-SR":  {-2.415, 119.651, BoundingBox{-3.490, 118.770, -0.929, 120.740}},
+	"ID-SS":  {-3.247, 104.234, BoundingBox{-4.714, 103.060, -1.785, 105.588}},
+	"ID-ST":  {-1.368, 120.892, BoundingBox{-2.890, 119.463, 0.792, 122.719}},
+	"ID-SU":  {2.270, 99.181, BoundingBox{0.325, 97.472, 4.064, 100.914}},
+	"ID-YO":  {-7.873, 110.403, BoundingBox{-8.099, 110.108, -7.628, 110.675}},
+	"IE-C":   {53.825, -8.605, BoundingBox{53.034, -9.690, 54.591, -7.498}},
+	"IE-CE":  {52.878, -8.879, BoundingBox{52.536, -9.422, 53.141, -8.305}},
+	"IE-CN":  {53.925, -7.332, BoundingBox{53.737, -7.737, 54.297, -6.930}},
+	"IE-CO":  {51.955, -8.679, BoundingBox{51.670, -9.312, 52.225, -8.034}},
+	"IE-CW":  {52.756, -6.828, BoundingBox{52.522, -7.231, 52.966, -6.391}},
+	"IE-D":   {53.369, -6.297, BoundingBox{53.155, -6.633, 53.659, -6.031}},
+	"IE-DL":  {54.720, -7.806, BoundingBox{54.481, -8.450, 55.130, -7.370}},
+	"IE-G":   {53.345, -8.833, BoundingBox{53.034, -9.497, 53.697, -8.196}},
+	"IE-KE":  {53.203, -6.853, BoundingBox{52.957, -7.200, 53.424, -6.468}},
+	"IE-KK":  {52.525, -7.268, BoundingBox{52.238, -7.639, 52.786, -6.915}},
+	"IE-KY":  {52.069, -9.480, BoundingBox{51.749, -9.980, 52.505, -9.076}},
+	"IE-L":   {53.183, -7.076, BoundingBox{52.189, -8.306, 54.014, -6.030}},
+	"IE-LD":  {53.681, -7.766, BoundingBox{53.421, -8.054, 53.930, -7.374}},
+	"IE-LH":  {53.837, -6.527, BoundingBox{53.653, -6.941, 54.014, -6.150}},
+	"IE-LK":  {52.459, -8.753, BoundingBox{52.225, -9.446, 52.788, -8.198}},
+	"IE-LM":  {54.216, -7.926, BoundingBox{53.877, -8.334, 54.512, -7.498}},
+	"IE-LS":  {52.961, -7.392, BoundingBox{52.753, -7.907, 53.207, -6.974}},
+	"IE-M":   {52.348, -8.565, BoundingBox{51.670, -9.980, 53.141, -7.004}},
+	"IE-MH":  {53.604, -6.724, BoundingBox{53.390, -7.083, 53.831, -6.192}},
+	"IE-MN":  {54.128, -6.950, BoundingBox{53.894, -7.523, 54.540, -6.742}},
+	"IE-MO":  {53.834, -9.232, BoundingBox{53.502, -9.690, 54.202, -8.768}},
+	"IE-OY":  {53.211, -7.805, BoundingBox{52.955, -8.306, 53.438, -7.200}},
+	"IE-RN":  {53.701, -8.334, BoundingBox{53.361, -8.826, 53.978, -7.936}},
+	"IE-SO":  {54.211, -8.611, BoundingBox{53.843, -9.128, 54.591, -8.209}},
+	"IE-TA":  {52.681, -8.001, BoundingBox{52.319, -8.431, 53.033, -7.509}},
+	"IE-U":   {54.412, -7.476, BoundingBox{53.737, -8.450, 55.130, -6.742}},
+	"IE-WD":  {52.178, -7.720, BoundingBox{51.845, -8.267, 52.451, -7.004}},
+	"IE-WH":  {53.528, -7.339, BoundingBox{53.314, -7.812, 53.750, -7.020}},
+	"IE-WW":  {52.995, -6.398, BoundingBox{52.727, -6.753, 53.186, -6.030}},
+	"IE-WX":  {52.429, -6.785, BoundingBox{52.189, -7.039, 52.682, -6.429}},
+	"IL-D":   {30.699, 34.867, BoundingBox{29.500, 34.270, 31.378, 35.420}},
+	"IL-HA":  {32.606, 35.118, BoundingBox{32.387, 34.854, 32.929, 35.557}},
+	"IL-JM":  {31.501, 34.930, BoundingBox{31.229, 34.409, 31.853, 35.413}},
+	"IL-M":   {32.019, 34.949, BoundingBox{31.559, 34.490, 32.445, 35.134}},
+	"IL-TA":  {32.023, 34.791, BoundingBox{31.765, 34.578, 32.193, 34.985}},
+	"IL-Z":   {32.894, 35.493, BoundingBox{32.403, 35.016, 33.280, 35.840}},
+	"IN-AN":  {10.985, 92.600, BoundingBox{6.751, 92.261, 13.574, 94.101}},
+	"IN-AP":  {16.599, 80.293, BoundingBox{13.547, 77.054, 19.445, 84.208}},
+	"IN-AR":  {28.368, 94.637, BoundingBox{27.239, 92.649, 29.450, 96.590}},
+	"IN-AS":  {26.854, 93.386, BoundingBox{25.038, 89.600, 28.366, 97.400}},
+	"IN-BR":  {25.809, 85.113, BoundingBox{24.609, 82.967, 27.337, 87.456}},
+	"IN-CH":  {30.730, 76.780, BoundingBox{30.670, 76.710, 30.790, 76.850}},
+	"IN-CT":  {21.616, 81.531, BoundingBox{19.246, 78.936, 24.218, 83.779}},
+	"IN-DD":  {20.868, 71.137, BoundingBox{20.210, 70.760, 21.199, 73.050}},
+	"IN-DL":  {28.652, 77.156, BoundingBox{28.500, 76.950, 28.833, 77.350}},
+	"IN-DN":  {20.257, 73.099, BoundingBox{20.090, 72.828, 20.444, 73.428}},
+	"IN-GA":  {15.438, 74.146, BoundingBox{15.053, 73.773, 15.793, 74.438}},
+	"IN-GJ":  {22.905, 72.003, BoundingBox{20.084, 68.180, 27.375, 75.524}},
+	"IN-HP":  {32.177, 77.384, BoundingBox{31.064, 75.633, 33.163, 79.198}},
+	"IN-HR":  {28.465, 76.478, BoundingBox{25.765, 73.604, 31.262, 78.266}},
+	"IN-JH":  {23.468, 84.894, BoundingBox{21.539, 82.415, 24.908, 86.751}},
+	"IN-JK":  {33.808, 76.485, BoundingBox{32.445, 73.750, 35.490, 79.210}},
+	"IN-KA":  {14.309, 76.254, BoundingBox{11.602, 74.153, 17.458, 78.715}},
+	"IN-KL":  {10.141, 76.867, BoundingBox{7.970, 75.105, 12.304, 78.183}},
+	"IN-LD":  {10.570, 72.640, BoundingBox{10.525, 72.594, 10.615, 72.686}},
+	"IN-MH":  {19.092, 75.304, BoundingBox{15.624, 72.820, 22.167, 78.354}},
+	"IN-ML":  {25.592, 91.379, BoundingBox{24.812, 89.914, 26.531, 92.578}},
+	"IN-MN":  {24.766, 93.629, BoundingBox{23.850, 92.514, 25.634, 94.648}},
+	"IN-MP":  {23.441, 77.816, BoundingBox{20.628, 74.690, 26.010, 80.993}},
+	"IN-MZ":  {23.284, 92.811, BoundingBox{22.040, 92.205, 24.436, 93.328}},
+	"IN-NL":  {26.095, 94.352, BoundingBox{25.233, 93.573, 26.897, 95.160}},
+	"IN-OR":  {20.576, 84.685, BoundingBox{18.582, 82.643, 22.412, 87.030}},
+	"IN-PB":  {30.696, 75.115, BoundingBox{29.015, 72.977, 32.464, 77.315}},
+	"IN-PY":  {11.930, 79.790, BoundingBox{11.813, 79.715, 12.028, 79.860}},
+	"IN-RJ":  {26.408, 73.457, BoundingBox{23.526, 69.970, 29.214, 76.528}},
+	"IN-SK":  {27.483, 88.443, BoundingBox{26.949, 88.040, 28.090, 88.900}},
+	"IN-TG":  {18.390, 78.738, BoundingBox{16.399, 76.475, 20.717, 80.907}},
+	"IN-TN":  {11.509, 78.852, BoundingBox{8.736, 77.085, 14.301, 80.290}},
+	"IN-TR":  {23.942, 91.971, BoundingBox{22.990, 91.160, 24.858, 92.713}},
+	"IN-UP":  {26.746, 80.465, BoundingBox{23.970, 77.350, 29.718, 83.548}},
+	"IN-UT":  {30.130, 79.313, BoundingBox{28.823, 77.902, 31.522, 81.110}},
+	"IN-WB":  {23.972, 87.871, BoundingBox{21.133, 86.604, 27.075, 89.600}},
+	"IQ-AN":  {32.996, 41.083, BoundingBox{31.305, 38.790, 34.637, 43.102}},
+	"IQ-AR":  {36.439, 44.330, BoundingBox{35.900, 43.403, 37.170, 45.224}},
+	"IQ-BA":  {30.358, 47.329, BoundingBox{29.177, 46.465, 31.292, 48.570}},
+	"IQ-BB":  {32.484, 44.544, BoundingBox{31.838, 43.984, 32.970, 45.162}},
+	"IQ-BG":  {33.407, 44.303, BoundingBox{32.891, 43.178, 34.041, 45.306}},
+	"IQ-DA":  {36.896, 43.110, BoundingBox{36.190, 42.063, 37.390, 44.089}},
+	"IQ-DI":  {34.090, 45.102, BoundingBox{33.242, 44.350, 34.838, 45.834}},
+	"IQ-DQ":  {30.995, 46.240, BoundingBox{30.019, 45.269, 31.814, 47.150}},
+	"IQ-KA":  {32.570, 43.390, BoundingBox{31.900, 42.547, 33.550, 44.161}},
+	"IQ-MA":  {31.879, 47.046, BoundingBox{31.170, 46.114, 32.708, 47.850}},
+	"IQ-MU":  {29.953, 45.259, BoundingBox{29.100, 43.752, 31.065, 46.629}},
+	"IQ-NA":  {31.087, 43.363, BoundingBox{29.863, 41.646, 31.900, 44.771}},
+	"IQ-NI":  {35.681, 42.210, BoundingBox{34.547, 41.076, 36.881, 43.495}},
+	"IQ-QA":  {31.702, 45.051, BoundingBox{31.003, 44.128, 32.440, 45.982}},
+	"IQ-SD":  {34.438, 43.304, BoundingBox{33.550, 41.844, 35.315, 44.641}},
+	"IQ-SW":  {35.441, 45.449, BoundingBox{34.759, 44.805, 36.340, 46.150}},
+	"IQ-TS":  {35.365, 44.224, BoundingBox{34.651, 43.217, 35.900, 45.058}},
+	"IQ-WA":  {32.617, 45.854, BoundingBox{31.785, 45.104, 33.400, 46.802}},
+	"IR-01":  {37.882, 46.561, BoundingBox{36.744, 45.450, 39.057, 47.593}},
+	"IR-02":  {37.879, 45.135, BoundingBox{36.057, 44.110, 39.710, 46.342}},
+	"IR-03":  {38.440, 47.872, BoundingBox{37.383, 46.967, 39.580, 48.975}},
+	"IR-04":  {33.013, 52.441, BoundingBox{31.359, 50.676, 34.479, 53.780}},
+	"IR-05":  {33.263, 46.763, BoundingBox{32.150, 45.446, 33.935, 47.699}},
+	"IR-06":  {28.657, 51.730, BoundingBox{27.425, 50.414, 29.767, 53.030}},
+	"IR-07":  {35.549, 51.638, BoundingBox{34.498, 50.436, 36.827, 52.898}},
+	"IR-08":  {32.293, 50.557, BoundingBox{31.416, 49.243, 33.380, 52.178}},
+	"IR-10":  {31.303, 48.792, BoundingBox{29.930, 47.549, 32.512, 49.972}},
+	"IR-11":  {36.566, 48.221, BoundingBox{35.650, 46.973, 37.511, 49.325}},
+	"IR-12":  {35.202, 54.654, BoundingBox{33.857, 52.821, 36.404, 56.955}},
+	"IR-13":  {28.161, 60.570, BoundingBox{25.080, 58.374, 32.399, 63.320}},
+	"IR-14":  {29.560, 53.488, BoundingBox{27.865, 51.618, 31.333, 55.150}},
+	"IR-15":  {29.671, 57.340, BoundingBox{27.950, 55.150, 31.430, 59.567}},
+	"IR-16":  {35.821, 46.814, BoundingBox{34.864, 45.378, 36.831, 48.077}},
+	"IR-17":  {34.396, 46.613, BoundingBox{33.806, 45.420, 35.054, 47.776}},
+	"IR-18":  {30.713, 51.136, BoundingBox{29.611, 49.508, 31.640, 52.977}},
+	"IR-19":  {37.230, 49.403, BoundingBox{36.611, 48.314, 38.100, 50.735}},
+	"IR-20":  {33.244, 48.431, BoundingBox{32.262, 47.429, 34.218, 49.669}},
+	"IR-21":  {36.209, 52.740, BoundingBox{35.070, 51.105, 36.945, 53.928}},
+	"IR-22":  {34.103, 49.826, BoundingBox{33.072, 48.904, 35.214, 50.782}},
+	"IR-23":  {27.306, 56.137, BoundingBox{25.478, 52.683, 28.716, 59.154}},
+	"IR-24":  {34.897, 48.497, BoundingBox{34.095, 47.563, 35.650, 49.508}},
+	"IR-25":  {32.604, 55.394, BoundingBox{30.094, 52.967, 35.174, 57.545}},
+	"IR-26":  {34.502, 51.200, BoundingBox{33.507, 50.030, 35.393, 52.826}},
+	"IR-27":  {36.900, 55.212, BoundingBox{35.957, 53.685, 37.960, 56.314}},
+	"IR-28":  {36.069, 49.989, BoundingBox{35.193, 48.940, 36.946, 51.107}},
+	"IR-29":  {32.753, 58.812, BoundingBox{30.805, 56.913, 34.479, 60.960}},
+	"IR-30":  {35.544, 59.258, BoundingBox{34.105, 56.926, 37.413, 61.210}},
+	"IR-31":  {36.940, 57.270, BoundingBox{35.375, 56.072, 38.120, 59.212}},
+	"IS-0":   {64.161, -22.060, BoundingBox{64.049, -22.364, 64.310, -21.763}},
+	"IS-1":   {64.177, -21.223, BoundingBox{63.727, -22.216, 64.612, -20.372}},
+	"IS-2":   {63.993, -22.377, BoundingBox{63.877, -22.760, 64.139, -22.038}},
+	"IS-3":   {64.884, -21.789, BoundingBox{64.465, -23.960, 65.458, -20.155}},
+	"IS-4":   {65.864, -22.644, BoundingBox{65.306, -24.330, 66.410, -21.251}},
+	"IS-5":   {65.424, -19.635, BoundingBox{64.732, -21.450, 66.280, -18.082}},
+	"IS-6":   {65.688, -16.714, BoundingBox{64.589, -18.633, 66.530, -14.510}},
+	"IS-7":   {64.894, -15.432, BoundingBox{63.978, -17.257, 66.048, -13.610}},
+	"IS-8":   {64.168, -18.783, BoundingBox{63.500, -20.726, 64.806, -16.528}},
+	"IT-21":  {45.041, 7.892, BoundingBox{44.133, 6.750, 46.160, 9.128}},
+	"IT-23":  {45.651, 7.237, BoundingBox{45.367, 6.800, 45.990, 7.733}},
+	"IT-25":  {45.615, 9.823, BoundingBox{44.770, 8.441, 46.841, 11.300}},
+	"IT-32":  {46.446, 11.197, BoundingBox{45.750, 10.430, 47.120, 12.160}},
+	"IT-34":  {45.779, 11.902, BoundingBox{44.925, 10.556, 47.106, 13.216}},
+	"IT-36":  {46.167, 13.162, BoundingBox{45.590, 12.285, 46.703, 13.940}},
+	"IT-42":  {44.285, 9.476, BoundingBox{43.690, 7.440, 44.773, 9.965}},
+	"IT-45":  {44.485, 11.178, BoundingBox{43.677, 9.184, 45.089, 12.843}},
+	"IT-52":  {43.457, 11.093, BoundingBox{42.256, 9.772, 44.509, 12.351}},
+	"IT-55":  {42.877, 12.444, BoundingBox{42.227, 11.907, 43.377, 12.981}},
+	"IT-57":  {43.307, 13.112, BoundingBox{42.602, 12.215, 43.955, 13.939}},
+	"IT-62":  {41.986, 12.653, BoundingBox{41.193, 11.334, 42.901, 13.873}},
+	"IT-65":  {42.262, 13.946, BoundingBox{41.820, 13.096, 42.911, 15.079}},
+	"IT-67":  {41.667, 14.499, BoundingBox{41.365, 13.825, 42.004, 15.139}},
+	"IT-72":  {40.849, 14.818, BoundingBox{39.839, 13.595, 41.454, 15.753}},
+	"IT-75":  {40.994, 16.616, BoundingBox{39.810, 15.088, 41.961, 18.480}},
+	"IT-77":  {40.459, 16.111, BoundingBox{39.977, 15.422, 41.022, 16.800}},
+	"IT-78":  {39.124, 16.286, BoundingBox{37.910, 15.499, 40.022, 17.170}},
+	"IT-82":  {37.581, 14.131, BoundingBox{36.620, 12.430, 38.227, 15.509}},
+	"IT-88":  {40.073, 9.033, BoundingBox{38.910, 8.160, 41.210, 9.810}},
+	"IT-AG":  {37.408, 13.503, BoundingBox{37.088, 12.962, 37.653, 13.893}},
+	"IT-AL":  {44.841, 8.694, BoundingBox{44.507, 8.450, 45.181, 9.128}},
+	"IT-AN":  {43.526, 13.228, BoundingBox{43.326, 12.822, 43.826, 13.641}},
+	"IT-AO":  {45.651, 7.237, BoundingBox{45.367, 6.800, 45.990, 7.733}},
+	"IT-AP":  {42.841, 13.364, BoundingBox{42.602, 12.966, 43.054, 13.893}},
+	"IT-AQ":  {42.081, 13.537, BoundingBox{41.820, 13.096, 42.407, 14.000}},
+	"IT-AR":  {43.493, 11.907, BoundingBox{43.108, 11.401, 43.810, 12.351}},
+	"IT-AT":  {44.841, 8.136, BoundingBox{44.539, 7.688, 45.160, 8.450}},
+	"IT-AV":  {40.935, 15.114, BoundingBox{40.616, 14.639, 41.272, 15.608}},
+	"IT-BA":  {40.931, 16.765, BoundingBox{40.633, 16.286, 41.256, 17.329}},
+	"IT-BG":  {45.777, 9.802, BoundingBox{45.489, 9.536, 46.060, 10.099}},
+	"IT-BI":  {45.576, 7.937, BoundingBox{45.326, 7.637, 45.763, 8.352}},
+	"IT-BL":  {46.464, 12.151, BoundingBox{46.013, 11.601, 47.106, 12.747}},
+	"IT-BN":  {41.231, 14.784, BoundingBox{40.944, 14.450, 41.454, 15.231}},
+	"IT-BO":  {44.437, 11.359, BoundingBox{44.111, 11.100, 44.835, 11.690}},
+	"IT-BR":  {40.574, 17.692, BoundingBox{40.281, 17.343, 40.953, 18.140}},
+	"IT-BS":  {45.730, 10.374, BoundingBox{45.360, 9.949, 46.132, 10.819}},
+	"IT-BT":  {41.140, 16.099, BoundingBox{40.810, 15.588, 41.486, 16.599}},
+	"IT-BZ":  {46.700, 11.335, BoundingBox{46.280, 10.430, 47.120, 12.160}},
+	"IT-CA":  {39.405, 9.330, BoundingBox{39.107, 8.944, 39.691, 9.709}},
+	"IT-CB":  {41.665, 14.779, BoundingBox{41.365, 14.382, 42.004, 15.139}},
+	"IT-CE":  {41.218, 14.069, BoundingBox{40.972, 13.595, 41.444, 14.450}},
+	"IT-CH":  {42.100, 14.483, BoundingBox{41.834, 14.000, 42.475, 15.079}},
+	"IT-CI":  {39.188, 8.719, BoundingBox{38.910, 8.421, 39.435, 9.049}},
+	"IT-CL":  {37.388, 14.064, BoundingBox{37.041, 13.779, 37.820, 14.449}},
+	"IT-CN":  {44.484, 7.414, BoundingBox{44.133, 6.835, 44.775, 7.983}},
+	"IT-CO":  {46.058, 9.160, BoundingBox{45.720, 8.880, 46.440, 9.400}},
+	"IT-CR":  {45.214, 10.080, BoundingBox{44.939, 9.748, 45.517, 10.421}},
+	"IT-CS":  {39.602, 16.179, BoundingBox{39.127, 15.538, 40.022, 16.871}},
+	"IT-CT":  {37.500, 14.902, BoundingBox{37.221, 14.543, 37.807, 15.274}},
+	"IT-CZ":  {38.944, 16.404, BoundingBox{38.596, 15.998, 39.190, 16.841}},
+	"IT-EN":  {37.727, 14.386, BoundingBox{37.235, 13.997, 38.105, 14.743}},
+	"IT-FC":  {44.009, 11.916, BoundingBox{43.745, 11.547, 44.234, 12.295}},
+	"IT-FE":  {44.767, 11.811, BoundingBox{44.503, 11.211, 44.925, 12.380}},
+	"IT-FG":  {41.600, 15.561, BoundingBox{41.091, 15.088, 41.961, 16.171}},
+	"IT-FI":  {43.784, 11.340, BoundingBox{43.490, 10.949, 44.117, 11.692}},
+	"IT-FM":  {43.134, 13.631, BoundingBox{42.905, 13.357, 43.405, 13.939}},
+	"IT-FR":  {41.587, 13.505, BoundingBox{41.193, 13.059, 41.896, 13.873}},
+	"IT-GE":  {44.490, 9.085, BoundingBox{44.196, 8.579, 44.773, 9.534}},
+	"IT-GO":  {45.968, 13.486, BoundingBox{45.673, 13.113, 46.411, 13.788}},
+	"IT-GR":  {42.721, 11.232, BoundingBox{42.256, 10.748, 43.070, 11.803}},
+	"IT-IM":  {43.963, 7.759, BoundingBox{43.690, 7.440, 44.233, 8.144}},
+	"IT-IS":  {41.670, 14.136, BoundingBox{41.412, 13.825, 41.949, 14.467}},
+	"IT-KR":  {39.214, 16.872, BoundingBox{38.869, 16.494, 39.578, 17.170}},
+	"IT-LC":  {45.928, 9.446, BoundingBox{45.672, 9.236, 46.223, 9.636}},
+	"IT-LE":  {40.195, 18.159, BoundingBox{39.810, 17.735, 40.505, 18.480}},
+	"IT-LI":  {43.151, 10.671, BoundingBox{42.730, 10.340, 43.472, 11.007}},
+	"IT-LO":  {45.287, 9.564, BoundingBox{45.061, 9.265, 45.528, 9.828}},
+	"IT-LT":  {41.452, 12.982, BoundingBox{41.203, 12.459, 41.765, 13.464}},
+	"IT-LU":  {43.968, 10.402, BoundingBox{43.651, 10.016, 44.279, 10.625}},
+	"IT-MB":  {45.607, 9.320, BoundingBox{45.424, 8.989, 45.766, 9.633}},
+	"IT-MC":  {43.169, 13.093, BoundingBox{42.845, 12.731, 43.387, 13.480}},
+	"IT-ME":  {37.982, 15.030, BoundingBox{37.689, 14.436, 38.227, 15.509}},
+	"IT-MI":  {45.426, 9.052, BoundingBox{45.275, 8.792, 45.644, 9.385}},
+	"IT-MN":  {45.079, 10.806, BoundingBox{44.788, 10.379, 45.428, 11.300}},
+	"IT-MO":  {44.413, 10.881, BoundingBox{44.169, 10.491, 44.793, 11.100}},
+	"IT-MS":  {44.273, 10.080, BoundingBox{43.964, 9.772, 44.509, 10.442}},
+	"IT-MT":  {40.371, 16.399, BoundingBox{39.988, 15.952, 40.823, 16.800}},
+	"IT-NA":  {40.860, 14.355, BoundingBox{40.593, 13.801, 41.078, 14.723}},
+	"IT-NO":  {45.458, 8.591, BoundingBox{45.158, 8.342, 45.758, 8.841}},
+	"IT-NU":  {40.314, 9.332, BoundingBox{39.971, 8.788, 40.575, 9.810}},
+	"IT-OG":  {39.845, 9.460, BoundingBox{39.549, 9.072, 40.153, 9.773}},
+	"IT-OR":  {40.014, 8.710, BoundingBox{39.728, 8.392, 40.335, 9.116}},
+	"IT-OT":  {40.830, 9.248, BoundingBox{40.575, 8.853, 41.210, 9.747}},
+	"IT-PA":  {37.849, 13.526, BoundingBox{37.570, 13.100, 38.085, 14.124}},
+	"IT-PC":  {44.833, 9.570, BoundingBox{44.533, 9.184, 45.089, 9.950}},
+	"IT-PD":  {45.362, 11.800, BoundingBox{45.200, 11.380, 45.634, 12.201}},
+	"IT-PE":  {42.375, 14.019, BoundingBox{42.050, 13.572, 42.659, 14.425}},
+	"IT-PG":  {43.039, 12.467, BoundingBox{42.739, 11.907, 43.377, 12.981}},
+	"IT-PI":  {43.535, 10.724, BoundingBox{43.287, 10.284, 43.756, 11.145}},
+	"IT-PN":  {46.089, 12.652, BoundingBox{45.825, 12.285, 46.456, 13.028}},
+	"IT-PO":  {44.021, 11.133, BoundingBox{43.734, 10.975, 44.200, 11.436}},
+	"IT-PR":  {44.715, 10.083, BoundingBox{44.451, 9.669, 44.986, 10.439}},
+	"IT-PT":  {43.947, 10.803, BoundingBox{43.676, 10.625, 44.190, 10.975}},
+	"IT-PU":  {43.630, 12.653, BoundingBox{43.331, 12.215, 43.955, 13.086}},
+	"IT-PV":  {45.077, 9.069, BoundingBox{44.770, 8.668, 45.275, 9.394}},
+	"IT-PZ":  {40.550, 15.812, BoundingBox{39.977, 15.422, 41.022, 16.210}},
+	"IT-RA":  {44.391, 12.056, BoundingBox{44.175, 11.658, 44.690, 12.472}},
+	"IT-RC":  {38.217, 15.960, BoundingBox{37.910, 15.499, 38.507, 16.355}},
+	"IT-RE":  {44.629, 10.573, BoundingBox{44.273, 10.245, 44.934, 11.021}},
+	"IT-RG":  {37.000, 14.619, BoundingBox{36.627, 14.129, 37.254, 15.085}},
+	"IT-RI":  {42.369, 12.917, BoundingBox{42.011, 12.381, 42.762, 13.334}},
+	"IT-RM":  {41.877, 12.511, BoundingBox{41.499, 11.843, 42.231, 13.138}},
+	"IT-RN":  {43.960, 12.426, BoundingBox{43.677, 12.123, 44.273, 12.843}},
+	"IT-RO":  {45.057, 11.819, BoundingBox{44.925, 11.268, 45.200, 12.376}},
+	"IT-SA":  {40.360, 15.270, BoundingBox{39.839, 14.705, 40.772, 15.753}},
+	"IT-SI":  {43.183, 11.453, BoundingBox{42.863, 10.964, 43.534, 11.978}},
+	"IT-SO":  {46.269, 10.040, BoundingBox{45.974, 9.224, 46.841, 10.582}},
+	"IT-SP":  {44.264, 9.653, BoundingBox{43.976, 9.317, 44.549, 9.965}},
+	"IT-SR":  {37.045, 15.040, BoundingBox{36.620, 14.684, 37.320, 15.310}},
+	"IT-SS":  {40.649, 8.620, BoundingBox{40.306, 8.160, 40.989, 9.066}},
+	"IT-SV":  {44.331, 8.237, BoundingBox{44.002, 7.865, 44.560, 8.671}},
+	"IT-TA":  {40.593, 17.181, BoundingBox{40.315, 16.800, 40.959, 17.552}},
+	"IT-TE":  {42.626, 13.678, BoundingBox{42.383, 13.210, 42.911, 14.170}},
+	"IT-TN":  {46.136, 11.028, BoundingBox{45.750, 10.490, 46.537, 11.692}},
+	"IT-TO":  {45.087, 7.383, BoundingBox{44.775, 6.750, 45.476, 8.008}},
+	"IT-TP":  {37.794, 12.802, BoundingBox{37.416, 12.430, 38.130, 13.100}},
+	"IT-TR":  {42.607, 12.408, BoundingBox{42.227, 11.970, 42.867, 12.890}},
+	"IT-TS":  {45.720, 13.735, BoundingBox{45.590, 13.497, 45.898, 13.940}},
+	"IT-TV":  {45.813, 12.162, BoundingBox{45.534, 11.800, 46.058, 12.565}},
+	"IT-UD":  {46.357, 13.177, BoundingBox{45.912, 12.676, 46.703, 13.810}},
+	"IT-VA":  {45.812, 8.752, BoundingBox{45.573, 8.441, 46.034, 9.042}},
+	"IT-VB":  {46.006, 8.352, BoundingBox{45.862, 8.093, 46.160, 8.624}},
+	"IT-VC":  {45.555, 8.283, BoundingBox{45.140, 7.717, 46.022, 8.495}},
+	"IT-VE":  {45.604, 12.588, BoundingBox{45.083, 12.051, 45.935, 13.216}},
+	"IT-VI":  {45.720, 11.514, BoundingBox{45.360, 11.047, 46.068, 11.891}},
+	"IT-VR":  {45.446, 11.012, BoundingBox{45.098, 10.556, 45.768, 11.398}},
+	"IT-VS":  {39.568, 8.760, BoundingBox{39.311, 8.412, 39.774, 9.131}},
+	"IT-VT":  {42.366, 11.856, BoundingBox{41.892, 11.334, 42.901, 12.354}},
+	"IT-VV":  {38.628, 16.136, BoundingBox{38.392, 15.800, 38.932, 16.485}},
+	"JM-01":  {17.936, -76.796, BoundingBox{17.831, -76.970, 18.033, -76.621}},
+	"JM-02":  {18.117, -76.736, BoundingBox{17.943, -76.925, 18.289, -76.591}},
+	"JM-03":  {17.951, -76.430, BoundingBox{17.878, -76.625, 18.025, -76.200}},
+	"JM-04":  {18.120, -76.485, BoundingBox{18.025, -76.625, 18.275, -76.285}},
+	"JM-05":  {18.294, -76.916, BoundingBox{18.166, -77.091, 18.421, -76.654}},
+	"JM-06":  {18.313, -77.255, BoundingBox{18.150, -77.425, 18.471, -77.060}},
+	"JM-07":  {18.345, -77.582, BoundingBox{18.185, -77.757, 18.506, -77.425}},
+	"JM-08":  {18.374, -77.851, BoundingBox{18.184, -78.000, 18.520, -77.696}},
+	"JM-09":  {18.386, -78.142, BoundingBox{18.266, -78.320, 18.487, -78.000}},
+	"JM-10":  {18.199, -78.079, BoundingBox{17.999, -78.340, 18.363, -77.881}},
+	"JM-11":  {18.033, -77.754, BoundingBox{17.811, -77.984, 18.232, -77.600}},
+	"JM-12":  {18.026, -77.484, BoundingBox{17.776, -77.600, 18.238, -77.309}},
+	"JM-13":  {17.905, -77.251, BoundingBox{17.700, -77.474, 18.150, -77.035}},
+	"JM-14":  {18.037, -77.028, BoundingBox{17.796, -77.191, 18.226, -76.887}},
+	"JO-AJ":  {32.337, 35.690, BoundingBox{32.183, 35.550, 32.503, 35.875}},
+	"JO-AM":  {31.256, 36.772, BoundingBox{30.496, 35.745, 32.074, 38.000}},
+	"JO-AQ":  {29.715, 35.484, BoundingBox{29.200, 34.920, 30.407, 36.186}},
+	"JO-AT":  {30.728, 35.766, BoundingBox{30.308, 35.204, 31.025, 36.492}},
+	"JO-AZ":  {31.970, 37.095, BoundingBox{31.544, 36.028, 32.403, 38.298}},
+	"JO-BA":  {32.038, 35.703, BoundingBox{31.857, 35.550, 32.213, 35.954}},
+	"JO-IR":  {32.545, 35.864, BoundingBox{32.415, 35.610, 32.710, 36.141}},
+	"JO-JA":  {32.255, 35.960, BoundingBox{32.067, 35.770, 32.442, 36.122}},
+	"JO-KA":  {31.228, 35.801, BoundingBox{31.025, 35.397, 31.474, 36.298}},
+	"JO-MA":  {32.489, 38.145, BoundingBox{31.835, 36.070, 33.380, 39.200}},
+	"JO-MD":  {31.614, 35.749, BoundingBox{31.421, 35.400, 31.877, 36.140}},
+	"JO-MN":  {30.195, 36.611, BoundingBox{29.284, 35.563, 30.829, 37.974}},
+	"JP-01":  {43.522, 142.805, BoundingBox{42.000, 140.070, 45.550, 145.540}},
+	"JP-02":  {42.262, 140.633, BoundingBox{40.122, 139.820, 42.954, 141.758}},
+	"JP-03":  {39.617, 141.361, BoundingBox{38.902, 140.720, 40.382, 141.910}},
+	"JP-04":  {38.545, 140.889, BoundingBox{37.737, 140.500, 39.114, 141.627}},
+	"JP-05":  {39.702, 140.368, BoundingBox{39.043, 139.882, 40.434, 140.870}},
+	"JP-06":  {38.441, 140.019, BoundingBox{37.886, 139.293, 39.109, 140.500}},
+	"JP-07":  {37.431, 140.270, BoundingBox{36.862, 139.475, 37.947, 140.980}},
+	"JP-08":  {36.337, 140.357, BoundingBox{35.880, 139.805, 36.887, 140.860}},
+	"JP-09":  {36.750, 139.769, BoundingBox{36.240, 139.268, 37.214, 140.453}},
+	"JP-10":  {36.587, 138.880, BoundingBox{36.045, 138.249, 37.050, 139.453}},
+	"JP-11":  {36.051, 139.372, BoundingBox{35.717, 138.818, 36.386, 139.928}},
+	"JP-12":  {35.546, 140.202, BoundingBox{35.009, 139.799, 35.949, 140.770}},
+	"JP-13":  {35.703, 139.624, BoundingBox{35.383, 139.081, 35.993, 139.980}},
+	"JP-14":  {35.201, 139.331, BoundingBox{34.714, 138.843, 35.718, 139.897}},
+	"JP-15":  {37.415, 138.868, BoundingBox{36.912, 137.913, 38.126, 139.546}},
+	"JP-16":  {36.668, 137.397, BoundingBox{36.178, 136.885, 37.186, 138.070}},
+	"JP-17":  {36.659, 136.666, BoundingBox{36.168, 136.164, 37.300, 137.142}},
+	"JP-18":  {35.892, 136.284, BoundingBox{35.479, 135.747, 36.355, 136.718}},
+	"JP-19":  {35.633, 138.530, BoundingBox{35.168, 137.772, 36.107, 139.061}},
+	"JP-20":  {36.250, 138.002, BoundingBox{35.545, 137.394, 36.987, 138.608}},
+	"JP-21":  {35.786, 137.100, BoundingBox{35.352, 136.571, 36.245, 137.770}},
+	"JP-22":  {34.989, 138.301, BoundingBox{34.627, 137.723, 35.524, 139.100}},
+	"JP-23":  {35.045, 137.247, BoundingBox{34.499, 136.616, 35.545, 137.784}},
+	"JP-24":  {34.507, 136.508, BoundingBox{33.928, 135.998, 34.955, 137.082}},
+	"JP-25":  {35.213, 136.202, BoundingBox{34.778, 135.763, 35.634, 136.696}},
+	"JP-26":  {35.267, 135.468, BoundingBox{34.877, 135.002, 35.657, 135.865}},
+	"JP-27":  {34.614, 135.552, BoundingBox{34.275, 135.153, 34.905, 136.000}},
+	"JP-28":  {35.033, 134.805, BoundingBox{34.293, 134.255, 35.729, 135.451}},
+	"JP-29":  {34.231, 135.954, BoundingBox{33.750, 135.449, 34.767, 136.372}},
+	"JP-30":  {33.895, 135.555, BoundingBox{33.460, 135.096, 34.302, 136.151}},
+	"JP-31":  {35.389, 133.849, BoundingBox{35.125, 133.106, 35.730, 134.614}},
+	"JP-32":  {35.106, 132.697, BoundingBox{34.752, 131.903, 35.503, 133.246}},
+	"JP-33":  {34.831, 133.752, BoundingBox{34.460, 133.184, 35.125, 134.365}},
+	"JP-34":  {34.512, 132.659, BoundingBox{34.067, 131.925, 34.922, 133.340}},
+	"JP-35":  {34.218, 131.596, BoundingBox{33.715, 130.787, 34.771, 132.265}},
+	"JP-36":  {33.771, 134.287, BoundingBox{33.200, 133.763, 34.231, 134.770}},
+	"JP-37":  {34.248, 133.908, BoundingBox{33.831, 133.299, 34.632, 134.503}},
+	"JP-38":  {33.432, 132.756, BoundingBox{32.747, 132.150, 34.092, 133.211}},
+	"JP-39":  {33.506, 133.391, BoundingBox{32.700, 132.902, 33.999, 134.159}},
+	"JP-40":  {33.569, 130.745, BoundingBox{33.063, 130.245, 34.120, 131.221}},
+	"JP-41":  {33.257, 130.108, BoundingBox{32.949, 129.410, 33.566, 130.619}},
+	"JP-42":  {32.867, 130.035, BoundingBox{32.436, 129.048, 34.608, 130.450}},
+	"JP-43":  {32.606, 130.772, BoundingBox{32.095, 130.193, 33.091, 131.484}},
+	"JP-44":  {33.120, 131.428, BoundingBox{32.622, 130.816, 33.715, 132.000}},
+	"JP-45":  {32.101, 131.267, BoundingBox{31.426, 130.672, 32.643, 131.792}},
+	"JP-46":  {30.702, 130.950, BoundingBox{28.051, 129.116, 32.150, 131.294}},
+	"JP-47":  {26.500, 127.950, BoundingBox{24.192, 123.972, 26.874, 128.368}},
+	"KE-01":  {0.763, 36.105, BoundingBox{0.084, 35.635, 1.566, 36.604}},
+	"KE-02":  {-0.906, 35.319, BoundingBox{-1.431, 34.979, -0.550, 35.754}},
+	"KE-03":  {0.734, 34.662, BoundingBox{0.454, 34.321, 1.088, 35.038}},
+	"KE-04":  {0.367, 34.247, BoundingBox{0.080, 33.890, 0.710, 34.500}},
+	"KE-05":  {1.037, 35.619, BoundingBox{0.569, 35.233, 1.489, 36.095}},
+	"KE-06":  {-0.661, 37.743, BoundingBox{-1.050, 37.450, -0.102, 38.231}},
+	"KE-07":  {-0.230, 40.090, BoundingBox{-1.216, 38.941, 0.650, 41.068}},
+	"KE-08":  {-0.533, 34.282, BoundingBox{-0.800, 33.895, -0.219, 34.716}},
+	"KE-09":  {1.111, 38.660, BoundingBox{0.031, 37.691, 2.230, 39.650}},
+	"KE-10":  {-1.982, 36.706, BoundingBox{-2.764, 35.952, -1.378, 37.310}},
+	"KE-11":  {0.379, 34.780, BoundingBox{0.154, 34.500, 0.596, 35.041}},
+	"KE-12":  {-0.238, 35.430, BoundingBox{-0.550, 35.035, 0.100, 35.804}},
+	"KE-13":  {-1.001, 36.569, BoundingBox{-1.323, 36.193, -0.648, 37.042}},
+	"KE-14":  {-3.040, 39.593, BoundingBox{-3.975, 39.022, -2.400, 40.237}},
+	"KE-15":  {-0.485, 37.315, BoundingBox{-0.916, 37.061, -0.033, 37.450}},
+	"KE-16":  {-0.866, 34.788, BoundingBox{-1.279, 34.500, -0.487, 35.014}},
+	"KE-17":  {-0.196, 34.854, BoundingBox{-0.416, 34.532, 0.062, 35.123}},
+	"KE-18":  {-1.505, 38.561, BoundingBox{-2.440, 37.850, -0.585, 39.050}},
+	"KE-19":  {-4.130, 39.139, BoundingBox{-4.680, 38.506, -3.580, 39.638}},
+	"KE-20":  {0.447, 36.859, BoundingBox{-0.059, 36.244, 0.987, 37.405}},
+	"KE-21":  {-1.801, 40.721, BoundingBox{-2.688, 39.923, -0.966, 41.590}},
+	"KE-22":  {-1.349, 37.515, BoundingBox{-1.830, 37.107, -0.912, 37.970}},
+	"KE-23":  {-2.372, 37.770, BoundingBox{-3.085, 37.102, -1.650, 38.640}},
+	"KE-24":  {3.368, 40.491, BoundingBox{2.410, 39.161, 4.260, 41.860}},
+	"KE-25":  {3.051, 37.836, BoundingBox{1.967, 36.629, 4.439, 39.240}},
+	"KE-26":  {0.356, 37.826, BoundingBox{-0.131, 37.303, 0.945, 38.671}},
+	"KE-27":  {-1.105, 34.490, BoundingBox{-1.579, 33.899, -0.800, 35.024}},
+	"KE-28":  {-4.010, 39.614, BoundingBox{-4.224, 39.491, -3.832, 39.712}},
+	"KE-29":  {-0.825, 37.054, BoundingBox{-1.128, 36.670, -0.561, 37.416}},
+	"KE-30":  {-1.295, 36.787, BoundingBox{-1.443, 36.260, -1.095, 37.113}},
+	"KE-31":  {-0.505, 35.997, BoundingBox{-1.039, 35.635, 0.100, 36.423}},
+	"KE-32":  {0.159, 35.172, BoundingBox{-0.081, 34.889, 0.456, 35.575}},
+	"KE-33":  {-1.462, 35.722, BoundingBox{-2.118, 34.994, -0.847, 36.261}},
+	"KE-34":  {-0.571, 34.968, BoundingBox{-0.894, 34.709, -0.345, 35.211}},
+	"KE-35":  {-0.227, 36.412, BoundingBox{-0.746, 36.000, 0.222, 36.727}},
+	"KE-36":  {-0.269, 36.975, BoundingBox{-0.648, 36.668, 0.074, 37.363}},
+	"KE-37":  {1.686, 37.054, BoundingBox{0.786, 36.201, 2.633, 38.056}},
+	"KE-38":  {-0.055, 34.202, BoundingBox{-0.370, 33.890, 0.283, 34.500}},
+	"KE-39":  {-3.279, 38.468, BoundingBox{-4.194, 37.673, -2.420, 39.119}},
+	"KE-40":  {-1.619, 39.642, BoundingBox{-2.400, 39.050, -0.760, 40.569}},
+	"KE-41":  {-0.288, 38.392, BoundingBox{-0.831, 37.531, 0.195, 39.031}},
+	"KE-42":  {1.103, 34.990, BoundingBox{0.714, 34.602, 1.499, 35.342}},
+	"KE-43":  {3.788, 35.525, BoundingBox{2.310, 34.010, 5.510, 36.876}},
+	"KE-44":  {0.459, 35.387, BoundingBox{0.100, 34.999, 0.820, 35.779}},
+	"KE-45":  {0.047, 34.658, BoundingBox{-0.232, 34.500, 0.283, 34.924}},
+	"KE-46":  {1.772, 40.008, BoundingBox{0.650, 38.807, 3.024, 40.986}},
+	"KE-47":  {1.902, 35.527, BoundingBox{1.220, 34.786, 2.567, 36.242}},
+	"KG-B":   {39.784, 70.946, BoundingBox{39.280, 69.460, 40.265, 72.048}},
+	"KG-C":   {42.512, 74.695, BoundingBox{41.537, 73.343, 43.300, 76.560}},
+	"KG-GB":  {42.840, 74.620, BoundingBox{42.688, 74.450, 42.960, 74.775}},
+	"KG-J":   {41.496, 72.531, BoundingBox{40.748, 70.420, 42.084, 74.000}},
+	"KG-N":   {41.325, 75.546, BoundingBox{40.372, 74.000, 42.436, 77.062}},
+	"KG-O":   {40.141, 73.221, BoundingBox{39.301, 71.977, 41.053, 74.787}},
+	"KG-T":   {42.308, 72.093, BoundingBox{41.737, 70.700, 42.850, 73.467}},
+	"KG-Y":   {42.160, 77.991, BoundingBox{41.085, 76.334, 42.980, 80.260}},
+	"KH-1":   {13.699, 103.021, BoundingBox{13.315, 102.408, 14.245, 103.507}},
+	"KH-10":  {12.518, 106.233, BoundingBox{11.758, 105.598, 13.140, 106.700}},
+	"KH-11":  {12.662, 107.099, BoundingBox{11.978, 106.700, 13.220, 107.578}},
+	"KH-12":  {11.552, 104.884, BoundingBox{11.422, 104.771, 11.655, 105.005}},
+	"KH-13":  {13.814, 104.958, BoundingBox{13.250, 104.344, 14.399, 105.583}},
+	"KH-14":  {11.389, 105.496, BoundingBox{10.909, 105.206, 11.708, 105.993}},
+	"KH-15":  {12.312, 103.457, BoundingBox{11.850, 102.677, 12.965, 104.055}},
+	"KH-16":  {13.776, 106.967, BoundingBox{13.091, 106.361, 14.570, 107.610}},
+	"KH-17":  {13.400, 104.038, BoundingBox{12.779, 103.419, 14.144, 104.626}},
+	"KH-18":  {10.827, 103.637, BoundingBox{10.557, 103.205, 11.047, 103.939}},
+	"KH-19":  {13.536, 106.011, BoundingBox{12.975, 105.406, 14.362, 106.618}},
+	"KH-2":   {13.034, 103.058, BoundingBox{12.475, 102.483, 13.434, 103.586}},
+	"KH-20":  {11.122, 105.805, BoundingBox{10.898, 105.318, 11.670, 106.250}},
+	"KH-21":  {10.960, 104.808, BoundingBox{10.636, 104.424, 11.231, 105.320}},
+	"KH-22":  {14.105, 103.752, BoundingBox{13.653, 103.093, 14.420, 104.411}},
+	"KH-23":  {10.575, 104.281, BoundingBox{10.490, 103.931, 10.650, 104.648}},
+	"KH-24":  {12.706, 102.645, BoundingBox{11.993, 102.350, 13.466, 102.986}},
+	"KH-3":   {12.046, 105.598, BoundingBox{11.659, 105.051, 12.587, 106.219}},
+	"KH-4":   {12.315, 104.395, BoundingBox{11.853, 103.974, 12.871, 104.990}},
+	"KH-5":   {11.557, 104.173, BoundingBox{11.150, 103.826, 12.000, 104.475}},
+	"KH-6":   {12.815, 105.036, BoundingBox{12.215, 104.308, 13.250, 105.666}},
+	"KH-7":   {10.873, 104.218, BoundingBox{10.597, 103.876, 11.150, 104.634}},
+	"KH-8":   {11.600, 105.167, BoundingBox{11.021, 104.475, 12.221, 105.380}},
+	"KH-9":   {11.460, 103.416, BoundingBox{11.005, 102.747, 11.850, 103.964}},
+	"KI-G":   {1.420, 173.000, BoundingBox{1.212, 172.792, 1.628, 173.208}},
+	"KI-L":   {1.870, -157.400, BoundingBox{1.621, -157.649, 2.119, -157.151}},
+	"KI-P":   {-2.800, -171.700, BoundingBox{-4.545, -171.767, -2.734, -171.455}},
+	"KM-A":   {-12.220, 44.430, BoundingBox{-12.370, 44.277, -12.070, 44.583}},
+	"KM-G":   {-11.700, 43.330, BoundingBox{-11.991, 43.033, -11.409, 43.627}},
+	"KM-M":   {-12.300, 43.730, BoundingBox{-12.416, 43.611, -12.184, 43.849}},
+	"KN-01":  {17.379, -62.728, BoundingBox{17.339, -62.784, 17.408, -62.667}},
+	"KN-02":  {17.307, -62.836, BoundingBox{17.252, -62.843, 17.360, -62.828}},
+	"KN-03":  {17.252, -62.725, BoundingBox{17.192, -62.770, 17.328, -62.677}},
+	"KN-04":  {17.122, -62.559, BoundingBox{17.092, -62.594, 17.145, -62.519}},
+	"KN-05":  {17.174, -62.561, BoundingBox{17.145, -62.595, 17.208, -62.519}},
+	"KN-06":  {17.367, -62.799, BoundingBox{17.348, -62.822, 17.390, -62.782}},
+	"KN-07":  {17.111, -62.605, BoundingBox{17.092, -62.634, 17.131, -62.584}},
+	"KN-08":  {17.343, -62.726, BoundingBox{17.314, -62.770, 17.380, -62.679}},
+	"KN-09":  {17.388, -62.772, BoundingBox{17.354, -62.795, 17.408, -62.740}},
+	"KN-10":  {17.140, -62.618, BoundingBox{17.119, -62.641, 17.155, -62.588}},
+	"KN-11":  {17.310, -62.660, BoundingBox{17.230, -62.713, 17.393, -62.617}},
+	"KN-12":  {17.198, -62.700, BoundingBox{17.155, -62.731, 17.257, -62.595}},
+	"KN-13":  {17.330, -62.812, BoundingBox{17.276, -62.828, 17.365, -62.783}},
+	"KN-15":  {17.269, -62.786, BoundingBox{17.192, -62.840, 17.343, -62.743}},
+	"KN-K":   {17.304, -62.733, BoundingBox{17.192, -62.843, 17.408, -62.617}},
+	"KN-N":   {17.160, -62.594, BoundingBox{17.092, -62.731, 17.257, -62.519}},
+	"KP-01":  {39.060, 125.725, BoundingBox{38.849, 125.386, 39.215, 125.950}},
+	"KP-02":  {39.368, 126.051, BoundingBox{38.567, 125.008, 40.117, 127.071}},
+	"KP-03":  {40.120, 125.108, BoundingBox{39.609, 124.270, 40.736, 125.845}},
+	"KP-04":  {40.723, 126.417, BoundingBox{39.917, 125.419, 41.729, 127.257}},
+	"KP-05":  {38.172, 125.392, BoundingBox{37.670, 124.710, 38.594, 126.086}},
+	"KP-06":  {38.375, 126.349, BoundingBox{37.750, 125.586, 38.929, 127.016}},
+	"KP-07":  {38.762, 127.469, BoundingBox{38.196, 126.824, 39.450, 128.350}},
+	"KP-08":  {40.167, 127.507, BoundingBox{39.450, 126.533, 40.843, 128.866}},
+	"KP-09":  {41.582, 129.215, BoundingBox{40.594, 128.431, 42.352, 129.958}},
+	"KP-10":  {41.152, 128.085, BoundingBox{40.376, 126.781, 42.096, 129.120}},
+	"KP-13":  {42.417, 130.041, BoundingBox{41.927, 129.354, 42.990, 130.780}},
+	"KR-11":  {37.560, 126.983, BoundingBox{37.440, 126.840, 37.680, 127.125}},
+	"KR-26":  {35.190, 129.045, BoundingBox{35.047, 128.935, 35.303, 129.226}},
+	"KR-27":  {35.873, 128.600, BoundingBox{35.760, 128.465, 35.985, 128.736}},
+	"KR-28":  {37.415, 126.587, BoundingBox{37.144, 126.251, 37.649, 126.847}},
+	"KR-29":  {35.160, 126.850, BoundingBox{35.065, 126.735, 35.255, 126.965}},
+	"KR-30":  {36.350, 127.383, BoundingBox{36.250, 127.265, 36.450, 127.500}},
+	"KR-31":  {35.570, 129.263, BoundingBox{35.390, 129.074, 35.675, 129.470}},
+	"KR-41":  {37.595, 127.202, BoundingBox{36.895, 126.170, 38.296, 127.935}},
+	"KR-42":  {37.716, 128.401, BoundingBox{37.044, 127.683, 38.610, 129.295}},
+	"KR-43":  {36.702, 127.874, BoundingBox{35.981, 127.250, 37.279, 128.470}},
+	"KR-44":  {36.400, 126.967, BoundingBox{35.726, 126.120, 36.919, 127.842}},
+	"KR-45":  {35.755, 127.097, BoundingBox{35.442, 126.424, 36.072, 127.745}},
+	"KR-46":  {34.985, 127.038, BoundingBox{34.390, 126.370, 35.729, 127.797}},
+	"KR-47":  {36.288, 128.745, BoundingBox{35.388, 127.728, 37.211, 129.470}},
+	"KR-48":  {35.257, 128.348, BoundingBox{34.688, 127.653, 35.719, 129.304}},
+	"KR-49":  {33.380, 126.550, BoundingBox{33.089, 126.202, 33.671, 126.898}},
+	"KW-AH":  {28.774, 47.942, BoundingBox{28.530, 47.461, 29.032, 48.420}},
+	"KW-FA":  {29.180, 47.736, BoundingBox{28.997, 47.413, 29.428, 47.997}},
+	"KW-HA":  {29.367, 48.069, BoundingBox{29.248, 47.961, 29.547, 48.180}},
+	"KW-JA":  {29.512, 47.312, BoundingBox{29.005, 46.570, 30.060, 47.935}},
+	"KW-KU":  {29.603, 47.952, BoundingBox{29.306, 47.732, 29.984, 48.172}},
+	"KW-MU":  {29.100, 48.067, BoundingBox{28.942, 47.886, 29.279, 48.250}},
+	"KZ-AKM": {51.153, 70.580, BoundingBox{48.830, 66.593, 53.737, 75.328}},
+	"KZ-AKT": {48.331, 58.391, BoundingBox{45.303, 54.681, 51.060, 62.919}},
+	"KZ-ALA": {43.263, 76.923, BoundingBox{43.150, 76.735, 43.375, 77.110}},
+	"KZ-ALM": {44.966, 78.134, BoundingBox{42.350, 74.371, 47.632, 82.616}},
+	"KZ-AST": {51.150, 71.450, BoundingBox{51.025, 71.250, 51.275, 71.650}},
+	"KZ-ATY": {47.665, 52.110, BoundingBox{45.767, 46.699, 49.400, 56.008}},
+	"KZ-KAR": {47.418, 71.693, BoundingBox{44.513, 65.922, 50.049, 77.716}},
+	"KZ-KUS": {51.188, 63.850, BoundingBox{48.221, 59.838, 54.322, 67.105}},
+	"KZ-KZY": {45.772, 63.825, BoundingBox{43.271, 59.113, 48.325, 67.686}},
+	"KZ-MAN": {44.021, 53.969, BoundingBox{41.260, 50.310, 46.134, 57.658}},
+	"KZ-PAV": {52.195, 76.105, BoundingBox{49.749, 73.105, 54.490, 79.040}},
+	"KZ-SEV": {54.001, 68.822, BoundingBox{52.665, 64.867, 55.390, 73.294}},
+	"KZ-VOS": {48.984, 81.431, BoundingBox{45.927, 76.191, 51.994, 87.360}},
+	"KZ-YUZ": {42.860, 68.267, BoundingBox{40.660, 65.655, 44.906, 71.101}},
+	"KZ-ZAP": {50.006, 51.004, BoundingBox{48.210, 46.470, 51.720, 55.559}},
+	"KZ-ZHA": {44.277, 72.601, BoundingBox{42.500, 69.441, 45.959, 75.219}},
+	"LA-AT":  {14.750, 106.953, BoundingBox{14.200, 106.307, 15.100, 107.542}},
+	"LA-BK":  {20.246, 100.778, BoundingBox{19.501, 100.120, 21.026, 101.355}},
+	"LA-BL":  {18.553, 104.216, BoundingBox{17.827, 103.333, 19.181, 105.092}},
+	"LA-CH":  {14.644, 105.882, BoundingBox{13.880, 105.220, 15.482, 106.392}},
+	"LA-HO":  {20.267, 103.945, BoundingBox{19.533, 103.029, 21.028, 104.820}},
+	"LA-KH":  {17.673, 105.125, BoundingBox{16.713, 104.347, 18.668, 105.984}},
+	"LA-LM":  {20.921, 101.294, BoundingBox{20.335, 100.639, 21.440, 102.021}},
+	"LA-LP":  {20.021, 102.482, BoundingBox{19.350, 101.557, 20.974, 103.185}},
+	"LA-OU":  {20.490, 101.950, BoundingBox{19.715, 101.261, 21.097, 102.936}},
+	"LA-PH":  {21.640, 102.264, BoundingBox{20.959, 101.650, 22.460, 103.073}},
+	"LA-SL":  {15.881, 106.319, BoundingBox{15.232, 105.507, 16.606, 107.128}},
+	"LA-SV":  {16.563, 105.641, BoundingBox{15.660, 104.763, 17.414, 106.556}},
+	"LA-VI":  {18.762, 102.255, BoundingBox{18.091, 101.707, 19.350, 102.849}},
+	"LA-VT":  {18.153, 102.513, BoundingBox{17.740, 101.462, 18.487, 103.225}},
+	"LA-XA":  {18.736, 101.432, BoundingBox{17.510, 100.731, 19.783, 101.961}},
+	"LA-XE":  {15.450, 106.964, BoundingBox{15.100, 106.193, 16.078, 107.560}},
+	"LA-XI":  {19.538, 103.387, BoundingBox{18.991, 102.609, 20.220, 104.110}},
+	"LA-XS":  {18.838, 103.136, BoundingBox{18.298, 102.576, 19.369, 103.760}},
+	"LB-AK":  {34.484, 36.217, BoundingBox{34.285, 35.928, 34.640, 36.516}},
+	"LB-AS":  {34.313, 35.950, BoundingBox{34.132, 35.732, 34.537, 36.147}},
+	"LB-BA":  {33.913, 35.539, BoundingBox{33.820, 35.442, 34.039, 35.624}},
+	"LB-BH":  {34.167, 36.308, BoundingBox{33.881, 36.049, 34.430, 36.610}},
+	"LB-BI":  {33.699, 35.876, BoundingBox{33.417, 35.641, 33.947, 36.157}},
+	"LB-JA":  {33.261, 35.333, BoundingBox{33.090, 35.130, 33.548, 35.505}},
+	"LB-JL":  {33.904, 35.708, BoundingBox{33.537, 35.325, 34.263, 36.072}},
+	"LB-NA":  {33.401, 35.616, BoundingBox{33.174, 35.373, 33.560, 35.883}},
+	"LI-01":  {47.067, 9.508, BoundingBox{47.050, 9.470, 47.094, 9.579}},
+	"LI-02":  {47.203, 9.515, BoundingBox{47.185, 9.488, 47.227, 9.542}},
+	"LI-03":  {47.219, 9.506, BoundingBox{47.196, 9.489, 47.235, 9.522}},
+	"LI-04":  {47.216, 9.568, BoundingBox{47.205, 9.522, 47.230, 9.629}},
+	"LI-05":  {47.184, 9.579, BoundingBox{47.156, 9.514, 47.211, 9.629}},
+	"LI-06":  {47.249, 9.532, BoundingBox{47.228, 9.510, 47.270, 9.558}},
+	"LI-07":  {47.165, 9.510, BoundingBox{47.142, 9.482, 47.187, 9.555}},
+	"LI-08":  {47.233, 9.576, BoundingBox{47.215, 9.529, 47.256, 9.630}},
+	"LI-09":  {47.092, 9.534, BoundingBox{47.058, 9.475, 47.121, 9.604}},
+	"LI-10":  {47.119, 9.588, BoundingBox{47.060, 9.538, 47.163, 9.623}},
+	"LI-11":  {47.136, 9.519, BoundingBox{47.115, 9.478, 47.159, 9.567}},
+	"LK-1":   {6.945, 80.045, BoundingBox{6.351, 79.776, 7.560, 80.318}},
+	"LK-11":  {6.852, 80.031, BoundingBox{6.665, 79.840, 7.014, 80.291}},
+	"LK-12":  {7.221, 79.984, BoundingBox{6.929, 79.776, 7.560, 80.239}},
+	"LK-13":  {6.584, 80.154, BoundingBox{6.351, 79.928, 6.822, 80.318}},
+	"LK-2":   {7.348, 80.722, BoundingBox{6.603, 80.392, 8.022, 81.159}},
+	"LK-21":  {7.334, 80.783, BoundingBox{7.125, 80.469, 7.529, 81.159}},
+	"LK-22":  {7.704, 80.688, BoundingBox{7.447, 80.475, 8.022, 81.057}},
+	"LK-23":  {6.906, 80.700, BoundingBox{6.603, 80.392, 7.125, 80.927}},
+	"LK-3":   {6.283, 80.778, BoundingBox{5.970, 80.118, 6.619, 81.481}},
+	"LK-31":  {6.256, 80.316, BoundingBox{5.996, 80.118, 6.435, 80.501}},
+	"LK-32":  {6.165, 80.595, BoundingBox{5.970, 80.334, 6.345, 80.843}},
+	"LK-33":  {6.356, 81.055, BoundingBox{6.100, 80.753, 6.619, 81.481}},
+	"LK-4":   {9.032, 80.363, BoundingBox{8.430, 79.779, 9.820, 81.049}},
+	"LK-41":  {9.573, 80.157, BoundingBox{9.250, 79.992, 9.820, 80.359}},
+	"LK-42":  {9.330, 80.345, BoundingBox{9.048, 80.007, 9.653, 80.661}},
+	"LK-43":  {8.799, 80.059, BoundingBox{8.430, 79.779, 9.250, 80.300}},
+	"LK-44":  {8.787, 80.520, BoundingBox{8.575, 80.247, 9.071, 80.864}},
+	"LK-45":  {9.097, 80.741, BoundingBox{8.827, 80.428, 9.413, 81.049}},
+	"LK-5":   {7.879, 81.478, BoundingBox{6.856, 80.758, 8.947, 81.790}},
+	"LK-51":  {7.723, 81.485, BoundingBox{7.475, 81.186, 8.218, 81.790}},
+	"LK-52":  {7.234, 81.519, BoundingBox{6.856, 81.186, 7.475, 81.784}},
+	"LK-53":  {8.524, 81.067, BoundingBox{8.218, 80.758, 8.947, 81.461}},
+	"LK-6":   {7.895, 80.069, BoundingBox{7.401, 79.700, 8.486, 80.475}},
+	"LK-61":  {7.676, 80.222, BoundingBox{7.401, 79.804, 8.032, 80.475}},
+	"LK-62":  {8.074, 79.943, BoundingBox{7.557, 79.700, 8.486, 80.286}},
+	"LK-7":   {8.122, 80.789, BoundingBox{7.475, 80.083, 8.575, 81.461}},
+	"LK-71":  {8.306, 80.486, BoundingBox{7.979, 80.083, 8.575, 80.862}},
+	"LK-72":  {7.947, 81.078, BoundingBox{7.475, 80.680, 8.267, 81.461}},
+	"LK-8":   {6.859, 81.248, BoundingBox{6.374, 80.853, 7.475, 81.694}},
+	"LK-81":  {7.049, 81.080, BoundingBox{6.615, 80.853, 7.475, 81.345}},
+	"LK-82":  {6.698, 81.389, BoundingBox{6.374, 80.983, 7.046, 81.694}},
+	"LK-9":   {6.840, 80.457, BoundingBox{6.323, 80.102, 7.441, 80.906}},
+	"LK-91":  {6.585, 80.545, BoundingBox{6.323, 80.269, 6.892, 80.906}},
+	"LK-92":  {7.184, 80.339, BoundingBox{6.867, 80.102, 7.441, 80.561}},
+	"LR-BG":  {7.058, -9.588, BoundingBox{6.421, -10.234, 7.587, -9.054}},
+	"LR-BM":  {7.035, -10.657, BoundingBox{6.464, -11.220, 7.678, -10.201}},
+	"LR-CM":  {7.270, -10.973, BoundingBox{6.577, -11.440, 7.934, -10.523}},
+	"LR-GB":  {6.170, -9.741, BoundingBox{5.642, -10.343, 6.675, -9.060}},
+	"LR-GG":  {5.851, -8.194, BoundingBox{5.350, -8.930, 6.405, -7.550}},
+	"LR-GK":  {4.870, -8.223, BoundingBox{4.376, -8.855, 5.350, -7.808}},
+	"LR-LO":  {7.942, -9.982, BoundingBox{7.436, -10.705, 8.540, -9.340}},
+	"LR-MG":  {6.577, -10.225, BoundingBox{5.867, -10.510, 7.225, -9.853}},
+	"LR-MO":  {6.345, -10.675, BoundingBox{5.898, -11.104, 6.716, -10.392}},
+	"LR-MY":  {4.858, -7.779, BoundingBox{4.360, -8.006, 5.441, -7.540}},
+	"LR-NI":  {6.923, -8.775, BoundingBox{6.186, -9.167, 7.710, -8.390}},
+	"LR-RI":  {5.663, -9.342, BoundingBox{4.994, -9.992, 6.255, -8.888}},
+	"LR-SI":  {5.354, -8.702, BoundingBox{4.764, -9.196, 6.060, -8.025}},
+	"LS-A":   {-29.555, 27.772, BoundingBox{-29.825, 27.329, -29.225, 28.150}},
+	"LS-B":   {-28.896, 28.525, BoundingBox{-29.155, 28.169, -28.650, 28.878}},
+	"LS-C":   {-29.055, 28.182, BoundingBox{-29.307, 27.865, -28.808, 28.475}},
+	"LS-D":   {-29.259, 27.941, BoundingBox{-29.526, 27.551, -28.998, 28.274}},
+	"LS-E":   {-29.829, 27.361, BoundingBox{-30.157, 27.000, -29.483, 27.782}},
+	"LS-F":   {-30.116, 27.706, BoundingBox{-30.514, 27.269, -29.825, 28.121}},
+	"LS-G":   {-30.339, 27.991, BoundingBox{-30.650, 27.617, -29.943, 28.333}},
+	"LS-H":   {-29.935, 28.524, BoundingBox{-30.218, 28.095, -29.750, 29.015}},
+	"LS-J":   {-29.273, 28.974, BoundingBox{-29.736, 28.618, -28.888, 29.330}},
+	"LS-K":   {-29.507, 28.536, BoundingBox{-29.750, 28.150, -29.125, 29.023}},
+	"LT-AL":  {54.256, 24.186, BoundingBox{53.910, 23.316, 54.697, 25.138}},
+	"LT-KL":  {55.655, 21.477, BoundingBox{55.156, 21.060, 56.138, 22.013}},
+	"LT-KU":  {55.080, 23.954, BoundingBox{54.635, 23.095, 55.521, 24.801}},
+	"LT-MR":  {54.605, 23.173, BoundingBox{54.122, 22.650, 55.060, 23.839}},
+	"LT-PN":  {55.836, 24.610, BoundingBox{55.243, 23.828, 56.370, 25.595}},
+	"LT-SA":  {55.894, 23.306, BoundingBox{55.385, 22.638, 56.318, 23.994}},
+	"LT-TA":  {55.295, 22.461, BoundingBox{54.897, 21.479, 55.671, 23.294}},
+	"LT-TE":  {55.993, 22.192, BoundingBox{55.592, 21.456, 56.340, 22.732}},
+	"LT-UT":  {55.455, 25.802, BoundingBox{54.974, 24.872, 56.067, 26.590}},
+	"LT-VL":  {54.743, 25.190, BoundingBox{54.144, 24.503, 55.258, 26.089}},
+	"LU-D":   {49.916, 5.960, BoundingBox{49.734, 5.710, 50.130, 6.240}},
+	"LU-G":   {49.699, 6.169, BoundingBox{49.594, 6.078, 49.828, 6.419}},
+	"LU-L":   {49.596, 5.941, BoundingBox{49.440, 5.670, 49.753, 6.205}},
+	"LV-001": {56.136, 27.011, BoundingBox{55.975, 26.711, 56.278, 27.300}},
+	"LV-002": {56.532, 25.264, BoundingBox{56.394, 25.124, 56.695, 25.430}},
+	"LV-003": {56.733, 21.615, BoundingBox{56.583, 21.313, 56.856, 21.847}},
+	"LV-004": {56.131, 25.805, BoundingBox{55.929, 25.502, 56.282, 26.115}},
+	"LV-005": {57.749, 24.838, BoundingBox{57.606, 24.627, 57.898, 25.083}},
+	"LV-006": {56.994, 21.548, BoundingBox{56.845, 21.295, 57.149, 21.777}},
+	"LV-007": {57.376, 27.095, BoundingBox{57.248, 26.738, 57.475, 27.469}},
+	"LV-008": {57.138, 25.474, BoundingBox{57.003, 25.229, 57.262, 25.768}},
+	"LV-009": {57.445, 26.556, BoundingBox{57.344, 26.252, 57.585, 26.865}},
+	"LV-010": {56.435, 22.827, BoundingBox{56.300, 22.384, 56.612, 23.159}},
+	"LV-011": {57.101, 24.429, BoundingBox{57.042, 24.271, 57.180, 24.550}},
+	"LV-012": {56.900, 23.828, BoundingBox{56.816, 23.655, 57.007, 23.977}},
+	"LV-013": {56.724, 24.380, BoundingBox{56.644, 24.193, 56.801, 24.545}},
+	"LV-014": {56.950, 27.595, BoundingBox{56.835, 27.332, 57.066, 27.839}},
+	"LV-015": {57.165, 27.272, BoundingBox{57.001, 27.002, 57.329, 27.507}},
+	"LV-016": {56.398, 24.304, BoundingBox{56.290, 24.077, 56.506, 24.654}},
+	"LV-017": {57.493, 25.198, BoundingBox{57.405, 24.988, 57.593, 25.397}},
+	"LV-018": {56.707, 22.656, BoundingBox{56.530, 22.458, 56.865, 22.829}},
+	"LV-019": {57.681, 25.338, BoundingBox{57.504, 25.006, 57.807, 25.653}},
+	"LV-020": {57.161, 24.246, BoundingBox{57.059, 24.137, 57.276, 24.389}},
+	"LV-021": {57.008, 26.331, BoundingBox{56.863, 26.033, 57.163, 26.578}},
+	"LV-022": {57.301, 25.248, BoundingBox{57.176, 25.082, 57.405, 25.402}},
+	"LV-023": {56.538, 27.880, BoundingBox{56.380, 27.800, 56.695, 28.014}},
+	"LV-024": {56.127, 27.588, BoundingBox{55.919, 27.250, 56.312, 28.033}},
+	"LV-025": {55.847, 26.245, BoundingBox{55.620, 26.020, 56.082, 27.030}},
+	"LV-026": {56.638, 23.262, BoundingBox{56.512, 23.025, 56.805, 23.445}},
+	"LV-027": {57.511, 22.317, BoundingBox{57.292, 21.990, 57.750, 22.575}},
+	"LV-028": {56.603, 21.391, BoundingBox{56.466, 21.232, 56.746, 21.617}},
+	"LV-029": {57.142, 23.048, BoundingBox{57.060, 22.861, 57.236, 23.266}},
+	"LV-030": {56.887, 25.620, BoundingBox{56.755, 25.335, 57.034, 25.940}},
+	"LV-031": {57.008, 24.393, BoundingBox{56.955, 24.252, 57.058, 24.540}},
+	"LV-032": {56.574, 21.195, BoundingBox{56.398, 21.081, 56.761, 21.364}},
+	"LV-033": {57.202, 26.695, BoundingBox{57.036, 26.390, 57.361, 27.040}},
+	"LV-034": {56.600, 24.188, BoundingBox{56.502, 23.991, 56.718, 24.360}},
+	"LV-035": {56.829, 24.512, BoundingBox{56.757, 24.381, 56.909, 24.637}},
+	"LV-036": {56.002, 26.174, BoundingBox{55.855, 25.871, 56.157, 26.462}},
+	"LV-037": {57.089, 24.679, BoundingBox{57.021, 24.550, 57.164, 24.826}},
+	"LV-038": {56.506, 24.978, BoundingBox{56.354, 24.802, 56.669, 25.166}},
+	"LV-039": {57.211, 26.119, BoundingBox{57.039, 25.768, 57.363, 26.390}},
+	"LV-040": {56.745, 22.996, BoundingBox{56.586, 22.744, 56.880, 23.333}},
+	"LV-041": {56.571, 23.618, BoundingBox{56.345, 23.377, 56.796, 23.933}},
+	"LV-042": {56.371, 25.819, BoundingBox{56.269, 25.628, 56.474, 25.997}},
+	"LV-043": {57.011, 22.714, BoundingBox{56.843, 22.361, 57.186, 22.997}},
+	"LV-044": {56.766, 27.618, BoundingBox{56.657, 27.280, 56.873, 27.895}},
+	"LV-045": {57.595, 25.227, BoundingBox{57.529, 24.986, 57.647, 25.399}},
+	"LV-046": {56.694, 25.434, BoundingBox{56.528, 25.275, 56.825, 25.616}},
+	"LV-047": {55.917, 27.160, BoundingBox{55.762, 26.907, 56.052, 27.484}},
+	"LV-048": {57.252, 24.750, BoundingBox{57.142, 24.650, 57.355, 24.890}},
+	"LV-049": {56.589, 26.080, BoundingBox{56.392, 25.824, 56.784, 26.386}},
+	"LV-050": {56.986, 22.032, BoundingBox{56.817, 21.760, 57.157, 22.370}},
+	"LV-051": {56.694, 24.659, BoundingBox{56.567, 24.504, 56.793, 24.798}},
+	"LV-052": {56.811, 24.195, BoundingBox{56.716, 24.084, 56.912, 24.332}},
+	"LV-053": {56.722, 24.878, BoundingBox{56.563, 24.725, 56.835, 25.070}},
+	"LV-054": {57.517, 24.654, BoundingBox{57.353, 24.242, 57.667, 24.991}},
+	"LV-055": {57.211, 25.072, BoundingBox{57.091, 24.864, 57.319, 25.265}},
+	"LV-056": {56.342, 26.202, BoundingBox{56.153, 25.944, 56.532, 26.454}},
+	"LV-057": {56.884, 26.734, BoundingBox{56.748, 26.474, 57.045, 27.046}},
+	"LV-058": {56.522, 27.695, BoundingBox{56.310, 27.537, 56.670, 27.800}},
+	"LV-059": {56.807, 26.210, BoundingBox{56.640, 25.904, 56.962, 26.512}},
+	"LV-060": {57.863, 25.056, BoundingBox{57.748, 24.819, 57.968, 25.225}},
+	"LV-061": {57.029, 24.992, BoundingBox{56.921, 24.777, 57.111, 25.265}},
+	"LV-062": {56.856, 23.980, BoundingBox{56.813, 23.819, 56.921, 24.097}},
+	"LV-063": {57.305, 22.982, BoundingBox{57.220, 22.817, 57.392, 23.183}},
+	"LV-064": {57.817, 25.504, BoundingBox{57.742, 25.338, 57.905, 25.718}},
+	"LV-065": {56.247, 25.205, BoundingBox{56.103, 24.871, 56.397, 25.537}},
+	"LV-066": {56.345, 21.197, BoundingBox{56.249, 21.069, 56.437, 21.380}},
+	"LV-067": {56.893, 25.020, BoundingBox{56.784, 24.556, 57.053, 25.356}},
+	"LV-068": {56.765, 23.966, BoundingBox{56.692, 23.779, 56.826, 24.127}},
+	"LV-069": {56.704, 23.790, BoundingBox{56.535, 23.447, 56.845, 24.063}},
+	"LV-070": {57.405, 24.968, BoundingBox{57.304, 24.729, 57.553, 25.185}},
+	"LV-071": {56.859, 21.275, BoundingBox{56.741, 21.089, 57.043, 21.451}},
+	"LV-072": {56.674, 25.732, BoundingBox{56.556, 25.570, 56.777, 25.884}},
+	"LV-073": {56.337, 26.611, BoundingBox{56.187, 26.380, 56.487, 26.862}},
+	"LV-074": {56.408, 21.556, BoundingBox{56.193, 21.321, 56.585, 21.776}},
+	"LV-075": {57.363, 25.442, BoundingBox{57.252, 25.300, 57.449, 25.576}},
+	"LV-076": {57.326, 25.665, BoundingBox{57.211, 25.497, 57.470, 25.852}},
+	"LV-077": {56.532, 27.163, BoundingBox{56.248, 26.930, 56.802, 27.693}},
+	"LV-078": {56.372, 26.956, BoundingBox{56.259, 26.675, 56.482, 27.205}},
+	"LV-079": {57.481, 22.698, BoundingBox{57.344, 22.575, 57.699, 22.907}},
+	"LV-080": {56.977, 24.638, BoundingBox{56.900, 24.502, 57.047, 24.804}},
+	"LV-081": {56.181, 21.287, BoundingBox{56.030, 21.060, 56.303, 21.635}},
+	"LV-082": {56.954, 27.132, BoundingBox{56.796, 26.855, 57.129, 27.397}},
+	"LV-083": {56.389, 23.906, BoundingBox{56.270, 23.637, 56.546, 24.117}},
+	"LV-084": {57.880, 25.273, BoundingBox{57.797, 25.148, 57.970, 25.398}},
+	"LV-085": {56.526, 25.596, BoundingBox{56.446, 25.410, 56.620, 25.783}},
+	"LV-086": {57.696, 24.448, BoundingBox{57.503, 24.238, 57.857, 24.653}},
+	"LV-087": {56.859, 24.344, BoundingBox{56.795, 24.234, 56.912, 24.446}},
+	"LV-088": {56.709, 22.370, BoundingBox{56.369, 22.036, 57.022, 22.647}},
+	"LV-089": {57.335, 24.383, BoundingBox{57.180, 24.182, 57.503, 24.659}},
+	"LV-090": {57.212, 24.562, BoundingBox{57.125, 24.408, 57.337, 24.650}},
+	"LV-091": {57.139, 24.895, BoundingBox{57.065, 24.755, 57.231, 25.067}},
+	"LV-092": {56.711, 25.143, BoundingBox{56.610, 24.971, 56.800, 25.275}},
+	"LV-093": {56.661, 22.019, BoundingBox{56.492, 21.773, 56.827, 22.255}},
+	"LV-094": {57.440, 26.015, BoundingBox{57.282, 25.657, 57.590, 26.354}},
+	"LV-095": {56.931, 24.376, BoundingBox{56.885, 24.234, 56.977, 24.527}},
+	"LV-096": {57.642, 25.740, BoundingBox{57.488, 25.528, 57.797, 26.011}},
+	"LV-097": {57.237, 22.520, BoundingBox{57.056, 22.181, 57.405, 22.882}},
+	"LV-098": {56.408, 23.372, BoundingBox{56.280, 23.129, 56.570, 23.657}},
+	"LV-099": {56.945, 23.149, BoundingBox{56.811, 22.900, 57.060, 23.358}},
+	"LV-100": {56.394, 21.958, BoundingBox{56.186, 21.627, 56.581, 22.389}},
+	"LV-101": {57.690, 25.920, BoundingBox{57.565, 25.717, 57.815, 26.255}},
+	"LV-102": {56.623, 26.638, BoundingBox{56.455, 26.349, 56.761, 26.940}},
+	"LV-103": {56.172, 26.474, BoundingBox{56.073, 26.155, 56.301, 26.760}},
+	"LV-104": {57.047, 25.836, BoundingBox{56.909, 25.569, 57.202, 26.080}},
+	"LV-105": {56.516, 24.593, BoundingBox{56.349, 24.360, 56.681, 24.814}},
+	"LV-106": {57.274, 21.828, BoundingBox{57.061, 21.375, 57.558, 22.209}},
+	"LV-107": {56.358, 25.488, BoundingBox{56.219, 25.182, 56.479, 25.737}},
+	"LV-108": {57.199, 27.600, BoundingBox{57.054, 27.397, 57.384, 27.805}},
+	"LV-109": {56.536, 26.903, BoundingBox{56.461, 26.694, 56.661, 27.064}},
+	"LV-110": {56.290, 27.964, BoundingBox{56.117, 27.706, 56.476, 28.180}},
+	"LV-DGV": {55.873, 26.530, BoundingBox{55.835, 26.455, 55.910, 26.605}},
+	"LV-JEL": {56.650, 23.720, BoundingBox{56.610, 23.650, 56.690, 23.790}},
+	"LV-JKB": {56.501, 25.846, BoundingBox{56.429, 25.721, 56.571, 25.935}},
+	"LV-JUR": {56.926, 23.560, BoundingBox{56.794, 23.325, 57.025, 23.915}},
+	"LV-LPX": {56.471, 21.094, BoundingBox{56.433, 21.076, 56.543, 21.125}},
+	"LV-REZ": {56.510, 27.333, BoundingBox{56.480, 27.275, 56.540, 27.390}},
+	"LV-RIX": {56.978, 24.112, BoundingBox{56.868, 23.908, 57.099, 24.272}},
+	"LV-VEN": {57.381, 21.603, BoundingBox{57.348, 21.539, 57.423, 21.645}},
+	"LV-VMR": {57.505, 25.484, BoundingBox{57.444, 25.333, 57.593, 25.672}},
+	"LY-BA":  {31.234, 20.594, BoundingBox{30.448, 19.431, 32.425, 21.746}},
+	"LY-BU":  {30.947, 23.567, BoundingBox{29.781, 21.866, 32.104, 25.160}},
+	"LY-DR":  {31.947, 22.683, BoundingBox{30.888, 22.150, 32.750, 23.772}},
+	"LY-GT":  {25.431, 10.823, BoundingBox{23.402, 9.320, 27.628, 12.250}},
+	"LY-JA":  {31.828, 21.772, BoundingBox{30.539, 21.248, 32.840, 22.150}},
+	"LY-JB":  {28.235, 23.912, BoundingBox{25.826, 22.406, 30.556, 25.000}},
+	"LY-JG":  {30.516, 13.085, BoundingBox{28.942, 11.350, 32.150, 15.064}},
+	"LY-JI":  {32.137, 12.928, BoundingBox{31.506, 12.238, 32.825, 13.433}},
+	"LY-JU":  {28.239, 16.552, BoundingBox{25.593, 14.150, 30.264, 18.433}},
+	"LY-KF":  {23.299, 21.900, BoundingBox{19.580, 17.563, 26.381, 25.000}},
+	"LY-MB":  {32.108, 14.022, BoundingBox{31.425, 13.365, 32.750, 15.037}},
+	"LY-MI":  {31.130, 15.133, BoundingBox{30.020, 13.897, 32.341, 16.367}},
+	"LY-MJ":  {32.132, 21.045, BoundingBox{31.105, 20.413, 32.785, 21.509}},
+	"LY-MQ":  {24.367, 15.175, BoundingBox{22.490, 12.250, 26.085, 18.412}},
+	"LY-NL":  {30.028, 10.785, BoundingBox{27.913, 9.480, 32.250, 12.150}},
+	"LY-NQ":  {32.589, 11.793, BoundingBox{32.150, 11.226, 33.140, 12.288}},
+	"LY-SB":  {26.686, 15.439, BoundingBox{25.324, 13.362, 28.537, 18.493}},
+	"LY-SR":  {30.040, 17.592, BoundingBox{28.575, 15.883, 31.234, 19.435}},
+	"LY-TB":  {32.644, 13.316, BoundingBox{32.277, 12.836, 32.880, 13.727}},
+	"LY-WA":  {28.010, 20.550, BoundingBox{25.447, 18.268, 30.572, 23.147}},
+	"LY-WD":  {26.465, 11.985, BoundingBox{24.947, 9.745, 27.686, 13.668}},
+	"LY-WS":  {28.176, 12.274, BoundingBox{27.018, 9.720, 29.358, 14.308}},
+	"LY-ZA":  {32.548, 12.393, BoundingBox{32.104, 12.101, 32.901, 12.837}},
+	"MA-01":  {35.120, -5.124, BoundingBox{34.454, -6.437, 35.760, -3.582}},
+	"MA-02":  {33.675, -2.565, BoundingBox{31.856, -3.838, 35.388, -1.120}},
+	"MA-03":  {33.698, -4.447, BoundingBox{32.476, -5.856, 35.076, -3.104}},
+	"MA-04":  {33.947, -6.381, BoundingBox{33.102, -8.163, 34.845, -5.390}},
+	"MA-05":  {32.415, -6.267, BoundingBox{31.184, -7.251, 33.356, -5.066}},
+	"MA-06":  {33.172, -7.516, BoundingBox{29.242, -9.579, 33.924, -6.537}},
+	"MA-07":  {31.711, -8.332, BoundingBox{30.567, -9.810, 32.846, -6.897}},
+	"MA-08":  {31.305, -5.228, BoundingBox{29.665, -7.657, 33.105, -2.905}},
+	"MA-09":  {30.082, -8.294, BoundingBox{28.995, -9.903, 31.061, -6.496}},
+	"MA-10":  {28.406, -9.996, BoundingBox{27.660, -11.983, 29.591, -8.333}},
+	"MA-11":  {27.792, -12.409, BoundingBox{26.085, -14.530, 28.115, -11.620}},
+	"MA-12":  {22.550, -14.330, BoundingBox{22.505, -15.979, 23.745, -14.281}},
+	"MA-AGD": {30.734, -9.382, BoundingBox{30.424, -9.769, 30.975, -9.001}},
+	"MA-AOU": {22.550, -14.330, BoundingBox{22.505, -14.379, 22.595, -14.281}},
+	"MA-ASZ": {28.386, -9.261, BoundingBox{27.660, -10.187, 29.284, -8.333}},
+	"MA-AZI": {31.695, -6.481, BoundingBox{31.184, -7.072, 32.143, -5.836}},
+	"MA-BEM": {32.330, -6.089, BoundingBox{31.917, -6.638, 32.922, -5.470}},
+	"MA-BER": {34.875, -2.368, BoundingBox{34.519, -2.736, 35.179, -1.989}},
+	"MA-BES": {33.443, -6.950, BoundingBox{33.172, -7.303, 33.751, -6.537}},
+	"MA-BOD": {26.130, -14.480, BoundingBox{26.085, -14.530, 26.175, -14.430}},
+	"MA-BOM": {33.119, -3.840, BoundingBox{32.476, -4.732, 33.738, -3.104}},
+	"MA-BRR": {33.175, -7.542, BoundingBox{33.029, -7.982, 33.340, -7.128}},
+	"MA-CAS": {33.561, -7.738, BoundingBox{33.460, -8.166, 33.745, -7.455}},
+	"MA-CHE": {35.080, -5.046, BoundingBox{34.612, -5.593, 35.457, -4.600}},
+	"MA-CHI": {31.433, -8.786, BoundingBox{30.919, -9.241, 31.920, -8.316}},
+	"MA-CHT": {29.819, -8.932, BoundingBox{29.242, -9.579, 30.464, -8.390}},
+	"MA-DRI": {34.903, -3.436, BoundingBox{34.575, -3.838, 35.388, -3.015}},
+	"MA-ERR": {31.658, -4.104, BoundingBox{30.534, -5.181, 32.533, -2.905}},
+	"MA-ESI": {31.361, -9.436, BoundingBox{30.975, -9.810, 31.943, -9.093}},
+	"MA-ESM": {26.740, -11.670, BoundingBox{26.695, -11.897, 27.707, -11.620}},
+	"MA-FAH": {35.674, -5.582, BoundingBox{35.539, -5.708, 35.760, -5.445}},
+	"MA-FES": {34.067, -4.870, BoundingBox{33.795, -5.181, 34.363, -4.499}},
+	"MA-FIG": {32.912, -2.280, BoundingBox{31.856, -3.191, 33.886, -1.120}},
+	"MA-FQH": {32.463, -6.755, BoundingBox{32.085, -7.251, 32.911, -6.263}},
+	"MA-GUE": {28.745, -10.113, BoundingBox{28.000, -10.696, 29.150, -9.441}},
+	"MA-GUF": {34.029, -3.344, BoundingBox{33.333, -3.792, 34.575, -2.941}},
+	"MA-HAJ": {33.667, -5.316, BoundingBox{33.434, -5.700, 33.905, -5.050}},
+	"MA-HAO": {31.160, -7.768, BoundingBox{30.567, -8.388, 31.632, -7.148}},
+	"MA-HOC": {35.056, -4.084, BoundingBox{34.600, -4.615, 35.400, -3.582}},
+	"MA-IFR": {33.288, -5.167, BoundingBox{32.944, -5.734, 33.601, -4.712}},
+	"MA-INE": {30.334, -9.384, BoundingBox{30.024, -9.659, 30.547, -9.008}},
+	"MA-JDI": {33.066, -8.371, BoundingBox{32.775, -8.992, 33.465, -7.939}},
+	"MA-JRA": {34.122, -2.093, BoundingBox{33.799, -2.479, 34.523, -1.691}},
+	"MA-KEN": {34.476, -6.346, BoundingBox{34.021, -6.716, 34.845, -5.976}},
+	"MA-KES": {31.963, -7.305, BoundingBox{31.451, -7.744, 32.467, -6.897}},
+	"MA-KHE": {33.573, -6.157, BoundingBox{33.102, -6.581, 34.016, -5.700}},
+	"MA-KHN": {32.821, -5.645, BoundingBox{32.154, -6.261, 33.356, -5.066}},
+	"MA-KHO": {32.945, -6.792, BoundingBox{32.479, -7.243, 33.302, -6.252}},
+	"MA-LAA": {27.150, -13.200, BoundingBox{27.105, -13.251, 27.195, -13.149}},
+	"MA-LAR": {35.077, -6.010, BoundingBox{34.711, -6.437, 35.433, -5.592}},
+	"MA-MAR": {31.699, -8.061, BoundingBox{31.340, -8.471, 31.992, -7.558}},
+	"MA-MDF": {35.654, -5.217, BoundingBox{35.519, -5.471, 35.760, -4.853}},
+	"MA-MED": {33.432, -7.414, BoundingBox{33.294, -7.628, 33.571, -7.233}},
+	"MA-MEK": {33.846, -5.594, BoundingBox{33.467, -5.856, 34.138, -5.274}},
+	"MA-MID": {32.560, -4.684, BoundingBox{32.042, -5.406, 33.105, -3.693}},
+	"MA-MOH": {33.715, -7.346, BoundingBox{33.510, -7.568, 33.924, -7.158}},
+	"MA-MOU": {34.232, -5.244, BoundingBox{33.887, -5.527, 34.553, -4.965}},
+	"MA-NAD": {35.011, -2.922, BoundingBox{34.654, -3.307, 35.329, -2.554}},
+	"MA-NOU": {33.340, -7.843, BoundingBox{33.143, -8.163, 33.460, -7.472}},
+	"MA-OUA": {30.803, -6.881, BoundingBox{30.107, -7.657, 31.477, -6.105}},
+	"MA-OUD": {23.700, -15.930, BoundingBox{23.655, -15.979, 23.745, -15.881}},
+	"MA-OUJ": {34.567, -2.004, BoundingBox{34.311, -2.320, 34.865, -1.768}},
+	"MA-OUZ": {34.752, -5.578, BoundingBox{34.454, -6.032, 35.163, -5.146}},
+	"MA-RAB": {33.915, -6.787, BoundingBox{33.702, -7.075, 34.101, -6.506}},
+	"MA-REH": {32.272, -7.883, BoundingBox{31.918, -8.225, 32.653, -7.469}},
+	"MA-SAF": {32.274, -9.072, BoundingBox{31.834, -9.473, 32.846, -8.724}},
+	"MA-SAL": {34.083, -6.630, BoundingBox{33.777, -6.926, 34.411, -6.346}},
+	"MA-SEF": {33.679, -4.686, BoundingBox{33.254, -5.050, 34.052, -4.295}},
+	"MA-SET": {32.797, -7.548, BoundingBox{32.448, -7.975, 33.114, -7.174}},
+	"MA-SIB": {32.659, -8.462, BoundingBox{32.421, -9.031, 32.887, -7.933}},
+	"MA-SIF": {29.298, -9.865, BoundingBox{29.150, -10.349, 29.591, -9.378}},
+	"MA-SIK": {34.336, -5.712, BoundingBox{33.993, -5.994, 34.635, -5.390}},
+	"MA-SIL": {34.176, -6.047, BoundingBox{33.948, -6.347, 34.565, -5.809}},
+	"MA-SKH": {33.777, -6.897, BoundingBox{33.529, -7.246, 34.019, -6.542}},
+	"MA-TAF": {27.847, -12.384, BoundingBox{27.660, -13.107, 28.115, -11.838}},
+	"MA-TAI": {34.246, -2.742, BoundingBox{33.663, -3.110, 34.721, -2.342}},
+	"MA-TAO": {34.586, -4.617, BoundingBox{34.124, -5.167, 35.076, -4.078}},
+	"MA-TAR": {30.475, -8.388, BoundingBox{29.724, -9.024, 31.061, -7.623}},
+	"MA-TAT": {29.758, -7.599, BoundingBox{28.995, -8.519, 30.463, -6.496}},
+	"MA-TAZ": {34.084, -4.019, BoundingBox{33.600, -4.505, 34.600, -3.581}},
+	"MA-TET": {35.425, -5.346, BoundingBox{35.175, -5.759, 35.627, -4.768}},
+	"MA-TIN": {31.367, -5.439, BoundingBox{30.683, -6.216, 32.157, -4.749}},
+	"MA-TIZ": {29.528, -9.432, BoundingBox{29.186, -9.903, 30.015, -8.913}},
+	"MA-TNG": {35.565, -5.853, BoundingBox{35.368, -6.096, 35.760, -5.660}},
+	"MA-TNT": {28.120, -10.979, BoundingBox{27.660, -11.983, 28.940, -10.145}},
+	"MA-YUS": {32.164, -8.493, BoundingBox{31.820, -8.796, 32.474, -8.179}},
+	"MA-ZAG": {30.314, -5.815, BoundingBox{29.665, -6.699, 30.946, -4.760}},
+	"MC-CL":  {43.731, 7.411, BoundingBox{43.727, 7.408, 43.735, 7.414}},
+	"MC-CO":  {43.735, 7.420, BoundingBox{43.732, 7.417, 43.737, 7.422}},
+	"MC-FO":  {43.728, 7.416, BoundingBox{43.724, 7.409, 43.731, 7.421}},
+	"MC-GA":  {43.739, 7.420, BoundingBox{43.737, 7.416, 43.742, 7.423}},
+	"MC-JE":  {43.732, 7.414, BoundingBox{43.730, 7.411, 43.734, 7.417}},
+	"MC-LA":  {43.744, 7.436, BoundingBox{43.741, 7.431, 43.748, 7.441}},
+	"MC-MA":  {43.733, 7.416, BoundingBox{43.731, 7.413, 43.735, 7.419}},
+	"MC-MC":  {43.740, 7.427, BoundingBox{43.737, 7.425, 43.742, 7.429}},
+	"MC-MG":  {43.736, 7.414, BoundingBox{43.734, 7.410, 43.739, 7.418}},
+	"MC-MO":  {43.731, 7.423, BoundingBox{43.727, 7.419, 43.733, 7.428}},
+	"MC-MU":  {43.743, 7.431, BoundingBox{43.740, 7.425, 43.746, 7.437}},
+	"MC-PH":  {43.734, 7.426, BoundingBox{43.732, 7.422, 43.737, 7.430}},
+	"MC-SD":  {43.737, 7.423, BoundingBox{43.736, 7.420, 43.739, 7.426}},
+	"MC-SO":  {43.741, 7.424, BoundingBox{43.738, 7.421, 43.745, 7.427}},
+	"MC-SP":  {43.738, 7.431, BoundingBox{43.735, 7.426, 43.741, 7.436}},
+	"MC-SR":  {43.748, 7.438, BoundingBox{43.744, 7.433, 43.752, 7.442}},
+	"MC-VR":  {43.747, 7.431, BoundingBox{43.745, 7.427, 43.749, 7.434}},
+	"MD-AN":  {46.882, 29.180, BoundingBox{46.673, 28.975, 47.100, 29.400}},
+	"MD-BA":  {47.779, 27.920, BoundingBox{47.604, 27.710, 47.932, 28.153}},
+	"MD-BD":  {46.829, 29.482, BoundingBox{46.711, 29.314, 46.961, 29.658}},
+	"MD-BR":  {48.280, 26.974, BoundingBox{48.120, 26.620, 48.430, 27.280}},
+	"MD-BS":  {46.365, 28.811, BoundingBox{46.191, 28.720, 46.531, 29.328}},
+	"MD-CA":  {45.807, 28.253, BoundingBox{45.490, 28.050, 46.090, 28.435}},
+	"MD-CL":  {47.279, 28.278, BoundingBox{47.117, 28.012, 47.403, 28.571}},
+	"MD-CM":  {46.551, 28.794, BoundingBox{46.359, 28.504, 46.718, 29.110}},
+	"MD-CR":  {47.149, 28.984, BoundingBox{47.007, 28.762, 47.285, 29.258}},
+	"MD-CS":  {46.604, 29.322, BoundingBox{46.396, 29.051, 46.760, 29.611}},
+	"MD-CT":  {46.229, 28.257, BoundingBox{46.090, 28.088, 46.390, 28.452}},
+	"MD-CU":  {47.013, 28.864, BoundingBox{46.898, 28.644, 47.134, 29.073}},
+	"MD-DO":  {48.240, 27.670, BoundingBox{48.087, 27.419, 48.391, 27.972}},
+	"MD-DR":  {48.049, 27.863, BoundingBox{47.871, 27.608, 48.254, 28.084}},
+	"MD-DU":  {47.284, 29.220, BoundingBox{47.096, 28.953, 47.458, 29.466}},
+	"MD-ED":  {48.121, 27.225, BoundingBox{47.913, 26.920, 48.314, 27.494}},
+	"MD-FA":  {47.532, 27.707, BoundingBox{47.365, 27.412, 47.724, 27.978}},
+	"MD-FL":  {47.894, 28.325, BoundingBox{47.707, 28.032, 48.025, 28.599}},
+	"MD-GA":  {46.133, 28.560, BoundingBox{45.831, 28.250, 46.387, 28.872}},
+	"MD-GL":  {47.772, 27.460, BoundingBox{47.592, 27.141, 47.916, 27.722}},
+	"MD-HI":  {46.779, 28.453, BoundingBox{46.599, 28.131, 46.976, 28.799}},
+	"MD-IA":  {46.858, 28.792, BoundingBox{46.711, 28.522, 47.005, 28.991}},
+	"MD-LE":  {46.501, 28.337, BoundingBox{46.310, 28.136, 46.721, 28.571}},
+	"MD-NI":  {47.025, 28.168, BoundingBox{46.796, 27.916, 47.228, 28.423}},
+	"MD-OC":  {48.378, 27.450, BoundingBox{48.272, 27.253, 48.470, 27.709}},
+	"MD-OR":  {47.414, 28.807, BoundingBox{47.249, 28.558, 47.567, 29.077}},
+	"MD-RE":  {47.680, 28.890, BoundingBox{47.542, 28.591, 47.873, 29.120}},
+	"MD-RI":  {47.958, 27.498, BoundingBox{47.838, 27.153, 48.097, 27.757}},
+	"MD-SD":  {47.868, 28.736, BoundingBox{47.644, 28.486, 48.098, 29.082}},
+	"MD-SI":  {47.613, 28.141, BoundingBox{47.401, 27.924, 47.784, 28.449}},
+	"MD-SN":  {46.947, 29.531, BoundingBox{46.593, 28.973, 47.706, 29.910}},
+	"MD-SO":  {48.109, 28.292, BoundingBox{48.025, 27.966, 48.281, 28.707}},
+	"MD-ST":  {47.138, 28.581, BoundingBox{46.969, 28.387, 47.331, 28.776}},
+	"MD-SV":  {46.473, 29.663, BoundingBox{46.350, 29.315, 46.642, 30.020}},
+	"MD-TA":  {45.814, 28.541, BoundingBox{45.577, 28.435, 46.053, 28.755}},
+	"MD-TE":  {47.506, 28.399, BoundingBox{47.353, 28.024, 47.709, 28.650}},
+	"MD-UN":  {47.263, 27.865, BoundingBox{47.031, 27.593, 47.414, 28.049}},
+	"ME-01":  {42.701, 19.738, BoundingBox{42.540, 19.539, 42.839, 19.978}},
+	"ME-02":  {42.150, 19.155, BoundingBox{41.994, 18.961, 42.288, 19.325}},
+	"ME-03":  {42.873, 19.895, BoundingBox{42.737, 19.691, 43.020, 20.031}},
+	"ME-04":  {43.074, 19.772, BoundingBox{42.919, 19.514, 43.233, 20.061}},
+	"ME-05":  {42.284, 18.918, BoundingBox{42.188, 18.747, 42.365, 19.061}},
+	"ME-06":  {42.384, 18.989, BoundingBox{42.262, 18.823, 42.533, 19.140}},
+	"ME-07":  {42.579, 19.130, BoundingBox{42.394, 18.857, 42.742, 19.387}},
+	"ME-08":  {42.556, 18.587, BoundingBox{42.404, 18.450, 42.726, 18.695}},
+	"ME-09":  {42.771, 19.457, BoundingBox{42.587, 19.238, 42.939, 19.694}},
+	"ME-10":  {42.511, 18.790, BoundingBox{42.347, 18.688, 42.651, 18.906}},
+	"ME-11":  {43.003, 19.518, BoundingBox{42.857, 19.340, 43.173, 19.735}},
+	"ME-12":  {42.779, 18.867, BoundingBox{42.606, 18.581, 42.955, 19.243}},
+	"ME-13":  {42.625, 19.950, BoundingBox{42.500, 19.769, 42.743, 20.146}},
+	"ME-14":  {43.331, 19.310, BoundingBox{43.145, 18.996, 43.520, 19.605}},
+	"ME-15":  {43.128, 18.832, BoundingBox{42.923, 18.635, 43.394, 18.980}},
+	"ME-16":  {42.426, 19.349, BoundingBox{42.212, 19.135, 42.621, 19.605}},
+	"ME-17":  {42.865, 20.141, BoundingBox{42.678, 20.014, 43.054, 20.340}},
+	"ME-18":  {42.946, 19.128, BoundingBox{42.774, 18.835, 43.062, 19.340}},
+	"ME-19":  {42.449, 18.687, BoundingBox{42.342, 18.614, 42.604, 18.756}},
+	"ME-20":  {41.983, 19.270, BoundingBox{41.880, 19.130, 42.086, 19.370}},
+	"ME-21":  {43.182, 19.151, BoundingBox{43.042, 18.980, 43.406, 19.419}},
+	"MG-A":   {-16.986, 48.788, BoundingBox{-19.145, 47.642, -15.379, 50.397}},
+	"MG-D":   {-14.229, 49.123, BoundingBox{-15.628, 47.421, -12.040, 50.480}},
+	"MG-F":   {-21.629, 46.688, BoundingBox{-24.513, 44.332, -19.953, 48.584}},
+	"MG-M":   {-16.969, 46.061, BoundingBox{-18.957, 43.960, -14.806, 48.168}},
+	"MG-T":   {-19.220, 46.802, BoundingBox{-20.404, 44.229, -17.734, 49.031}},
+	"MG-U":   {-23.280, 44.987, BoundingBox{-25.600, 43.250, -20.132, 47.266}},
+	"MH-ALK": {10.300, 169.930, BoundingBox{10.255, 169.884, 10.345, 169.976}},
+	"MH-ALL": {7.400, 168.750, BoundingBox{7.355, 168.705, 7.445, 168.795}},
+	"MH-ARN": {7.080, 171.600, BoundingBox{7.035, 171.555, 7.125, 171.645}},
+	"MH-AUR": {8.150, 171.100, BoundingBox{8.105, 171.055, 8.195, 171.145}},
+	"MH-EBO": {4.600, 168.700, BoundingBox{4.555, 168.655, 4.645, 168.745}},
+	"MH-ENI": {11.450, 162.300, BoundingBox{11.405, 162.254, 11.495, 162.346}},
+	"MH-JAB": {7.750, 168.980, BoundingBox{7.705, 168.935, 7.795, 169.025}},
+	"MH-JAL": {6.000, 169.600, BoundingBox{5.875, 169.475, 6.125, 169.725}},
+	"MH-KIL": {5.640, 169.120, BoundingBox{5.595, 169.075, 5.685, 169.165}},
+	"MH-KWA": {9.000, 167.500, BoundingBox{8.668, 167.164, 9.332, 167.836}},
+	"MH-L":   {9.000, 167.500, BoundingBox{4.555, 162.254, 11.495, 169.725}},
+	"MH-LAE": {8.930, 166.250, BoundingBox{8.885, 166.204, 8.975, 166.296}},
+	"MH-LIB": {8.310, 167.380, BoundingBox{8.265, 167.335, 8.355, 167.425}},
+	"MH-LIK": {9.900, 169.150, BoundingBox{9.855, 169.104, 9.945, 169.196}},
+	"MH-MAJ": {7.100, 171.250, BoundingBox{6.934, 171.083, 7.266, 171.417}},
+	"MH-MAL": {8.700, 171.150, BoundingBox{8.655, 171.105, 8.745, 171.195}},
+	"MH-MEJ": {10.280, 170.870, BoundingBox{10.235, 170.824, 10.325, 170.916}},
+	"MH-MIL": {6.080, 171.800, BoundingBox{6.035, 171.755, 6.125, 171.845}},
+	"MH-NMK": {5.600, 168.120, BoundingBox{5.555, 168.075, 5.645, 168.165}},
+	"MH-NMU": {7.950, 168.200, BoundingBox{7.905, 168.155, 7.995, 168.245}},
+	"MH-RON": {11.200, 166.850, BoundingBox{11.155, 166.804, 11.245, 166.896}},
+	"MH-T":   {7.100, 171.250, BoundingBox{6.035, 169.104, 11.275, 171.845}},
+	"MH-UJA": {8.930, 165.700, BoundingBox{8.885, 165.654, 8.975, 165.746}},
+	"MH-UTI": {11.230, 169.820, BoundingBox{11.185, 169.774, 11.275, 169.866}},
+	"MH-WTJ": {9.450, 170.000, BoundingBox{9.405, 169.954, 9.495, 170.046}},
+	"MH-WTN": {10.120, 166.000, BoundingBox{10.075, 165.954, 10.165, 166.046}},
+	"MK-01":  {41.963, 21.492, BoundingBox{41.927, 21.452, 41.997, 21.533}},
+	"MK-02":  {42.054, 21.576, BoundingBox{41.999, 21.505, 42.101, 21.681}},
+	"MK-03":  {41.677, 22.787, BoundingBox{41.556, 22.635, 41.798, 22.927}},
+	"MK-04":  {40.990, 21.279, BoundingBox{40.867, 21.129, 41.113, 21.414}},
+	"MK-05":  {41.209, 22.565, BoundingBox{41.130, 22.436, 41.264, 22.653}},
+	"MK-06":  {41.930, 20.862, BoundingBox{41.860, 20.731, 42.010, 21.017}},
+	"MK-07":  {41.383, 22.753, BoundingBox{41.305, 22.661, 41.477, 22.833}},
+	"MK-08":  {41.901, 21.052, BoundingBox{41.800, 20.948, 42.005, 21.178}},
+	"MK-09":  {42.061, 21.469, BoundingBox{42.014, 21.435, 42.108, 21.505}},
+	"MK-10":  {41.319, 22.511, BoundingBox{41.248, 22.349, 41.401, 22.686}},
+	"MK-11":  {41.540, 22.710, BoundingBox{41.436, 22.552, 41.648, 22.880}},
+	"MK-12":  {41.286, 20.612, BoundingBox{41.167, 20.517, 41.366, 20.709}},
+	"MK-13":  {41.729, 21.771, BoundingBox{41.615, 21.633, 41.846, 21.904}},
+	"MK-14":  {41.853, 22.580, BoundingBox{41.743, 22.454, 41.964, 22.702}},
+	"MK-15":  {41.399, 21.009, BoundingBox{41.301, 20.902, 41.505, 21.092}},
+	"MK-16":  {41.842, 20.813, BoundingBox{41.768, 20.654, 41.906, 20.975}},
+	"MK-17":  {42.014, 21.496, BoundingBox{41.986, 21.458, 42.054, 21.530}},
+	"MK-18":  {41.184, 22.370, BoundingBox{41.130, 22.133, 41.264, 22.593}},
+	"MK-19":  {41.773, 21.004, BoundingBox{41.697, 20.810, 41.860, 21.195}},
+	"MK-20":  {41.609, 21.944, BoundingBox{41.533, 21.767, 41.692, 22.116}},
+	"MK-21":  {41.575, 20.551, BoundingBox{41.466, 20.460, 41.689, 20.680}},
+	"MK-22":  {41.305, 20.846, BoundingBox{41.194, 20.667, 41.432, 21.031}},
+	"MK-23":  {41.949, 22.768, BoundingBox{41.824, 22.643, 42.096, 22.892}},
+	"MK-24":  {41.326, 22.223, BoundingBox{41.147, 22.073, 41.524, 22.404}},
+	"MK-25":  {41.202, 21.148, BoundingBox{41.073, 20.989, 41.323, 21.349}},
+	"MK-26":  {41.267, 22.684, BoundingBox{41.186, 22.618, 41.324, 22.782}},
+	"MK-27":  {41.478, 21.464, BoundingBox{41.347, 21.323, 41.615, 21.646}},
+	"MK-28":  {41.472, 20.845, BoundingBox{41.383, 20.745, 41.549, 20.975}},
+	"MK-29":  {42.028, 21.317, BoundingBox{41.945, 21.251, 42.096, 21.372}},
+	"MK-30":  {41.949, 21.109, BoundingBox{41.816, 21.008, 42.031, 21.185}},
+	"MK-31":  {41.623, 20.905, BoundingBox{41.544, 20.750, 41.711, 21.051}},
+	"MK-32":  {41.839, 21.625, BoundingBox{41.748, 21.545, 41.927, 21.765}},
+	"MK-33":  {41.805, 22.438, BoundingBox{41.740, 22.350, 41.892, 22.562}},
+	"MK-34":  {41.994, 21.607, BoundingBox{41.936, 21.525, 42.041, 21.722}},
+	"MK-35":  {42.092, 21.160, BoundingBox{42.002, 21.074, 42.178, 21.247}},
+	"MK-36":  {41.297, 21.940, BoundingBox{41.077, 21.750, 41.484, 22.103}},
+	"MK-37":  {41.820, 22.220, BoundingBox{41.732, 22.084, 41.901, 22.365}},
+	"MK-38":  {41.990, 21.375, BoundingBox{41.949, 21.332, 42.040, 21.415}},
+	"MK-39":  {41.957, 21.429, BoundingBox{41.925, 21.374, 41.990, 21.470}},
+	"MK-40":  {41.521, 20.965, BoundingBox{41.478, 20.858, 41.554, 21.056}},
+	"MK-41":  {41.506, 22.371, BoundingBox{41.373, 22.222, 41.616, 22.530}},
+	"MK-42":  {41.966, 22.398, BoundingBox{41.871, 22.298, 42.066, 22.506}},
+	"MK-43":  {42.079, 22.182, BoundingBox{42.040, 21.948, 42.156, 22.369}},
+	"MK-44":  {42.203, 22.364, BoundingBox{42.060, 22.193, 42.320, 22.570}},
+	"MK-45":  {41.304, 21.360, BoundingBox{41.219, 21.239, 41.411, 21.457}},
+	"MK-46":  {41.371, 21.219, BoundingBox{41.292, 21.075, 41.451, 21.344}},
+	"MK-47":  {42.098, 21.746, BoundingBox{41.995, 21.623, 42.245, 21.902}},
+	"MK-48":  {42.180, 21.565, BoundingBox{42.090, 21.430, 42.265, 21.686}},
+	"MK-49":  {41.768, 21.917, BoundingBox{41.678, 21.754, 41.864, 22.053}},
+	"MK-50":  {41.708, 20.696, BoundingBox{41.547, 20.525, 41.860, 20.889}},
+	"MK-51":  {42.058, 22.577, BoundingBox{41.920, 22.405, 42.199, 22.730}},
+	"MK-52":  {41.585, 21.268, BoundingBox{41.437, 21.116, 41.732, 21.425}},
+	"MK-53":  {41.151, 21.404, BoundingBox{41.057, 21.233, 41.233, 21.587}},
+	"MK-54":  {41.509, 22.123, BoundingBox{41.371, 22.012, 41.615, 22.231}},
+	"MK-55":  {41.043, 21.626, BoundingBox{40.895, 21.392, 41.182, 21.931}},
+	"MK-56":  {41.432, 22.882, BoundingBox{41.315, 22.809, 41.559, 22.950}},
+	"MK-57":  {41.631, 21.075, BoundingBox{41.526, 20.939, 41.740, 21.207}},
+	"MK-58":  {41.104, 20.843, BoundingBox{40.972, 20.659, 41.212, 21.024}},
+	"MK-59":  {41.945, 21.699, BoundingBox{41.865, 21.536, 42.020, 21.815}},
+	"MK-60":  {41.803, 22.823, BoundingBox{41.715, 22.688, 41.885, 22.910}},
+	"MK-61":  {41.461, 21.118, BoundingBox{41.352, 21.047, 41.565, 21.208}},
+	"MK-62":  {41.306, 21.644, BoundingBox{41.139, 21.439, 41.492, 21.848}},
+	"MK-63":  {41.984, 22.155, BoundingBox{41.890, 21.958, 42.040, 22.345}},
+	"MK-64":  {41.655, 22.483, BoundingBox{41.535, 22.276, 41.751, 22.661}},
+	"MK-65":  {42.197, 22.085, BoundingBox{42.050, 21.950, 42.312, 22.232}},
+	"MK-66":  {40.968, 21.029, BoundingBox{40.840, 20.804, 41.117, 21.218}},
+	"MK-67":  {41.501, 21.878, BoundingBox{41.435, 21.719, 41.560, 22.051}},
+	"MK-68":  {41.967, 21.229, BoundingBox{41.832, 21.153, 42.099, 21.319}},
+	"MK-69":  {41.913, 21.937, BoundingBox{41.792, 21.764, 42.047, 22.103}},
+	"MK-70":  {41.823, 21.321, BoundingBox{41.688, 21.173, 41.951, 21.462}},
+	"MK-71":  {42.194, 21.867, BoundingBox{42.038, 21.681, 42.303, 21.997}},
+	"MK-72":  {41.169, 20.681, BoundingBox{41.060, 20.583, 41.272, 20.795}},
+	"MK-73":  {41.440, 22.574, BoundingBox{41.359, 22.453, 41.543, 22.687}},
+	"MK-74":  {41.810, 21.478, BoundingBox{41.683, 21.378, 41.938, 21.545}},
+	"MK-75":  {42.084, 21.036, BoundingBox{42.028, 20.946, 42.143, 21.102}},
+	"MK-76":  {42.031, 20.895, BoundingBox{41.966, 20.752, 42.100, 21.023}},
+	"MK-77":  {41.992, 21.422, BoundingBox{41.975, 21.399, 42.011, 21.451}},
+	"MK-78":  {41.457, 20.625, BoundingBox{41.356, 20.479, 41.578, 20.745}},
+	"MK-79":  {42.007, 21.442, BoundingBox{41.990, 21.415, 42.020, 21.462}},
+	"MK-80":  {41.627, 21.611, BoundingBox{41.476, 21.388, 41.766, 21.805}},
+	"MK-81":  {41.886, 22.285, BoundingBox{41.797, 22.164, 41.965, 22.368}},
+	"MK-82":  {42.139, 21.361, BoundingBox{42.055, 21.231, 42.224, 21.492}},
+	"MK-83":  {41.705, 22.176, BoundingBox{41.592, 22.028, 41.826, 22.353}},
+	"MK-84":  {42.044, 21.409, BoundingBox{42.011, 21.369, 42.076, 21.435}},
+	"ML-1":   {13.802, -10.647, BoundingBox{11.872, -12.170, 15.410, -8.984}},
+	"ML-2":   {13.552, -8.055, BoundingBox{10.611, -10.338, 15.498, -6.300}},
+	"ML-3":   {11.203, -6.558, BoundingBox{10.100, -8.340, 12.501, -4.906}},
+	"ML-4":   {13.603, -5.577, BoundingBox{12.040, -6.766, 15.453, -4.281}},
+	"ML-5":   {14.805, -3.215, BoundingBox{13.227, -5.123, 16.418, -1.336}},
+	"ML-6":   {20.066, -3.897, BoundingBox{14.989, -6.450, 24.970, -0.933}},
+	"ML-7":   {16.429, 0.851, BoundingBox{14.853, -1.982, 18.074, 4.270}},
+	"ML-8":   {19.553, 0.904, BoundingBox{17.128, -2.124, 23.161, 4.270}},
+	"ML-BK0": {12.645, -8.000, BoundingBox{12.545, -8.125, 12.745, -7.875}},
+	"MM-01":  {24.286, 95.499, BoundingBox{22.273, 93.323, 27.054, 97.070}},
+	"MM-02":  {18.398, 95.851, BoundingBox{16.804, 94.285, 20.024, 97.270}},
+	"MM-03":  {20.449, 94.786, BoundingBox{19.461, 93.345, 21.490, 96.143}},
+	"MM-04":  {21.563, 96.060, BoundingBox{20.133, 94.739, 22.870, 97.000}},
+	"MM-05":  {12.564, 98.790, BoundingBox{9.930, 97.925, 14.472, 99.590}},
+	"MM-06":  {16.766, 96.146, BoundingBox{15.980, 95.623, 17.539, 96.996}},
+	"MM-07":  {16.747, 95.029, BoundingBox{15.710, 94.190, 17.929, 95.797}},
+	"MM-11":  {26.320, 97.443, BoundingBox{24.223, 96.032, 28.340, 98.710}},
+	"MM-12":  {19.513, 97.184, BoundingBox{18.211, 96.097, 20.585, 98.298}},
+	"MM-13":  {17.302, 97.621, BoundingBox{16.750, 97.009, 18.248, 98.546}},
+	"MM-14":  {21.935, 93.611, BoundingBox{20.560, 92.300, 23.890, 94.922}},
+	"MM-15":  {15.781, 98.121, BoundingBox{14.294, 97.255, 16.750, 98.900}},
+	"MM-16":  {19.654, 93.848, BoundingBox{18.261, 92.467, 20.685, 94.912}},
+	"MM-17":  {21.839, 98.480, BoundingBox{19.713, 96.833, 24.396, 101.180}},
+	"MN-035": {49.030, 104.050, BoundingBox{48.905, 103.860, 49.155, 104.240}},
+	"MN-037": {49.430, 105.723, BoundingBox{48.624, 104.821, 50.344, 106.962}},
+	"MN-039": {47.851, 110.629, BoundingBox{46.000, 108.637, 49.448, 112.471}},
+	"MN-041": {50.184, 99.823, BoundingBox{48.377, 97.211, 52.050, 102.346}},
+	"MN-043": {46.756, 92.378, BoundingBox{45.028, 90.478, 48.566, 94.400}},
+	"MN-046": {49.567, 93.240, BoundingBox{48.177, 90.754, 50.800, 96.285}},
+	"MN-047": {47.725, 106.569, BoundingBox{46.092, 103.587, 49.289, 109.353}},
+	"MN-049": {49.754, 106.896, BoundingBox{49.083, 105.247, 50.410, 108.478}},
+	"MN-051": {46.218, 113.475, BoundingBox{44.810, 111.091, 47.885, 116.522}},
+	"MN-053": {43.228, 104.383, BoundingBox{41.600, 100.465, 44.987, 107.839}},
+	"MN-055": {45.688, 102.876, BoundingBox{43.972, 101.158, 47.231, 104.644}},
+	"MN-057": {48.080, 96.315, BoundingBox{46.750, 93.638, 49.898, 98.665}},
+	"MN-059": {45.247, 106.404, BoundingBox{43.721, 104.520, 46.555, 108.273}},
+	"MN-061": {48.157, 115.348, BoundingBox{46.211, 112.137, 50.250, 119.770}},
+	"MN-063": {44.164, 109.664, BoundingBox{42.483, 107.358, 46.000, 112.124}},
+	"MN-064": {46.237, 108.640, BoundingBox{45.085, 106.955, 47.333, 110.595}},
+	"MN-065": {45.017, 96.133, BoundingBox{42.689, 93.021, 46.750, 98.000}},
+	"MN-067": {48.934, 103.539, BoundingBox{47.187, 101.534, 50.485, 105.554}},
+	"MN-069": {44.738, 99.638, BoundingBox{42.520, 98.000, 47.133, 101.748}},
+	"MN-071": {48.866, 90.031, BoundingBox{47.460, 87.750, 50.344, 92.088}},
+	"MN-073": {47.707, 100.737, BoundingBox{46.457, 98.512, 49.078, 103.250}},
+	"MN-1":   {47.923, 106.923, BoundingBox{47.735, 106.610, 48.110, 107.235}},
+	"MR-01":  {18.347, -6.990, BoundingBox{15.495, -8.974, 21.965, -5.320}},
+	"MR-02":  {16.603, -9.312, BoundingBox{15.239, -10.720, 18.428, -7.412}},
+	"MR-03":  {16.857, -11.511, BoundingBox{15.719, -12.552, 17.916, -10.600}},
+	"MR-04":  {16.024, -12.709, BoundingBox{15.155, -13.766, 16.773, -11.835}},
+	"MR-05":  {17.594, -13.411, BoundingBox{16.168, -14.511, 19.167, -12.290}},
+	"MR-06":  {17.797, -15.353, BoundingBox{16.140, -16.550, 19.467, -14.081}},
+	"MR-07":  {21.116, -10.304, BoundingBox{19.092, -13.081, 23.140, -6.679}},
+	"MR-08":  {20.651, -16.086, BoundingBox{19.411, -17.060, 21.330, -15.173}},
+	"MR-09":  {18.710, -11.068, BoundingBox{17.484, -13.036, 19.927, -8.879}},
+	"MR-10":  {15.396, -11.646, BoundingBox{14.620, -12.689, 16.023, -10.344}},
+	"MR-11":  {24.277, -8.842, BoundingBox{21.777, -12.939, 27.400, -4.920}},
+	"MR-12":  {20.113, -14.207, BoundingBox{18.679, -15.995, 22.849, -12.709}},
+	"MR-NKC": {18.101, -16.009, BoundingBox{17.926, -16.173, 18.297, -15.840}},
+	"MT-01":  {35.888, 14.439, BoundingBox{35.880, 14.422, 35.899, 14.458}},
+	"MT-02":  {35.896, 14.454, BoundingBox{35.887, 14.448, 35.903, 14.460}},
+	"MT-03":  {35.890, 14.522, BoundingBox{35.883, 14.516, 35.897, 14.527}},
+	"MT-04":  {35.896, 14.463, BoundingBox{35.884, 14.456, 35.906, 14.473}},
+	"MT-05":  {35.807, 14.538, BoundingBox{35.780, 14.496, 35.839, 14.583}},
+	"MT-06":  {35.880, 14.520, BoundingBox{35.875, 14.510, 35.884, 14.530}},
+	"MT-07":  {35.848, 14.344, BoundingBox{35.806, 14.259, 35.887, 14.411}},
+	"MT-08":  {35.872, 14.525, BoundingBox{35.864, 14.515, 35.879, 14.534}},
+	"MT-09":  {35.892, 14.505, BoundingBox{35.883, 14.498, 35.901, 14.513}},
+	"MT-10":  {36.037, 14.238, BoundingBox{36.032, 14.228, 36.042, 14.248}},
+	"MT-11":  {35.846, 14.502, BoundingBox{35.832, 14.491, 35.857, 14.511}},
+	"MT-12":  {35.906, 14.488, BoundingBox{35.899, 14.480, 35.912, 14.497}},
+	"MT-13":  {35.998, 14.288, BoundingBox{35.952, 14.265, 36.039, 14.323}},
+	"MT-14":  {36.078, 14.198, BoundingBox{36.051, 14.174, 36.100, 14.220}},
+	"MT-15":  {35.938, 14.447, BoundingBox{35.917, 14.424, 35.970, 14.466}},
+	"MT-16":  {36.058, 14.229, BoundingBox{36.049, 14.217, 36.069, 14.248}},
+	"MT-17":  {35.845, 14.518, BoundingBox{35.833, 14.508, 35.857, 14.530}},
+	"MT-18":  {35.884, 14.487, BoundingBox{35.876, 14.478, 35.889, 14.498}},
+	"MT-19":  {35.910, 14.457, BoundingBox{35.903, 14.446, 35.917, 14.465}},
+	"MT-20":  {35.886, 14.514, BoundingBox{35.880, 14.506, 35.893, 14.521}},
+	"MT-21":  {35.898, 14.535, BoundingBox{35.881, 14.524, 35.921, 14.547}},
+	"MT-22":  {36.034, 14.212, BoundingBox{36.011, 14.179, 36.050, 14.233}},
+	"MT-23":  {35.844, 14.486, BoundingBox{35.838, 14.475, 35.852, 14.499}},
+	"MT-24":  {35.902, 14.443, BoundingBox{35.895, 14.432, 35.908, 14.453}},
+	"MT-25":  {35.860, 14.486, BoundingBox{35.850, 14.470, 35.871, 14.499}},
+	"MT-26":  {35.878, 14.495, BoundingBox{35.869, 14.484, 35.887, 14.506}},
+	"MT-27":  {35.862, 14.574, BoundingBox{35.828, 14.549, 35.894, 14.593}},
+	"MT-28":  {35.832, 14.560, BoundingBox{35.803, 14.528, 35.855, 14.586}},
+	"MT-29":  {35.886, 14.413, BoundingBox{35.870, 14.397, 35.900, 14.424}},
+	"MT-30":  {35.968, 14.338, BoundingBox{35.928, 14.249, 36.027, 14.405}},
+	"MT-31":  {35.908, 14.310, BoundingBox{35.870, 14.225, 35.940, 14.399}},
+	"MT-32":  {35.914, 14.418, BoundingBox{35.893, 14.397, 35.934, 14.437}},
+	"MT-33":  {35.849, 14.465, BoundingBox{35.837, 14.450, 35.862, 14.478}},
+	"MT-34":  {35.893, 14.486, BoundingBox{35.888, 14.480, 35.899, 14.495}},
+	"MT-35":  {35.896, 14.386, BoundingBox{35.882, 14.353, 35.915, 14.412}},
+	"MT-36":  {36.003, 14.195, BoundingBox{35.967, 14.156, 36.035, 14.243}},
+	"MT-37":  {36.054, 14.297, BoundingBox{36.027, 14.277, 36.085, 14.312}},
+	"MT-38":  {35.916, 14.441, BoundingBox{35.906, 14.429, 35.928, 14.454}},
+	"MT-39":  {35.874, 14.506, BoundingBox{35.868, 14.495, 35.883, 14.515}},
+	"MT-40":  {35.969, 14.490, BoundingBox{35.922, 14.450, 36.018, 14.548}},
+	"MT-41":  {35.893, 14.495, BoundingBox{35.887, 14.488, 35.897, 14.499}},
+	"MT-42":  {36.040, 14.350, BoundingBox{35.991, 14.300, 36.084, 14.425}},
+	"MT-43":  {35.873, 14.469, BoundingBox{35.860, 14.455, 35.884, 14.485}},
+	"MT-44":  {35.819, 14.438, BoundingBox{35.798, 14.398, 35.846, 14.467}},
+	"MT-45":  {36.047, 14.243, BoundingBox{36.038, 14.230, 36.058, 14.253}},
+	"MT-46":  {35.876, 14.396, BoundingBox{35.862, 14.368, 35.887, 14.417}},
+	"MT-47":  {35.820, 14.493, BoundingBox{35.792, 14.478, 35.838, 14.507}},
+	"MT-48":  {35.927, 14.498, BoundingBox{35.912, 14.481, 35.951, 14.521}},
+	"MT-49":  {35.908, 14.474, BoundingBox{35.899, 14.465, 35.917, 14.483}},
+	"MT-50":  {36.038, 14.180, BoundingBox{35.993, 14.150, 36.079, 14.217}},
+	"MT-51":  {35.983, 14.421, BoundingBox{35.923, 14.377, 36.041, 14.469}},
+	"MT-52":  {35.970, 14.231, BoundingBox{35.910, 14.177, 36.033, 14.269}},
+	"MT-53":  {35.861, 14.506, BoundingBox{35.854, 14.497, 35.868, 14.516}},
+	"MT-54":  {35.890, 14.473, BoundingBox{35.883, 14.460, 35.899, 14.482}},
+	"MT-55":  {35.847, 14.427, BoundingBox{35.817, 14.404, 35.866, 14.458}},
+	"MT-56":  {35.941, 14.536, BoundingBox{35.903, 14.493, 35.977, 14.579}},
+	"MT-57":  {35.921, 14.478, BoundingBox{35.914, 14.465, 35.930, 14.492}},
+	"MT-58":  {35.900, 14.495, BoundingBox{35.896, 14.484, 35.906, 14.504}},
+	"MT-59":  {35.864, 14.517, BoundingBox{35.857, 14.508, 35.872, 14.526}},
+	"MT-60":  {35.910, 14.527, BoundingBox{35.892, 14.504, 35.934, 14.556}},
+	"MT-61":  {36.063, 14.275, BoundingBox{36.038, 14.248, 36.088, 14.305}},
+	"MT-62":  {36.031, 14.261, BoundingBox{36.013, 14.246, 36.044, 14.275}},
+	"MT-63":  {35.915, 14.571, BoundingBox{35.873, 14.538, 35.961, 14.600}},
+	"MT-64":  {35.874, 14.541, BoundingBox{35.864, 14.529, 35.885, 14.555}},
+	"MT-65":  {36.080, 14.240, BoundingBox{36.059, 14.201, 36.098, 14.282}},
+	"MT-66":  {35.872, 14.439, BoundingBox{35.861, 14.416, 35.882, 14.459}},
+	"MT-67":  {35.857, 14.536, BoundingBox{35.845, 14.521, 35.867, 14.551}},
+	"MT-68":  {35.813, 14.473, BoundingBox{35.793, 14.456, 35.839, 14.490}},
+	"MU-AG":  {-10.400, 56.650, BoundingBox{-10.445, 56.604, -10.355, 56.696}},
+	"MU-BL":  {-20.338, 57.333, BoundingBox{-20.488, 57.258, -20.176, 57.430}},
+	"MU-BR":  {-20.203, 57.381, BoundingBox{-20.281, 57.258, -20.124, 57.509}},
+	"MU-CC":  {-16.450, 59.600, BoundingBox{-16.495, 59.553, -16.405, 59.647}},
+	"MU-CU":  {-20.328, 57.560, BoundingBox{-20.394, 57.489, -20.272, 57.637}},
+	"MU-FL":  {-20.218, 57.739, BoundingBox{-20.337, 57.619, -20.097, 57.842}},
+	"MU-GP":  {-20.411, 57.693, BoundingBox{-20.554, 57.563, -20.292, 57.842}},
+	"MU-MO":  {-20.226, 57.575, BoundingBox{-20.292, 57.503, -20.165, 57.637}},
+	"MU-PA":  {-20.078, 57.564, BoundingBox{-20.169, 57.457, -20.006, 57.651}},
+	"MU-PL":  {-20.086, 57.489, BoundingBox{-20.174, 57.394, -20.006, 57.592}},
+	"MU-PU":  {-20.128, 57.425, BoundingBox{-20.206, 57.303, -20.039, 57.547}},
+	"MU-PW":  {-20.377, 57.462, BoundingBox{-20.437, 57.402, -20.314, 57.544}},
+	"MU-QB":  {-20.273, 57.466, BoundingBox{-20.313, 57.402, -20.234, 57.530}},
+	"MU-RO":  {-19.720, 63.420, BoundingBox{-19.803, 63.332, -19.637, 63.508}},
+	"MU-RP":  {-20.063, 57.663, BoundingBox{-20.132, 57.561, -20.006, 57.769}},
+	"MU-SA":  {-20.484, 57.497, BoundingBox{-20.554, 57.358, -20.390, 57.624}},
+	"MU-VP":  {-20.301, 57.492, BoundingBox{-20.336, 57.430, -20.263, 57.547}},
+	"MV-00":  {3.705, 72.963, BoundingBox{3.465, 72.734, 3.885, 73.185}},
+	"MV-01":  {-0.583, 73.134, BoundingBox{-0.832, 72.868, -0.238, 73.515}},
+	"MV-02":  {4.152, 72.971, BoundingBox{3.835, 72.734, 4.605, 73.211}},
+	"MV-03":  {5.350, 73.550, BoundingBox{5.305, 73.287, 5.595, 73.595}},
+	"MV-04":  {3.603, 73.527, BoundingBox{3.335, 73.108, 3.861, 74.066}},
+	"MV-05":  {1.724, 73.358, BoundingBox{1.243, 72.718, 2.344, 73.882}},
+	"MV-07":  {6.829, 73.014, BoundingBox{6.726, 72.684, 6.995, 73.412}},
+	"MV-08":  {2.153, 73.070, BoundingBox{1.771, 72.718, 2.382, 73.579}},
+	"MV-12":  {2.950, 73.550, BoundingBox{2.905, 73.505, 2.995, 73.595}},
+	"MV-13":  {5.814, 72.814, BoundingBox{5.535, 72.431, 6.157, 73.269}},
+	"MV-14":  {3.361, 73.146, BoundingBox{3.155, 72.905, 3.503, 73.209}},
+	"MV-17":  {2.850, 72.950, BoundingBox{2.805, 72.905, 2.895, 72.995}},
+	"MV-20":  {5.150, 73.000, BoundingBox{4.604, 72.955, 5.195, 73.187}},
+	"MV-23":  {6.537, 72.933, BoundingBox{6.151, 72.431, 6.830, 73.611}},
+	"MV-24":  {6.265, 73.237, BoundingBox{5.950, 72.485, 6.633, 73.769}},
+	"MV-25":  {5.890, 73.425, BoundingBox{5.535, 73.009, 6.235, 73.769}},
+	"MV-26":  {4.427, 73.492, BoundingBox{4.169, 73.076, 4.665, 74.055}},
+	"MV-27":  {0.600, 73.350, BoundingBox{0.555, 73.013, 1.264, 73.566}},
+	"MV-28":  {0.300, 73.300, BoundingBox{0.255, 73.255, 0.345, 73.345}},
+	"MV-29":  {-0.355, 73.316, BoundingBox{-0.655, 72.992, -0.168, 73.532}},
+	"MV-CE":  {3.207, 72.950, BoundingBox{2.805, 72.905, 3.503, 73.595}},
+	"MV-MLE": {4.028, 73.664, BoundingBox{3.786, 73.176, 4.286, 74.066}},
+	"MV-NC":  {3.999, 72.965, BoundingBox{3.335, 72.734, 4.665, 74.066}},
+	"MV-NO":  {5.835, 73.129, BoundingBox{4.604, 72.431, 6.235, 73.769}},
+	"MV-SC":  {0.600, 73.350, BoundingBox{0.255, 73.013, 1.264, 73.566}},
+	"MV-SU":  {-0.500, 73.200, BoundingBox{-0.832, 72.868, -0.168, 73.532}},
+	"MV-UN":  {6.426, 73.083, BoundingBox{5.950, 72.431, 6.995, 73.769}},
+	"MV-US":  {1.809, 73.300, BoundingBox{1.243, 72.718, 2.382, 73.882}},
+	"MW-BA":  {-14.975, 35.044, BoundingBox{-15.313, 34.765, -14.626, 35.297}},
+	"MW-BL":  {-15.714, 34.962, BoundingBox{-15.960, 34.757, -15.391, 35.178}},
+	"MW-C":   {-13.548, 33.921, BoundingBox{-15.118, 32.689, -12.250, 35.102}},
+	"MW-CK":  {-16.152, 34.685, BoundingBox{-16.555, 34.355, -15.813, 34.965}},
+	"MW-CR":  {-15.677, 35.249, BoundingBox{-15.900, 35.018, -15.420, 35.425}},
+	"MW-CT":  {-9.663, 33.440, BoundingBox{-10.280, 32.759, -9.230, 33.927}},
+	"MW-DE":  {-14.240, 34.416, BoundingBox{-14.597, 33.867, -14.000, 34.884}},
+	"MW-DO":  {-13.612, 33.801, BoundingBox{-13.877, 33.402, -13.450, 34.153}},
+	"MW-KR":  {-10.209, 33.912, BoundingBox{-10.582, 33.414, -9.672, 34.367}},
+	"MW-KS":  {-12.956, 33.331, BoundingBox{-13.496, 32.844, -12.416, 33.769}},
+	"MW-LI":  {-14.039, 33.710, BoundingBox{-14.450, 33.328, -13.663, 34.111}},
+	"MW-LK":  {-12.126, 34.301, BoundingBox{-12.334, 34.189, -11.843, 34.776}},
+	"MW-MC":  {-13.632, 33.084, BoundingBox{-14.068, 32.689, -13.231, 33.441}},
+	"MW-MG":  {-14.323, 35.209, BoundingBox{-14.789, 34.820, -13.741, 35.692}},
+	"MW-MH":  {-14.968, 35.520, BoundingBox{-15.300, 35.259, -14.639, 35.733}},
+	"MW-MU":  {-15.930, 35.520, BoundingBox{-16.089, 35.360, -15.818, 35.770}},
+	"MW-MW":  {-15.676, 34.539, BoundingBox{-15.927, 34.310, -15.441, 34.836}},
+	"MW-MZ":  {-11.889, 33.525, BoundingBox{-12.471, 33.110, -11.264, 34.040}},
+	"MW-N":   {-11.261, 33.835, BoundingBox{-15.562, 32.759, -9.230, 34.976}},
+	"MW-NB":  {-11.619, 34.152, BoundingBox{-12.250, 33.657, -11.085, 34.560}},
+	"MW-NE":  {-15.325, 34.661, BoundingBox{-15.562, 34.327, -15.075, 34.976}},
+	"MW-NI":  {-13.255, 33.919, BoundingBox{-13.450, 33.460, -12.962, 34.410}},
+	"MW-NK":  {-12.707, 34.067, BoundingBox{-13.206, 33.621, -12.250, 34.476}},
+	"MW-NS":  {-16.573, 35.013, BoundingBox{-16.800, 34.773, -16.398, 35.247}},
+	"MW-NU":  {-14.729, 34.709, BoundingBox{-15.118, 34.439, -14.307, 34.987}},
+	"MW-PH":  {-15.648, 35.610, BoundingBox{-15.873, 35.425, -15.334, 35.768}},
+	"MW-RU":  {-10.905, 33.823, BoundingBox{-11.343, 33.200, -10.439, 34.471}},
+	"MW-S":   {-15.358, 35.121, BoundingBox{-16.800, 34.310, -13.741, 35.770}},
+	"MW-SA":  {-13.710, 34.503, BoundingBox{-14.000, 34.040, -13.192, 35.102}},
+	"MW-TH":  {-16.128, 35.134, BoundingBox{-16.414, 34.911, -15.875, 35.383}},
+	"MW-ZO":  {-15.345, 35.339, BoundingBox{-15.586, 34.971, -15.108, 35.735}},
+	"MX-AGU": {21.925, -102.237, BoundingBox{20.832, -103.075, 22.863, -101.231}},
+	"MX-BCN": {31.182, -115.021, BoundingBox{28.738, -117.130, 32.720, -112.588}},
+	"MX-BCS": {26.329, -112.128, BoundingBox{22.820, -115.060, 29.132, -109.204}},
+	"MX-CAM": {18.784, -90.108, BoundingBox{17.631, -91.616, 20.383, -88.757}},
+	"MX-CHH": {28.961, -106.293, BoundingBox{26.540, -108.755, 31.750, -103.544}},
+	"MX-CHP": {16.316, -92.461, BoundingBox{14.540, -94.361, 17.337, -90.440}},
+	"MX-CMX": {19.290, -99.144, BoundingBox{19.062, -99.300, 19.525, -98.943}},
+	"MX-COA": {27.613, -102.154, BoundingBox{25.263, -104.379, 29.780, -99.716}},
+	"MX-COL": {19.288, -103.977, BoundingBox{18.090, -105.592, 20.244, -102.843}},
+	"MX-DUR": {25.061, -104.752, BoundingBox{23.300, -106.291, 26.971, -102.649}},
+	"MX-GRO": {17.508, -99.782, BoundingBox{16.305, -101.627, 18.623, -98.173}},
+	"MX-GUA": {20.849, -101.202, BoundingBox{19.795, -102.275, 21.907, -100.363}},
+	"MX-HID": {20.584, -98.641, BoundingBox{19.939, -99.470, 21.461, -97.511}},
+	"MX-JAL": {21.192, -103.630, BoundingBox{19.552, -105.230, 23.399, -102.185}},
+	"MX-MEX": {19.463, -99.712, BoundingBox{18.553, -100.754, 20.282, -98.525}},
+	"MX-MIC": {19.018, -101.819, BoundingBox{17.625, -102.922, 20.360, -100.627}},
+	"MX-MOR": {18.431, -99.019, BoundingBox{17.483, -100.132, 19.089, -98.219}},
+	"MX-NAY": {22.003, -105.090, BoundingBox{20.154, -106.418, 23.300, -104.126}},
+	"MX-NLE": {25.684, -100.134, BoundingBox{24.115, -102.095, 27.729, -97.898}},
+	"MX-OAX": {16.878, -96.746, BoundingBox{15.650, -98.409, 18.250, -94.958}},
+	"MX-PUE": {18.529, -97.700, BoundingBox{17.428, -98.503, 19.633, -96.774}},
+	"MX-QUE": {20.955, -99.855, BoundingBox{19.957, -100.547, 21.903, -99.086}},
+	"MX-ROO": {19.907, -88.010, BoundingBox{18.037, -89.239, 21.540, -86.767}},
+	"MX-SIN": {25.696, -107.551, BoundingBox{23.210, -109.419, 27.501, -106.005}},
+	"MX-SLP": {22.847, -100.328, BoundingBox{21.650, -101.581, 24.231, -98.816}},
+	"MX-SON": {29.720, -110.287, BoundingBox{27.239, -112.940, 31.818, -107.678}},
+	"MX-TAB": {17.907, -92.404, BoundingBox{17.190, -93.749, 18.821, -91.000}},
+	"MX-TAM": {24.275, -98.555, BoundingBox{22.754, -100.257, 25.954, -97.140}},
+	"MX-TLA": {19.713, -97.979, BoundingBox{18.915, -98.525, 20.403, -97.363}},
+	"MX-VER": {19.267, -96.322, BoundingBox{16.094, -99.280, 22.947, -93.623}},
+	"MX-YUC": {20.618, -89.220, BoundingBox{19.586, -90.481, 21.490, -88.072}},
+	"MX-ZAC": {23.838, -102.567, BoundingBox{22.526, -104.304, 25.344, -101.086}},
+	"MY-01":  {2.007, 103.518, BoundingBox{1.230, 102.826, 3.024, 104.250}},
+	"MY-02":  {5.916, 100.763, BoundingBox{5.417, 100.259, 6.438, 101.337}},
+	"MY-03":  {5.310, 102.009, BoundingBox{4.311, 101.213, 6.220, 102.694}},
+	"MY-04":  {2.311, 102.593, BoundingBox{1.771, 101.916, 2.740, 103.029}},
+	"MY-05":  {2.807, 102.400, BoundingBox{2.408, 101.845, 3.226, 103.087}},
+	"MY-06":  {3.731, 102.763, BoundingBox{2.844, 101.890, 4.477, 103.472}},
+	"MY-07":  {5.356, 100.532, BoundingBox{4.884, 100.200, 5.789, 101.019}},
+	"MY-08":  {4.616, 101.135, BoundingBox{3.874, 100.484, 5.476, 101.915}},
+	"MY-09":  {6.408, 100.328, BoundingBox{6.138, 100.090, 6.640, 100.636}},
+	"MY-10":  {3.453, 101.648, BoundingBox{2.456, 100.756, 4.267, 102.423}},
+	"MY-11":  {4.870, 102.884, BoundingBox{4.215, 102.297, 5.795, 103.437}},
+	"MY-12":  {5.053, 116.791, BoundingBox{2.893, 114.542, 6.930, 119.180}},
+	"MY-13":  {2.200, 112.833, BoundingBox{0.770, 109.660, 4.093, 115.211}},
+	"MY-14":  {3.130, 101.690, BoundingBox{3.035, 101.595, 3.220, 101.785}},
+	"MY-15":  {4.115, 114.415, BoundingBox{3.559, 113.865, 5.607, 115.625}},
+	"MY-16":  {2.962, 101.690, BoundingBox{2.890, 101.645, 3.035, 101.735}},
+	"MZ-A":   {-13.041, 36.288, BoundingBox{-15.022, 34.280, -11.345, 38.162}},
+	"MZ-B":   {-19.347, 33.283, BoundingBox{-21.257, 32.154, -17.206, 33.950}},
+	"MZ-G":   {-23.005, 32.734, BoundingBox{-24.775, 31.190, -21.212, 34.366}},
+	"MZ-I":   {-22.651, 34.572, BoundingBox{-24.759, 33.284, -20.994, 35.610}},
+	"MZ-L":   {-25.367, 32.582, BoundingBox{-26.740, 31.750, -24.342, 34.329}},
+	"MZ-MPM": {-25.965, 32.520, BoundingBox{-26.060, 32.331, -25.818, 32.641}},
+	"MZ-N":   {-15.033, 39.078, BoundingBox{-16.903, 37.147, -13.622, 40.780}},
+	"MZ-P":   {-12.316, 39.327, BoundingBox{-13.884, 37.740, -10.320, 40.592}},
+	"MZ-Q":   {-16.563, 36.758, BoundingBox{-18.481, 34.743, -14.853, 39.013}},
+	"MZ-S":   {-18.761, 34.784, BoundingBox{-21.018, 33.950, -16.860, 36.469}},
+	"MZ-T":   {-15.529, 32.727, BoundingBox{-17.263, 30.180, -13.970, 34.833}},
+	"NA-CA":  {-17.750, 23.508, BoundingBox{-18.280, 21.697, -17.300, 25.080}},
+	"NA-ER":  {-21.972, 15.096, BoundingBox{-24.267, 13.489, -20.286, 16.473}},
+	"NA-HA":  {-24.795, 16.876, BoundingBox{-26.566, 14.499, -23.600, 19.900}},
+	"NA-KA":  {-27.123, 17.939, BoundingBox{-29.050, 15.091, -24.937, 19.900}},
+	"NA-KH":  {-22.656, 17.086, BoundingBox{-23.600, 15.484, -21.204, 18.663}},
+	"NA-KU":  {-19.471, 13.770, BoundingBox{-21.093, 11.747, -17.515, 15.870}},
+	"NA-OD":  {-20.493, 17.884, BoundingBox{-21.822, 15.938, -19.202, 19.930}},
+	"NA-OH":  {-22.089, 19.510, BoundingBox{-24.648, 18.075, -20.250, 20.893}},
+	"NA-OK":  {-18.820, 19.721, BoundingBox{-20.250, 18.200, -17.311, 21.759}},
+	"NA-ON":  {-18.874, 15.715, BoundingBox{-20.297, 14.932, -17.522, 16.395}},
+	"NA-OS":  {-17.816, 13.833, BoundingBox{-19.058, 11.730, -16.940, 15.536}},
+	"NA-OT":  {-18.819, 17.223, BoundingBox{-20.201, 16.041, -17.486, 18.281}},
+	"NA-OW":  {-17.640, 16.759, BoundingBox{-18.250, 15.472, -17.310, 18.262}},
+	"NE-1":   {19.894, 10.958, BoundingBox{16.568, 5.752, 23.470, 15.900}},
+	"NE-2":   {15.998, 13.199, BoundingBox{12.460, 11.150, 19.855, 15.670}},
+	"NE-3":   {13.268, 3.273, BoundingBox{11.660, 1.590, 14.896, 5.120}},
+	"NE-4":   {14.342, 7.352, BoundingBox{12.830, 5.502, 16.495, 9.231}},
+	"NE-5":   {16.635, 5.375, BoundingBox{13.834, 3.101, 19.649, 7.470}},
+	"NE-6":   {14.262, 1.838, BoundingBox{12.742, 0.300, 15.473, 3.329}},
+	"NE-7":   {15.160, 9.546, BoundingBox{12.839, 7.402, 17.046, 11.150}},
+	"NE-8":   {13.492, 2.104, BoundingBox{13.366, 2.029, 13.580, 2.190}},
+	"NG-AB":  {5.509, 7.605, BoundingBox{4.970, 7.231, 6.003, 8.139}},
+	"NG-AD":  {9.432, 12.332, BoundingBox{8.016, 11.214, 10.628, 13.395}},
+	"NG-AK":  {4.924, 7.944, BoundingBox{4.410, 7.345, 5.463, 8.624}},
+	"NG-AN":  {6.283, 6.836, BoundingBox{5.789, 6.344, 6.990, 7.469}},
+	"NG-BA":  {10.621, 9.823, BoundingBox{9.631, 8.779, 11.566, 10.700}},
+	"NG-BE":  {7.334, 8.943, BoundingBox{6.559, 7.691, 8.194, 9.992}},
+	"NG-BO":  {11.905, 13.224, BoundingBox{10.369, 12.109, 13.600, 14.580}},
+	"NG-BY":  {4.776, 5.997, BoundingBox{4.244, 5.244, 5.262, 6.551}},
+	"NG-CR":  {5.965, 8.677, BoundingBox{5.108, 8.055, 6.623, 9.631}},
+	"NG-DE":  {5.680, 5.748, BoundingBox{5.142, 4.786, 6.193, 6.562}},
+	"NG-EB":  {6.332, 8.094, BoundingBox{5.662, 7.547, 6.987, 8.682}},
+	"NG-ED":  {6.651, 5.890, BoundingBox{6.046, 4.992, 7.377, 6.649}},
+	"NG-EK":  {7.929, 5.489, BoundingBox{7.166, 4.798, 8.794, 6.027}},
+	"NG-EN":  {6.823, 7.461, BoundingBox{6.000, 6.759, 7.535, 8.051}},
+	"NG-FC":  {8.974, 7.057, BoundingBox{8.048, 5.950, 9.810, 7.985}},
+	"NG-GO":  {10.318, 11.310, BoundingBox{9.114, 10.429, 11.350, 12.508}},
+	"NG-IM":  {5.518, 6.929, BoundingBox{5.091, 6.470, 5.895, 7.323}},
+	"NG-JI":  {12.322, 9.719, BoundingBox{11.337, 8.536, 13.280, 10.552}},
+	"NG-KD":  {10.362, 7.671, BoundingBox{9.395, 6.584, 11.383, 8.827}},
+	"NG-KE":  {11.418, 4.337, BoundingBox{10.049, 3.570, 12.867, 5.391}},
+	"NG-KN":  {11.581, 8.480, BoundingBox{10.621, 7.412, 12.754, 9.408}},
+	"NG-KO":  {7.817, 6.701, BoundingBox{6.973, 5.925, 8.789, 7.720}},
+	"NG-KT":  {12.505, 7.523, BoundingBox{11.360, 6.537, 13.378, 8.563}},
+	"NG-KW":  {9.130, 4.477, BoundingBox{8.156, 3.309, 10.139, 5.827}},
+	"NG-LA":  {6.488, 3.421, BoundingBox{6.260, 2.690, 6.878, 4.128}},
+	"NG-NA":  {8.545, 8.324, BoundingBox{7.660, 7.546, 9.555, 9.292}},
+	"NG-NI":  {10.085, 5.693, BoundingBox{8.778, 4.323, 11.180, 6.752}},
+	"NG-OG":  {6.933, 3.739, BoundingBox{5.840, 2.700, 7.684, 4.867}},
+	"NG-ON":  {6.851, 5.032, BoundingBox{5.959, 4.567, 7.511, 5.630}},
+	"NG-OS":  {7.678, 4.438, BoundingBox{7.127, 3.840, 8.196, 4.922}},
+	"NG-OY":  {8.371, 3.400, BoundingBox{7.490, 2.720, 9.552, 4.282}},
+	"NG-PL":  {9.169, 9.547, BoundingBox{8.051, 8.478, 10.112, 10.716}},
+	"NG-RI":  {4.782, 6.867, BoundingBox{4.240, 6.412, 5.319, 7.373}},
+	"NG-SO":  {13.096, 5.142, BoundingBox{12.050, 3.904, 13.870, 6.562}},
+	"NG-TA":  {7.895, 10.890, BoundingBox{6.640, 9.631, 9.198, 12.128}},
+	"NG-YO":  {12.296, 11.447, BoundingBox{11.133, 10.465, 13.390, 12.645}},
+	"NG-ZA":  {11.909, 6.177, BoundingBox{10.903, 5.123, 13.291, 7.147}},
+	"NI-AN":  {14.027, -84.169, BoundingBox{13.043, -85.218, 15.020, -83.150}},
+	"NI-AS":  {12.450, -84.084, BoundingBox{11.548, -84.728, 13.178, -83.470}},
+	"NI-BO":  {12.614, -85.327, BoundingBox{12.112, -85.969, 13.068, -84.684}},
+	"NI-CA":  {11.735, -86.257, BoundingBox{11.418, -86.616, 11.968, -85.971}},
+	"NI-CI":  {12.778, -87.088, BoundingBox{12.299, -87.670, 13.226, -86.648}},
+	"NI-CO":  {12.076, -84.998, BoundingBox{11.480, -85.553, 12.808, -84.521}},
+	"NI-ES":  {13.082, -86.366, BoundingBox{12.707, -86.894, 13.375, -85.951}},
+	"NI-GR":  {11.919, -85.790, BoundingBox{11.580, -86.095, 12.292, -85.479}},
+	"NI-JI":  {13.551, -85.376, BoundingBox{13.033, -86.010, 14.312, -84.715}},
+	"NI-LE":  {12.518, -86.577, BoundingBox{12.094, -86.958, 12.888, -86.129}},
+	"NI-MD":  {13.491, -86.450, BoundingBox{13.277, -86.760, 13.758, -85.995}},
+	"NI-MN":  {12.263, -86.289, BoundingBox{11.939, -86.719, 12.655, -85.891}},
+	"NI-MS":  {12.071, -86.070, BoundingBox{11.815, -86.341, 12.357, -85.827}},
+	"NI-MT":  {12.901, -85.717, BoundingBox{12.525, -86.154, 13.207, -85.033}},
+	"NI-NS":  {13.749, -86.139, BoundingBox{13.490, -86.699, 14.040, -85.808}},
+	"NI-RI":  {11.424, -85.533, BoundingBox{11.050, -86.080, 11.814, -85.068}},
+	"NI-SJ":  {11.291, -84.383, BoundingBox{10.730, -85.144, 11.827, -83.660}},
+	"NL-AW":  {12.520, -69.970, BoundingBox{12.387, -70.106, 12.653, -69.834}},
+	"NL-BQ1": {12.180, -68.270, BoundingBox{11.997, -68.457, 12.363, -68.083}},
+	"NL-BQ2": {17.630, -63.240, BoundingBox{17.597, -63.275, 17.663, -63.205}},
+	"NL-BQ3": {17.490, -63.016, BoundingBox{17.445, -63.017, 17.535, -62.923}},
+	"NL-CW":  {12.200, -69.000, BoundingBox{11.951, -69.255, 12.449, -68.745}},
+	"NL-DR":  {52.844, 6.577, BoundingBox{52.568, 6.021, 53.102, 7.051}},
+	"NL-FL":  {52.541, 5.614, BoundingBox{52.229, 5.163, 52.846, 6.043}},
+	"NL-FR":  {53.120, 5.727, BoundingBox{52.760, 4.902, 53.510, 6.305}},
+	"NL-GE":  {51.998, 6.000, BoundingBox{51.625, 5.522, 52.332, 6.687}},
+	"NL-GR":  {53.262, 6.653, BoundingBox{52.997, 6.219, 53.502, 7.090}},
+	"NL-LI":  {51.168, 5.829, BoundingBox{50.750, 5.346, 51.625, 6.160}},
+	"NL-NB":  {51.576, 5.199, BoundingBox{51.221, 4.519, 51.815, 5.882}},
+	"NL-NH":  {52.672, 4.844, BoundingBox{52.277, 4.288, 53.149, 5.330}},
+	"NL-OV":  {52.401, 6.452, BoundingBox{51.997, 5.976, 52.736, 6.933}},
+	"NL-SX":  {18.028, -63.084, BoundingBox{17.990, -63.160, 18.065, -62.990}},
+	"NL-UT":  {52.078, 5.187, BoundingBox{51.815, 4.778, 52.375, 5.569}},
+	"NL-ZE":  {51.498, 4.027, BoundingBox{51.270, 3.310, 51.851, 4.539}},
+	"NL-ZH":  {51.982, 4.469, BoundingBox{51.581, 3.968, 52.385, 4.918}},
+	"NO-01":  {59.335, 11.099, BoundingBox{58.860, 10.605, 59.661, 11.747}},
+	"NO-02":  {60.134, 10.965, BoundingBox{59.474, 9.286, 60.795, 12.437}},
+	"NO-03":  {59.950, 10.743, BoundingBox{59.840, 10.549, 60.061, 10.934}},
+	"NO-04":  {61.481, 11.431, BoundingBox{60.604, 10.500, 62.430, 12.630}},
+	"NO-05":  {61.487, 9.350, BoundingBox{60.749, 7.868, 62.414, 10.500}},
+	"NO-06":  {60.379, 8.826, BoundingBox{59.788, 7.547, 60.882, 10.284}},
+	"NO-07":  {59.321, 9.815, BoundingBox{58.868, 9.189, 59.637, 10.851}},
+	"NO-08":  {59.523, 8.222, BoundingBox{58.991, 6.837, 60.036, 9.500}},
+	"NO-09":  {58.829, 8.134, BoundingBox{58.367, 7.034, 59.225, 9.333}},
+	"NO-10":  {58.412, 7.228, BoundingBox{58.080, 6.093, 58.779, 8.478}},
+	"NO-11":  {59.153, 6.268, BoundingBox{58.434, 5.306, 59.710, 7.277}},
+	"NO-12":  {60.330, 6.331, BoundingBox{59.690, 5.127, 60.979, 7.675}},
+	"NO-14":  {61.483, 6.460, BoundingBox{60.792, 4.990, 62.260, 8.032}},
+	"NO-15":  {62.556, 7.915, BoundingBox{61.818, 5.406, 63.492, 10.142}},
+	"NO-18":  {66.742, 14.635, BoundingBox{64.803, 11.454, 68.975, 17.954}},
+	"NO-19":  {69.307, 19.880, BoundingBox{68.410, 17.342, 70.260, 22.547}},
+	"NO-20":  {70.032, 26.162, BoundingBox{68.650, 21.524, 71.190, 31.290}},
+	"NO-21":  {78.995, 18.073, BoundingBox{76.770, 10.440, 80.660, 27.410}},
+	"NO-22":  {71.000, -8.500, BoundingBox{70.955, -8.638, 71.045, -8.362}},
+	"NO-50":  {63.772, 11.220, BoundingBox{62.369, 8.630, 65.192, 13.920}},
+	"NP-1":   {27.520, 85.404, BoundingBox{26.567, 83.904, 28.595, 86.397}},
+	"NP-2":   {28.939, 82.247, BoundingBox{27.663, 80.950, 30.395, 83.289}},
+	"NP-3":   {28.348, 83.733, BoundingBox{27.303, 82.609, 29.493, 85.093}},
+	"NP-4":   {27.199, 87.223, BoundingBox{26.400, 86.350, 28.082, 88.170}},
+	"NP-5":   {29.352, 81.046, BoundingBox{28.462, 80.090, 30.420, 81.843}},
+	"NP-BA":  {27.945, 85.466, BoundingBox{27.261, 84.766, 28.595, 86.397}},
+	"NP-BH":  {28.511, 81.771, BoundingBox{27.899, 80.950, 29.066, 82.358}},
+	"NP-DH":  {28.877, 83.430, BoundingBox{28.259, 82.700, 29.493, 84.140}},
+	"NP-GA":  {28.291, 84.289, BoundingBox{27.591, 83.565, 28.971, 85.093}},
+	"NP-JA":  {27.153, 85.923, BoundingBox{26.567, 85.276, 28.003, 86.350}},
+	"NP-KA":  {29.520, 82.344, BoundingBox{28.788, 81.598, 30.395, 83.289}},
+	"NP-KO":  {27.036, 87.294, BoundingBox{26.400, 86.739, 27.938, 87.600}},
+	"NP-LU":  {27.771, 83.361, BoundingBox{27.303, 82.609, 28.319, 83.997}},
+	"NP-MA":  {29.487, 80.671, BoundingBox{28.683, 80.090, 30.385, 81.469}},
+	"NP-ME":  {27.141, 87.850, BoundingBox{26.404, 87.600, 27.920, 88.170}},
+	"NP-NA":  {27.415, 84.733, BoundingBox{26.727, 83.904, 27.987, 85.392}},
+	"NP-RA":  {28.251, 82.586, BoundingBox{27.663, 82.070, 28.810, 83.175}},
+	"NP-SA":  {27.371, 86.722, BoundingBox{26.493, 86.350, 28.082, 87.361}},
+	"NP-SE":  {29.290, 81.219, BoundingBox{28.462, 80.369, 30.420, 81.843}},
+	"NR-01":  {-0.537, 166.896, BoundingBox{-0.550, 166.880, -0.530, 166.921}},
+	"NR-02":  {-0.508, 166.957, BoundingBox{-0.524, 166.940, -0.493, 166.974}},
+	"NR-03":  {-0.497, 166.948, BoundingBox{-0.520, 166.938, -0.480, 166.964}},
+	"NR-04":  {-0.537, 166.966, BoundingBox{-0.556, 166.945, -0.524, 166.980}},
+	"NR-05":  {-0.497, 166.922, BoundingBox{-0.520, 166.908, -0.480, 166.933}},
+	"NR-06":  {-0.553, 166.903, BoundingBox{-0.575, 166.880, -0.534, 166.924}},
+	"NR-07":  {-0.530, 166.933, BoundingBox{-0.542, 166.921, -0.518, 166.945}},
+	"NR-08":  {-0.522, 166.896, BoundingBox{-0.530, 166.880, -0.508, 166.921}},
+	"NR-09":  {-0.495, 166.934, BoundingBox{-0.518, 166.923, -0.480, 166.942}},
+	"NR-10":  {-0.517, 166.966, BoundingBox{-0.527, 166.944, -0.503, 166.980}},
+	"NR-11":  {-0.557, 166.952, BoundingBox{-0.580, 166.936, -0.533, 166.975}},
+	"NR-12":  {-0.511, 166.902, BoundingBox{-0.526, 166.881, -0.496, 166.924}},
+	"NR-13":  {-0.501, 166.910, BoundingBox{-0.522, 166.893, -0.481, 166.927}},
+	"NR-14":  {-0.563, 166.925, BoundingBox{-0.580, 166.904, -0.538, 166.941}},
+	"NZ-AUK": {-36.889, 174.954, BoundingBox{-37.574, 174.051, -36.130, 175.884}},
+	"NZ-BOP": {-38.288, 176.580, BoundingBox{-38.800, 175.950, -37.619, 177.390}},
+	"NZ-CAN": {-43.233, 172.136, BoundingBox{-44.701, 170.766, -41.973, 173.802}},
+	"NZ-CIT": {-43.950, -176.550, BoundingBox{-43.995, -176.612, -43.905, -176.488}},
+	"NZ-GIS": {-38.391, 177.790, BoundingBox{-39.170, 176.990, -37.580, 178.520}},
+	"NZ-HKB": {-39.470, 176.574, BoundingBox{-40.537, 175.879, -38.800, 177.615}},
+	"NZ-MBH": {-41.662, 173.721, BoundingBox{-42.317, 173.006, -40.930, 174.250}},
+	"NZ-MWT": {-39.699, 175.556, BoundingBox{-40.437, 174.705, -38.835, 176.342}},
+	"NZ-N":   {-38.518, 175.666, BoundingBox{-41.690, 172.640, -34.450, 178.520}},
+	"NZ-NSN": {-41.006, 173.047, BoundingBox{-41.522, 172.641, -40.490, 173.511}},
+	"NZ-NTL": {-35.429, 173.705, BoundingBox{-36.300, 172.640, -34.450, 174.601}},
+	"NZ-OTA": {-45.198, 169.856, BoundingBox{-46.462, 168.253, -44.450, 171.269}},
+	"NZ-S":   {-43.987, 170.513, BoundingBox{-46.640, 166.510, -40.490, 174.250}},
+	"NZ-STL": {-45.674, 168.048, BoundingBox{-46.640, 166.510, -44.356, 169.647}},
+	"NZ-TAS": {-41.445, 172.535, BoundingBox{-42.104, 171.670, -40.597, 173.353}},
+	"NZ-TKI": {-39.218, 174.544, BoundingBox{-39.838, 173.820, -38.541, 175.272}},
+	"NZ-WGN": {-40.947, 175.579, BoundingBox{-41.690, 174.650, -40.389, 176.555}},
+	"NZ-WKO": {-38.077, 175.404, BoundingBox{-38.856, 174.627, -37.174, 176.107}},
+	"NZ-WTC": {-43.425, 170.484, BoundingBox{-44.450, 168.003, -41.702, 172.250}},
+	"OM-BA":  {23.711, 57.101, BoundingBox{23.202, 56.467, 24.412, 57.844}},
+	"OM-BU":  {24.232, 56.145, BoundingBox{23.518, 55.528, 24.920, 56.736}},
+	"OM-DA":  {22.287, 57.354, BoundingBox{21.148, 56.468, 23.398, 58.262}},
+	"OM-MA":  {23.303, 58.363, BoundingBox{22.803, 57.727, 23.802, 59.131}},
+	"OM-MU":  {26.075, 56.293, BoundingBox{25.710, 56.070, 26.400, 56.490}},
+	"OM-SH":  {21.838, 58.645, BoundingBox{20.430, 57.527, 23.053, 59.810}},
+	"OM-WU":  {19.899, 56.371, BoundingBox{17.880, 54.479, 21.454, 58.419}},
+	"OM-ZA":  {22.630, 56.084, BoundingBox{21.401, 55.210, 23.765, 56.973}},
+	"OM-ZU":  {18.237, 53.942, BoundingBox{16.650, 52.000, 19.826, 56.099}},
+	"PA-1":   {9.143, -82.529, BoundingBox{8.773, -82.930, 9.570, -81.933}},
+	"PA-2":   {8.517, -80.455, BoundingBox{8.151, -80.863, 8.874, -80.150}},
+	"PA-3":   {9.034, -80.207, BoundingBox{8.608, -81.161, 9.610, -79.447}},
+	"PA-4":   {8.505, -82.470, BoundingBox{8.070, -82.970, 8.856, -81.947}},
+	"PA-5":   {7.844, -77.744, BoundingBox{7.220, -78.292, 8.449, -77.240}},
+	"PA-6":   {7.820, -80.742, BoundingBox{7.235, -81.205, 8.284, -80.351}},
+	"PA-7":   {7.532, -80.432, BoundingBox{7.220, -80.894, 7.945, -80.000}},
+	"PA-8":   {8.953, -78.521, BoundingBox{8.336, -80.150, 9.597, -77.947}},
+	"PA-9":   {8.182, -81.191, BoundingBox{7.653, -81.668, 8.779, -80.721}},
+	"PA-EM":  {8.437, -77.929, BoundingBox{7.710, -78.430, 9.092, -77.350}},
+	"PA-KY":  {9.226, -78.538, BoundingBox{9.050, -79.089, 9.558, -77.886}},
+	"PA-NB":  {8.566, -81.697, BoundingBox{8.006, -82.130, 9.030, -81.142}},
+	"PE-AMA": {-4.678, -77.656, BoundingBox{-6.208, -79.150, -2.804, -76.213}},
+	"PE-ANC": {-9.421, -77.603, BoundingBox{-10.579, -78.733, -8.239, -76.785}},
+	"PE-APU": {-14.241, -73.093, BoundingBox{-15.466, -74.185, -12.625, -71.835}},
+	"PE-ARE": {-15.727, -72.508, BoundingBox{-17.022, -74.314, -14.591, -71.081}},
+	"PE-AYA": {-13.418, -74.009, BoundingBox{-15.119, -75.003, -11.946, -73.119}},
+	"PE-CAJ": {-6.498, -78.495, BoundingBox{-7.341, -79.200, -5.468, -77.504}},
+	"PE-CAL": {-11.967, -77.150, BoundingBox{-12.290, -77.318, -11.771, -77.065}},
+	"PE-CUS": {-13.015, -71.918, BoundingBox{-14.657, -73.180, -11.247, -70.371}},
+	"PE-HUC": {-9.087, -75.911, BoundingBox{-10.417, -76.902, -7.854, -74.900}},
+	"PE-HUV": {-12.818, -75.023, BoundingBox{-13.724, -75.852, -12.200, -73.830}},
+	"PE-ICA": {-14.509, -75.246, BoundingBox{-15.831, -76.420, -13.369, -74.144}},
+	"PE-JUN": {-11.497, -74.440, BoundingBox{-12.200, -75.900, -10.542, -72.856}},
+	"PE-LAL": {-7.981, -78.354, BoundingBox{-9.033, -79.560, -7.154, -77.104}},
+	"PE-LAM": {-6.472, -79.804, BoundingBox{-7.668, -81.080, -5.531, -79.200}},
+	"PE-LIM": {-11.720, -76.589, BoundingBox{-13.674, -77.984, -10.305, -75.560}},
+	"PE-LMA": {-12.095, -76.898, BoundingBox{-12.480, -77.065, -11.739, -76.725}},
+	"PE-LOR": {-3.569, -73.927, BoundingBox{-6.625, -77.237, -0.060, -69.890}},
+	"PE-MDD": {-11.766, -70.545, BoundingBox{-13.520, -72.537, -9.490, -68.670}},
+	"PE-MOQ": {-16.617, -70.905, BoundingBox{-17.770, -72.129, -15.592, -69.473}},
+	"PE-PAS": {-10.402, -75.425, BoundingBox{-11.238, -76.613, -9.490, -74.328}},
+	"PE-PIU": {-5.208, -80.278, BoundingBox{-6.236, -81.410, -4.413, -79.150}},
+	"PE-PUN": {-14.764, -70.021, BoundingBox{-16.430, -71.415, -13.282, -68.907}},
+	"PE-SAM": {-6.893, -76.011, BoundingBox{-8.369, -77.759, -5.300, -73.812}},
+	"PE-TAC": {-17.399, -70.097, BoundingBox{-18.350, -71.380, -16.376, -68.960}},
+	"PE-TUM": {-4.133, -80.690, BoundingBox{-4.634, -81.363, -3.400, -79.773}},
+	"PE-UCA": {-9.375, -73.653, BoundingBox{-11.403, -74.900, -6.484, -71.621}},
+	"PG-CPK": {-6.196, 144.859, BoundingBox{-6.844, 144.274, -5.382, 145.406}},
+	"PG-CPM": {-8.718, 147.188, BoundingBox{-10.253, 145.767, -7.547, 148.739}},
+	"PG-EBR": {-4.783, 151.985, BoundingBox{-5.851, 150.992, -3.819, 153.140}},
+	"PG-EHG": {-6.301, 145.788, BoundingBox{-7.207, 145.079, -5.592, 146.566}},
+	"PG-EPW": {-5.281, 143.536, BoundingBox{-5.950, 142.738, -4.637, 144.285}},
+	"PG-ESW": {-4.207, 143.265, BoundingBox{-4.989, 141.993, -3.282, 144.604}},
+	"PG-GPK": {-7.403, 144.867, BoundingBox{-8.136, 143.500, -6.791, 146.056}},
+	"PG-MBA": {-10.126, 149.752, BoundingBox{-10.650, 148.741, -9.512, 150.800}},
+	"PG-MPL": {-6.762, 146.815, BoundingBox{-7.795, 145.923, -5.684, 147.890}},
+	"PG-MPM": {-4.892, 145.169, BoundingBox{-5.661, 144.353, -3.878, 146.314}},
+	"PG-MRL": {-2.080, 147.000, BoundingBox{-2.125, 146.955, -2.035, 147.045}},
+	"PG-NCD": {-9.250, 147.022, BoundingBox{-9.564, 146.708, -8.956, 147.317}},
+	"PG-NIK": {-3.167, 151.759, BoundingBox{-3.886, 150.660, -2.500, 152.829}},
+	"PG-NPP": {-9.076, 148.397, BoundingBox{-10.253, 147.526, -7.711, 149.310}},
+	"PG-NSB": {-6.132, 155.232, BoundingBox{-6.920, 154.510, -5.040, 156.020}},
+	"PG-SAN": {-3.865, 141.646, BoundingBox{-5.258, 141.000, -2.600, 142.719}},
+	"PG-SHM": {-6.174, 142.682, BoundingBox{-7.959, 141.016, -4.816, 144.329}},
+	"PG-WBK": {-5.793, 149.882, BoundingBox{-6.320, 148.320, -5.000, 151.274}},
+	"PG-WHM": {-5.596, 144.325, BoundingBox{-6.593, 143.786, -4.591, 144.888}},
+	"PG-WPD": {-7.887, 142.080, BoundingBox{-9.330, 141.020, -6.019, 143.500}},
+	"PH-00":  {14.599, 121.007, BoundingBox{14.370, 120.735, 14.750, 121.150}},
+	"PH-01":  {16.836, 120.473, BoundingBox{15.588, 119.880, 18.510, 121.085}},
+	"PH-02":  {17.040, 121.801, BoundingBox{15.929, 120.722, 20.495, 122.520}},
+	"PH-03":  {15.354, 120.819, BoundingBox{14.400, 119.908, 16.101, 121.964}},
+	"PH-05":  {13.553, 123.265, BoundingBox{12.155, 122.322, 14.340, 124.296}},
+	"PH-06":  {10.946, 122.658, BoundingBox{10.023, 121.880, 11.890, 123.455}},
+	"PH-07":  {9.940, 123.357, BoundingBox{9.020, 122.380, 11.230, 124.436}},
+	"PH-08":  {11.540, 125.014, BoundingBox{10.130, 124.270, 12.560, 125.780}},
+	"PH-09":  {7.728, 122.770, BoundingBox{6.505, 121.920, 8.529, 123.688}},
+	"PH-10":  {8.272, 124.716, BoundingBox{7.568, 123.250, 9.215, 125.555}},
+	"PH-11":  {6.885, 125.215, BoundingBox{5.580, 124.423, 8.003, 126.540}},
+	"PH-12":  {7.273, 124.900, BoundingBox{6.060, 123.623, 8.426, 125.255}},
+	"PH-13":  {8.725, 125.828, BoundingBox{7.931, 125.083, 10.195, 126.442}},
+	"PH-14":  {7.395, 124.342, BoundingBox{5.055, 119.955, 8.334, 124.798}},
+	"PH-15":  {17.339, 121.083, BoundingBox{16.059, 120.386, 18.504, 121.865}},
+	"PH-40":  {14.188, 121.464, BoundingBox{13.402, 120.630, 15.350, 122.407}},
+	"PH-41":  {11.270, 119.495, BoundingBox{8.370, 117.170, 13.731, 122.672}},
+	"PH-ABR": {17.617, 120.741, BoundingBox{17.294, 120.386, 17.890, 121.073}},
+	"PH-AGN": {8.983, 125.587, BoundingBox{8.510, 125.083, 9.350, 126.061}},
+	"PH-AGS": {8.272, 125.874, BoundingBox{7.931, 125.372, 8.721, 126.442}},
+	"PH-AKL": {11.583, 122.184, BoundingBox{11.313, 121.880, 11.890, 122.506}},
+	"PH-ALB": {13.218, 123.599, BoundingBox{12.829, 123.176, 13.634, 123.941}},
+	"PH-ANT": {11.066, 122.126, BoundingBox{10.578, 121.970, 11.496, 122.353}},
+	"PH-APA": {18.091, 121.232, BoundingBox{17.694, 120.892, 18.504, 121.500}},
+	"PH-AUR": {15.835, 121.500, BoundingBox{15.350, 121.207, 16.101, 121.964}},
+	"PH-BAN": {14.734, 120.445, BoundingBox{14.400, 120.130, 14.978, 120.737}},
+	"PH-BAS": {6.550, 122.000, BoundingBox{6.505, 121.955, 6.595, 122.045}},
+	"PH-BEN": {16.484, 120.709, BoundingBox{16.059, 120.479, 16.869, 120.997}},
+	"PH-BIL": {11.396, 124.510, BoundingBox{11.220, 124.300, 11.645, 124.837}},
+	"PH-BOH": {9.850, 124.250, BoundingBox{9.667, 124.064, 10.033, 124.436}},
+	"PH-BTG": {13.843, 121.123, BoundingBox{13.402, 120.630, 14.116, 121.584}},
+	"PH-BTN": {20.450, 121.970, BoundingBox{20.405, 121.922, 20.495, 122.018}},
+	"PH-BUK": {7.983, 125.075, BoundingBox{7.568, 124.581, 8.367, 125.555}},
+	"PH-BUL": {14.997, 121.070, BoundingBox{14.750, 120.730, 15.250, 121.370}},
+	"PH-CAG": {17.941, 121.908, BoundingBox{17.451, 121.500, 18.480, 122.340}},
+	"PH-CAM": {8.916, 124.829, BoundingBox{8.859, 124.674, 9.215, 125.085}},
+	"PH-CAN": {13.954, 122.698, BoundingBox{13.432, 122.322, 14.340, 123.223}},
+	"PH-CAP": {11.373, 122.762, BoundingBox{11.141, 122.320, 11.580, 123.120}},
+	"PH-CAS": {13.654, 123.251, BoundingBox{13.193, 122.636, 14.106, 123.777}},
+	"PH-CAT": {13.697, 123.898, BoundingBox{13.481, 123.751, 13.869, 124.296}},
+	"PH-CAV": {14.186, 120.890, BoundingBox{13.874, 120.632, 14.443, 121.164}},
+	"PH-CEB": {10.457, 123.732, BoundingBox{9.835, 123.359, 11.230, 124.080}},
+	"PH-COM": {7.661, 126.109, BoundingBox{7.122, 125.771, 8.003, 126.488}},
+	"PH-DAO": {7.023, 126.247, BoundingBox{6.270, 125.905, 7.673, 126.540}},
+	"PH-DAS": {6.875, 125.294, BoundingBox{6.350, 124.840, 7.464, 125.911}},
+	"PH-DAV": {7.577, 125.596, BoundingBox{7.108, 125.221, 7.974, 125.912}},
+	"PH-DIN": {10.150, 125.600, BoundingBox{10.105, 125.554, 10.195, 125.646}},
+	"PH-EAS": {11.533, 125.420, BoundingBox{11.050, 125.013, 12.208, 125.780}},
+	"PH-GUI": {10.649, 122.330, BoundingBox{10.440, 121.991, 10.866, 123.006}},
+	"PH-IFU": {16.814, 121.244, BoundingBox{16.555, 120.839, 17.127, 121.537}},
+	"PH-ILI": {10.985, 122.556, BoundingBox{10.707, 122.136, 11.227, 123.069}},
+	"PH-ILN": {18.185, 120.791, BoundingBox{17.854, 120.482, 18.510, 121.085}},
+	"PH-ILS": {17.160, 120.543, BoundingBox{16.842, 120.345, 17.543, 120.803}},
+	"PH-ISA": {16.965, 122.031, BoundingBox{16.347, 121.511, 17.491, 122.520}},
+	"PH-KAL": {17.442, 121.398, BoundingBox{17.082, 120.981, 17.768, 121.865}},
+	"PH-LAG": {14.166, 121.465, BoundingBox{13.755, 121.139, 14.463, 121.777}},
+	"PH-LAN": {8.003, 124.014, BoundingBox{7.533, 123.623, 8.426, 124.427}},
+	"PH-LAS": {7.775, 124.424, BoundingBox{7.400, 124.008, 8.334, 124.798}},
+	"PH-LEY": {11.040, 124.771, BoundingBox{10.581, 124.374, 11.405, 125.116}},
+	"PH-LUN": {16.545, 120.401, BoundingBox{16.213, 119.880, 16.893, 120.572}},
+	"PH-MAD": {13.487, 122.409, BoundingBox{13.190, 121.571, 13.731, 122.672}},
+	"PH-MAG": {7.021, 124.363, BoundingBox{6.750, 123.940, 7.400, 124.794}},
+	"PH-MAS": {12.200, 123.500, BoundingBox{12.155, 123.454, 12.245, 123.546}},
+	"PH-MDC": {13.082, 120.833, BoundingBox{12.392, 120.320, 13.470, 121.100}},
+	"PH-MDR": {12.862, 121.260, BoundingBox{12.210, 121.100, 13.422, 121.530}},
+	"PH-MOU": {17.083, 120.952, BoundingBox{16.781, 120.670, 17.371, 121.322}},
+	"PH-MSC": {8.325, 123.539, BoundingBox{7.989, 123.250, 8.690, 123.998}},
+	"PH-MSR": {8.559, 124.927, BoundingBox{8.241, 124.362, 8.968, 125.402}},
+	"PH-NCO": {7.251, 124.907, BoundingBox{6.751, 124.525, 7.618, 125.255}},
+	"PH-NEC": {10.448, 123.123, BoundingBox{10.023, 122.628, 10.925, 123.455}},
+	"PH-NER": {9.678, 122.980, BoundingBox{9.097, 122.380, 10.073, 123.563}},
+	"PH-NSA": {12.369, 124.789, BoundingBox{12.030, 124.270, 12.560, 125.311}},
+	"PH-NUE": {15.593, 121.070, BoundingBox{15.250, 120.702, 15.990, 121.509}},
+	"PH-NUV": {16.243, 121.084, BoundingBox{15.929, 120.722, 16.586, 121.350}},
+	"PH-PAM": {15.071, 120.627, BoundingBox{14.764, 120.282, 15.323, 120.948}},
+	"PH-PAN": {15.933, 120.266, BoundingBox{15.588, 119.884, 16.258, 120.758}},
+	"PH-PLW": {9.886, 118.690, BoundingBox{8.370, 117.170, 11.370, 119.690}},
+	"PH-QUE": {14.245, 121.862, BoundingBox{13.666, 121.309, 15.350, 122.407}},
+	"PH-QUI": {16.347, 121.727, BoundingBox{16.100, 121.350, 16.702, 122.278}},
+	"PH-RIZ": {14.593, 121.326, BoundingBox{14.355, 121.150, 14.902, 121.615}},
+	"PH-ROM": {12.500, 122.300, BoundingBox{12.455, 122.254, 12.545, 122.346}},
+	"PH-SAR": {5.989, 125.309, BoundingBox{5.580, 124.844, 6.350, 125.680}},
+	"PH-SCO": {6.282, 124.833, BoundingBox{5.853, 124.423, 6.756, 125.259}},
+	"PH-SIG": {9.256, 123.205, BoundingBox{9.020, 122.931, 9.584, 123.596}},
+	"PH-SLE": {10.449, 124.997, BoundingBox{10.130, 124.775, 10.768, 125.280}},
+	"PH-SLU": {5.950, 121.100, BoundingBox{5.905, 121.055, 5.995, 121.145}},
+	"PH-SOR": {12.868, 123.943, BoundingBox{12.540, 123.620, 13.179, 124.180}},
+	"PH-SUK": {6.490, 124.372, BoundingBox{6.060, 123.994, 6.750, 124.783}},
+	"PH-SUN": {9.487, 125.655, BoundingBox{9.350, 125.410, 9.760, 126.089}},
+	"PH-SUR": {8.831, 126.098, BoundingBox{8.297, 125.732, 9.366, 126.397}},
+	"PH-TAR": {15.515, 120.553, BoundingBox{15.233, 120.238, 15.865, 120.828}},
+	"PH-TAW": {5.100, 120.000, BoundingBox{5.055, 119.955, 5.145, 120.045}},
+	"PH-WSA": {11.936, 125.086, BoundingBox{11.342, 124.690, 12.426, 125.466}},
+	"PH-ZAN": {8.159, 122.967, BoundingBox{7.843, 122.477, 8.529, 123.314}},
+	"PH-ZAS": {7.598, 123.203, BoundingBox{6.900, 121.920, 8.205, 123.688}},
+	"PH-ZMB": {15.308, 120.141, BoundingBox{14.900, 119.908, 15.693, 120.403}},
+	"PH-ZSI": {7.659, 122.538, BoundingBox{7.199, 122.079, 8.107, 122.995}},
+	"PK-BA":  {27.965, 65.066, BoundingBox{25.080, 60.870, 31.171, 69.106}},
+	"PK-GB":  {35.724, 75.182, BoundingBox{34.500, 73.250, 37.130, 77.840}},
+	"PK-IS":  {33.708, 73.027, BoundingBox{33.550, 72.656, 34.025, 73.259}},
+	"PK-JK":  {33.210, 73.952, BoundingBox{31.602, 73.020, 35.204, 75.260}},
+	"PK-KP":  {32.820, 71.301, BoundingBox{28.923, 68.566, 36.754, 73.550}},
+	"PK-PB":  {30.571, 72.301, BoundingBox{27.910, 69.789, 34.145, 74.420}},
+	"PK-SD":  {26.256, 68.502, BoundingBox{23.690, 64.777, 29.130, 71.454}},
+	"PK-TA":  {32.107, 69.443, BoundingBox{29.768, 66.811, 35.370, 72.085}},
+	"PL-DS":  {51.158, 16.349, BoundingBox{50.245, 14.904, 51.849, 17.679}},
+	"PL-KP":  {53.092, 18.584, BoundingBox{52.261, 16.910, 53.806, 19.930}},
+	"PL-LB":  {52.202, 15.349, BoundingBox{51.291, 14.168, 52.885, 16.375}},
+	"PL-LD":  {51.674, 19.288, BoundingBox{50.898, 17.896, 52.510, 20.715}},
+	"PL-LU":  {51.269, 22.843, BoundingBox{50.341, 21.565, 52.250, 24.030}},
+	"PL-MA":  {49.808, 20.281, BoundingBox{49.220, 19.002, 50.452, 21.231}},
+	"PL-MZ":  {52.400, 21.070, BoundingBox{51.568, 19.530, 53.160, 22.698}},
+	"PL-OP":  {50.790, 17.879, BoundingBox{50.016, 16.653, 51.578, 18.879}},
+	"PL-PD":  {53.283, 22.766, BoundingBox{52.250, 21.564, 54.330, 23.800}},
+	"PL-PK":  {49.953, 22.179, BoundingBox{49.030, 21.181, 50.658, 23.570}},
+	"PL-PM":  {54.171, 17.848, BoundingBox{53.479, 16.254, 54.850, 19.534}},
+	"PL-SK":  {50.891, 20.896, BoundingBox{50.161, 19.646, 51.609, 22.021}},
+	"PL-SL":  {50.297, 19.062, BoundingBox{49.440, 18.066, 50.988, 19.972}},
+	"PL-WN":  {53.786, 20.717, BoundingBox{53.074, 19.328, 54.431, 22.368}},
+	"PL-WP":  {52.361, 17.212, BoundingBox{51.545, 16.104, 53.330, 18.550}},
+	"PL-ZP":  {53.539, 15.536, BoundingBox{52.828, 14.070, 54.479, 16.963}},
+	"PS-BTH": {31.614, 35.288, BoundingBox{31.453, 35.058, 31.735, 35.484}},
+	"PS-DEB": {31.408, 34.383, BoundingBox{31.352, 34.318, 31.473, 34.445}},
+	"PS-GZA": {31.484, 34.448, BoundingBox{31.419, 34.386, 31.548, 34.514}},
+	"PS-HBN": {31.499, 35.069, BoundingBox{31.350, 34.930, 31.664, 35.277}},
+	"PS-JEM": {31.820, 35.256, BoundingBox{31.735, 35.045, 31.915, 35.371}},
+	"PS-JEN": {32.412, 35.257, BoundingBox{32.301, 35.139, 32.530, 35.403}},
+	"PS-JRH": {31.875, 35.455, BoundingBox{31.653, 35.333, 32.079, 35.550}},
+	"PS-KYS": {31.333, 34.324, BoundingBox{31.281, 34.267, 31.383, 34.386}},
+	"PS-NBS": {32.168, 35.343, BoundingBox{32.031, 35.157, 32.317, 35.550}},
+	"PS-NGZ": {31.552, 34.501, BoundingBox{31.497, 34.443, 31.610, 34.560}},
+	"PS-QQA": {32.178, 35.098, BoundingBox{32.087, 35.039, 32.238, 35.159}},
+	"PS-RBH": {31.946, 35.179, BoundingBox{31.835, 34.970, 32.055, 35.398}},
+	"PS-RFH": {31.265, 34.267, BoundingBox{31.220, 34.210, 31.315, 34.323}},
+	"PS-SLT": {32.075, 35.146, BoundingBox{31.967, 35.001, 32.199, 35.319}},
+	"PS-TBS": {32.299, 35.458, BoundingBox{32.101, 35.313, 32.446, 35.550}},
+	"PS-TKM": {32.296, 35.151, BoundingBox{32.217, 35.087, 32.402, 35.221}},
+	"PT-01":  {40.670, -8.511, BoundingBox{40.317, -8.925, 40.992, -8.099}},
+	"PT-02":  {37.888, -7.790, BoundingBox{37.506, -8.688, 38.250, -7.030}},
+	"PT-03":  {41.557, -8.409, BoundingBox{41.345, -8.994, 41.832, -7.993}},
+	"PT-04":  {41.521, -6.896, BoundingBox{41.062, -7.307, 41.920, -6.390}},
+	"PT-05":  {39.930, -7.499, BoundingBox{39.539, -8.022, 40.322, -7.010}},
+	"PT-06":  {40.159, -8.279, BoundingBox{39.727, -8.962, 40.526, -7.708}},
+	"PT-07":  {38.594, -7.834, BoundingBox{38.250, -8.486, 38.995, -7.221}},
+	"PT-08":  {37.239, -8.187, BoundingBox{36.840, -8.900, 37.617, -7.450}},
+	"PT-09":  {40.637, -7.188, BoundingBox{40.198, -7.700, 41.145, -6.851}},
+	"PT-10":  {39.712, -8.806, BoundingBox{39.329, -9.370, 40.210, -8.219}},
+	"PT-11":  {38.955, -9.110, BoundingBox{38.431, -9.530, 39.464, -8.514}},
+	"PT-12":  {39.178, -7.592, BoundingBox{38.733, -8.051, 39.627, -7.100}},
+	"PT-13":  {41.178, -8.422, BoundingBox{40.917, -8.926, 41.425, -7.925}},
+	"PT-14":  {39.286, -8.345, BoundingBox{38.798, -8.871, 39.741, -7.925}},
+	"PT-15":  {38.289, -8.635, BoundingBox{37.591, -9.335, 38.802, -8.114}},
+	"PT-16":  {41.912, -8.548, BoundingBox{41.571, -9.030, 42.280, -8.031}},
+	"PT-17":  {41.462, -7.613, BoundingBox{41.108, -8.048, 41.876, -7.179}},
+	"PT-18":  {40.805, -7.778, BoundingBox{40.320, -8.189, 41.166, -7.285}},
+	"PT-20":  {37.780, -25.500, BoundingBox{36.887, -31.329, 39.550, -24.996}},
+	"PT-30":  {32.768, -16.933, BoundingBox{32.484, -17.286, 33.126, -16.261}},
+	"PW-002": {7.486, 134.392, BoundingBox{7.391, 134.287, 7.617, 134.550}},
+	"PW-004": {7.309, 134.633, BoundingBox{7.209, 134.511, 7.436, 134.779}},
+	"PW-010": {6.945, 134.186, BoundingBox{6.900, 134.150, 7.009, 134.241}},
+	"PW-050": {3.000, 131.120, BoundingBox{2.955, 131.075, 3.045, 131.165}},
+	"PW-100": {8.070, 134.720, BoundingBox{8.025, 134.675, 8.115, 134.765}},
+	"PW-150": {7.319, 134.443, BoundingBox{7.209, 134.287, 7.431, 134.593}},
+	"PW-212": {7.482, 134.749, BoundingBox{7.409, 134.578, 7.525, 134.873}},
+	"PW-214": {7.614, 134.734, BoundingBox{7.580, 134.590, 7.650, 134.873}},
+	"PW-218": {7.722, 134.644, BoundingBox{7.650, 134.443, 7.791, 134.843}},
+	"PW-222": {7.668, 134.477, BoundingBox{7.577, 134.320, 7.776, 134.614}},
+	"PW-224": {7.490, 134.526, BoundingBox{7.430, 134.434, 7.545, 134.580}},
+	"PW-226": {7.392, 134.727, BoundingBox{7.286, 134.565, 7.481, 134.873}},
+	"PW-227": {7.579, 134.454, BoundingBox{7.504, 134.287, 7.653, 134.594}},
+	"PW-228": {7.553, 134.732, BoundingBox{7.525, 134.587, 7.580, 134.873}},
+	"PW-350": {7.006, 134.257, BoundingBox{6.900, 134.150, 7.100, 134.350}},
+	"PW-370": {5.330, 132.220, BoundingBox{5.285, 132.175, 5.375, 132.265}},
+	"PY-1":   {-22.745, -57.497, BoundingBox{-23.587, -58.602, -21.587, -56.500}},
+	"PY-10":  {-25.433, -54.885, BoundingBox{-26.540, -55.452, -24.496, -54.290}},
+	"PY-11":  {-25.216, -57.380, BoundingBox{-25.900, -57.825, -24.551, -57.029}},
+	"PY-12":  {-26.821, -57.914, BoundingBox{-27.412, -58.620, -26.101, -57.405}},
+	"PY-13":  {-22.910, -56.072, BoundingBox{-23.620, -56.500, -22.090, -55.533}},
+	"PY-14":  {-24.232, -55.333, BoundingBox{-24.852, -56.150, -23.435, -54.290}},
+	"PY-15":  {-23.586, -59.051, BoundingBox{-25.224, -61.212, -21.987, -57.340}},
+	"PY-16":  {-20.555, -59.355, BoundingBox{-22.091, -61.488, -19.340, -57.870}},
+	"PY-19":  {-21.656, -61.204, BoundingBox{-23.560, -62.690, -19.580, -59.343}},
+	"PY-2":   {-24.103, -56.797, BoundingBox{-24.827, -57.627, -23.452, -56.150}},
+	"PY-3":   {-25.207, -56.828, BoundingBox{-25.723, -57.259, -24.730, -56.400}},
+	"PY-4":   {-25.808, -56.376, BoundingBox{-26.394, -56.797, -25.255, -55.808}},
+	"PY-5":   {-25.173, -55.815, BoundingBox{-25.787, -56.400, -24.635, -55.205}},
+	"PY-6":   {-26.259, -55.743, BoundingBox{-26.655, -56.568, -25.754, -54.863}},
+	"PY-7":   {-26.957, -55.769, BoundingBox{-27.544, -56.459, -26.496, -54.775}},
+	"PY-8":   {-26.968, -56.923, BoundingBox{-27.550, -57.524, -26.362, -56.371}},
+	"PY-9":   {-26.078, -57.241, BoundingBox{-26.507, -57.956, -25.630, -56.628}},
+	"PY-ASU": {-25.292, -57.623, BoundingBox{-25.446, -57.758, -25.182, -57.461}},
+	"QA-DA":  {25.280, 51.525, BoundingBox{25.156, 51.427, 25.397, 51.610}},
+	"QA-KH":  {25.710, 51.246, BoundingBox{25.575, 50.788, 25.925, 51.595}},
+	"QA-MS":  {25.924, 51.158, BoundingBox{25.711, 50.858, 26.110, 51.469}},
+	"QA-RA":  {25.100, 51.055, BoundingBox{24.617, 50.740, 25.498, 51.463}},
+	"QA-US":  {25.486, 51.141, BoundingBox{25.367, 50.749, 25.575, 51.502}},
+	"QA-WA":  {24.846, 51.332, BoundingBox{24.560, 51.019, 25.205, 51.604}},
+	"QA-ZA":  {25.520, 51.521, BoundingBox{25.389, 51.389, 25.659, 51.604}},
+	"RO-AB":  {46.168, 23.378, BoundingBox{45.576, 22.591, 46.553, 24.059}},
+	"RO-AG":  {45.026, 24.783, BoundingBox{44.550, 24.298, 45.486, 25.236}},
+	"RO-AR":  {46.278, 21.755, BoundingBox{45.765, 20.721, 46.751, 22.599}},
+	"RO-B":   {44.427, 26.100, BoundingBox{44.340, 25.975, 44.515, 26.225}},
+	"RO-BC":  {46.488, 26.720, BoundingBox{46.088, 26.150, 46.945, 27.265}},
+	"RO-BH":  {46.960, 22.166, BoundingBox{46.445, 21.412, 47.598, 22.758}},
+	"RO-BN":  {47.255, 24.658, BoundingBox{46.893, 23.944, 47.791, 25.333}},
+	"RO-BR":  {45.123, 27.687, BoundingBox{44.714, 27.097, 45.490, 28.265}},
+	"RO-BT":  {47.812, 26.620, BoundingBox{47.350, 25.974, 48.220, 27.345}},
+	"RO-BV":  {45.769, 25.233, BoundingBox{45.344, 24.765, 46.228, 25.753}},
+	"RO-BZ":  {45.234, 26.713, BoundingBox{44.800, 26.206, 45.629, 27.203}},
+	"RO-CJ":  {46.762, 23.628, BoundingBox{46.377, 22.754, 47.240, 24.223}},
+	"RO-CL":  {44.271, 27.144, BoundingBox{43.971, 26.621, 44.578, 27.706}},
+	"RO-CS":  {45.168, 22.036, BoundingBox{44.592, 21.335, 45.754, 22.716}},
+	"RO-CT":  {44.211, 28.219, BoundingBox{43.710, 27.652, 44.732, 28.753}},
+	"RO-CV":  {45.890, 26.066, BoundingBox{45.500, 25.569, 46.313, 26.549}},
+	"RO-DB":  {44.894, 25.372, BoundingBox{44.503, 24.962, 45.356, 25.819}},
+	"RO-DJ":  {44.143, 23.596, BoundingBox{43.734, 22.835, 44.657, 24.268}},
+	"RO-GJ":  {45.037, 23.284, BoundingBox{44.562, 22.634, 45.505, 23.861}},
+	"RO-GL":  {45.739, 27.806, BoundingBox{45.326, 27.333, 46.169, 28.399}},
+	"RO-GR":  {43.993, 25.798, BoundingBox{43.690, 25.493, 44.237, 26.326}},
+	"RO-HD":  {45.776, 22.793, BoundingBox{45.307, 22.098, 46.400, 23.614}},
+	"RO-HR":  {46.530, 25.584, BoundingBox{46.125, 25.076, 47.025, 26.150}},
+	"RO-IF":  {44.474, 25.640, BoundingBox{43.992, 25.275, 44.891, 26.802}},
+	"RO-IL":  {44.649, 27.373, BoundingBox{44.287, 26.720, 44.968, 28.060}},
+	"RO-IS":  {47.192, 27.268, BoundingBox{46.803, 26.714, 47.679, 27.950}},
+	"RO-MH":  {44.564, 22.769, BoundingBox{43.974, 21.922, 45.051, 23.444}},
+	"RO-MM":  {47.665, 23.985, BoundingBox{47.192, 23.434, 48.039, 24.770}},
+	"RO-MS":  {46.574, 24.664, BoundingBox{46.139, 24.029, 46.969, 25.246}},
+	"RO-NT":  {47.049, 26.218, BoundingBox{46.605, 25.426, 47.474, 26.853}},
+	"RO-OT":  {44.291, 24.410, BoundingBox{43.725, 23.859, 44.733, 24.975}},
+	"RO-PH":  {45.174, 25.978, BoundingBox{44.828, 25.455, 45.500, 26.476}},
+	"RO-SB":  {45.854, 24.297, BoundingBox{45.443, 23.623, 46.339, 24.833}},
+	"RO-SJ":  {47.123, 23.057, BoundingBox{46.566, 22.518, 47.501, 23.734}},
+	"RO-SM":  {47.691, 22.883, BoundingBox{47.340, 22.050, 48.100, 23.484}},
+	"RO-SV":  {47.557, 25.582, BoundingBox{47.025, 24.885, 48.012, 26.277}},
+	"RO-TL":  {45.053, 28.817, BoundingBox{44.539, 28.111, 45.460, 29.630}},
+	"RO-TM":  {45.770, 21.167, BoundingBox{45.237, 20.220, 46.249, 22.099}},
+	"RO-TR":  {44.060, 25.121, BoundingBox{43.693, 24.554, 44.539, 25.572}},
+	"RO-VL":  {45.165, 24.055, BoundingBox{44.659, 23.533, 45.580, 24.613}},
+	"RO-VN":  {45.846, 26.997, BoundingBox{45.432, 26.454, 46.236, 27.500}},
+	"RO-VS":  {46.542, 27.689, BoundingBox{46.137, 27.148, 46.997, 28.160}},
+	"RS-00":  {44.700, 20.387, BoundingBox{44.259, 19.934, 45.109, 20.805}},
+	"RS-01":  {45.932, 19.633, BoundingBox{45.642, 19.212, 46.170, 19.980}},
+	"RS-02":  {45.413, 20.492, BoundingBox{45.098, 20.066, 45.781, 21.001}},
+	"RS-03":  {45.838, 20.188, BoundingBox{45.540, 19.824, 46.146, 20.691}},
+	"RS-04":  {45.033, 21.072, BoundingBox{44.756, 20.528, 45.368, 21.542}},
+	"RS-05":  {45.733, 19.190, BoundingBox{45.398, 18.830, 46.039, 19.555}},
+	"RS-06":  {45.392, 19.714, BoundingBox{45.175, 19.210, 45.665, 20.155}},
+	"RS-07":  {44.964, 19.680, BoundingBox{44.658, 19.038, 45.175, 20.227}},
+	"RS-08":  {44.502, 19.502, BoundingBox{44.120, 19.010, 44.888, 19.941}},
+	"RS-09":  {44.217, 19.961, BoundingBox{43.917, 19.499, 44.619, 20.408}},
+	"RS-10":  {44.546, 20.981, BoundingBox{44.259, 20.700, 44.785, 21.315}},
+	"RS-11":  {44.470, 21.557, BoundingBox{44.148, 21.140, 44.861, 22.075}},
+	"RS-12":  {44.088, 20.737, BoundingBox{43.737, 20.328, 44.359, 21.146}},
+	"RS-13":  {43.941, 21.358, BoundingBox{43.679, 20.888, 44.252, 21.769}},
+	"RS-14":  {44.233, 22.178, BoundingBox{43.836, 21.655, 44.700, 22.710}},
+	"RS-15":  {43.698, 22.206, BoundingBox{43.413, 21.718, 43.978, 22.668}},
+	"RS-16":  {43.536, 19.742, BoundingBox{43.118, 19.220, 43.988, 20.127}},
+	"RS-17":  {43.746, 20.317, BoundingBox{43.414, 19.882, 44.055, 20.810}},
+	"RS-18":  {43.305, 20.457, BoundingBox{43.027, 19.934, 43.628, 20.989}},
+	"RS-19":  {43.538, 21.184, BoundingBox{43.262, 20.752, 43.766, 21.656}},
+	"RS-20":  {43.374, 21.919, BoundingBox{43.090, 21.532, 43.711, 22.380}},
+	"RS-21":  {43.146, 21.358, BoundingBox{42.870, 20.984, 43.382, 21.701}},
+	"RS-22":  {43.132, 22.530, BoundingBox{42.698, 22.156, 43.492, 22.990}},
+	"RS-23":  {42.904, 21.857, BoundingBox{42.668, 21.407, 43.142, 22.296}},
+	"RS-24":  {42.524, 22.117, BoundingBox{42.276, 21.713, 42.802, 22.550}},
+	"RS-25":  {42.600, 21.150, BoundingBox{42.555, 21.089, 42.645, 21.211}},
+	"RS-26":  {42.917, 20.325, BoundingBox{42.655, 20.006, 43.085, 20.512}},
+	"RS-27":  {42.250, 20.700, BoundingBox{42.205, 20.639, 42.295, 20.761}},
+	"RS-28":  {43.060, 20.506, BoundingBox{42.910, 20.341, 43.247, 21.064}},
+	"RS-29":  {42.373, 21.623, BoundingBox{42.250, 21.389, 42.545, 21.760}},
+	"RS-KM":  {42.963, 20.380, BoundingBox{42.205, 20.006, 43.247, 21.760}},
+	"RS-VO":  {45.413, 20.077, BoundingBox{44.658, 18.830, 46.170, 21.542}},
+	"RU-AD":  {44.654, 39.975, BoundingBox{44.298, 38.942, 44.998, 41.126}},
+	"RU-AL":  {50.805, 87.497, BoundingBox{49.210, 83.695, 52.375, 90.978}},
+	"RU-ALT": {52.509, 82.438, BoundingBox{50.810, 78.104, 54.327, 86.885}},
+	"RU-AMU": {54.150, 128.060, BoundingBox{50.408, 121.615, 58.148, 134.020}},
+	"RU-ARK": {67.738, 44.770, BoundingBox{61.162, 37.500, 80.920, 68.850}},
+	"RU-AST": {46.951, 47.222, BoundingBox{45.441, 45.068, 48.300, 49.100}},
+	"RU-BA":  {54.749, 56.438, BoundingBox{52.935, 53.114, 56.750, 58.750}},
+	"RU-BEL": {50.718, 37.414, BoundingBox{49.849, 35.326, 51.618, 38.954}},
+	"RU-BRY": {53.007, 33.305, BoundingBox{52.002, 31.310, 54.017, 35.047}},
+	"RU-BU":  {52.581, 109.657, BoundingBox{49.147, 106.250, 55.714, 113.977}},
+	"RU-CE":  {43.335, 45.839, BoundingBox{42.621, 44.915, 43.964, 46.964}},
+	"RU-CHE": {54.896, 60.693, BoundingBox{52.923, 58.750, 56.669, 63.977}},
+	"RU-CHU": {66.773, 169.839, BoundingBox{61.390, 152.706, 71.560, -169.900}},
+	"RU-CU":  {55.431, 47.093, BoundingBox{54.643, 44.834, 56.282, 49.100}},
+	"RU-DA":  {43.247, 47.102, BoundingBox{41.150, 44.589, 45.329, 48.580}},
+	"RU-IN":  {43.277, 44.923, BoundingBox{42.465, 44.184, 44.042, 45.639}},
+	"RU-IRK": {56.642, 104.610, BoundingBox{50.090, 97.798, 62.524, 112.314}},
+	"RU-IVA": {57.103, 41.550, BoundingBox{56.237, 39.498, 58.041, 43.284}},
+	"RU-KAM": {59.740, 162.841, BoundingBox{53.108, 154.152, 64.788, 173.112}},
+	"RU-KB":  {43.695, 43.523, BoundingBox{42.955, 42.394, 44.269, 44.747}},
+	"RU-KC":  {43.800, 41.925, BoundingBox{43.218, 40.829, 44.464, 42.947}},
+	"RU-KDA": {45.232, 39.783, BoundingBox{43.382, 36.680, 46.745, 42.123}},
+	"RU-KEM": {55.108, 86.599, BoundingBox{52.725, 83.218, 57.292, 90.124}},
+	"RU-KGD": {54.693, 21.293, BoundingBox{54.310, 19.660, 55.190, 22.760}},
+	"RU-KGN": {55.791, 65.289, BoundingBox{54.241, 61.731, 57.381, 69.615}},
+	"RU-KHA": {56.510, 135.896, BoundingBox{48.490, 126.914, 61.825, 147.257}},
+	"RU-KHM": {62.001, 70.304, BoundingBox{59.085, 62.115, 65.393, 78.622}},
+	"RU-KIR": {59.592, 49.678, BoundingBox{57.236, 46.393, 61.743, 53.243}},
+	"RU-KK":  {53.165, 90.091, BoundingBox{51.514, 86.669, 55.131, 93.641}},
+	"RU-KL":  {46.454, 44.864, BoundingBox{45.132, 42.163, 47.910, 47.486}},
+	"RU-KLU": {54.333, 35.157, BoundingBox{53.519, 33.545, 55.254, 36.877}},
+	"RU-KO":  {64.656, 57.859, BoundingBox{60.900, 48.000, 68.947, 68.000}},
+	"RU-KOS": {59.027, 44.126, BoundingBox{57.370, 41.101, 61.089, 46.623}},
+	"RU-KR":  {63.588, 33.688, BoundingBox{61.228, 29.540, 65.925, 37.500}},
+	"RU-KRS": {51.689, 36.069, BoundingBox{50.836, 34.129, 52.250, 38.413}},
+	"RU-KYA": {67.614, 95.628, BoundingBox{53.226, 82.184, 81.250, 114.130}},
+	"RU-LEN": {60.229, 31.829, BoundingBox{58.447, 27.429, 62.066, 36.003}},
+	"RU-LIP": {52.562, 39.204, BoundingBox{51.645, 37.679, 53.597, 40.619}},
+	"RU-MAG": {62.952, 151.980, BoundingBox{58.780, 144.922, 67.161, 159.822}},
+	"RU-ME":  {56.759, 48.259, BoundingBox{55.821, 45.936, 57.803, 50.453}},
+	"RU-MO":  {54.488, 44.297, BoundingBox{53.663, 42.527, 55.431, 46.343}},
+	"RU-MOS": {55.758, 37.288, BoundingBox{54.500, 34.193, 57.341, 39.775}},
+	"RU-MOW": {55.578, 37.396, BoundingBox{55.161, 36.729, 55.997, 37.910}},
+	"RU-MUR": {67.661, 34.284, BoundingBox{65.681, 28.450, 69.910, 44.522}},
+	"RU-NEN": {67.763, 54.470, BoundingBox{65.583, 44.522, 71.606, 65.124}},
+	"RU-NGR": {58.414, 33.218, BoundingBox{56.954, 30.005, 59.665, 36.297}},
+	"RU-NIZ": {57.114, 45.067, BoundingBox{55.328, 42.308, 59.358, 47.963}},
+	"RU-NVS": {55.313, 79.927, BoundingBox{53.055, 76.069, 57.399, 84.253}},
+	"RU-OMS": {56.277, 73.984, BoundingBox{53.490, 69.536, 59.260, 77.561}},
+	"RU-ORE": {52.045, 56.710, BoundingBox{50.550, 51.496, 53.543, 61.590}},
+	"RU-ORL": {52.835, 36.215, BoundingBox{52.250, 34.643, 53.607, 37.898}},
+	"RU-PER": {59.518, 56.454, BoundingBox{56.750, 52.537, 61.833, 59.771}},
+	"RU-PNZ": {52.947, 44.694, BoundingBox{51.796, 42.626, 53.728, 47.101}},
+	"RU-PRI": {45.075, 134.559, BoundingBox{42.220, 130.630, 47.450, 138.950}},
+	"RU-PSK": {57.207, 29.499, BoundingBox{55.670, 27.290, 58.727, 31.955}},
+	"RU-ROS": {47.681, 41.040, BoundingBox{46.376, 38.059, 49.330, 43.963}},
+	"RU-RYA": {54.389, 40.886, BoundingBox{53.461, 38.864, 55.230, 42.700}},
+	"RU-SA":  {66.646, 126.021, BoundingBox{58.000, 101.905, 76.140, 154.125}},
+	"RU-SAK": {50.604, 142.951, BoundingBox{45.970, 138.127, 54.068, 159.449}},
+	"RU-SAM": {53.078, 50.795, BoundingBox{51.455, 47.825, 54.450, 53.643}},
+	"RU-SAR": {51.430, 46.807, BoundingBox{49.870, 43.216, 52.806, 50.320}},
+	"RU-SE":  {42.921, 44.204, BoundingBox{42.451, 43.145, 43.390, 45.511}},
+	"RU-SMO": {55.151, 32.408, BoundingBox{53.795, 29.826, 56.413, 34.434}},
+	"RU-SPE": {59.950, 29.732, BoundingBox{59.793, 28.660, 60.138, 30.580}},
+	"RU-STA": {45.138, 43.452, BoundingBox{44.244, 41.918, 46.413, 45.751}},
+	"RU-SVE": {59.325, 62.014, BoundingBox{56.587, 58.457, 62.661, 66.228}},
+	"RU-TA":  {55.369, 51.494, BoundingBox{54.188, 49.100, 56.706, 54.338}},
+	"RU-TAM": {52.533, 41.929, BoundingBox{51.323, 40.284, 53.706, 43.664}},
+	"RU-TOM": {59.775, 82.482, BoundingBox{56.428, 75.203, 63.295, 89.410}},
+	"RU-TUL": {53.846, 37.772, BoundingBox{53.063, 35.915, 54.500, 39.472}},
+	"RU-TVE": {57.072, 34.627, BoundingBox{55.812, 31.830, 58.652, 37.095}},
+	"RU-TY":  {51.683, 95.065, BoundingBox{49.730, 90.362, 53.502, 98.919}},
+	"RU-TYU": {57.964, 68.860, BoundingBox{55.617, 64.468, 59.763, 74.018}},
+	"RU-UD":  {57.126, 53.618, BoundingBox{55.751, 50.173, 58.771, 57.480}},
+	"RU-ULY": {53.945, 47.806, BoundingBox{52.715, 45.985, 54.899, 50.006}},
+	"RU-VGG": {49.399, 44.369, BoundingBox{47.846, 41.084, 51.024, 47.327}},
+	"RU-VLA": {55.892, 40.850, BoundingBox{55.187, 39.145, 56.824, 42.884}},
+	"RU-VLG": {60.422, 40.214, BoundingBox{58.633, 35.854, 62.003, 46.436}},
+	"RU-VOR": {50.708, 40.663, BoundingBox{49.293, 38.389, 51.825, 43.355}},
+	"RU-YAN": {67.989, 76.644, BoundingBox{62.434, 65.090, 73.842, 85.914}},
+	"RU-YAR": {58.096, 38.633, BoundingBox{56.781, 36.091, 59.302, 41.405}},
+	"RU-YEV": {48.952, 133.120, BoundingBox{46.815, 127.415, 51.019, 138.779}},
+	"RU-ZAB": {54.367, 117.555, BoundingBox{49.130, 110.527, 58.000, 126.501}},
+	"RW-01":  {-2.055, 30.127, BoundingBox{-2.410, 29.708, -1.652, 30.497}},
+	"RW-02":  {-1.793, 30.528, BoundingBox{-2.399, 30.216, -1.130, 30.820}},
+	"RW-03":  {-1.563, 29.869, BoundingBox{-1.941, 29.357, -1.149, 30.384}},
+	"RW-04":  {-2.174, 29.360, BoundingBox{-2.850, 29.020, -1.555, 29.724}},
+	"RW-05":  {-2.515, 29.609, BoundingBox{-2.920, 29.099, -2.019, 30.186}},
+	"SA-01":  {22.518, 45.890, BoundingBox{18.998, 42.825, 26.078, 48.685}},
+	"SA-02":  {22.134, 41.011, BoundingBox{20.237, 38.915, 23.734, 43.322}},
+	"SA-03":  {24.931, 39.555, BoundingBox{22.877, 36.943, 27.252, 42.319}},
+	"SA-04":  {23.014, 50.343, BoundingBox{18.488, 45.870, 29.130, 55.670}},
+	"SA-05":  {26.350, 44.073, BoundingBox{23.471, 41.501, 29.155, 46.338}},
+	"SA-06":  {27.615, 41.532, BoundingBox{25.547, 39.093, 29.048, 44.319}},
+	"SA-07":  {27.897, 36.941, BoundingBox{25.576, 34.630, 29.955, 39.132}},
+	"SA-08":  {30.004, 42.209, BoundingBox{28.866, 40.353, 31.901, 45.293}},
+	"SA-09":  {17.507, 43.082, BoundingBox{16.350, 41.573, 18.110, 44.810}},
+	"SA-10":  {18.547, 46.714, BoundingBox{16.950, 44.597, 20.336, 48.844}},
+	"SA-11":  {20.317, 41.898, BoundingBox{18.416, 40.074, 21.990, 43.398}},
+	"SA-12":  {30.087, 39.192, BoundingBox{27.589, 37.000, 32.160, 41.039}},
+	"SA-14":  {19.032, 43.266, BoundingBox{17.859, 41.380, 20.931, 44.793}},
+	"SB-CE":  {-8.464, 159.854, BoundingBox{-9.145, 159.795, -8.389, 160.146}},
+	"SB-CH":  {-7.040, 156.970, BoundingBox{-7.400, 156.490, -6.600, 157.540}},
+	"SB-CT":  {-9.456, 159.953, BoundingBox{-9.755, 159.765, -9.256, 160.185}},
+	"SB-GU":  {-9.647, 160.234, BoundingBox{-9.900, 159.640, -9.240, 160.850}},
+	"SB-IS":  {-7.894, 159.095, BoundingBox{-8.472, 158.210, -7.320, 159.890}},
+	"SB-MK":  {-10.586, 161.853, BoundingBox{-10.830, 161.320, -9.707, 162.400}},
+	"SB-ML":  {-8.976, 161.080, BoundingBox{-9.749, 160.580, -8.320, 161.680}},
+	"SB-RB":  {-11.650, 160.200, BoundingBox{-11.695, 160.154, -11.605, 160.246}},
+	"SB-TE":  {-10.700, 166.000, BoundingBox{-10.745, 165.954, -10.655, 166.046}},
+	"SB-WE":  {-8.200, 157.200, BoundingBox{-8.245, 157.155, -8.154, 157.245}},
+	"SC-01":  {-4.705, 55.573, BoundingBox{-4.738, 55.495, -4.683, 55.630}},
+	"SC-02":  {-4.709, 55.489, BoundingBox{-4.731, 55.467, -4.683, 55.515}},
+	"SC-03":  {-4.569, 55.500, BoundingBox{-4.605, 55.420, -4.531, 55.570}},
+	"SC-04":  {-4.726, 55.412, BoundingBox{-4.776, 55.343, -4.682, 55.476}},
+	"SC-05":  {-4.739, 55.553, BoundingBox{-4.755, 55.490, -4.715, 55.630}},
+	"SC-06":  {-4.778, 55.437, BoundingBox{-4.830, 55.364, -4.725, 55.498}},
+	"SC-07":  {-4.343, 55.768, BoundingBox{-4.413, 55.712, -4.249, 55.813}},
+	"SC-08":  {-4.614, 55.428, BoundingBox{-4.638, 55.420, -4.592, 55.441}},
+	"SC-09":  {-4.620, 55.448, BoundingBox{-4.632, 55.440, -4.609, 55.456}},
+	"SC-10":  {-4.609, 55.380, BoundingBox{-4.635, 55.330, -4.568, 55.420}},
+	"SC-11":  {-4.619, 55.529, BoundingBox{-4.685, 55.485, -4.558, 55.583}},
+	"SC-12":  {-4.554, 55.448, BoundingBox{-4.592, 55.380, -4.530, 55.543}},
+	"SC-13":  {-4.677, 55.456, BoundingBox{-4.694, 55.425, -4.661, 55.487}},
+	"SC-14":  {-4.320, 55.701, BoundingBox{-4.413, 55.647, -4.247, 55.767}},
+	"SC-15":  {-4.356, 55.807, BoundingBox{-4.381, 55.796, -4.322, 55.813}},
+	"SC-16":  {-4.594, 55.498, BoundingBox{-4.615, 55.440, -4.561, 55.565}},
+	"SC-17":  {-4.619, 55.470, BoundingBox{-4.627, 55.453, -4.611, 55.496}},
+	"SC-18":  {-4.636, 55.456, BoundingBox{-4.647, 55.443, -4.627, 55.468}},
+	"SC-19":  {-4.653, 55.455, BoundingBox{-4.663, 55.437, -4.639, 55.471}},
+	"SC-20":  {-4.642, 55.578, BoundingBox{-4.683, 55.499, -4.571, 55.630}},
+	"SC-21":  {-9.420, 46.350, BoundingBox{-9.586, 46.182, -4.635, 55.437}},
+	"SC-22":  {-4.636, 55.437, BoundingBox{-4.655, 55.424, -4.617, 55.449}},
+	"SC-23":  {-4.791, 55.527, BoundingBox{-4.830, 55.448, -4.755, 55.617}},
+	"SC-24":  {-4.657, 55.481, BoundingBox{-4.679, 55.464, -4.634, 55.496}},
+	"SC-25":  {-4.627, 55.487, BoundingBox{-4.650, 55.464, -4.602, 55.518}},
+	"SD-DC":  {13.021, 23.939, BoundingBox{11.131, 22.835, 14.766, 25.570}},
+	"SD-DE":  {11.839, 26.292, BoundingBox{9.470, 25.019, 13.919, 27.100}},
+	"SD-DN":  {16.738, 25.512, BoundingBox{13.492, 22.728, 21.018, 27.913}},
+	"SD-DS":  {10.619, 24.315, BoundingBox{8.620, 22.863, 12.503, 25.873}},
+	"SD-DW":  {13.429, 22.671, BoundingBox{11.416, 21.940, 15.200, 23.195}},
+	"SD-GD":  {13.965, 35.362, BoundingBox{12.358, 34.253, 15.303, 36.430}},
+	"SD-GZ":  {14.554, 33.534, BoundingBox{13.535, 32.148, 15.630, 34.537}},
+	"SD-KA":  {16.352, 35.784, BoundingBox{14.640, 34.303, 17.650, 38.097}},
+	"SD-KH":  {16.005, 32.321, BoundingBox{14.603, 30.472, 17.371, 34.355}},
+	"SD-KN":  {15.008, 29.285, BoundingBox{12.813, 27.169, 17.291, 31.391}},
+	"SD-KS":  {11.393, 29.210, BoundingBox{9.400, 27.100, 13.966, 32.095}},
+	"SD-NB":  {11.358, 33.944, BoundingBox{9.460, 32.034, 12.345, 35.578}},
+	"SD-NO":  {19.943, 29.265, BoundingBox{16.958, 25.000, 22.000, 33.250}},
+	"SD-NR":  {18.323, 33.408, BoundingBox{16.427, 31.259, 21.076, 35.459}},
+	"SD-NW":  {13.211, 31.970, BoundingBox{11.539, 30.642, 14.704, 33.296}},
+	"SD-RS":  {19.962, 35.674, BoundingBox{17.650, 33.250, 22.000, 38.410}},
+	"SD-SI":  {12.910, 34.090, BoundingBox{12.193, 32.858, 13.980, 35.594}},
+	"SE-AB":  {59.434, 17.844, BoundingBox{58.932, 17.142, 59.834, 18.590}},
+	"SE-AC":  {65.101, 17.894, BoundingBox{63.671, 14.123, 66.679, 21.796}},
+	"SE-BD":  {67.196, 20.177, BoundingBox{65.447, 15.551, 69.110, 23.900}},
+	"SE-C":   {60.214, 17.476, BoundingBox{59.577, 16.175, 60.842, 18.790}},
+	"SE-D":   {59.047, 16.706, BoundingBox{58.510, 15.723, 59.539, 17.788}},
+	"SE-E":   {58.297, 15.470, BoundingBox{57.704, 13.994, 58.855, 16.783}},
+	"SE-F":   {57.591, 14.220, BoundingBox{57.081, 12.879, 58.216, 15.507}},
+	"SE-G":   {56.794, 14.521, BoundingBox{56.336, 13.560, 57.215, 15.723}},
+	"SE-H":   {57.269, 15.944, BoundingBox{56.613, 15.152, 57.836, 16.630}},
+	"SE-I":   {57.750, 18.750, BoundingBox{57.017, 17.920, 57.983, 19.186}},
+	"SE-K":   {56.365, 15.241, BoundingBox{55.937, 14.102, 56.720, 16.191}},
+	"SE-M":   {55.913, 13.525, BoundingBox{55.360, 12.624, 56.480, 14.481}},
+	"SE-N":   {56.990, 12.809, BoundingBox{56.317, 11.746, 57.559, 13.706}},
+	"SE-O":   {58.346, 12.495, BoundingBox{57.522, 11.030, 59.114, 14.055}},
+	"SE-S":   {59.704, 13.000, BoundingBox{58.887, 11.226, 60.654, 14.700}},
+	"SE-T":   {59.231, 14.743, BoundingBox{58.630, 13.472, 60.086, 15.940}},
+	"SE-U":   {59.913, 15.965, BoundingBox{59.279, 14.700, 60.584, 17.170}},
+	"SE-W":   {61.164, 14.023, BoundingBox{60.166, 11.982, 62.113, 16.227}},
+	"SE-X":   {61.623, 16.224, BoundingBox{60.619, 14.506, 62.455, 17.626}},
+	"SE-Y":   {63.224, 17.244, BoundingBox{62.170, 15.580, 64.139, 19.901}},
+	"SE-Z":   {63.353, 13.999, BoundingBox{61.988, 11.930, 65.298, 16.023}},
+	"SG-01":  {1.276, 103.828, BoundingBox{1.200, 103.741, 1.377, 103.928}},
+	"SG-02":  {1.389, 103.884, BoundingBox{1.314, 103.823, 1.441, 103.961}},
+	"SG-03":  {1.401, 103.757, BoundingBox{1.333, 103.649, 1.450, 103.832}},
+	"SG-04":  {1.333, 103.990, BoundingBox{1.233, 103.895, 1.425, 104.100}},
+	"SG-05":  {1.304, 103.681, BoundingBox{1.210, 103.600, 1.419, 103.769}},
+	"SH-AC":  {-7.940, -14.360, BoundingBox{-8.023, -14.444, -7.857, -14.276}},
+	"SH-HL":  {-15.960, -5.710, BoundingBox{-16.076, -5.831, -15.844, -5.589}},
+	"SH-TA":  {-37.110, -12.280, BoundingBox{-37.193, -12.384, -37.027, -12.176}},
+	"SI-001": {45.920, 13.880, BoundingBox{45.824, 13.790, 46.017, 14.018}},
+	"SI-002": {46.606, 16.231, BoundingBox{46.557, 16.184, 46.648, 16.274}},
+	"SI-003": {46.341, 14.096, BoundingBox{46.277, 14.042, 46.396, 14.156}},
+	"SI-004": {46.289, 13.895, BoundingBox{46.184, 13.758, 46.396, 14.048}},
+	"SI-005": {45.899, 14.366, BoundingBox{45.855, 14.295, 45.965, 14.440}},
+	"SI-006": {46.340, 13.550, BoundingBox{46.295, 13.485, 46.385, 13.615}},
+	"SI-007": {46.010, 13.530, BoundingBox{45.965, 13.465, 46.055, 13.595}},
+	"SI-008": {45.975, 14.434, BoundingBox{45.921, 14.364, 46.025, 14.504}},
+	"SI-009": {45.892, 15.604, BoundingBox{45.797, 15.494, 45.984, 15.703}},
+	"SI-010": {46.663, 16.089, BoundingBox{46.612, 16.036, 46.709, 16.125}},
+	"SI-011": {46.240, 15.252, BoundingBox{46.195, 15.207, 46.287, 15.316}},
+	"SI-012": {46.271, 14.513, BoundingBox{46.220, 14.448, 46.324, 14.579}},
+	"SI-013": {45.781, 14.358, BoundingBox{45.683, 14.277, 45.855, 14.440}},
+	"SI-014": {46.130, 13.956, BoundingBox{46.039, 13.825, 46.228, 14.083}},
+	"SI-015": {46.562, 16.284, BoundingBox{46.542, 16.231, 46.588, 16.320}},
+	"SI-016": {46.452, 14.860, BoundingBox{46.401, 14.746, 46.495, 14.975}},
+	"SI-017": {45.532, 15.179, BoundingBox{45.450, 15.014, 45.614, 15.330}},
+	"SI-018": {46.483, 15.869, BoundingBox{46.453, 15.816, 46.516, 15.925}},
+	"SI-019": {45.691, 14.008, BoundingBox{45.608, 13.900, 45.768, 14.085}},
+	"SI-020": {45.850, 14.738, BoundingBox{45.794, 14.645, 45.910, 14.824}},
+	"SI-021": {46.076, 14.290, BoundingBox{46.040, 14.205, 46.118, 14.384}},
+	"SI-022": {46.075, 14.655, BoundingBox{46.021, 14.569, 46.131, 14.720}},
+	"SI-023": {46.141, 14.616, BoundingBox{46.106, 14.573, 46.177, 14.662}},
+	"SI-024": {46.440, 15.947, BoundingBox{46.410, 15.898, 46.469, 16.005}},
+	"SI-025": {46.581, 15.034, BoundingBox{46.545, 14.963, 46.624, 15.086}},
+	"SI-026": {46.517, 15.763, BoundingBox{46.474, 15.725, 46.555, 15.818}},
+	"SI-027": {46.119, 14.156, BoundingBox{46.056, 14.065, 46.172, 14.248}},
+	"SI-028": {46.417, 16.012, BoundingBox{46.379, 15.965, 46.455, 16.066}},
+	"SI-029": {46.662, 15.981, BoundingBox{46.629, 15.930, 46.693, 16.043}},
+	"SI-030": {46.271, 14.796, BoundingBox{46.211, 14.700, 46.328, 14.880}},
+	"SI-031": {46.791, 16.222, BoundingBox{46.745, 16.160, 46.850, 16.300}},
+	"SI-032": {45.953, 14.670, BoundingBox{45.890, 14.585, 46.024, 14.740}},
+	"SI-033": {46.824, 16.282, BoundingBox{46.782, 16.234, 46.848, 16.328}},
+	"SI-034": {46.133, 15.111, BoundingBox{46.061, 15.048, 46.192, 15.173}},
+	"SI-035": {45.560, 13.991, BoundingBox{45.485, 13.872, 45.651, 14.088}},
+	"SI-036": {45.992, 13.997, BoundingBox{45.905, 13.829, 46.066, 14.140}},
+	"SI-037": {45.908, 14.506, BoundingBox{45.855, 14.431, 45.983, 14.592}},
+	"SI-038": {45.548, 14.251, BoundingBox{45.470, 14.065, 45.647, 14.442}},
+	"SI-039": {45.938, 14.811, BoundingBox{45.862, 14.725, 46.010, 14.917}},
+	"SI-040": {45.530, 13.660, BoundingBox{45.485, 13.596, 45.575, 13.735}},
+	"SI-041": {46.448, 14.017, BoundingBox{46.397, 13.906, 46.498, 14.158}},
+	"SI-042": {46.479, 15.984, BoundingBox{46.449, 15.921, 46.516, 16.025}},
+	"SI-043": {46.257, 14.628, BoundingBox{46.187, 14.551, 46.331, 14.723}},
+	"SI-044": {46.077, 13.640, BoundingBox{46.027, 13.575, 46.135, 13.832}},
+	"SI-045": {46.397, 15.773, BoundingBox{46.348, 15.703, 46.437, 15.832}},
+	"SI-046": {46.250, 13.580, BoundingBox{46.205, 13.515, 46.295, 13.645}},
+	"SI-047": {46.696, 16.396, BoundingBox{46.624, 16.306, 46.761, 16.484}},
+	"SI-048": {45.643, 14.881, BoundingBox{45.573, 14.716, 45.739, 15.023}},
+	"SI-049": {45.836, 13.823, BoundingBox{45.778, 13.773, 45.899, 13.867}},
+	"SI-050": {45.517, 13.845, BoundingBox{45.490, 13.734, 45.562, 13.942}},
+	"SI-051": {46.066, 15.536, BoundingBox{45.994, 15.430, 46.131, 15.619}},
+	"SI-052": {46.230, 14.355, BoundingBox{46.189, 14.287, 46.277, 14.390}},
+	"SI-053": {46.442, 13.854, BoundingBox{46.362, 13.777, 46.510, 13.937}},
+	"SI-054": {45.967, 15.481, BoundingBox{45.893, 15.381, 46.043, 15.607}},
+	"SI-055": {46.636, 15.572, BoundingBox{46.586, 15.456, 46.671, 15.672}},
+	"SI-056": {46.840, 16.080, BoundingBox{46.795, 16.014, 46.885, 16.146}},
+	"SI-057": {46.145, 15.245, BoundingBox{46.083, 15.164, 46.203, 15.322}},
+	"SI-058": {46.569, 15.822, BoundingBox{46.528, 15.771, 46.616, 15.855}},
+	"SI-059": {46.539, 16.461, BoundingBox{46.450, 16.386, 46.635, 16.560}},
+	"SI-060": {46.073, 14.802, BoundingBox{46.010, 14.712, 46.148, 14.902}},
+	"SI-061": {46.055, 14.508, BoundingBox{45.984, 14.382, 46.140, 14.661}},
+	"SI-062": {46.362, 14.842, BoundingBox{46.309, 14.786, 46.407, 14.895}},
+	"SI-063": {46.507, 16.195, BoundingBox{46.453, 16.126, 46.561, 16.240}},
+	"SI-064": {45.910, 14.194, BoundingBox{45.846, 14.084, 45.995, 14.295}},
+	"SI-065": {45.626, 14.461, BoundingBox{45.497, 14.346, 45.715, 14.590}},
+	"SI-066": {45.675, 14.619, BoundingBox{45.609, 14.512, 45.741, 14.722}},
+	"SI-067": {46.344, 14.718, BoundingBox{46.279, 14.607, 46.414, 14.806}},
+	"SI-068": {46.188, 14.702, BoundingBox{46.123, 14.630, 46.247, 14.786}},
+	"SI-069": {46.356, 15.730, BoundingBox{46.304, 15.655, 46.408, 15.807}},
+	"SI-070": {46.556, 15.646, BoundingBox{46.520, 15.578, 46.592, 15.731}},
+	"SI-071": {46.139, 14.402, BoundingBox{46.082, 14.334, 46.191, 14.483}},
+	"SI-072": {46.173, 14.576, BoundingBox{46.149, 14.517, 46.201, 14.630}},
+	"SI-073": {45.663, 15.291, BoundingBox{45.554, 15.250, 45.747, 15.366}},
+	"SI-074": {46.511, 14.858, BoundingBox{46.495, 14.774, 46.542, 14.921}},
+	"SI-075": {45.850, 13.630, BoundingBox{45.805, 13.565, 45.895, 13.695}},
+	"SI-076": {46.449, 15.199, BoundingBox{46.391, 15.100, 46.515, 15.312}},
+	"SI-077": {46.154, 14.782, BoundingBox{46.088, 14.687, 46.219, 14.873}},
+	"SI-078": {46.701, 16.249, BoundingBox{46.645, 16.181, 46.745, 16.313}},
+	"SI-079": {46.371, 14.948, BoundingBox{46.321, 14.895, 46.422, 15.003}},
+	"SI-080": {46.651, 16.168, BoundingBox{46.619, 16.125, 46.686, 16.232}},
+	"SI-081": {46.610, 15.123, BoundingBox{46.553, 15.060, 46.661, 15.181}},
+	"SI-082": {46.275, 14.295, BoundingBox{46.221, 14.221, 46.317, 14.375}},
+	"SI-083": {46.305, 14.961, BoundingBox{46.280, 14.935, 46.335, 14.995}},
+	"SI-084": {45.977, 13.650, BoundingBox{45.915, 13.585, 46.032, 13.832}},
+	"SI-085": {45.779, 15.196, BoundingBox{45.724, 15.109, 45.846, 15.291}},
+	"SI-086": {46.595, 16.285, BoundingBox{46.571, 16.247, 46.622, 16.326}},
+	"SI-087": {46.416, 16.158, BoundingBox{46.355, 16.087, 46.468, 16.229}},
+	"SI-088": {45.554, 14.713, BoundingBox{45.485, 14.636, 45.630, 14.798}},
+	"SI-089": {46.612, 15.689, BoundingBox{46.566, 15.615, 46.661, 15.742}},
+	"SI-090": {45.520, 13.570, BoundingBox{45.475, 13.506, 45.565, 13.634}},
+	"SI-091": {45.675, 14.200, BoundingBox{45.602, 14.085, 45.736, 14.354}},
+	"SI-092": {46.153, 15.605, BoundingBox{46.107, 15.498, 46.206, 15.731}},
+	"SI-093": {46.614, 15.362, BoundingBox{46.547, 15.267, 46.667, 15.458}},
+	"SI-094": {45.789, 14.182, BoundingBox{45.726, 14.057, 45.858, 14.293}},
+	"SI-095": {46.315, 14.429, BoundingBox{46.270, 14.350, 46.369, 14.528}},
+	"SI-096": {46.428, 15.874, BoundingBox{46.389, 15.830, 46.458, 15.915}},
+	"SI-097": {46.723, 16.151, BoundingBox{46.681, 16.088, 46.765, 16.221}},
+	"SI-098": {46.440, 15.639, BoundingBox{46.389, 15.529, 46.478, 15.716}},
+	"SI-099": {46.064, 15.179, BoundingBox{45.998, 15.059, 46.123, 15.286}},
+	"SI-100": {46.629, 16.033, BoundingBox{46.598, 15.963, 46.673, 16.101}},
+	"SI-101": {46.632, 15.255, BoundingBox{46.575, 15.178, 46.665, 15.337}},
+	"SI-102": {46.323, 14.174, BoundingBox{46.280, 14.062, 46.388, 14.251}},
+	"SI-103": {46.518, 14.977, BoundingBox{46.452, 14.924, 46.580, 15.036}},
+	"SI-104": {45.734, 14.760, BoundingBox{45.644, 14.667, 45.803, 14.873}},
+	"SI-105": {46.800, 16.030, BoundingBox{46.755, 15.964, 46.845, 16.096}},
+	"SI-106": {46.232, 15.629, BoundingBox{46.181, 15.580, 46.278, 15.670}},
+	"SI-107": {46.213, 15.715, BoundingBox{46.138, 15.670, 46.280, 15.769}},
+	"SI-108": {46.523, 15.530, BoundingBox{46.459, 15.455, 46.602, 15.587}},
+	"SI-109": {45.658, 15.149, BoundingBox{45.600, 15.020, 45.727, 15.250}},
+	"SI-110": {46.016, 15.321, BoundingBox{45.952, 15.204, 46.089, 15.434}},
+	"SI-111": {45.742, 13.898, BoundingBox{45.662, 13.835, 45.795, 13.964}},
+	"SI-112": {46.501, 15.082, BoundingBox{46.441, 14.985, 46.563, 15.176}},
+	"SI-113": {46.395, 15.567, BoundingBox{46.346, 15.510, 46.462, 15.660}},
+	"SI-114": {46.321, 15.433, BoundingBox{46.277, 15.351, 46.367, 15.507}},
+	"SI-115": {46.461, 15.771, BoundingBox{46.426, 15.707, 46.503, 15.833}},
+	"SI-116": {46.566, 16.031, BoundingBox{46.517, 15.985, 46.611, 16.080}},
+	"SI-117": {46.233, 14.421, BoundingBox{46.190, 14.390, 46.270, 14.462}},
+	"SI-118": {46.663, 15.684, BoundingBox{46.647, 15.605, 46.674, 15.748}},
+	"SI-119": {45.809, 15.334, BoundingBox{45.743, 15.264, 45.893, 15.404}},
+	"SI-120": {46.226, 15.409, BoundingBox{46.180, 15.355, 46.281, 15.468}},
+	"SI-121": {45.920, 15.302, BoundingBox{45.865, 15.217, 45.966, 15.396}},
+	"SI-122": {46.166, 14.294, BoundingBox{46.114, 14.214, 46.223, 14.381}},
+	"SI-123": {45.976, 14.585, BoundingBox{45.920, 14.504, 46.022, 14.664}},
+	"SI-124": {46.230, 15.513, BoundingBox{46.161, 15.451, 46.293, 15.580}},
+	"SI-125": {46.332, 15.033, BoundingBox{46.305, 14.991, 46.362, 15.089}},
+	"SI-126": {46.408, 15.033, BoundingBox{46.350, 14.947, 46.454, 15.117}},
+	"SI-127": {46.209, 15.317, BoundingBox{46.162, 15.254, 46.255, 15.355}},
+	"SI-128": {46.165, 13.793, BoundingBox{46.068, 13.722, 46.277, 13.872}},
+	"SI-129": {46.166, 15.049, BoundingBox{46.118, 14.985, 46.199, 15.109}},
+	"SI-130": {45.912, 14.997, BoundingBox{45.843, 14.905, 45.974, 15.088}},
+	"SI-131": {46.387, 14.314, BoundingBox{46.312, 14.229, 46.465, 14.410}},
+	"SI-132": {46.632, 16.309, BoundingBox{46.598, 16.254, 46.674, 16.377}},
+	"SI-133": {46.362, 15.127, BoundingBox{46.307, 15.066, 46.423, 15.184}},
+	"SI-134": {45.840, 14.615, BoundingBox{45.795, 14.533, 45.895, 14.702}},
+	"SI-135": {46.367, 15.893, BoundingBox{46.339, 15.834, 46.397, 15.944}},
+	"SI-136": {45.835, 13.996, BoundingBox{45.765, 13.858, 45.920, 14.110}},
+	"SI-137": {46.398, 15.303, BoundingBox{46.334, 15.222, 46.461, 15.364}},
+	"SI-138": {46.184, 14.478, BoundingBox{46.139, 14.418, 46.220, 14.525}},
+	"SI-139": {46.294, 15.319, BoundingBox{46.255, 15.233, 46.337, 15.397}},
+	"SI-140": {45.963, 14.293, BoundingBox{45.915, 14.205, 45.990, 14.365}},
+	"SI-141": {46.578, 15.192, BoundingBox{46.526, 15.136, 46.640, 15.262}},
+	"SI-142": {46.118, 14.968, BoundingBox{46.046, 14.871, 46.183, 15.059}},
+	"SI-143": {46.383, 16.067, BoundingBox{46.337, 16.008, 46.435, 16.119}},
+	"SI-144": {46.383, 15.376, BoundingBox{46.329, 15.336, 46.455, 15.429}},
+	"SI-146": {46.225, 14.145, BoundingBox{46.160, 14.025, 46.280, 14.261}},
+	"SI-147": {46.040, 14.121, BoundingBox{45.972, 14.017, 46.101, 14.207}},
+	"SI-148": {46.621, 15.905, BoundingBox{46.590, 15.839, 46.654, 15.966}},
+	"SI-149": {46.051, 15.669, BoundingBox{45.964, 15.595, 46.139, 15.745}},
+	"SI-150": {45.784, 14.494, BoundingBox{45.715, 14.420, 45.855, 14.577}},
+	"SI-151": {46.286, 15.024, BoundingBox{46.254, 14.978, 46.315, 15.076}},
+	"SI-152": {46.714, 16.066, BoundingBox{46.686, 16.024, 46.748, 16.095}},
+	"SI-153": {46.575, 15.952, BoundingBox{46.540, 15.908, 46.623, 15.985}},
+	"SI-154": {46.124, 15.405, BoundingBox{46.050, 15.313, 46.180, 15.504}},
+	"SI-155": {46.339, 15.219, BoundingBox{46.285, 15.156, 46.396, 15.295}},
+	"SI-156": {46.651, 16.357, BoundingBox{46.610, 16.288, 46.705, 16.435}},
+	"SI-157": {45.726, 15.035, BoundingBox{45.652, 14.922, 45.789, 15.157}},
+	"SI-158": {46.773, 16.133, BoundingBox{46.744, 16.085, 46.814, 16.160}},
+	"SI-159": {46.410, 15.829, BoundingBox{46.363, 15.793, 46.454, 15.864}},
+	"SI-160": {46.498, 15.608, BoundingBox{46.465, 15.534, 46.535, 15.665}},
+	"SI-161": {46.788, 16.345, BoundingBox{46.740, 16.285, 46.843, 16.414}},
+	"SI-162": {46.015, 14.265, BoundingBox{45.990, 14.192, 46.040, 14.330}},
+	"SI-163": {46.391, 14.502, BoundingBox{46.322, 14.372, 46.455, 14.610}},
+	"SI-164": {46.207, 14.543, BoundingBox{46.171, 14.505, 46.242, 14.588}},
+	"SI-165": {45.528, 14.936, BoundingBox{45.464, 14.790, 45.581, 15.060}},
+	"SI-166": {46.563, 16.118, BoundingBox{46.520, 16.079, 46.615, 16.177}},
+	"SI-167": {46.520, 15.392, BoundingBox{46.455, 15.328, 46.604, 15.455}},
+	"SI-168": {46.392, 15.938, BoundingBox{46.359, 15.892, 46.420, 15.979}},
+	"SI-169": {46.500, 15.698, BoundingBox{46.454, 15.665, 46.549, 15.725}},
+	"SI-170": {45.857, 15.090, BoundingBox{45.817, 15.011, 45.908, 15.155}},
+	"SI-171": {46.416, 15.455, BoundingBox{46.344, 15.368, 46.472, 15.510}},
+	"SI-172": {46.321, 15.876, BoundingBox{46.273, 15.803, 46.364, 15.950}},
+	"SI-173": {46.286, 15.090, BoundingBox{46.251, 15.034, 46.320, 15.144}},
+	"SI-174": {46.228, 15.096, BoundingBox{46.191, 15.042, 46.269, 15.141}},
+	"SI-175": {46.538, 14.920, BoundingBox{46.494, 14.879, 46.578, 14.959}},
+	"SI-176": {46.494, 16.310, BoundingBox{46.450, 16.239, 46.549, 16.409}},
+	"SI-177": {46.517, 15.271, BoundingBox{46.458, 15.174, 46.576, 15.343}},
+	"SI-178": {46.579, 15.476, BoundingBox{46.473, 15.428, 46.662, 15.545}},
+	"SI-179": {45.761, 14.632, BoundingBox{45.712, 14.561, 45.795, 14.705}},
+	"SI-180": {46.412, 14.684, BoundingBox{46.340, 14.590, 46.482, 14.790}},
+	"SI-181": {46.652, 15.827, BoundingBox{46.615, 15.744, 46.677, 15.906}},
+	"SI-182": {46.516, 15.961, BoundingBox{46.484, 15.915, 46.540, 16.026}},
+	"SI-183": {45.930, 13.655, BoundingBox{45.885, 13.585, 45.975, 13.791}},
+	"SI-184": {46.215, 15.015, BoundingBox{46.178, 14.957, 46.259, 15.073}},
+	"SI-185": {46.527, 15.878, BoundingBox{46.500, 15.815, 46.552, 15.929}},
+	"SI-186": {46.121, 14.546, BoundingBox{46.080, 14.488, 46.156, 14.595}},
+	"SI-187": {46.563, 16.364, BoundingBox{46.497, 16.320, 46.610, 16.409}},
+	"SI-188": {46.590, 16.167, BoundingBox{46.549, 16.109, 46.622, 16.215}},
+	"SI-189": {46.227, 14.916, BoundingBox{46.168, 14.828, 46.280, 15.002}},
+	"SI-190": {46.249, 15.170, BoundingBox{46.186, 15.118, 46.310, 15.223}},
+	"SI-191": {46.284, 15.789, BoundingBox{46.235, 15.716, 46.333, 15.872}},
+	"SI-192": {46.425, 14.180, BoundingBox{46.370, 14.092, 46.476, 14.267}},
+	"SI-193": {45.819, 14.908, BoundingBox{45.730, 14.813, 45.908, 15.017}},
+	"SI-194": {46.016, 14.917, BoundingBox{45.940, 14.800, 46.092, 15.019}},
+	"SI-195": {46.667, 15.913, BoundingBox{46.652, 15.872, 46.678, 15.944}},
+	"SI-196": {46.343, 15.989, BoundingBox{46.299, 15.935, 46.383, 16.066}},
+	"SI-197": {45.838, 15.449, BoundingBox{45.754, 15.367, 45.923, 15.553}},
+	"SI-198": {46.318, 15.668, BoundingBox{46.273, 15.616, 46.379, 15.733}},
+	"SI-199": {45.934, 15.163, BoundingBox{45.881, 15.077, 45.994, 15.234}},
+	"SI-200": {46.308, 15.565, BoundingBox{46.259, 15.487, 46.352, 15.633}},
+	"SI-201": {45.894, 13.684, BoundingBox{45.845, 13.605, 45.941, 13.790}},
+	"SI-202": {46.430, 16.281, BoundingBox{46.383, 16.204, 46.456, 16.408}},
+	"SI-203": {45.794, 15.065, BoundingBox{45.753, 14.988, 45.828, 15.135}},
+	"SI-204": {46.573, 15.883, BoundingBox{46.548, 15.855, 46.600, 15.919}},
+	"SI-205": {46.483, 16.084, BoundingBox{46.432, 16.024, 46.535, 16.162}},
+	"SI-206": {45.858, 15.221, BoundingBox{45.803, 15.155, 45.910, 15.285}},
+	"SI-207": {46.364, 14.017, BoundingBox{46.283, 13.904, 46.412, 14.101}},
+	"SI-208": {46.026, 14.377, BoundingBox{45.974, 14.330, 46.079, 14.439}},
+	"SI-209": {46.315, 14.902, BoundingBox{46.270, 14.865, 46.373, 14.935}},
+	"SI-210": {46.602, 15.766, BoundingBox{46.549, 15.722, 46.663, 15.826}},
+	"SI-211": {45.996, 15.083, BoundingBox{45.932, 14.989, 46.060, 15.205}},
+	"SK-BC":  {48.589, 19.592, BoundingBox{48.105, 18.693, 49.101, 20.373}},
+	"SK-BL":  {48.247, 17.224, BoundingBox{47.831, 16.880, 48.746, 17.659}},
+	"SK-KI":  {48.686, 21.375, BoundingBox{48.320, 20.320, 48.900, 22.355}},
+	"SK-NI":  {48.122, 18.329, BoundingBox{47.760, 17.622, 48.501, 19.105}},
+	"SK-PV":  {49.138, 21.280, BoundingBox{48.900, 20.199, 49.470, 22.560}},
+	"SK-TA":  {48.467, 17.607, BoundingBox{47.954, 17.053, 48.820, 18.166}},
+	"SK-TC":  {48.831, 18.295, BoundingBox{48.470, 17.583, 49.323, 18.823}},
+	"SK-ZI":  {49.186, 19.211, BoundingBox{48.773, 18.403, 49.570, 20.212}},
+	"SL-E":   {8.492, -11.034, BoundingBox{7.540, -11.852, 9.529, -10.230}},
+	"SL-N":   {9.323, -11.835, BoundingBox{8.413, -12.866, 10.050, -10.755}},
+	"SL-S":   {7.615, -11.867, BoundingBox{6.790, -12.733, 8.456, -11.033}},
+	"SL-W":   {8.425, -12.786, BoundingBox{7.574, -13.250, 9.213, -12.225}},
+	"SM-01":  {43.949, 12.421, BoundingBox{43.927, 12.400, 43.975, 12.442}},
+	"SM-02":  {43.912, 12.423, BoundingBox{43.896, 12.405, 43.927, 12.440}},
+	"SM-03":  {43.950, 12.471, BoundingBox{43.931, 12.441, 43.969, 12.502}},
+	"SM-04":  {43.930, 12.496, BoundingBox{43.910, 12.473, 43.950, 12.510}},
+	"SM-05":  {43.909, 12.456, BoundingBox{43.891, 12.440, 43.928, 12.470}},
+	"SM-06":  {43.942, 12.453, BoundingBox{43.925, 12.436, 43.964, 12.474}},
+	"SM-07":  {43.930, 12.437, BoundingBox{43.918, 12.418, 43.947, 12.457}},
+	"SM-08":  {43.908, 12.481, BoundingBox{43.890, 12.470, 43.929, 12.500}},
+	"SM-09":  {43.969, 12.477, BoundingBox{43.948, 12.437, 43.990, 12.510}},
+	"SN-DB":  {14.738, -16.088, BoundingBox{14.279, -16.529, 15.269, -15.540}},
+	"SN-DK":  {14.655, -17.241, BoundingBox{14.248, -17.630, 15.025, -17.010}},
+	"SN-FK":  {14.082, -16.556, BoundingBox{13.111, -17.065, 14.587, -16.036}},
+	"SN-KA":  {14.361, -14.958, BoundingBox{13.656, -15.806, 15.128, -14.213}},
+	"SN-KD":  {12.996, -14.593, BoundingBox{12.589, -15.373, 13.766, -13.666}},
+	"SN-KE":  {12.898, -12.356, BoundingBox{12.330, -13.671, 13.766, -11.470}},
+	"SN-KL":  {13.918, -15.894, BoundingBox{13.603, -16.261, 14.356, -15.458}},
+	"SN-LG":  {15.500, -15.760, BoundingBox{14.804, -16.672, 16.326, -14.700}},
+	"SN-MT":  {15.242, -13.698, BoundingBox{14.407, -14.711, 16.290, -12.310}},
+	"SN-SE":  {12.915, -15.627, BoundingBox{12.520, -16.055, 13.365, -15.204}},
+	"SN-SL":  {16.103, -15.018, BoundingBox{15.350, -16.262, 16.600, -14.074}},
+	"SN-TC":  {13.849, -13.196, BoundingBox{12.626, -14.350, 14.764, -12.045}},
+	"SN-TH":  {14.974, -16.760, BoundingBox{14.341, -17.116, 15.681, -16.408}},
+	"SN-ZG":  {12.809, -16.393, BoundingBox{12.380, -16.832, 13.146, -15.917}},
+	"SO-AW":  {10.579, 43.273, BoundingBox{9.746, 42.560, 11.460, 44.153}},
+	"SO-BK":  {4.191, 43.758, BoundingBox{3.424, 42.396, 4.990, 44.641}},
+	"SO-BN":  {2.172, 45.282, BoundingBox{1.845, 45.078, 2.652, 45.513}},
+	"SO-BR":  {10.551, 49.890, BoundingBox{9.014, 48.609, 12.020, 51.130}},
+	"SO-BY":  {2.873, 43.567, BoundingBox{1.873, 42.682, 3.649, 44.707}},
+	"SO-GA":  {5.073, 46.879, BoundingBox{3.661, 45.528, 6.536, 48.337}},
+	"SO-GE":  {2.988, 41.945, BoundingBox{1.831, 40.980, 4.238, 42.851}},
+	"SO-HI":  {4.291, 45.385, BoundingBox{3.195, 44.554, 5.602, 46.562}},
+	"SO-JD":  {1.301, 42.332, BoundingBox{0.188, 40.983, 2.393, 43.570}},
+	"SO-JH":  {-0.245, 41.731, BoundingBox{-1.680, 40.985, 0.904, 43.048}},
+	"SO-MU":  {6.500, 47.996, BoundingBox{5.007, 46.409, 7.727, 49.479}},
+	"SO-NU":  {8.340, 49.130, BoundingBox{6.860, 47.906, 9.658, 50.470}},
+	"SO-SA":  {10.360, 47.563, BoundingBox{9.473, 45.931, 11.392, 48.685}},
+	"SO-SD":  {3.089, 45.956, BoundingBox{2.019, 44.771, 3.936, 47.255}},
+	"SO-SH":  {1.912, 44.304, BoundingBox{0.641, 43.362, 3.207, 45.254}},
+	"SO-SO":  {8.792, 47.311, BoundingBox{7.570, 46.234, 9.704, 48.424}},
+	"SO-TO":  {9.508, 45.628, BoundingBox{8.258, 44.601, 10.741, 46.558}},
+	"SO-WO":  {9.738, 44.195, BoundingBox{8.847, 43.141, 10.564, 45.063}},
+	"SR-BR":  {4.316, -54.913, BoundingBox{3.146, -56.062, 5.262, -54.010}},
+	"SR-CM":  {5.674, -54.820, BoundingBox{5.255, -55.100, 6.017, -54.488}},
+	"SR-CR":  {5.454, -56.305, BoundingBox{4.705, -56.647, 5.904, -55.868}},
+	"SR-MA":  {5.474, -54.394, BoundingBox{4.950, -54.755, 5.893, -53.960}},
+	"SR-NI":  {4.874, -57.131, BoundingBox{3.873, -58.040, 5.970, -56.350}},
+	"SR-PM":  {5.891, -55.171, BoundingBox{5.651, -55.440, 6.030, -54.977}},
+	"SR-PR":  {5.231, -55.353, BoundingBox{4.734, -55.885, 5.575, -54.825}},
+	"SR-SA":  {5.264, -55.890, BoundingBox{4.580, -56.350, 5.973, -55.462}},
+	"SR-SI":  {3.287, -56.058, BoundingBox{1.820, -57.928, 4.621, -54.189}},
+	"SR-WA":  {5.754, -55.370, BoundingBox{5.575, -55.612, 5.989, -55.100}},
+	"SS-BN":  {9.014, 26.720, BoundingBox{7.318, 25.059, 10.410, 28.185}},
+	"SS-BW":  {8.126, 25.669, BoundingBox{6.067, 23.890, 10.252, 27.085}},
+	"SS-EC":  {4.791, 31.122, BoundingBox{3.510, 29.563, 6.167, 32.250}},
+	"SS-EE":  {5.142, 33.569, BoundingBox{3.665, 32.250, 6.564, 35.300}},
+	"SS-EW":  {5.639, 28.251, BoundingBox{4.290, 26.420, 6.850, 29.913}},
+	"SS-JG":  {7.306, 32.523, BoundingBox{5.755, 30.952, 8.838, 34.724}},
+	"SS-LK":  {6.772, 30.056, BoundingBox{5.401, 28.437, 7.900, 31.410}},
+	"SS-NU":  {10.023, 32.675, BoundingBox{8.644, 31.155, 12.250, 33.970}},
+	"SS-UY":  {8.981, 30.167, BoundingBox{7.900, 28.648, 10.290, 31.483}},
+	"SS-WR":  {7.958, 28.283, BoundingBox{6.850, 27.053, 9.400, 29.589}},
+	"ST-P":   {1.620, 7.400, BoundingBox{1.520, 7.300, 1.720, 7.500}},
+	"ST-S":   {0.230, 6.600, BoundingBox{-0.019, 6.351, 0.479, 6.849}},
+	"SV-AH":  {13.868, -89.876, BoundingBox{13.652, -90.100, 14.095, -89.631}},
+	"SV-CA":  {13.881, -88.685, BoundingBox{13.701, -88.850, 14.092, -88.447}},
+	"SV-CH":  {14.145, -89.077, BoundingBox{13.946, -89.275, 14.399, -88.751}},
+	"SV-CU":  {13.843, -88.956, BoundingBox{13.675, -89.128, 13.999, -88.850}},
+	"SV-LI":  {13.618, -89.369, BoundingBox{13.424, -89.572, 13.868, -89.150}},
+	"SV-MO":  {13.797, -88.143, BoundingBox{13.630, -88.454, 13.960, -87.786}},
+	"SV-PA":  {13.462, -88.944, BoundingBox{13.234, -89.184, 13.675, -88.728}},
+	"SV-SA":  {14.124, -89.469, BoundingBox{13.844, -89.767, 14.420, -89.275}},
+	"SV-SM":  {13.442, -88.191, BoundingBox{13.153, -88.422, 13.689, -88.050}},
+	"SV-SO":  {13.685, -89.691, BoundingBox{13.494, -89.984, 13.902, -89.466}},
+	"SV-SS":  {13.789, -89.188, BoundingBox{13.549, -89.403, 14.006, -88.984}},
+	"SV-SV":  {13.596, -88.659, BoundingBox{13.320, -88.878, 13.776, -88.421}},
+	"SV-UN":  {13.486, -87.904, BoundingBox{13.150, -88.050, 13.837, -87.720}},
+	"SV-US":  {13.349, -88.469, BoundingBox{13.155, -88.746, 13.680, -88.200}},
+	"SY-DI":  {33.517, 36.293, BoundingBox{33.426, 36.195, 33.609, 36.395}},
+	"SY-DR":  {32.752, 36.192, BoundingBox{32.447, 35.711, 33.026, 36.450}},
+	"SY-DY":  {34.889, 40.246, BoundingBox{33.736, 39.194, 35.828, 41.380}},
+	"SY-HA":  {36.494, 40.855, BoundingBox{35.652, 39.685, 37.230, 42.350}},
+	"SY-HI":  {34.397, 38.127, BoundingBox{33.268, 36.270, 35.423, 39.550}},
+	"SY-HL":  {36.201, 37.643, BoundingBox{35.370, 36.713, 36.900, 38.392}},
+	"SY-HM":  {35.244, 37.051, BoundingBox{34.861, 36.272, 35.633, 37.849}},
+	"SY-ID":  {35.843, 36.708, BoundingBox{35.425, 36.187, 36.512, 37.292}},
+	"SY-LA":  {35.489, 36.128, BoundingBox{35.248, 35.845, 35.850, 36.366}},
+	"SY-QU":  {33.069, 35.968, BoundingBox{32.715, 35.700, 33.355, 36.239}},
+	"SY-RA":  {35.934, 39.054, BoundingBox{34.938, 38.116, 36.820, 40.074}},
+	"SY-RD":  {33.495, 37.134, BoundingBox{32.853, 35.855, 34.179, 38.585}},
+	"SY-SU":  {32.745, 36.957, BoundingBox{32.310, 36.450, 33.127, 37.825}},
+	"SY-TA":  {34.959, 36.164, BoundingBox{34.610, 35.929, 35.281, 36.432}},
+	"SZ-HH":  {-26.015, 31.339, BoundingBox{-26.300, 30.751, -25.660, 31.881}},
+	"SZ-LU":  {-26.506, 31.831, BoundingBox{-26.993, 31.600, -25.962, 32.070}},
+	"SZ-MA":  {-26.541, 31.128, BoundingBox{-26.839, 30.680, -26.300, 31.600}},
+	"SZ-SH":  {-26.990, 31.423, BoundingBox{-27.290, 30.796, -26.710, 31.953}},
+	"TD-BA":  {13.953, 18.502, BoundingBox{12.500, 16.957, 15.750, 19.750}},
+	"TD-BG":  {14.642, 16.661, BoundingBox{13.320, 15.073, 16.206, 18.003}},
+	"TD-BO":  {17.909, 18.264, BoundingBox{15.750, 15.324, 20.193, 20.419}},
+	"TD-CB":  {11.533, 16.193, BoundingBox{10.496, 14.923, 12.642, 17.250}},
+	"TD-EN":  {18.277, 22.017, BoundingBox{15.853, 20.067, 21.083, 23.887}},
+	"TD-GR":  {11.505, 18.420, BoundingBox{10.400, 17.250, 12.500, 19.816}},
+	"TD-HL":  {12.934, 15.826, BoundingBox{12.201, 14.538, 13.775, 17.127}},
+	"TD-KA":  {15.877, 15.497, BoundingBox{14.121, 13.775, 18.057, 17.317}},
+	"TD-LC":  {13.974, 14.402, BoundingBox{12.797, 13.540, 15.086, 15.292}},
+	"TD-LO":  {8.588, 15.720, BoundingBox{7.476, 14.995, 9.348, 16.684}},
+	"TD-LR":  {7.961, 16.432, BoundingBox{7.420, 15.280, 8.750, 17.301}},
+	"TD-MA":  {8.522, 17.558, BoundingBox{7.690, 16.731, 9.472, 18.481}},
+	"TD-MC":  {9.562, 18.727, BoundingBox{8.341, 17.508, 10.400, 20.391}},
+	"TD-ME":  {10.088, 15.507, BoundingBox{9.175, 14.763, 10.947, 16.235}},
+	"TD-MO":  {9.428, 14.625, BoundingBox{8.754, 13.950, 10.020, 15.287}},
+	"TD-ND":  {12.113, 15.013, BoundingBox{11.873, 14.817, 12.340, 15.175}},
+	"TD-OD":  {13.508, 20.803, BoundingBox{12.379, 19.750, 14.715, 22.321}},
+	"TD-SA":  {10.724, 20.429, BoundingBox{9.176, 19.271, 12.424, 21.872}},
+	"TD-SI":  {11.895, 21.630, BoundingBox{10.689, 20.054, 13.003, 22.880}},
+	"TD-TA":  {9.784, 16.738, BoundingBox{8.750, 15.720, 10.732, 17.608}},
+	"TD-TI":  {21.077, 17.330, BoundingBox{18.710, 14.850, 23.410, 20.717}},
+	"TD-WF":  {15.375, 21.236, BoundingBox{13.918, 19.193, 16.563, 23.890}},
+	"TG-C":   {8.627, 1.103, BoundingBox{7.952, 0.407, 9.241, 1.660}},
+	"TG-K":   {9.656, 0.893, BoundingBox{9.148, 0.370, 10.314, 1.549}},
+	"TG-M":   {6.471, 1.273, BoundingBox{5.930, 0.639, 7.039, 1.870}},
+	"TG-P":   {7.435, 1.062, BoundingBox{6.750, 0.490, 8.033, 1.640}},
+	"TG-S":   {10.609, 0.458, BoundingBox{9.968, -0.050, 11.020, 0.937}},
+	"TH-10":  {13.785, 100.748, BoundingBox{13.558, 100.297, 14.022, 101.092}},
+	"TH-11":  {13.538, 100.701, BoundingBox{13.410, 100.452, 13.731, 100.975}},
+	"TH-12":  {13.972, 100.358, BoundingBox{13.743, 100.188, 14.206, 100.592}},
+	"TH-13":  {14.088, 100.707, BoundingBox{13.888, 100.420, 14.280, 100.955}},
+	"TH-14":  {14.349, 100.535, BoundingBox{14.143, 100.156, 14.650, 100.854}},
+	"TH-15":  {14.580, 100.346, BoundingBox{14.257, 100.105, 14.750, 100.687}},
+	"TH-16":  {15.191, 101.021, BoundingBox{14.719, 100.540, 15.662, 101.500}},
+	"TH-17":  {14.985, 100.406, BoundingBox{14.750, 100.061, 15.393, 100.663}},
+	"TH-18":  {15.137, 100.024, BoundingBox{14.803, 99.662, 15.417, 100.460}},
+	"TH-19":  {14.610, 101.116, BoundingBox{14.259, 100.681, 14.891, 101.604}},
+	"TH-20":  {13.065, 101.118, BoundingBox{12.630, 100.830, 13.507, 101.567}},
+	"TH-21":  {12.900, 101.517, BoundingBox{12.606, 101.175, 13.250, 101.775}},
+	"TH-22":  {12.893, 102.113, BoundingBox{12.382, 101.775, 13.370, 102.503}},
+	"TH-23":  {12.388, 102.431, BoundingBox{12.190, 102.209, 12.591, 102.580}},
+	"TH-24":  {13.549, 101.416, BoundingBox{13.226, 100.975, 13.908, 101.894}},
+	"TH-25":  {14.074, 101.713, BoundingBox{13.511, 101.299, 14.591, 102.058}},
+	"TH-26":  {14.236, 101.253, BoundingBox{13.875, 100.886, 14.649, 101.659}},
+	"TH-27":  {13.910, 102.314, BoundingBox{13.306, 101.856, 14.466, 102.919}},
+	"TH-30":  {15.025, 102.083, BoundingBox{14.401, 101.500, 15.611, 102.693}},
+	"TH-31":  {14.799, 102.907, BoundingBox{14.137, 102.448, 15.509, 103.369}},
+	"TH-32":  {14.911, 103.557, BoundingBox{14.286, 103.124, 15.483, 103.900}},
+	"TH-33":  {14.825, 104.356, BoundingBox{14.306, 103.900, 15.381, 104.994}},
+	"TH-34":  {15.068, 105.152, BoundingBox{14.270, 104.573, 15.805, 105.590}},
+	"TH-35":  {15.717, 104.182, BoundingBox{15.313, 103.797, 16.264, 104.549}},
+	"TH-36":  {15.956, 101.922, BoundingBox{15.405, 101.376, 16.715, 102.586}},
+	"TH-37":  {15.867, 104.810, BoundingBox{15.372, 104.390, 16.299, 105.371}},
+	"TH-39":  {17.126, 102.201, BoundingBox{16.636, 101.686, 17.782, 102.720}},
+	"TH-40":  {16.401, 102.570, BoundingBox{15.648, 101.988, 16.896, 103.044}},
+	"TH-41":  {17.327, 102.941, BoundingBox{16.849, 102.417, 17.708, 103.384}},
+	"TH-42":  {17.396, 101.565, BoundingBox{16.766, 100.958, 18.070, 102.145}},
+	"TH-43":  {17.916, 102.926, BoundingBox{17.616, 102.039, 18.310, 103.691}},
+	"TH-44":  {15.925, 103.072, BoundingBox{15.446, 102.583, 16.524, 103.522}},
+	"TH-45":  {15.879, 103.687, BoundingBox{15.389, 103.279, 16.387, 104.073}},
+	"TH-46":  {16.678, 103.499, BoundingBox{16.251, 102.968, 17.150, 104.024}},
+	"TH-47":  {17.533, 103.830, BoundingBox{16.774, 103.331, 18.265, 104.309}},
+	"TH-48":  {17.240, 104.449, BoundingBox{16.850, 104.130, 17.868, 104.755}},
+	"TH-49":  {16.516, 104.407, BoundingBox{16.134, 103.983, 16.850, 104.912}},
+	"TH-50":  {19.085, 98.762, BoundingBox{18.265, 98.350, 19.747, 99.358}},
+	"TH-51":  {18.066, 98.836, BoundingBox{17.532, 98.053, 18.796, 99.425}},
+	"TH-52":  {18.445, 99.627, BoundingBox{17.850, 99.283, 18.975, 100.114}},
+	"TH-53":  {17.710, 100.550, BoundingBox{17.269, 99.899, 18.212, 101.051}},
+	"TH-54":  {18.217, 100.164, BoundingBox{17.750, 99.663, 18.719, 100.710}},
+	"TH-55":  {18.869, 100.799, BoundingBox{18.096, 100.252, 19.506, 101.280}},
+	"TH-56":  {19.181, 99.962, BoundingBox{18.706, 99.225, 19.603, 100.666}},
+	"TH-57":  {19.855, 99.838, BoundingBox{19.385, 98.907, 20.420, 100.601}},
+	"TH-58":  {18.515, 98.005, BoundingBox{17.627, 97.380, 19.716, 98.350}},
+	"TH-60":  {15.710, 100.169, BoundingBox{15.370, 99.589, 16.142, 100.788}},
+	"TH-61":  {15.371, 99.241, BoundingBox{14.791, 98.544, 15.800, 99.858}},
+	"TH-62":  {16.278, 99.417, BoundingBox{15.800, 98.743, 16.857, 99.950}},
+	"TH-63":  {17.098, 98.668, BoundingBox{16.213, 97.829, 17.701, 99.302}},
+	"TH-64":  {17.314, 99.593, BoundingBox{16.741, 98.946, 17.850, 100.189}},
+	"TH-65":  {16.945, 100.582, BoundingBox{16.539, 99.911, 17.360, 101.221}},
+	"TH-66":  {16.279, 100.397, BoundingBox{15.769, 99.950, 16.679, 100.838}},
+	"TH-67":  {16.222, 101.208, BoundingBox{15.523, 100.737, 16.902, 101.735}},
+	"TH-70":  {13.589, 99.478, BoundingBox{13.200, 99.098, 14.071, 99.907}},
+	"TH-71":  {14.617, 98.909, BoundingBox{13.833, 98.190, 15.319, 99.475}},
+	"TH-72":  {14.505, 99.777, BoundingBox{14.098, 99.434, 14.906, 100.162}},
+	"TH-73":  {13.949, 99.929, BoundingBox{13.620, 99.457, 14.237, 100.251}},
+	"TH-74":  {13.559, 100.255, BoundingBox{13.410, 100.024, 13.764, 100.472}},
+	"TH-75":  {13.336, 99.947, BoundingBox{13.033, 99.720, 13.645, 100.134}},
+	"TH-76":  {12.781, 99.637, BoundingBox{12.350, 99.200, 13.200, 100.073}},
+	"TH-77":  {11.788, 99.624, BoundingBox{11.078, 99.203, 12.350, 100.023}},
+	"TH-80":  {8.400, 99.844, BoundingBox{7.942, 99.347, 9.034, 100.335}},
+	"TH-81":  {8.208, 99.120, BoundingBox{7.695, 98.653, 8.657, 99.531}},
+	"TH-82":  {8.697, 98.505, BoundingBox{8.216, 98.150, 9.277, 98.951}},
+	"TH-83":  {8.083, 98.327, BoundingBox{7.790, 98.186, 8.244, 98.456}},
+	"TH-84":  {9.051, 99.204, BoundingBox{8.544, 98.535, 9.688, 99.949}},
+	"TH-85":  {9.771, 98.775, BoundingBox{9.248, 98.353, 10.355, 99.176}},
+	"TH-86":  {10.647, 99.162, BoundingBox{10.002, 98.752, 11.236, 99.564}},
+	"TH-90":  {6.961, 100.563, BoundingBox{6.470, 100.187, 7.472, 100.952}},
+	"TH-91":  {6.857, 100.047, BoundingBox{6.460, 99.620, 7.185, 100.543}},
+	"TH-92":  {7.580, 99.592, BoundingBox{7.052, 99.190, 8.019, 99.865}},
+	"TH-93":  {7.606, 100.103, BoundingBox{7.165, 99.776, 8.035, 100.451}},
+	"TH-94":  {6.673, 101.247, BoundingBox{6.469, 100.790, 6.929, 101.728}},
+	"TH-95":  {6.242, 101.157, BoundingBox{5.690, 100.577, 6.622, 101.517}},
+	"TH-96":  {6.156, 101.719, BoundingBox{5.731, 101.376, 6.632, 102.140}},
+	"TH-S":   {12.932, 100.915, BoundingBox{12.880, 100.878, 12.981, 100.950}},
+	"TJ-GB":  {38.244, 72.719, BoundingBox{36.740, 70.579, 39.430, 74.980}},
+	"TJ-KT":  {38.161, 69.165, BoundingBox{37.020, 67.440, 39.185, 70.673}},
+	"TJ-SU":  {39.687, 69.080, BoundingBox{38.775, 67.467, 40.960, 71.223}},
+	"TL-AL":  {-8.772, 125.635, BoundingBox{-8.919, 125.448, -8.649, 125.800}},
+	"TL-AN":  {-9.034, 125.516, BoundingBox{-9.238, 125.350, -8.869, 125.695}},
+	"TL-BA":  {-8.551, 126.442, BoundingBox{-8.700, 126.122, -8.381, 126.715}},
+	"TL-BO":  {-8.965, 125.160, BoundingBox{-9.193, 124.970, -8.750, 125.350}},
+	"TL-CO":  {-9.233, 125.272, BoundingBox{-9.390, 125.077, -9.086, 125.545}},
+	"TL-DI":  {-8.561, 125.637, BoundingBox{-8.665, 125.438, -8.150, 125.849}},
+	"TL-ER":  {-8.806, 125.368, BoundingBox{-8.949, 125.180, -8.652, 125.513}},
+	"TL-LA":  {-8.488, 126.942, BoundingBox{-8.762, 126.688, -8.270, 127.340}},
+	"TL-LI":  {-8.679, 125.248, BoundingBox{-8.783, 125.043, -8.567, 125.450}},
+	"TL-MF":  {-9.030, 125.826, BoundingBox{-9.223, 125.591, -8.851, 126.123}},
+	"TL-MT":  {-8.691, 126.001, BoundingBox{-9.004, 125.778, -8.423, 126.229}},
+	"TL-OE":  {-9.300, 124.300, BoundingBox{-9.450, 124.148, -9.150, 124.452}},
+	"TL-VI":  {-8.817, 126.390, BoundingBox{-9.028, 126.112, -8.700, 126.752}},
+	"TM-A":   {38.744, 58.635, BoundingBox{36.822, 55.781, 40.177, 60.793}},
+	"TM-B":   {39.771, 54.843, BoundingBox{37.200, 52.500, 42.320, 56.987}},
+	"TM-D":   {41.085, 58.907, BoundingBox{39.819, 56.153, 42.750, 61.867}},
+	"TM-L":   {38.786, 63.050, BoundingBox{37.203, 60.553, 41.088, 66.550}},
+	"TM-M":   {36.884, 62.329, BoundingBox{35.270, 59.998, 38.515, 65.140}},
+	"TM-S":   {37.963, 58.376, BoundingBox{37.875, 58.265, 38.058, 58.490}},
+	"TN-11":  {36.780, 10.179, BoundingBox{36.654, 9.996, 36.885, 10.559}},
+	"TN-12":  {37.076, 10.074, BoundingBox{36.859, 9.906, 37.271, 10.210}},
+	"TN-13":  {36.593, 10.286, BoundingBox{36.353, 9.922, 36.826, 10.529}},
+	"TN-14":  {36.777, 9.807, BoundingBox{36.510, 9.542, 37.105, 10.049}},
+	"TN-21":  {36.730, 10.736, BoundingBox{36.243, 10.461, 37.090, 11.100}},
+	"TN-22":  {36.274, 9.978, BoundingBox{35.950, 9.542, 36.565, 10.510}},
+	"TN-23":  {37.118, 9.527, BoundingBox{36.802, 9.012, 37.350, 9.972}},
+	"TN-31":  {36.710, 9.214, BoundingBox{36.330, 8.820, 37.167, 9.632}},
+	"TN-32":  {36.621, 8.633, BoundingBox{36.300, 8.220, 37.097, 9.043}},
+	"TN-33":  {35.957, 8.646, BoundingBox{35.567, 8.242, 36.300, 9.000}},
+	"TN-34":  {35.975, 9.322, BoundingBox{35.491, 9.000, 36.379, 9.774}},
+	"TN-41":  {35.535, 9.854, BoundingBox{35.112, 9.302, 35.980, 10.343}},
+	"TN-42":  {35.202, 8.753, BoundingBox{34.800, 8.180, 35.622, 9.358}},
+	"TN-43":  {34.838, 9.543, BoundingBox{34.265, 8.995, 35.385, 10.050}},
+	"TN-51":  {35.908, 10.365, BoundingBox{35.528, 9.976, 36.272, 10.692}},
+	"TN-52":  {35.636, 10.709, BoundingBox{35.445, 10.392, 35.877, 10.940}},
+	"TN-53":  {35.248, 10.559, BoundingBox{34.906, 10.134, 35.546, 10.902}},
+	"TN-61":  {34.709, 10.241, BoundingBox{34.182, 9.796, 35.144, 10.821}},
+	"TN-71":  {34.354, 8.815, BoundingBox{33.822, 8.096, 34.800, 9.548}},
+	"TN-72":  {33.846, 8.024, BoundingBox{33.101, 7.520, 34.620, 8.674}},
+	"TN-73":  {33.123, 8.913, BoundingBox{32.347, 7.943, 33.888, 9.869}},
+	"TN-81":  {33.685, 9.854, BoundingBox{32.847, 9.200, 34.322, 10.532}},
+	"TN-82":  {32.974, 10.793, BoundingBox{32.201, 9.929, 33.783, 11.490}},
+	"TN-83":  {31.825, 9.827, BoundingBox{30.310, 8.686, 32.849, 11.145}},
+	"TO-01":  {-21.315, -175.078, BoundingBox{-21.415, -175.174, -21.165, -174.882}},
+	"TO-02":  {-19.800, -174.350, BoundingBox{-20.049, -174.615, -19.551, -174.085}},
+	"TO-03":  {-15.900, -173.800, BoundingBox{-15.945, -173.847, -15.855, -173.753}},
+	"TO-04":  {-21.159, -175.213, BoundingBox{-21.378, -175.423, -20.962, -174.977}},
+	"TO-05":  {-18.650, -173.980, BoundingBox{-18.816, -174.155, -18.484, -173.805}},
+	"TR-01":  {37.293, 35.360, BoundingBox{36.619, 34.600, 37.993, 36.039}},
+	"TR-02":  {37.770, 38.343, BoundingBox{37.181, 37.574, 38.221, 39.246}},
+	"TR-03":  {38.725, 30.751, BoundingBox{38.251, 29.931, 39.282, 31.620}},
+	"TR-04":  {39.531, 42.960, BoundingBox{38.951, 42.122, 40.072, 43.867}},
+	"TR-05":  {40.600, 35.772, BoundingBox{40.028, 35.283, 41.074, 36.451}},
+	"TR-06":  {39.752, 32.524, BoundingBox{39.104, 31.832, 40.502, 33.100}},
+	"TR-07":  {36.769, 31.087, BoundingBox{36.110, 28.937, 37.439, 33.108}},
+	"TR-08":  {41.081, 41.796, BoundingBox{40.477, 41.206, 41.569, 42.327}},
+	"TR-09":  {37.727, 27.749, BoundingBox{36.998, 26.920, 38.267, 28.503}},
+	"TR-10":  {39.702, 27.885, BoundingBox{39.156, 27.038, 40.442, 28.759}},
+	"TR-11":  {40.045, 30.030, BoundingBox{39.598, 29.500, 40.425, 30.698}},
+	"TR-12":  {39.011, 40.562, BoundingBox{38.422, 39.905, 39.658, 41.159}},
+	"TR-13":  {38.538, 42.360, BoundingBox{38.049, 41.523, 39.121, 42.850}},
+	"TR-14":  {40.463, 31.757, BoundingBox{39.936, 30.969, 40.968, 32.503}},
+	"TR-15":  {37.454, 29.987, BoundingBox{36.906, 29.405, 38.143, 30.731}},
+	"TR-16":  {40.008, 28.881, BoundingBox{39.387, 28.124, 40.456, 29.500}},
+	"TR-17":  {39.937, 26.752, BoundingBox{39.135, 26.040, 40.786, 27.492}},
+	"TR-18":  {40.607, 33.612, BoundingBox{40.147, 32.705, 41.075, 34.301}},
+	"TR-19":  {40.579, 34.819, BoundingBox{39.968, 34.249, 41.181, 35.465}},
+	"TR-20":  {37.739, 29.101, BoundingBox{37.011, 28.445, 38.231, 29.757}},
+	"TR-21":  {37.963, 40.078, BoundingBox{37.236, 39.241, 38.571, 40.778}},
+	"TR-22":  {41.467, 26.629, BoundingBox{40.768, 26.055, 42.065, 27.033}},
+	"TR-23":  {38.668, 39.099, BoundingBox{37.953, 38.301, 39.381, 39.956}},
+	"TR-24":  {39.695, 39.239, BoundingBox{39.335, 38.350, 40.073, 40.312}},
+	"TR-25":  {39.989, 41.439, BoundingBox{39.375, 40.574, 40.623, 42.273}},
+	"TR-26":  {39.625, 31.150, BoundingBox{39.002, 30.300, 40.286, 31.902}},
+	"TR-27":  {37.161, 37.575, BoundingBox{36.751, 36.825, 37.634, 38.228}},
+	"TR-28":  {40.434, 38.440, BoundingBox{39.714, 37.762, 41.032, 39.069}},
+	"TR-29":  {40.359, 39.312, BoundingBox{39.991, 38.763, 40.824, 39.865}},
+	"TR-30":  {37.573, 43.921, BoundingBox{37.000, 43.137, 38.122, 44.770}},
+	"TR-31":  {36.389, 36.195, BoundingBox{35.820, 35.370, 36.774, 36.710}},
+	"TR-32":  {37.892, 30.973, BoundingBox{37.400, 29.911, 38.365, 31.755}},
+	"TR-33":  {36.659, 33.933, BoundingBox{36.153, 33.066, 37.219, 34.874}},
+	"TR-34":  {41.143, 28.508, BoundingBox{40.751, 28.117, 41.568, 29.830}},
+	"TR-35":  {38.465, 27.032, BoundingBox{37.750, 26.320, 39.217, 27.776}},
+	"TR-36":  {40.457, 42.956, BoundingBox{39.998, 42.040, 41.029, 43.750}},
+	"TR-37":  {41.513, 33.750, BoundingBox{41.006, 33.052, 42.028, 34.501}},
+	"TR-38":  {38.679, 35.960, BoundingBox{37.948, 35.200, 39.408, 36.942}},
+	"TR-39":  {41.712, 27.521, BoundingBox{41.350, 26.894, 42.140, 28.261}},
+	"TR-40":  {39.241, 34.104, BoundingBox{38.775, 33.213, 39.877, 34.841}},
+	"TR-41":  {40.794, 29.937, BoundingBox{40.425, 29.577, 41.180, 30.288}},
+	"TR-42":  {38.103, 32.724, BoundingBox{36.972, 31.509, 39.215, 34.600}},
+	"TR-43":  {39.315, 29.642, BoundingBox{38.801, 28.706, 39.779, 30.461}},
+	"TR-44":  {38.590, 37.899, BoundingBox{37.974, 36.921, 39.323, 38.799}},
+	"TR-45":  {38.761, 28.086, BoundingBox{38.169, 27.224, 39.246, 28.722}},
+	"TR-46":  {37.901, 36.835, BoundingBox{37.236, 35.952, 38.650, 37.614}},
+	"TR-47":  {37.300, 40.862, BoundingBox{36.884, 40.001, 37.689, 41.820}},
+	"TR-48":  {37.056, 28.421, BoundingBox{36.564, 27.438, 37.708, 29.415}},
+	"TR-49":  {39.016, 41.683, BoundingBox{38.378, 41.041, 39.587, 42.507}},
+	"TR-50":  {38.697, 34.770, BoundingBox{38.300, 34.160, 39.186, 35.200}},
+	"TR-51":  {37.885, 34.756, BoundingBox{37.283, 33.982, 38.300, 35.607}},
+	"TR-52":  {40.805, 37.441, BoundingBox{40.227, 36.749, 41.345, 38.185}},
+	"TR-53":  {40.870, 40.889, BoundingBox{40.446, 40.239, 41.385, 41.458}},
+	"TR-54":  {40.658, 30.521, BoundingBox{40.199, 30.138, 41.149, 30.937}},
+	"TR-55":  {41.279, 36.047, BoundingBox{40.839, 35.165, 41.827, 36.898}},
+	"TR-56":  {37.859, 42.035, BoundingBox{37.292, 41.600, 38.301, 42.850}},
+	"TR-57":  {41.646, 34.882, BoundingBox{41.165, 34.144, 42.040, 35.700}},
+	"TR-58":  {39.549, 37.313, BoundingBox{38.754, 36.208, 40.320, 38.350}},
+	"TR-59":  {40.989, 27.271, BoundingBox{40.313, 26.374, 41.350, 28.153}},
+	"TR-60":  {40.256, 36.515, BoundingBox{39.548, 35.780, 40.934, 37.303}},
+	"TR-61":  {40.858, 39.687, BoundingBox{40.536, 38.983, 41.100, 40.283}},
+	"TR-62":  {39.190, 39.572, BoundingBox{38.706, 38.603, 39.530, 40.208}},
+	"TR-63":  {37.186, 39.138, BoundingBox{36.710, 38.197, 37.920, 40.030}},
+	"TR-64":  {38.578, 29.271, BoundingBox{38.075, 28.597, 39.219, 30.001}},
+	"TR-65":  {38.535, 43.588, BoundingBox{37.907, 42.850, 39.175, 44.420}},
+	"TR-66":  {39.628, 35.297, BoundingBox{39.066, 34.384, 40.137, 36.220}},
+	"TR-67":  {41.189, 31.852, BoundingBox{40.877, 31.340, 41.557, 32.213}},
+	"TR-68":  {38.398, 33.757, BoundingBox{37.843, 33.181, 38.978, 34.455}},
+	"TR-69":  {40.193, 40.297, BoundingBox{39.620, 39.722, 40.730, 40.871}},
+	"TR-70":  {37.054, 33.030, BoundingBox{36.355, 32.295, 37.656, 33.577}},
+	"TR-71":  {39.763, 33.598, BoundingBox{39.051, 33.100, 40.234, 34.402}},
+	"TR-72":  {38.009, 41.165, BoundingBox{37.499, 40.657, 38.524, 41.600}},
+	"TR-73":  {37.558, 42.614, BoundingBox{37.156, 41.806, 38.049, 43.215}},
+	"TR-74":  {41.613, 32.587, BoundingBox{41.313, 32.012, 41.920, 33.097}},
+	"TR-75":  {41.103, 42.728, BoundingBox{40.587, 42.147, 41.580, 43.609}},
+	"TR-76":  {39.759, 43.994, BoundingBox{39.103, 43.373, 40.386, 44.790}},
+	"TR-77":  {40.544, 29.264, BoundingBox{40.253, 28.662, 40.834, 29.678}},
+	"TR-78":  {41.039, 32.681, BoundingBox{40.494, 32.122, 41.567, 33.274}},
+	"TR-79":  {36.832, 36.992, BoundingBox{36.482, 36.546, 37.145, 37.586}},
+	"TR-80":  {37.143, 36.237, BoundingBox{36.736, 35.659, 37.751, 36.825}},
+	"TR-81":  {40.821, 31.095, BoundingBox{40.283, 30.660, 41.193, 31.598}},
+	"TT-ARI": {10.706, -61.248, BoundingBox{10.493, -61.366, 10.886, -61.129}},
+	"TT-CHA": {10.489, -61.478, BoundingBox{10.410, -61.664, 10.585, -61.294}},
+	"TT-CTT": {10.409, -61.306, BoundingBox{10.310, -61.484, 10.506, -61.142}},
+	"TT-DMN": {10.676, -61.610, BoundingBox{10.476, -61.680, 10.798, -61.515}},
+	"TT-ETO": {11.281, -60.630, BoundingBox{11.067, -60.788, 11.433, -60.514}},
+	"TT-PED": {10.129, -61.429, BoundingBox{10.037, -61.554, 10.220, -61.282}},
+	"TT-POS": {10.592, -61.546, BoundingBox{10.449, -61.665, 10.731, -61.442}},
+	"TT-PRT": {10.231, -61.276, BoundingBox{10.065, -61.389, 10.378, -61.160}},
+	"TT-PTF": {10.172, -61.739, BoundingBox{10.000, -61.950, 10.402, -61.566}},
+	"TT-RCM": {10.238, -61.068, BoundingBox{10.070, -61.240, 10.400, -60.925}},
+	"TT-SFO": {10.320, -61.517, BoundingBox{10.208, -61.664, 10.446, -61.371}},
+	"TT-SGE": {10.633, -61.046, BoundingBox{10.400, -61.219, 10.890, -60.900}},
+	"TT-SIP": {10.087, -61.605, BoundingBox{10.001, -61.761, 10.217, -61.494}},
+	"TT-SJL": {10.745, -61.416, BoundingBox{10.625, -61.515, 10.847, -61.300}},
+	"TT-TUP": {10.583, -61.367, BoundingBox{10.501, -61.467, 10.668, -61.265}},
+	"TT-WTO": {11.215, -60.778, BoundingBox{11.067, -60.886, 11.422, -60.624}},
+	"TV-FUN": {-8.520, 179.130, BoundingBox{-8.645, 179.004, -8.395, 179.256}},
+	"TV-NIT": {-6.110, 177.340, BoundingBox{-6.155, 177.295, -6.065, 177.385}},
+	"TV-NKF": {-8.000, 178.400, BoundingBox{-8.045, 178.355, -7.955, 178.445}},
+	"TV-NKL": {-9.380, 179.830, BoundingBox{-9.446, 179.763, -9.314, 179.897}},
+	"TV-NMA": {-5.670, 176.120, BoundingBox{-5.736, 176.053, -5.604, 176.187}},
+	"TV-NMG": {-6.290, 176.320, BoundingBox{-6.335, 176.275, -6.245, 176.365}},
+	"TV-NUI": {-7.230, 177.150, BoundingBox{-7.296, 177.083, -7.164, 177.217}},
+	"TV-VAI": {-7.480, 178.680, BoundingBox{-7.546, 178.613, -7.414, 178.747}},
+	"TW-CHA": {23.948, 120.490, BoundingBox{23.797, 120.305, 24.101, 120.639}},
+	"TW-CYI": {23.469, 120.469, BoundingBox{23.396, 120.400, 23.515, 120.537}},
+	"TW-CYQ": {23.426, 120.643, BoundingBox{23.175, 120.110, 23.705, 121.159}},
+	"TW-HSQ": {24.763, 121.091, BoundingBox{24.270, 119.876, 26.226, 121.434}},
+	"TW-HSZ": {24.731, 120.937, BoundingBox{24.653, 120.829, 24.828, 121.032}},
+	"TW-HUA": {23.796, 121.349, BoundingBox{23.272, 121.066, 24.265, 121.692}},
+	"TW-ILA": {24.488, 121.612, BoundingBox{24.156, 121.342, 24.819, 121.900}},
+	"TW-KEE": {25.119, 121.726, BoundingBox{24.995, 121.598, 25.232, 121.950}},
+	"TW-KHH": {22.669, 120.325, BoundingBox{22.621, 120.287, 22.703, 120.349}},
+	"TW-KHQ": {22.877, 120.599, BoundingBox{22.473, 120.215, 23.175, 120.928}},
+	"TW-MIA": {24.454, 120.971, BoundingBox{24.230, 120.635, 24.671, 121.343}},
+	"TW-NAN": {23.846, 120.963, BoundingBox{23.500, 120.658, 24.164, 121.225}},
+	"TW-PEN": {23.736, 119.600, BoundingBox{23.362, 118.260, 24.550, 119.827}},
+	"TW-PIF": {22.405, 120.720, BoundingBox{21.970, 120.433, 22.700, 120.998}},
+	"TW-TAO": {24.893, 121.247, BoundingBox{24.753, 121.098, 25.077, 121.400}},
+	"TW-TNN": {22.998, 120.225, BoundingBox{22.845, 120.173, 23.132, 120.260}},
+	"TW-TNQ": {23.127, 120.348, BoundingBox{22.868, 120.138, 23.368, 120.600}},
+	"TW-TPE": {25.064, 121.563, BoundingBox{24.964, 121.470, 25.182, 121.625}},
+	"TW-TPQ": {24.959, 121.583, BoundingBox{24.690, 121.262, 25.300, 121.949}},
+	"TW-TTT": {22.885, 121.091, BoundingBox{21.984, 120.814, 23.323, 121.622}},
+	"TW-TXG": {24.175, 120.677, BoundingBox{24.074, 120.610, 24.335, 120.812}},
+	"TW-TXQ": {24.175, 120.805, BoundingBox{23.783, 120.430, 24.447, 121.285}},
+	"TW-YUN": {23.733, 120.411, BoundingBox{23.599, 120.153, 23.890, 120.695}},
+	"TZ-01":  {-3.075, 35.719, BoundingBox{-4.140, 34.559, -1.874, 37.068}},
+	"TZ-02":  {-7.042, 39.275, BoundingBox{-7.898, 38.933, -6.658, 39.901}},
+	"TZ-03":  {-6.090, 36.055, BoundingBox{-7.103, 34.852, -4.877, 37.414}},
+	"TZ-04":  {-8.281, 35.326, BoundingBox{-9.680, 34.232, -6.904, 36.596}},
+	"TZ-05":  {-2.098, 31.437, BoundingBox{-3.631, 30.420, -0.998, 32.672}},
+	"TZ-06":  {-5.011, 39.694, BoundingBox{-5.202, 39.089, -4.602, 40.042}},
+	"TZ-07":  {-5.879, 39.210, BoundingBox{-6.076, 38.610, -5.510, 39.629}},
+	"TZ-08":  {-4.933, 30.438, BoundingBox{-6.590, 29.340, -3.297, 31.598}},
+	"TZ-09":  {-3.773, 37.448, BoundingBox{-4.737, 36.686, -2.745, 38.443}},
+	"TZ-10":  {-5.302, 39.734, BoundingBox{-5.491, 39.458, -5.106, 40.042}},
+	"TZ-11":  {-6.200, 39.472, BoundingBox{-6.391, 39.257, -5.967, 39.642}},
+	"TZ-12":  {-9.404, 38.301, BoundingBox{-10.614, 36.904, -8.328, 39.775}},
+	"TZ-13":  {-1.888, 34.228, BoundingBox{-3.113, 32.838, -0.950, 35.518}},
+	"TZ-14":  {-8.350, 33.457, BoundingBox{-10.101, 31.918, -6.842, 34.584}},
+	"TZ-15":  {-6.064, 39.199, BoundingBox{-6.391, 38.386, -5.787, 39.372}},
+	"TZ-16":  {-7.829, 37.062, BoundingBox{-9.388, 36.008, -6.316, 38.119}},
+	"TZ-17":  {-10.603, 38.805, BoundingBox{-11.545, 37.500, -9.678, 40.320}},
+	"TZ-18":  {-2.378, 32.861, BoundingBox{-3.381, 31.679, -0.992, 34.168}},
+	"TZ-19":  {-7.206, 38.463, BoundingBox{-8.473, 37.385, -5.933, 39.901}},
+	"TZ-20":  {-7.323, 31.383, BoundingBox{-8.857, 29.693, -5.965, 32.973}},
+	"TZ-21":  {-10.543, 35.943, BoundingBox{-11.720, 34.237, -9.283, 37.500}},
+	"TZ-22":  {-3.786, 33.215, BoundingBox{-4.787, 31.452, -2.889, 34.741}},
+	"TZ-23":  {-5.567, 34.384, BoundingBox{-7.131, 33.500, -3.948, 35.438}},
+	"TZ-24":  {-5.560, 32.373, BoundingBox{-6.981, 30.917, -4.094, 33.500}},
+	"TZ-25":  {-5.190, 38.168, BoundingBox{-6.189, 37.244, -4.151, 39.114}},
+	"TZ-26":  {-4.577, 36.408, BoundingBox{-5.642, 35.065, -3.606, 37.519}},
+	"UA-05":  {48.842, 28.810, BoundingBox{47.726, 27.325, 49.800, 29.994}},
+	"UA-07":  {51.194, 24.809, BoundingBox{50.290, 23.530, 51.910, 25.916}},
+	"UA-09":  {48.934, 38.984, BoundingBox{47.846, 37.719, 49.930, 40.080}},
+	"UA-12":  {48.401, 34.860, BoundingBox{47.537, 33.650, 49.267, 36.497}},
+	"UA-14":  {47.921, 37.557, BoundingBox{46.854, 36.420, 48.905, 38.998}},
+	"UA-18":  {50.664, 28.414, BoundingBox{49.800, 27.201, 51.600, 29.372}},
+	"UA-21":  {48.566, 23.141, BoundingBox{47.880, 22.090, 49.330, 24.247}},
+	"UA-23":  {47.318, 35.726, BoundingBox{46.408, 34.149, 48.318, 37.083}},
+	"UA-26":  {48.624, 24.579, BoundingBox{47.764, 23.664, 49.317, 25.493}},
+	"UA-30":  {50.425, 30.525, BoundingBox{50.270, 30.335, 50.582, 30.710}},
+	"UA-32":  {50.414, 31.484, BoundingBox{49.254, 29.274, 51.710, 32.579}},
+	"UA-35":  {48.374, 32.441, BoundingBox{47.684, 30.450, 49.290, 33.650}},
+	"UA-40":  {44.622, 33.590, BoundingBox{44.402, 33.330, 44.887, 33.848}},
+	"UA-43":  {45.323, 34.380, BoundingBox{44.360, 32.450, 46.409, 36.530}},
+	"UA-46":  {49.810, 23.897, BoundingBox{49.097, 22.520, 50.655, 25.166}},
+	"UA-48":  {47.334, 31.780, BoundingBox{46.281, 30.010, 48.055, 33.526}},
+	"UA-51":  {46.571, 30.302, BoundingBox{45.290, 28.230, 47.986, 31.339}},
+	"UA-53":  {49.646, 33.882, BoundingBox{48.687, 32.444, 50.378, 35.311}},
+	"UA-56":  {50.998, 26.523, BoundingBox{50.146, 25.377, 51.864, 27.827}},
+	"UA-59":  {51.025, 34.061, BoundingBox{50.224, 32.862, 52.340, 35.699}},
+	"UA-61":  {49.541, 25.537, BoundingBox{48.798, 24.579, 50.316, 26.323}},
+	"UA-63":  {49.551, 36.651, BoundingBox{48.500, 35.224, 50.487, 38.405}},
+	"UA-65":  {46.698, 33.701, BoundingBox{45.895, 32.043, 47.721, 35.194}},
+	"UA-68":  {49.548, 27.022, BoundingBox{48.596, 26.145, 50.364, 28.052}},
+	"UA-71":  {49.086, 31.155, BoundingBox{47.983, 29.892, 50.015, 32.687}},
+	"UA-74":  {51.421, 32.158, BoundingBox{50.231, 30.607, 52.312, 33.459}},
+	"UA-77":  {48.430, 26.033, BoundingBox{47.740, 24.824, 48.965, 27.427}},
+	"UG-101": {-0.583, 32.535, BoundingBox{-1.022, 31.971, 0.012, 33.342}},
+	"UG-102": {0.319, 32.593, BoundingBox{0.230, 32.500, 0.405, 32.683}},
+	"UG-103": {0.916, 31.700, BoundingBox{0.571, 31.390, 1.297, 32.027}},
+	"UG-104": {0.864, 32.459, BoundingBox{0.605, 32.147, 1.087, 32.774}},
+	"UG-105": {-0.383, 31.797, BoundingBox{-0.902, 31.445, 0.067, 32.024}},
+	"UG-106": {0.139, 32.245, BoundingBox{-0.052, 31.980, 0.410, 32.439}},
+	"UG-107": {0.501, 31.370, BoundingBox{0.209, 30.973, 0.943, 31.722}},
+	"UG-108": {0.198, 32.883, BoundingBox{-0.302, 32.653, 0.561, 33.094}},
+	"UG-109": {1.353, 32.436, BoundingBox{1.076, 32.072, 1.683, 32.848}},
+	"UG-110": {-0.802, 31.523, BoundingBox{-1.030, 31.091, -0.421, 32.082}},
+	"UG-111": {0.013, 31.436, BoundingBox{-0.367, 31.070, 0.279, 31.833}},
+	"UG-112": {0.721, 32.872, BoundingBox{0.469, 32.645, 1.038, 33.144}},
+	"UG-113": {0.137, 32.629, BoundingBox{-0.470, 32.240, 0.677, 33.101}},
+	"UG-114": {0.404, 31.966, BoundingBox{0.009, 31.652, 0.743, 32.286}},
+	"UG-115": {1.110, 32.025, BoundingBox{0.735, 31.708, 1.459, 32.289}},
+	"UG-116": {-0.413, 31.157, BoundingBox{-0.711, 30.898, -0.083, 31.452}},
+	"UG-201": {0.529, 33.771, BoundingBox{0.243, 33.586, 0.733, 33.953}},
+	"UG-202": {0.413, 33.988, BoundingBox{0.054, 33.836, 0.654, 34.204}},
+	"UG-203": {0.620, 33.441, BoundingBox{0.445, 33.228, 0.775, 33.619}},
+	"UG-204": {0.357, 33.158, BoundingBox{-0.136, 32.960, 0.711, 33.385}},
+	"UG-205": {1.059, 33.098, BoundingBox{0.697, 32.766, 1.356, 33.351}},
+	"UG-206": {1.468, 34.442, BoundingBox{1.168, 34.222, 1.709, 34.707}},
+	"UG-207": {2.008, 34.064, BoundingBox{1.674, 33.764, 2.421, 34.312}},
+	"UG-208": {1.534, 33.931, BoundingBox{1.305, 33.656, 1.703, 34.236}},
+	"UG-209": {1.073, 34.151, BoundingBox{0.914, 34.032, 1.208, 34.290}},
+	"UG-210": {1.227, 33.624, BoundingBox{0.990, 33.314, 1.434, 33.901}},
+	"UG-211": {1.653, 33.570, BoundingBox{1.381, 33.353, 1.894, 33.843}},
+	"UG-212": {0.704, 34.144, BoundingBox{0.552, 33.945, 0.862, 34.341}},
+	"UG-213": {1.624, 33.193, BoundingBox{1.346, 32.929, 1.855, 33.393}},
+	"UG-214": {-0.294, 33.528, BoundingBox{-0.972, 33.083, 0.465, 33.900}},
+	"UG-215": {1.253, 34.272, BoundingBox{1.120, 34.101, 1.443, 34.463}},
+	"UG-216": {2.146, 33.620, BoundingBox{1.862, 33.324, 2.374, 33.959}},
+	"UG-217": {1.069, 33.940, BoundingBox{0.970, 33.778, 1.215, 34.086}},
+	"UG-218": {1.348, 34.680, BoundingBox{1.057, 34.509, 1.603, 34.885}},
+	"UG-219": {0.859, 33.957, BoundingBox{0.685, 33.792, 0.970, 34.125}},
+	"UG-220": {0.952, 33.448, BoundingBox{0.748, 33.290, 1.238, 33.636}},
+	"UG-221": {0.872, 34.289, BoundingBox{0.737, 34.122, 0.980, 34.471}},
+	"UG-222": {0.835, 33.706, BoundingBox{0.687, 33.556, 0.999, 33.865}},
+	"UG-223": {1.047, 34.410, BoundingBox{0.912, 34.224, 1.183, 34.579}},
+	"UG-224": {1.356, 34.065, BoundingBox{1.175, 33.848, 1.598, 34.242}},
+	"UG-301": {3.371, 31.890, BoundingBox{3.014, 31.451, 3.672, 32.359}},
+	"UG-302": {1.902, 32.479, BoundingBox{1.647, 32.110, 2.221, 32.850}},
+	"UG-303": {2.934, 31.059, BoundingBox{2.679, 30.787, 3.131, 31.429}},
+	"UG-304": {2.782, 32.344, BoundingBox{2.432, 31.837, 3.283, 32.719}},
+	"UG-305": {3.442, 32.892, BoundingBox{2.938, 32.338, 3.814, 33.499}},
+	"UG-306": {2.987, 34.156, BoundingBox{2.488, 33.616, 3.264, 34.609}},
+	"UG-307": {2.329, 32.930, BoundingBox{1.940, 32.628, 2.648, 33.340}},
+	"UG-308": {2.499, 34.535, BoundingBox{2.162, 34.127, 3.027, 34.943}},
+	"UG-309": {3.583, 31.690, BoundingBox{3.455, 31.424, 3.719, 32.367}},
+	"UG-310": {2.542, 31.179, BoundingBox{2.195, 30.770, 2.892, 31.644}},
+	"UG-311": {1.904, 34.639, BoundingBox{1.564, 34.272, 2.218, 35.040}},
+	"UG-312": {2.883, 33.117, BoundingBox{2.480, 32.680, 3.347, 33.533}},
+	"UG-313": {3.422, 31.309, BoundingBox{2.981, 31.060, 3.780, 31.525}},
+	"UG-314": {1.575, 32.824, BoundingBox{1.236, 32.482, 1.941, 33.120}},
+	"UG-315": {3.640, 33.952, BoundingBox{3.245, 33.422, 4.250, 34.550}},
+	"UG-316": {3.445, 30.980, BoundingBox{3.285, 30.821, 3.658, 31.137}},
+	"UG-317": {2.698, 33.668, BoundingBox{2.356, 33.277, 3.291, 34.138}},
+	"UG-318": {2.010, 33.188, BoundingBox{1.826, 32.858, 2.321, 33.424}},
+	"UG-319": {2.923, 31.870, BoundingBox{2.430, 31.428, 3.387, 32.372}},
+	"UG-320": {3.202, 31.015, BoundingBox{3.083, 30.811, 3.335, 31.295}},
+	"UG-321": {2.260, 32.243, BoundingBox{1.882, 31.833, 2.547, 32.641}},
+	"UG-401": {0.833, 30.105, BoundingBox{0.437, 29.868, 1.473, 30.392}},
+	"UG-402": {-0.443, 30.125, BoundingBox{-0.731, 29.744, -0.181, 30.449}},
+	"UG-403": {1.454, 31.109, BoundingBox{1.035, 30.445, 1.791, 31.709}},
+	"UG-404": {-1.199, 30.016, BoundingBox{-1.422, 29.828, -1.008, 30.299}},
+	"UG-405": {0.857, 30.337, BoundingBox{0.381, 30.098, 1.545, 30.493}},
+	"UG-406": {0.121, 30.040, BoundingBox{-0.230, 29.808, 0.446, 30.270}},
+	"UG-407": {0.919, 31.004, BoundingBox{0.361, 30.590, 1.391, 31.406}},
+	"UG-408": {-1.255, 29.715, BoundingBox{-1.440, 29.580, -1.074, 29.856}},
+	"UG-409": {1.702, 31.827, BoundingBox{1.298, 31.419, 2.144, 32.184}},
+	"UG-410": {-0.579, 30.642, BoundingBox{-0.847, 30.411, -0.353, 30.947}},
+	"UG-411": {-0.927, 30.309, BoundingBox{-1.192, 30.048, -0.672, 30.547}},
+	"UG-412": {-0.679, 29.865, BoundingBox{-1.014, 29.590, -0.336, 30.123}},
+	"UG-413": {0.219, 30.524, BoundingBox{0.005, 30.260, 0.449, 30.922}},
+	"UG-414": {-0.934, 29.742, BoundingBox{-1.140, 29.584, -0.621, 30.008}},
+	"UG-415": {0.755, 30.655, BoundingBox{0.260, 30.434, 1.479, 30.979}},
+	"UG-416": {-0.159, 30.450, BoundingBox{-0.415, 30.129, 0.061, 30.718}},
+	"UG-417": {-0.860, 30.848, BoundingBox{-1.087, 30.529, -0.593, 31.157}},
+	"UG-418": {-0.104, 30.885, BoundingBox{-0.477, 30.623, 0.274, 31.132}},
+	"UG-419": {2.083, 31.418, BoundingBox{1.726, 30.767, 2.535, 31.892}},
+	"UG-C":   {0.119, 32.105, BoundingBox{-1.030, 30.898, 1.683, 33.342}},
+	"UG-E":   {0.875, 33.688, BoundingBox{-0.972, 32.766, 2.421, 34.885}},
+	"UG-N":   {2.822, 32.908, BoundingBox{1.236, 30.770, 4.250, 35.040}},
+	"UG-W":   {0.519, 30.719, BoundingBox{-1.440, 29.580, 2.535, 32.184}},
+	"UM-67":  {16.730, -169.575, BoundingBox{16.685, -169.577, 16.775, -169.483}},
+	"UM-71":  {28.210, -177.370, BoundingBox{28.144, -177.445, 28.276, -177.295}},
+	"UM-76":  {18.400, -75.010, BoundingBox{18.375, -75.036, 18.425, -74.984}},
+	"UM-79":  {19.300, 166.630, BoundingBox{19.250, 166.577, 19.350, 166.683}},
+	"UM-81":  {0.190, -176.480, BoundingBox{0.165, -176.505, 0.215, -176.455}},
+	"UM-84":  {0.810, -176.620, BoundingBox{0.785, -176.645, 0.835, -176.595}},
+	"UM-86":  {-0.370, -160.020, BoundingBox{-0.395, -160.045, -0.345, -159.995}},
+	"UM-89":  {6.400, -162.400, BoundingBox{6.358, -162.442, 6.442, -162.358}},
+	"UM-95":  {5.880, -162.080, BoundingBox{5.830, -162.130, 5.930, -162.030}},
+	"US-AK":  {64.73, -152.28, BoundingBox{51.21, 172.44, 71.39, -129.98}},
+	"US-AL":  {32.79, -86.83, BoundingBox{30.14, -88.47, 35.01, -84.89}},
+	"US-AR":  {34.90, -92.44, BoundingBox{33.00, -94.62, 36.50, -89.64}},
+	"US-AS":  {-14.30, -170.70, BoundingBox{-14.38, -170.84, -14.16, -169.42}},
+	"US-AZ":  {34.29, -111.66, BoundingBox{31.33, -114.82, 37.00, -109.05}},
+	"US-CA":  {37.18, -119.47, BoundingBox{32.53, -124.41, 42.01, -114.13}},
+	"US-CO":  {39.00, -105.55, BoundingBox{36.99, -109.06, 41.00, -102.04}},
+	"US-CT":  {41.62, -72.73, BoundingBox{40.95, -73.73, 42.05, -71.79}},
+	"US-DC":  {38.90, -77.02, BoundingBox{38.79, -77.12, 38.99, -76.91}},
+	"US-DE":  {38.99, -75.51, BoundingBox{38.45, -75.79, 39.84, -75.05}},
+	"US-FL":  {28.63, -82.45, BoundingBox{24.52, -87.63, 31.00, -80.03}},
+	"US-GA":  {32.64, -83.44, BoundingBox{30.36, -85.61, 35.00, -80.84}},
+	"US-GU":  {13.44, 144.79, BoundingBox{13.24, 144.62, 13.65, 144.96}},
+	"US-HI":  {19.595, -155.447, BoundingBox{18.91, -178.33, 28.40, -154.81}},
+	"US-IA":  {42.08, -93.50, BoundingBox{40.38, -96.64, 43.50, -90.14}},
+	"US-ID":  {44.35, -114.61, BoundingBox{41.99, -117.24, 49.00, -111.04}},
+	"US-IL":  {40.04, -89.20, BoundingBox{36.97, -91.51, 42.51, -87.02}},
+	"US-IN":  {39.89, -86.28, BoundingBox{37.77, -88.10, 41.76, -84.78}},
+	"US-KS":  {38.49, -98.38, BoundingBox{36.99, -102.05, 40.00, -94.59}},
+	"US-KY":  {37.53, -85.30, BoundingBox{36.50, -89.57, 39.15, -81.96}},
+	"US-LA":  {31.07, -92.00, BoundingBox{28.93, -94.04, 33.02, -88.82}},
+	"US-MA":  {42.26, -71.81, BoundingBox{41.24, -73.51, 42.89, -69.93}},
+	"US-MD":  {39.06, -76.80, BoundingBox{37.91, -79.49, 39.72, -75.05}},
+	"US-ME":  {45.37, -69.24, BoundingBox{42.98, -71.08, 47.46, -66.95}},
+	"US-MI":  {44.35, -85.41, BoundingBox{41.70, -90.42, 48.31, -82.41}},
+	"US-MN":  {46.28, -94.31, BoundingBox{43.50, -97.24, 49.38, -89.49}},
+	"US-MO":  {38.36, -92.46, BoundingBox{35.99, -95.77, 40.61, -89.10}},
+	"US-MP":  {15.180, 145.750, BoundingBox{14.11, 144.89, 20.55, 145.87}},
+	"US-MS":  {32.74, -89.67, BoundingBox{30.17, -91.66, 35.00, -88.10}},
+	"US-MT":  {47.05, -109.63, BoundingBox{44.36, -116.05, 49.00, -104.04}},
+	"US-NC":  {35.56, -79.39, BoundingBox{33.84, -84.32, 36.59, -75.46}},
+	"US-ND":  {47.45, -100.47, BoundingBox{45.94, -104.05, 49.00, -96.55}},
+	"US-NE":  {41.54, -99.80, BoundingBox{40.00, -104.05, 43.00, -95.31}},
+	"US-NH":  {43.68, -71.58, BoundingBox{42.70, -72.56, 45.31, -70.61}},
+	"US-NJ":  {40.19, -74.67, BoundingBox{38.93, -75.56, 41.36, -73.89}},
+	"US-NM":  {34.41, -106.11, BoundingBox{31.33, -109.05, 37.00, -103.00}},
+	"US-NV":  {39.33, -116.63, BoundingBox{35.00, -120.01, 42.00, -114.04}},
+	"US-NY":  {42.95, -75.53, BoundingBox{40.50, -79.76, 45.02, -71.86}},
+	"US-OH":  {40.29, -82.79, BoundingBox{38.40, -84.82, 41.98, -80.52}},
+	"US-OK":  {35.59, -97.49, BoundingBox{33.62, -103.00, 37.00, -94.43}},
+	"US-OR":  {43.93, -120.56, BoundingBox{41.99, -124.57, 46.29, -116.46}},
+	"US-PA":  {40.88, -77.80, BoundingBox{39.72, -80.52, 42.27, -74.69}},
+	"US-PR":  {18.22, -66.59, BoundingBox{17.88, -67.27, 18.52, -65.22}},
+	"US-RI":  {41.68, -71.56, BoundingBox{41.15, -71.91, 42.02, -71.12}},
+	"US-SC":  {33.92, -80.90, BoundingBox{32.03, -83.35, 35.22, -78.54}},
+	"US-SD":  {44.44, -100.23, BoundingBox{42.48, -104.06, 45.95, -96.44}},
+	"US-TN":  {35.86, -86.35, BoundingBox{34.98, -90.31, 36.68, -81.65}},
+	"US-TX":  {31.48, -99.33, BoundingBox{25.84, -106.65, 36.50, -93.51}},
+	"US-UM":  {5.88, -162.08, BoundingBox{-0.39, 166.60, 28.22, -160.02}},
+	"US-UT":  {39.31, -111.67, BoundingBox{37.00, -114.05, 42.00, -109.04}},
+	"US-VA":  {37.52, -78.85, BoundingBox{36.54, -83.68, 39.47, -75.24}},
+	"US-VI":  {17.730, -64.780, BoundingBox{17.67, -65.09, 18.42, -64.56}},
+	"US-VT":  {44.07, -72.67, BoundingBox{42.73, -73.44, 45.02, -71.46}},
+	"US-WA":  {47.38, -120.45, BoundingBox{45.54, -124.85, 49.00, -116.92}},
+	"US-WI":  {44.62, -89.99, BoundingBox{42.49, -92.89, 47.31, -86.25}},
+	"US-WV":  {38.64, -80.62, BoundingBox{37.20, -82.64, 40.64, -77.72}},
+	"US-WY":  {42.99, -107.55, BoundingBox{40.99, -111.06, 45.01, -104.05}},
+	"UY-AR":  {-30.633, -56.985, BoundingBox{-31.112, -57.815, -30.110, -56.050}},
+	"UY-CA":  {-34.458, -55.887, BoundingBox{-34.806, -56.378, -34.068, -55.432}},
+	"UY-CL":  {-32.282, -54.382, BoundingBox{-32.750, -55.262, -31.496, -53.210}},
+	"UY-CO":  {-34.135, -57.641, BoundingBox{-34.460, -58.378, -33.807, -57.099}},
+	"UY-DU":  {-32.930, -55.938, BoundingBox{-33.507, -56.730, -32.455, -55.138}},
+	"UY-FD":  {-33.764, -55.765, BoundingBox{-34.292, -56.367, -33.234, -55.226}},
+	"UY-FS":  {-33.536, -56.853, BoundingBox{-34.025, -57.428, -32.949, -56.251}},
+	"UY-LA":  {-33.846, -54.984, BoundingBox{-34.299, -55.439, -33.231, -54.506}},
+	"UY-MA":  {-34.561, -54.914, BoundingBox{-34.950, -55.465, -34.214, -54.196}},
+	"UY-MO":  {-34.729, -56.254, BoundingBox{-34.860, -56.551, -34.568, -55.898}},
+	"UY-PA":  {-32.029, -57.531, BoundingBox{-32.481, -58.140, -31.386, -56.677}},
+	"UY-RN":  {-32.709, -57.331, BoundingBox{-33.180, -58.136, -32.308, -56.581}},
+	"UY-RO":  {-33.966, -54.013, BoundingBox{-34.588, -54.591, -33.412, -53.370}},
+	"UY-RV":  {-31.349, -55.498, BoundingBox{-31.902, -56.272, -30.819, -54.561}},
+	"UY-SA":  {-31.367, -57.076, BoundingBox{-32.028, -57.967, -30.837, -56.243}},
+	"UY-SJ":  {-34.271, -56.680, BoundingBox{-34.705, -57.163, -33.903, -56.227}},
+	"UY-SO":  {-33.448, -57.884, BoundingBox{-33.957, -58.430, -32.834, -57.281}},
+	"UY-TA":  {-32.066, -55.919, BoundingBox{-32.549, -56.702, -31.430, -55.080}},
+	"UY-TT":  {-33.124, -54.319, BoundingBox{-33.668, -55.223, -32.750, -53.229}},
+	"UZ-AN":  {40.836, 72.285, BoundingBox{40.329, 71.752, 41.332, 73.060}},
+	"UZ-BU":  {40.165, 64.027, BoundingBox{38.750, 62.155, 41.253, 65.549}},
+	"UZ-FA":  {40.432, 71.315, BoundingBox{40.150, 70.460, 40.766, 72.091}},
+	"UZ-JI":  {40.531, 67.382, BoundingBox{39.347, 66.022, 41.990, 68.655}},
+	"UZ-NG":  {41.123, 71.148, BoundingBox{40.526, 70.472, 42.270, 72.003}},
+	"UZ-NW":  {42.364, 63.845, BoundingBox{40.807, 61.512, 43.754, 66.311}},
+	"UZ-QA":  {38.785, 65.990, BoundingBox{38.009, 64.470, 39.472, 67.655}},
+	"UZ-QR":  {43.644, 58.327, BoundingBox{41.310, 55.930, 45.590, 61.745}},
+	"UZ-SA":  {40.109, 66.238, BoundingBox{39.005, 65.169, 41.601, 67.757}},
+	"UZ-SI":  {40.419, 68.627, BoundingBox{39.666, 67.780, 41.145, 69.291}},
+	"UZ-SU":  {38.047, 67.488, BoundingBox{37.140, 66.430, 39.037, 68.390}},
+	"UZ-TK":  {41.285, 69.238, BoundingBox{41.105, 69.003, 41.419, 69.400}},
+	"UZ-TO":  {41.333, 69.896, BoundingBox{40.653, 68.746, 42.222, 70.816}},
+	"UZ-XO":  {41.916, 61.140, BoundingBox{40.502, 59.895, 43.164, 62.378}},
+	"VC-01":  {13.255, -61.115, BoundingBox{13.176, -61.195, 13.343, -61.055}},
+	"VC-02":  {13.167, -61.252, BoundingBox{13.109, -61.339, 13.224, -61.189}},
+	"VC-03":  {13.341, -61.202, BoundingBox{13.271, -61.316, 13.391, -61.090}},
+	"VC-04":  {13.158, -61.154, BoundingBox{13.109, -61.217, 13.218, -61.070}},
+	"VC-05":  {13.257, -61.282, BoundingBox{13.185, -61.345, 13.337, -61.195}},
+	"VC-06":  {13.000, -61.230, BoundingBox{12.755, -61.346, 13.050, -61.179}},
+	"VE-A":   {10.493, -66.928, BoundingBox{10.409, -67.038, 10.575, -66.818}},
+	"VE-B":   {8.587, -64.622, BoundingBox{7.043, -65.535, 10.098, -63.369}},
+	"VE-C":   {7.018, -68.478, BoundingBox{5.591, -70.322, 8.176, -66.746}},
+	"VE-D":   {9.717, -67.321, BoundingBox{9.055, -67.782, 10.412, -66.740}},
+	"VE-E":   {8.029, -70.060, BoundingBox{7.012, -70.707, 9.002, -69.112}},
+	"VE-F":   {5.911, -63.153, BoundingBox{3.770, -65.901, 7.921, -60.600}},
+	"VE-G":   {10.105, -68.021, BoundingBox{9.564, -68.461, 10.694, -67.639}},
+	"VE-H":   {8.945, -68.127, BoundingBox{7.955, -68.914, 9.815, -67.381}},
+	"VE-I":   {11.150, -69.983, BoundingBox{10.555, -71.523, 12.160, -68.761}},
+	"VE-J":   {7.970, -66.454, BoundingBox{6.298, -67.753, 9.493, -65.414}},
+	"VE-K":   {10.135, -69.806, BoundingBox{9.398, -70.813, 10.690, -69.100}},
+	"VE-L":   {8.289, -71.212, BoundingBox{7.068, -72.195, 9.176, -70.570}},
+	"VE-M":   {9.986, -66.214, BoundingBox{9.417, -67.428, 10.605, -65.007}},
+	"VE-N":   {9.046, -62.944, BoundingBox{7.798, -63.919, 10.166, -61.961}},
+	"VE-O":   {10.786, -64.076, BoundingBox{10.037, -64.883, 11.291, -63.654}},
+	"VE-P":   {8.884, -69.155, BoundingBox{8.099, -69.912, 9.707, -68.421}},
+	"VE-R":   {10.217, -63.493, BoundingBox{9.550, -64.708, 10.720, -61.880}},
+	"VE-S":   {7.839, -71.938, BoundingBox{6.990, -72.745, 8.931, -70.953}},
+	"VE-T":   {9.522, -70.576, BoundingBox{8.706, -71.249, 10.585, -69.788}},
+	"VE-U":   {10.462, -68.757, BoundingBox{9.636, -69.299, 11.340, -68.207}},
+	"VE-V":   {10.142, -72.195, BoundingBox{8.906, -73.300, 11.729, -70.892}},
+	"VE-W":   {11.800, -66.700, BoundingBox{11.755, -66.746, 11.845, -66.654}},
+	"VE-X":   {10.515, -67.435, BoundingBox{10.342, -67.727, 10.650, -66.130}},
+	"VE-Y":   {8.214, -61.386, BoundingBox{6.492, -62.673, 9.907, -59.760}},
+	"VE-Z":   {3.549, -65.884, BoundingBox{0.720, -67.820, 6.387, -63.326}},
+	"VN-01":  {22.351, 103.058, BoundingBox{21.831, 102.170, 22.718, 103.650}},
+	"VN-02":  {22.313, 104.069, BoundingBox{21.752, 103.650, 22.809, 104.672}},
+	"VN-03":  {22.811, 105.065, BoundingBox{22.341, 104.394, 23.350, 105.589}},
+	"VN-04":  {22.672, 106.122, BoundingBox{22.252, 105.543, 23.150, 106.730}},
+	"VN-05":  {21.171, 103.927, BoundingBox{20.606, 103.168, 21.781, 104.593}},
+	"VN-06":  {21.787, 104.560, BoundingBox{21.271, 104.027, 22.347, 105.028}},
+	"VN-07":  {22.109, 105.174, BoundingBox{21.685, 104.688, 22.537, 105.545}},
+	"VN-09":  {21.874, 106.605, BoundingBox{21.458, 106.166, 22.426, 107.196}},
+	"VN-13":  {21.392, 107.321, BoundingBox{20.907, 106.845, 21.770, 108.050}},
+	"VN-14":  {20.591, 105.043, BoundingBox{20.165, 104.507, 21.027, 105.612}},
+	"VN-15":  {20.892, 105.570, BoundingBox{20.575, 105.287, 21.131, 105.814}},
+	"VN-18":  {20.181, 105.854, BoundingBox{19.797, 105.525, 20.453, 106.100}},
+	"VN-20":  {20.543, 106.363, BoundingBox{20.323, 106.145, 20.700, 106.616}},
+	"VN-21":  {19.882, 105.372, BoundingBox{19.241, 104.700, 20.383, 105.922}},
+	"VN-22":  {19.260, 104.858, BoundingBox{18.573, 103.900, 19.880, 105.718}},
+	"VN-23":  {18.362, 105.746, BoundingBox{17.731, 105.159, 19.046, 106.369}},
+	"VN-24":  {17.504, 106.342, BoundingBox{16.878, 105.758, 18.084, 106.901}},
+	"VN-25":  {16.759, 106.898, BoundingBox{16.178, 106.363, 17.342, 107.413}},
+	"VN-26":  {16.199, 107.486, BoundingBox{15.726, 107.019, 16.664, 107.939}},
+	"VN-27":  {15.516, 107.949, BoundingBox{15.150, 107.375, 15.925, 108.632}},
+	"VN-28":  {14.664, 107.909, BoundingBox{14.139, 107.380, 15.150, 108.551}},
+	"VN-29":  {14.967, 108.648, BoundingBox{14.417, 108.211, 15.605, 109.060}},
+	"VN-30":  {13.833, 108.079, BoundingBox{13.350, 107.401, 14.401, 108.654}},
+	"VN-31":  {14.005, 108.901, BoundingBox{13.550, 108.539, 14.558, 109.310}},
+	"VN-32":  {13.145, 108.934, BoundingBox{12.682, 108.438, 13.550, 109.340}},
+	"VN-33":  {12.871, 108.129, BoundingBox{12.250, 107.538, 13.350, 108.709}},
+	"VN-34":  {12.346, 108.915, BoundingBox{11.943, 108.434, 12.715, 109.283}},
+	"VN-35":  {11.745, 108.174, BoundingBox{11.338, 107.536, 12.250, 108.550}},
+	"VN-36":  {11.712, 108.824, BoundingBox{11.226, 108.550, 12.098, 109.222}},
+	"VN-37":  {11.468, 106.178, BoundingBox{11.076, 105.810, 11.806, 106.469}},
+	"VN-39":  {11.113, 107.311, BoundingBox{10.750, 106.886, 11.599, 107.686}},
+	"VN-40":  {11.132, 108.019, BoundingBox{10.660, 107.598, 11.485, 108.641}},
+	"VN-41":  {10.737, 106.125, BoundingBox{10.466, 105.795, 10.959, 106.458}},
+	"VN-43":  {10.575, 107.290, BoundingBox{10.203, 106.983, 10.750, 107.751}},
+	"VN-44":  {10.540, 105.059, BoundingBox{10.207, 104.516, 10.905, 105.425}},
+	"VN-45":  {10.587, 105.657, BoundingBox{10.249, 105.425, 10.930, 105.978}},
+	"VN-46":  {10.406, 106.315, BoundingBox{10.160, 105.978, 10.614, 106.581}},
+	"VN-47":  {10.004, 105.031, BoundingBox{9.450, 104.330, 10.576, 105.401}},
+	"VN-49":  {10.155, 106.013, BoundingBox{9.865, 105.783, 10.466, 106.254}},
+	"VN-50":  {10.076, 106.574, BoundingBox{9.788, 106.242, 10.362, 106.918}},
+	"VN-51":  {9.755, 106.311, BoundingBox{9.414, 106.011, 10.048, 106.662}},
+	"VN-52":  {9.551, 105.955, BoundingBox{9.222, 105.642, 9.878, 106.255}},
+	"VN-53":  {22.236, 105.811, BoundingBox{21.901, 105.430, 22.676, 106.307}},
+	"VN-54":  {21.385, 106.482, BoundingBox{21.108, 106.100, 21.696, 106.883}},
+	"VN-55":  {9.279, 105.524, BoundingBox{8.882, 105.047, 9.550, 105.996}},
+	"VN-56":  {21.204, 106.090, BoundingBox{20.964, 105.854, 21.458, 106.337}},
+	"VN-57":  {11.273, 106.698, BoundingBox{11.000, 106.356, 11.539, 107.041}},
+	"VN-58":  {11.746, 106.917, BoundingBox{11.395, 106.325, 12.179, 107.454}},
+	"VN-59":  {9.044, 105.138, BoundingBox{8.600, 104.800, 9.450, 105.539}},
+	"VN-61":  {20.914, 106.360, BoundingBox{20.700, 106.159, 21.129, 106.542}},
+	"VN-63":  {20.551, 105.879, BoundingBox{20.384, 105.582, 20.749, 106.162}},
+	"VN-66":  {20.797, 106.050, BoundingBox{20.615, 105.801, 20.981, 106.254}},
+	"VN-67":  {20.277, 106.176, BoundingBox{19.999, 106.030, 20.490, 106.386}},
+	"VN-68":  {21.258, 104.963, BoundingBox{20.813, 104.548, 21.714, 105.352}},
+	"VN-69":  {21.694, 105.874, BoundingBox{21.349, 105.431, 21.954, 106.241}},
+	"VN-70":  {21.397, 105.520, BoundingBox{21.102, 105.282, 21.749, 105.864}},
+	"VN-71":  {21.633, 103.092, BoundingBox{20.836, 102.337, 22.235, 103.679}},
+	"VN-72":  {12.161, 107.675, BoundingBox{11.567, 107.139, 12.818, 108.275}},
+	"VN-73":  {9.751, 105.514, BoundingBox{9.550, 105.199, 10.017, 105.857}},
+	"VN-CT":  {10.083, 105.654, BoundingBox{9.848, 105.384, 10.286, 105.930}},
+	"VN-DN":  {15.974, 108.118, BoundingBox{15.668, 107.752, 16.305, 108.584}},
+	"VN-HN":  {21.062, 105.833, BoundingBox{20.806, 105.632, 21.323, 106.032}},
+	"VN-HP":  {20.927, 106.724, BoundingBox{20.583, 106.468, 21.248, 107.044}},
+	"VN-SG":  {10.684, 106.686, BoundingBox{10.050, 106.166, 11.252, 107.067}},
+	"VU-MAP": {-16.333, 167.687, BoundingBox{-16.800, 167.180, -15.890, 168.334}},
+	"VU-PAM": {-15.594, 168.180, BoundingBox{-15.916, 167.695, -15.250, 168.353}},
+	"VU-SAM": {-15.222, 166.908, BoundingBox{-15.740, 166.630, -14.630, 167.270}},
+	"VU-SEE": {-17.670, 168.400, BoundingBox{-17.878, 168.182, -17.462, 168.618}},
+	"VU-TAE": {-18.800, 169.100, BoundingBox{-20.283, 168.881, -18.592, 169.889}},
+	"VU-TOB": {-14.270, 167.500, BoundingBox{-14.466, 167.329, -13.755, 167.671}},
+	"WS-AA":  {-13.964, -171.945, BoundingBox{-14.236, -172.075, -13.652, -171.795}},
+	"WS-AL":  {-14.017, -171.457, BoundingBox{-14.114, -171.490, -13.915, -171.425}},
+	"WS-AT":  {-14.105, -171.652, BoundingBox{-14.236, -171.838, -13.948, -171.490}},
+	"WS-FA":  {-13.562, -172.211, BoundingBox{-13.760, -172.368, -13.340, -172.105}},
+	"WS-GE":  {-13.444, -172.409, BoundingBox{-13.640, -172.525, -13.284, -172.238}},
+	"WS-GI":  {-13.481, -172.596, BoundingBox{-13.687, -172.710, -13.284, -172.525}},
+	"WS-PA":  {-13.784, -172.511, BoundingBox{-13.916, -172.698, -13.640, -172.361}},
+	"WS-SA":  {-13.772, -172.297, BoundingBox{-13.916, -172.406, -13.622, -172.135}},
+	"WS-TU":  {-13.786, -171.754, BoundingBox{-14.101, -171.934, -13.604, -171.563}},
+	"WS-VF":  {-13.849, -171.536, BoundingBox{-13.992, -171.675, -13.654, -171.425}},
+	"WS-VS":  {-13.620, -172.702, BoundingBox{-13.786, -172.755, -13.425, -172.618}},
+	"YE-AB":  {13.737, 46.331, BoundingBox{13.178, 45.374, 14.383, 47.456}},
+	"YE-AD":  {13.019, 45.070, BoundingBox{12.700, 44.533, 13.359, 45.535}},
+	"YE-AM":  {16.226, 44.088, BoundingBox{15.736, 43.398, 16.664, 44.769}},
+	"YE-BA":  {14.346, 45.571, BoundingBox{13.628, 44.869, 14.800, 46.374}},
+	"YE-DA":  {13.738, 44.905, BoundingBox{13.297, 44.355, 14.315, 45.398}},
+	"YE-DH":  {14.645, 44.487, BoundingBox{14.230, 43.952, 15.051, 45.044}},
+	"YE-HD":  {16.133, 49.400, BoundingBox{11.982, 47.327, 18.699, 54.361}},
+	"YE-HJ":  {16.073, 43.167, BoundingBox{15.462, 42.700, 16.800, 43.694}},
+	"YE-IB":  {14.022, 44.163, BoundingBox{13.580, 43.549, 14.346, 44.704}},
+	"YE-JA":  {16.641, 45.871, BoundingBox{15.950, 44.666, 17.430, 47.379}},
+	"YE-LA":  {13.074, 44.465, BoundingBox{12.590, 43.908, 13.578, 45.112}},
+	"YE-MA":  {15.407, 45.586, BoundingBox{14.800, 44.826, 15.950, 46.465}},
+	"YE-MR":  {17.162, 51.290, BoundingBox{14.989, 49.722, 19.000, 53.110}},
+	"YE-MU":  {14.832, 43.080, BoundingBox{13.954, 42.600, 15.468, 43.466}},
+	"YE-MW":  {15.428, 43.485, BoundingBox{15.034, 42.801, 15.838, 43.931}},
+	"YE-RA":  {14.531, 43.610, BoundingBox{13.949, 43.149, 15.076, 44.001}},
+	"YE-SD":  {17.024, 44.038, BoundingBox{16.517, 43.120, 17.580, 44.993}},
+	"YE-SH":  {15.019, 47.325, BoundingBox{13.666, 46.135, 16.750, 48.769}},
+	"YE-SN":  {15.430, 44.379, BoundingBox{14.944, 43.821, 16.011, 44.863}},
+	"YE-TA":  {13.339, 43.700, BoundingBox{12.609, 43.151, 14.029, 44.355}},
+	"ZA-EC":  {-31.959, 26.141, BoundingBox{-33.990, 23.235, -30.350, 29.732}},
+	"ZA-FS":  {-28.879, 26.113, BoundingBox{-30.350, 23.830, -27.232, 28.650}},
+	"ZA-GT":  {-26.081, 27.971, BoundingBox{-28.042, 26.546, -24.038, 29.455}},
+	"ZA-LP":  {-23.543, 29.521, BoundingBox{-25.005, 26.889, -22.090, 31.858}},
+	"ZA-MP":  {-25.763, 30.458, BoundingBox{-27.396, 29.033, -24.173, 32.830}},
+	"ZA-NC":  {-29.258, 20.912, BoundingBox{-31.932, 16.340, -24.770, 24.259}},
+	"ZA-NL":  {-28.825, 30.429, BoundingBox{-31.439, 28.650, -26.956, 32.756}},
+	"ZA-NW":  {-26.556, 24.685, BoundingBox{-28.279, 21.961, -24.549, 26.947}},
+	"ZA-WC":  {-32.869, 20.563, BoundingBox{-34.820, 17.657, -30.854, 23.852}},
+	"ZM-01":  {-15.368, 23.563, BoundingBox{-17.520, 21.890, -12.900, 25.803}},
+	"ZM-02":  {-14.191, 28.535, BoundingBox{-15.030, 26.150, -13.197, 30.307}},
+	"ZM-03":  {-12.886, 31.655, BoundingBox{-14.589, 30.000, -11.271, 33.310}},
+	"ZM-04":  {-10.687, 29.413, BoundingBox{-12.711, 28.370, -8.366, 30.739}},
+	"ZM-05":  {-9.976, 31.462, BoundingBox{-11.660, 29.350, -8.240, 33.490}},
+	"ZM-06":  {-12.901, 24.960, BoundingBox{-14.737, 21.931, -10.930, 26.655}},
+	"ZM-07":  {-16.562, 26.380, BoundingBox{-17.960, 24.762, -14.645, 28.267}},
+	"ZM-08":  {-13.087, 27.569, BoundingBox{-14.395, 26.211, -11.610, 30.000}},
+	"ZM-09":  {-15.251, 28.579, BoundingBox{-16.661, 26.011, -13.875, 30.951}},
+	"ZW-BU":  {-20.132, 28.587, BoundingBox{-20.267, 28.439, -19.948, 28.690}},
+	"ZW-HA":  {-18.085, 30.751, BoundingBox{-18.451, 30.156, -17.707, 31.245}},
+	"ZW-MA":  {-19.333, 32.189, BoundingBox{-20.336, 31.299, -18.319, 32.770}},
+	"ZW-MC":  {-16.708, 31.393, BoundingBox{-17.748, 29.923, -15.510, 32.850}},
+	"ZW-ME":  {-18.246, 31.702, BoundingBox{-19.327, 30.214, -17.148, 32.850}},
+	"ZW-MI":  {-19.207, 29.827, BoundingBox{-20.234, 28.686, -18.400, 31.048}},
+	"ZW-MN":  {-18.817, 27.441, BoundingBox{-20.743, 25.260, -16.834, 29.020}},
+	"ZW-MS":  {-21.016, 29.324, BoundingBox{-22.270, 27.727, -19.431, 30.820}},
+	"ZW-MV":  {-20.700, 31.301, BoundingBox{-22.250, 30.180, -19.323, 32.606}},
+	"ZW-MW":  {-17.162, 29.547, BoundingBox{-18.400, 28.084, -15.570, 31.032}},
+}
@@ -0,0 +1,241 @@
+// Package iban validates, formats and generates International Bank Account Numbers (ISO 13616) by the IBAN registry
+// keyed by the codes of package countries.
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/xianb/countries"
+)
+
+// Errors of Parse, use errors.Is to check them; countries.ErrNotFound is returned for countries without IBAN
+var (
+	ErrInvalidLength    = errors.New("invalid length")
+	ErrInvalidStructure = errors.New("invalid BBAN structure")
+	ErrInvalidChecksum  = errors.New("invalid checksum")
+)
+
+// Spec - 国家的 IBAN 规范
+type Spec struct {
+	Country countries.CountryCode `json:"country"` // 国家代码
+	Length  int                   `json:"length"`  // IBAN 长度
+	BBAN    string                `json:"bban"`    // BBAN 结构（SWIFT 表示法），例如 "8!n10!n"
+	SEPA    bool                  `json:"sepa"`    // 是否为 SEPA 成员
+	bank    [2]int                // BBAN 中银行代码的位置
+	branch  [2]int                // BBAN 中分行代码的位置
+}
+
+// IBAN - 国际银行账号
+type IBAN struct {
+	Country     countries.CountryCode `json:"country"`     // 国家代码
+	CheckDigits string                `json:"checkDigits"` // 校验码
+	BBAN        string                `json:"bban"`        // 基本银行账号
+	BankID      string                `json:"bankId"`      // 银行代码
+	BranchID    string                `json:"branchId"`    // 分行代码，没有时为空
+}
+
+// part - a part of the BBAN structure: length, character class and whether the length is fixed
+type part struct {
+	length int
+	class  byte
+	fixed  bool
+}
+
+// Lookup - returns the IBAN specification of the country, example: iban.Lookup(countries.DEU) has Length 22,
+// BBAN "8!n10!n" and SEPA true; returns countries.ErrNotFound for countries without IBAN
+func Lookup(c countries.CountryCode) (*Spec, error) {
+	bban, bank, branch, sepa := registry(c)
+	if bban == "" {
+		return nil, fmt.Errorf("iban::Lookup: country %v err: %w", c, countries.ErrNotFound)
+	}
+	length := 4
+	for _, p := range structure(bban) {
+		length += p.length
+	}
+	return &Spec{Country: c, Length: length, BBAN: bban, SEPA: sepa, bank: bank, branch: branch}, nil
+}
+
+// All - returns specifications of all countries of the IBAN registry in the order of countries.All()
+func All() []*Spec {
+	var specs []*Spec
+	for _, c := range countries.All() {
+		if spec, err := Lookup(c); err == nil {
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
+
+// Parse - returns the IBAN of the electronic ("DE89370400440532013000") or the print ("DE89 3704 0044 0532 0130 00") form,
+// case-insensitive, an "IBAN" prefix is skipped; errors are countries.ErrNotFound for countries without IBAN,
+// ErrInvalidLength, ErrInvalidStructure and ErrInvalidChecksum (also for check digits 00, 01 and 99, valid ones are 02 to 98)
+func Parse(s string) (*IBAN, error) {
+	code := strings.ToUpper(strings.NewReplacer(" ", "", "-", "", "\t", "").Replace(s))
+	code = strings.TrimPrefix(code, "IBAN")
+	if len(code) < 4 {
+		return nil, fmt.Errorf("iban::Parse: %q err: %w", s, ErrInvalidLength)
+	}
+	spec, err := Lookup(countries.ByAlpha2(code[:2]))
+	if err != nil {
+		return nil, fmt.Errorf("iban::Parse: %q err: %w", s, countries.ErrNotFound)
+	}
+	if len(code) != spec.Length {
+		return nil, fmt.Errorf("iban::Parse: %q, %v IBAN has %d characters err: %w", s, spec.Country, spec.Length, ErrInvalidLength)
+	}
+	if !matches("2!n", code[2:4]) || !matches(spec.BBAN, code[4:]) {
+		return nil, fmt.Errorf("iban::Parse: %q, %v BBAN is %s err: %w", s, spec.Country, spec.BBAN, ErrInvalidStructure)
+	}
+	if check, _ := strconv.Atoi(code[2:4]); check < 2 || check > 98 || mod97(code[4:]+code[:4]) != 1 {
+		return nil, fmt.Errorf("iban::Parse: %q err: %w", s, ErrInvalidChecksum)
+	}
+	return spec.iban(code[4:], code[2:4]), nil
+}
+
+// Validate - returns nil, if the IBAN is valid, errors are as of Parse
+func Validate(s string) error {
+	_, err := Parse(s)
+	return err
+}
+
+// New - returns the IBAN of the country and the BBAN with computed check digits, example: iban.New(countries.DEU, "370400440532013000")
+// returns DE89370400440532013000; errors are countries.ErrNotFound and ErrInvalidStructure
+func New(c countries.CountryCode, bban string) (*IBAN, error) {
+	spec, err := Lookup(c)
+	if err != nil {
+		return nil, fmt.Errorf("iban::New: country %v err: %w", c, countries.ErrNotFound)
+	}
+	bban = strings.ToUpper(strings.ReplaceAll(bban, " ", ""))
+	if !matches(spec.BBAN, bban) {
+		return nil, fmt.Errorf("iban::New: %q, %v BBAN is %s err: %w", bban, c, spec.BBAN, ErrInvalidStructure)
+	}
+	return spec.iban(bban, checkDigits(spec.Country.Alpha2(), bban)), nil
+}
+
+// Generate - returns a random valid IBAN of the country for tests, the BBAN follows the structure of the registry
+// with national check digits of BE, ES, FR, IT, NO and PT (other national check digits are not computed);
+// uses the default source of math/rand, if r is nil; returns countries.ErrNotFound for countries without IBAN
+func Generate(c countries.CountryCode, r *rand.Rand) (*IBAN, error) {
+	spec, err := Lookup(c)
+	if err != nil {
+		return nil, fmt.Errorf("iban::Generate: country %v err: %w", c, countries.ErrNotFound)
+	}
+	intn := rand.Intn
+	if r != nil {
+		intn = r.Intn
+	}
+	for {
+		var b strings.Builder
+		for _, p := range structure(spec.BBAN) {
+			alphabet := charset(p.class)
+			for i := 0; i < p.length; i++ {
+				b.WriteByte(alphabet[intn(len(alphabet))])
+			}
+		}
+		if bban, ok := national(spec.Country, b.String()); ok {
+			return New(spec.Country, bban)
+		}
+	}
+}
+
+// String - implements fmt.Stringer, returns the electronic form of the IBAN, example: "DE89370400440532013000"
+func (i *IBAN) String() string {
+	return i.Country.Alpha2() + i.CheckDigits + i.BBAN
+}
+
+// PrintFormat - returns the print form of the IBAN in groups of four characters, example: "DE89 3704 0044 0532 0130 00"
+func (i *IBAN) PrintFormat() string {
+	code := i.String()
+	groups := make([]string, 0, len(code)/4+1)
+	for len(code) > 4 {
+		groups = append(groups, code[:4])
+		code = code[4:]
+	}
+	return strings.Join(append(groups, code), " ")
+}
+
+// IsSEPA - returns true, if the country of the IBAN is a member of the Single Euro Payments Area
+func (i *IBAN) IsSEPA() bool {
+	spec, err := Lookup(i.Country)
+	return err == nil && spec.SEPA
+}
+
+// iban - returns the IBAN of the BBAN and the check digits with bank and branch identifiers
+func (s *Spec) iban(bban, check string) *IBAN {
+	return &IBAN{
+		Country: s.Country, CheckDigits: check, BBAN: bban, BankID: bban[s.bank[0]:s.bank[1]], BranchID: bban[s.branch[0]:s.branch[1]],
+	}
+}
+
+// structure - returns parts of the BBAN structure in SWIFT notation, example: "4!a6!n8!n"
+func structure(bban string) []part {
+	var parts []part
+	for i := 0; i < len(bban); {
+		j := i
+		for j < len(bban) && bban[j] >= '0' && bban[j] <= '9' {
+			j++
+		}
+		length, _ := strconv.Atoi(bban[i:j])
+		p := part{length: length}
+		if j < len(bban) && bban[j] == '!' {
+			p.fixed = true
+			j++
+		}
+		if j < len(bban) {
+			p.class = bban[j]
+		}
+		parts = append(parts, p)
+		i = j + 1
+	}
+	return parts
+}
+
+// matches - returns true, if the value matches the BBAN structure
+func matches(bban, value string) bool {
+	for _, p := range structure(bban) {
+		n := 0
+		for n < p.length && n < len(value) && strings.IndexByte(charset(p.class), value[n]) >= 0 {
+			n++
+		}
+		if n == 0 || (p.fixed && n != p.length) {
+			return false
+		}
+		value = value[n:]
+	}
+	return value == ""
+}
+
+// charset - returns characters of the class of the SWIFT notation
+func charset(class byte) string {
+	switch class {
+	case 'n':
+		return "0123456789"
+	case 'a':
+		return "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	case 'c':
+		return "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	}
+	return ""
+}
+
+// checkDigits - returns the ISO 7064 MOD 97-10 check digits of the IBAN of the country and the BBAN
+func checkDigits(alpha2, bban string) string {
+	return fmt.Sprintf("%02d", 98-mod97(bban+alpha2+"00"))
+}
+
+// mod97 - returns the remainder of the number of the code divided by 97, letters are 10 (A) to 35 (Z)
+func mod97(code string) int {
+	remainder := 0
+	for i := 0; i < len(code); i++ {
+		switch c := code[i]; {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		}
+	}
+	return remainder
+}
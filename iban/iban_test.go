@@ -0,0 +1,135 @@
+package iban
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/xianb/countries"
+)
+
+//nolint:gocyclo
+func TestParse(t *testing.T) {
+	valid := []struct {
+		iban, bank, branch string
+		country            countries.CountryCode
+	}{
+		{"DE89370400440532013000", "37040044", "", countries.DEU},
+		{"GB29 NWBK 6016 1331 9268 19", "NWBK", "601613", countries.GBR},
+		{"fr1420041010050500013m02606", "20041", "01005", countries.FRA},
+		{"IBAN NL91ABNA0417164300", "ABNA", "", countries.NLD},
+		{"BE68539007547034", "539", "", countries.BEL},
+		{"CH9300762011623852957", "00762", "", countries.CHE},
+		{"IT60X0542811101000000123456", "05428", "11101", countries.ITA},
+		{"ES9121000418450200051332", "2100", "0418", countries.ESP},
+		{"AT611904300234573201", "19043", "", countries.AUT},
+		{"NO9386011117947", "8601", "", countries.NOR},
+		{"MT84MALT011000012345MTLCAST001S", "MALT", "01100", countries.MLT},
+		{"SA0380000000608010167519", "80", "", countries.SAU},
+		{"BR9700360305000010009795493P1", "00360305", "00001", countries.BRA},
+		{"PL61109010140000071219812874", "10901014", "", countries.POL},
+		{"SE4550000000058398257466", "500", "", countries.SWE},
+	}
+	for _, v := range valid {
+		out, err := Parse(v.iban)
+		if err != nil || out.Country != v.country || out.BankID != v.bank || out.BranchID != v.branch {
+			t.Errorf("Test Parse() err, iban %v, want %v, got %v, %v", v.iban, v.bank, out, err)
+		}
+	}
+	invalid := []struct {
+		iban string
+		want error
+	}{
+		{"DE89370400440532013001", ErrInvalidChecksum},
+		{"DE8937040044053201300", ErrInvalidLength},
+		{"GB29NWBK6016133192681A", ErrInvalidStructure},
+		{"US12345678901234567890", countries.ErrNotFound},
+		{"XX", ErrInvalidLength},
+		{"DEXX370400440532013000", ErrInvalidStructure},
+		{"DE99370400440532013014", ErrInvalidChecksum},
+		{"DE00370400440532013050", ErrInvalidChecksum},
+		{"DE01370400440532013032", ErrInvalidChecksum},
+	}
+	for _, v := range invalid {
+		if _, err := Parse(v.iban); !errors.Is(err, v.want) || Validate(v.iban) == nil {
+			t.Errorf("Test Parse() err, iban %v, want %v, got %v", v.iban, v.want, err)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	out, err := Parse("DE89370400440532013000")
+	if err != nil || out.PrintFormat() != "DE89 3704 0044 0532 0130 00" || out.String() != "DE89370400440532013000" || !out.IsSEPA() {
+		t.Errorf("Test PrintFormat() err, want %v, got %v, %v", "DE89 3704 0044 0532 0130 00", out, err)
+	}
+	if out, err = New(countries.DEU, "370400440532013000"); err != nil || out.CheckDigits != "89" {
+		t.Errorf("Test New() err, want %v, got %v, %v", "89", out, err)
+	}
+	if out, err = Parse("AE070331234567890123456"); err != nil || out.IsSEPA() {
+		t.Errorf("Test IsSEPA() err, want %v, got %v, %v", false, out, err)
+	}
+	if _, err = New(countries.DEU, "37040044053201300A"); !errors.Is(err, ErrInvalidStructure) {
+		t.Errorf("Test New() err, want %v, got %v", ErrInvalidStructure, err)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	specs := All()
+	if len(specs) < 80 {
+		t.Errorf("Test All() err, want the IBAN registry, got %v countries", len(specs))
+	}
+	r := rand.New(rand.NewSource(1))
+	for _, spec := range specs {
+		out, err := Generate(spec.Country, r)
+		if err != nil || len(out.String()) != spec.Length || Validate(out.PrintFormat()) != nil {
+			t.Errorf("Test Generate() err, country %v, got %v, %v", spec.Country, out, err)
+		}
+	}
+	if spec, err := Lookup(countries.DEU); err != nil || spec.Length != 22 || spec.BBAN != "8!n10!n" || !spec.SEPA {
+		t.Errorf("Test Lookup() err, want %v, got %v, %v", 22, spec, err)
+	}
+	if spec, err := Lookup(countries.NOR); err != nil || spec.Length != 15 {
+		t.Errorf("Test Lookup() err, want %v, got %v, %v", 15, spec, err)
+	}
+	if _, err := Lookup(countries.USA); !errors.Is(err, countries.ErrNotFound) {
+		t.Errorf("Test Lookup() err, want %v, got %v", countries.ErrNotFound, err)
+	}
+	if _, err := Generate(countries.USA, nil); !errors.Is(err, countries.ErrNotFound) {
+		t.Errorf("Test Generate() err, want %v, got %v", countries.ErrNotFound, err)
+	}
+	if out, err := Generate(countries.FRA, nil); err != nil || out.Country != countries.FRA {
+		t.Errorf("Test Generate() err, want %v, got %v, %v", countries.FRA, out, err)
+	}
+}
+
+func TestNational(t *testing.T) {
+	for _, code := range []string{
+		"BE68539007547034", "ES9121000418450200051332", "FR1420041010050500013M02606", "IT60X0542811101000000123456",
+		"NO9386011117947", "PT50000201231234567890154",
+	} {
+		out, err := Parse(code)
+		if err != nil {
+			t.Errorf("Test Parse() err, iban %v, got %v", code, err)
+			continue
+		}
+		if bban, ok := national(out.Country, out.BBAN); !ok || bban != out.BBAN {
+			t.Errorf("Test national() err, want %v, got %v, %v", out.BBAN, bban, ok)
+		}
+	}
+	r := rand.New(rand.NewSource(1))
+	for _, c := range []countries.CountryCode{countries.BEL, countries.ESP, countries.FRA, countries.ITA, countries.NOR, countries.PRT} {
+		for i := 0; i < 100; i++ {
+			out, err := Generate(c, r)
+			if err != nil {
+				t.Errorf("Test Generate() err, country %v, got %v", c, err)
+				continue
+			}
+			if bban, ok := national(c, out.BBAN); !ok || bban != out.BBAN {
+				t.Errorf("Test Generate() err, country %v, want national check digits %v, got %v", c, bban, out.BBAN)
+			}
+		}
+	}
+	if _, ok := national(countries.NOR, "86011117050"); ok {
+		t.Errorf("Test national() err, want no check digit of %v", "8601111705")
+	}
+}
@@ -0,0 +1,95 @@
+package iban
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xianb/countries"
+)
+
+// national - returns the BBAN with the national check digits computed for the countries which have them in the BBAN
+// (BE, ES, FR, IT, NO and PT), the BBAN as is for other countries; false, if no check digits exist for the BBAN
+// (a Norwegian account with the remainder 1)
+func national(c countries.CountryCode, bban string) (string, bool) { //nolint:gocyclo
+	switch c {
+	case countries.BE:
+		// bank and account modulo 97, 97 instead of 0
+		check := mod97(bban[:10])
+		if check == 0 {
+			check = 97
+		}
+		return fmt.Sprintf("%s%02d", bban[:10], check), true
+	case countries.ES:
+		// two MOD 11 digits: the bank and the branch, the account number
+		return bban[:8] + mod11ES("00"+bban[:8]) + mod11ES(bban[10:]) + bban[10:], true
+	case countries.FR:
+		// RIB key: 97 - (89 × bank + 15 × branch + 3 × account) modulo 97, letters of the account are digits
+		var account strings.Builder
+		for i := 10; i < 21; i++ {
+			account.WriteByte(ribDigit(bban[i]))
+		}
+		key := 97 - (89*mod97(bban[:5])+15*mod97(bban[5:10])+3*mod97(account.String()))%97
+		return fmt.Sprintf("%s%02d", bban[:21], key), true
+	case countries.IT:
+		// CIN: a letter of the sum of the bank, the branch and the account, characters at odd positions by a table
+		sum := 0
+		for i := 1; i < 23; i++ {
+			value := int(bban[i] - '0')
+			if bban[i] >= 'A' {
+				value = int(bban[i] - 'A')
+			}
+			if i%2 == 1 {
+				value = cinOdd[value]
+			}
+			sum += value
+		}
+		return string(rune('A'+sum%26)) + bban[1:], true
+	case countries.NO:
+		// MOD 11 of the bank and the account with weights 5, 4, 3, 2, 7, 6, 5, 4, 3, 2
+		sum := 0
+		for i, weight := range []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2} {
+			sum += weight * int(bban[i]-'0')
+		}
+		check := (11 - sum%11) % 11
+		if check == 10 {
+			return bban, false
+		}
+		return fmt.Sprintf("%s%d", bban[:10], check), true
+	case countries.PT:
+		// NIB: 98 - the bank, the branch and the account × 100 modulo 97
+		return fmt.Sprintf("%s%02d", bban[:19], 98-mod97(bban[:19]+"00")), true
+	}
+	return bban, true
+}
+
+// cinOdd - values of digits and letters (0 to 9 for digits, 0 to 25 for letters) at odd positions of the Italian CIN
+var cinOdd = [26]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+// mod11ES - returns the Spanish check digit of ten digits with weights 1, 2, 4, 8, 5, 10, 9, 7, 3, 6
+func mod11ES(digits string) string {
+	sum := 0
+	for i, weight := range []int{1, 2, 4, 8, 5, 10, 9, 7, 3, 6} {
+		sum += weight * int(digits[i]-'0')
+	}
+	switch check := 11 - sum%11; check {
+	case 11:
+		return "0"
+	case 10:
+		return "1"
+	default:
+		return fmt.Sprint(check)
+	}
+}
+
+// ribDigit - returns the digit of a character of a French account number: A to I and J to R are 1 to 9, S to Z are 2 to 9
+func ribDigit(c byte) byte {
+	switch {
+	case c >= 'A' && c <= 'I':
+		return '1' + c - 'A'
+	case c >= 'J' && c <= 'R':
+		return '1' + c - 'J'
+	case c >= 'S' && c <= 'Z':
+		return '2' + c - 'S'
+	}
+	return c
+}
@@ -0,0 +1,190 @@
+package iban
+
+import (
+	"github.com/xianb/countries"
+)
+
+// registry - returns the BBAN structure (SWIFT notation: n - digits, a - upper case letters, c - letters and digits,
+// ! - fixed length), offsets of the bank and branch identifiers in the BBAN and SEPA membership of the country
+// of the IBAN registry; an empty structure for countries without IBAN
+func registry(c countries.CountryCode) (bban string, bank, branch [2]int, sepa bool) { //nolint:gocyclo
+	switch c {
+	case countries.AD:
+		return "4!n4!n12!c", [2]int{0, 4}, [2]int{4, 8}, true
+	case countries.AE:
+		return "3!n16!n", [2]int{0, 3}, [2]int{}, false
+	case countries.AL:
+		return "8!n16!c", [2]int{0, 3}, [2]int{3, 7}, true
+	case countries.AT:
+		return "5!n11!n", [2]int{0, 5}, [2]int{}, true
+	case countries.AZ:
+		return "4!a20!c", [2]int{0, 4}, [2]int{}, false
+	case countries.BA:
+		return "3!n3!n8!n2!n", [2]int{0, 3}, [2]int{3, 6}, false
+	case countries.BE:
+		return "3!n7!n2!n", [2]int{0, 3}, [2]int{}, true
+	case countries.BG:
+		return "4!a4!n2!n8!c", [2]int{0, 4}, [2]int{4, 8}, true
+	case countries.BH:
+		return "4!a14!c", [2]int{0, 4}, [2]int{}, false
+	case countries.BI:
+		return "5!n5!n11!n2!n", [2]int{0, 5}, [2]int{5, 10}, false
+	case countries.BR:
+		return "8!n5!n10!n1!a1!c", [2]int{0, 8}, [2]int{8, 13}, false
+	case countries.BY:
+		return "4!c4!n16!c", [2]int{0, 4}, [2]int{}, false
+	case countries.CH:
+		return "5!n12!c", [2]int{0, 5}, [2]int{}, true
+	case countries.CR:
+		return "4!n14!n", [2]int{0, 4}, [2]int{}, false
+	case countries.CY:
+		return "3!n5!n16!c", [2]int{0, 3}, [2]int{3, 8}, true
+	case countries.CZ:
+		return "4!n6!n10!n", [2]int{0, 4}, [2]int{}, true
+	case countries.DE:
+		return "8!n10!n", [2]int{0, 8}, [2]int{}, true
+	case countries.DJ:
+		return "5!n5!n11!n2!n", [2]int{0, 5}, [2]int{5, 10}, false
+	case countries.DK:
+		return "4!n9!n1!n", [2]int{0, 4}, [2]int{}, true
+	case countries.DO:
+		return "4!c20!n", [2]int{0, 4}, [2]int{}, false
+	case countries.EE:
+		return "2!n2!n11!n1!n", [2]int{0, 2}, [2]int{}, true
+	case countries.EG:
+		return "4!n4!n17!n", [2]int{0, 4}, [2]int{4, 8}, false
+	case countries.ES:
+		return "4!n4!n1!n1!n10!n", [2]int{0, 4}, [2]int{4, 8}, true
+	case countries.FI:
+		return "3!n11!n", [2]int{0, 3}, [2]int{}, true
+	case countries.FK:
+		return "2!a12!n", [2]int{0, 2}, [2]int{}, false
+	case countries.FO:
+		return "4!n9!n1!n", [2]int{0, 4}, [2]int{}, false
+	case countries.FR:
+		return "5!n5!n11!c2!n", [2]int{0, 5}, [2]int{5, 10}, true
+	case countries.GB:
+		return "4!a6!n8!n", [2]int{0, 4}, [2]int{4, 10}, true
+	case countries.GE:
+		return "2!a16!n", [2]int{0, 2}, [2]int{}, false
+	case countries.GI:
+		return "4!a15!c", [2]int{0, 4}, [2]int{}, true
+	case countries.GL:
+		return "4!n9!n1!n", [2]int{0, 4}, [2]int{}, false
+	case countries.GR:
+		return "3!n4!n16!c", [2]int{0, 3}, [2]int{3, 7}, true
+	case countries.GT:
+		return "4!c20!c", [2]int{0, 4}, [2]int{}, false
+	case countries.HR:
+		return "7!n10!n", [2]int{0, 7}, [2]int{}, true
+	case countries.HU:
+		return "3!n4!n1!n15!n1!n", [2]int{0, 3}, [2]int{3, 7}, true
+	case countries.IE:
+		return "4!a6!n8!n", [2]int{0, 4}, [2]int{4, 10}, true
+	case countries.IL:
+		return "3!n3!n13!n", [2]int{0, 3}, [2]int{3, 6}, false
+	case countries.IQ:
+		return "4!a3!n12!n", [2]int{0, 4}, [2]int{4, 7}, false
+	case countries.IS:
+		return "4!n2!n6!n10!n", [2]int{0, 2}, [2]int{2, 4}, true
+	case countries.IT:
+		return "1!a5!n5!n12!c", [2]int{1, 6}, [2]int{6, 11}, true
+	case countries.JO:
+		return "4!a4!n18!c", [2]int{0, 4}, [2]int{4, 8}, false
+	case countries.KW:
+		return "4!a22!c", [2]int{0, 4}, [2]int{}, false
+	case countries.KZ:
+		return "3!n13!c", [2]int{0, 3}, [2]int{}, false
+	case countries.LB:
+		return "4!n20!c", [2]int{0, 4}, [2]int{}, false
+	case countries.LC:
+		return "4!a24!c", [2]int{0, 4}, [2]int{}, false
+	case countries.LI:
+		return "5!n12!c", [2]int{0, 5}, [2]int{}, true
+	case countries.LT:
+		return "5!n11!n", [2]int{0, 5}, [2]int{}, true
+	case countries.LU:
+		return "3!n13!c", [2]int{0, 3}, [2]int{}, true
+	case countries.LV:
+		return "4!a13!c", [2]int{0, 4}, [2]int{}, true
+	case countries.LY:
+		return "3!n3!n15!n", [2]int{0, 3}, [2]int{3, 6}, false
+	case countries.MC:
+		return "5!n5!n11!c2!n", [2]int{0, 5}, [2]int{5, 10}, true
+	case countries.MD:
+		return "2!c18!c", [2]int{0, 2}, [2]int{}, true
+	case countries.ME:
+		return "3!n13!n2!n", [2]int{0, 3}, [2]int{}, true
+	case countries.MK:
+		return "3!n10!c2!n", [2]int{0, 3}, [2]int{}, true
+	case countries.MN:
+		return "4!n12!n", [2]int{0, 4}, [2]int{}, false
+	case countries.MR:
+		return "5!n5!n11!n2!n", [2]int{0, 5}, [2]int{5, 10}, false
+	case countries.MT:
+		return "4!a5!n18!c", [2]int{0, 4}, [2]int{4, 9}, true
+	case countries.MU:
+		return "4!a2!n2!n12!n3!n3!a", [2]int{0, 6}, [2]int{6, 8}, false
+	case countries.NI:
+		return "4!a20!n", [2]int{0, 4}, [2]int{}, false
+	case countries.NL:
+		return "4!a10!n", [2]int{0, 4}, [2]int{}, true
+	case countries.NO:
+		return "4!n6!n1!n", [2]int{0, 4}, [2]int{}, true
+	case countries.OM:
+		return "3!n16!c", [2]int{0, 3}, [2]int{}, false
+	case countries.PK:
+		return "4!a16!c", [2]int{0, 4}, [2]int{}, false
+	case countries.PL:
+		return "8!n16!n", [2]int{0, 8}, [2]int{}, true
+	case countries.PS:
+		return "4!a21!c", [2]int{0, 4}, [2]int{}, false
+	case countries.PT:
+		return "4!n4!n11!n2!n", [2]int{0, 4}, [2]int{4, 8}, true
+	case countries.QA:
+		return "4!a21!c", [2]int{0, 4}, [2]int{}, false
+	case countries.RO:
+		return "4!a16!c", [2]int{0, 4}, [2]int{}, true
+	case countries.RS:
+		return "3!n13!n2!n", [2]int{0, 3}, [2]int{}, true
+	case countries.RU:
+		return "9!n5!n15!c", [2]int{0, 9}, [2]int{9, 14}, false
+	case countries.SA:
+		return "2!n18!c", [2]int{0, 2}, [2]int{}, false
+	case countries.SC:
+		return "4!a2!n2!n16!n3!a", [2]int{0, 6}, [2]int{6, 8}, false
+	case countries.SD:
+		return "2!n12!n", [2]int{0, 2}, [2]int{}, false
+	case countries.SE:
+		return "3!n16!n1!n", [2]int{0, 3}, [2]int{}, true
+	case countries.SI:
+		return "5!n8!n2!n", [2]int{0, 5}, [2]int{}, true
+	case countries.SK:
+		return "4!n6!n10!n", [2]int{0, 4}, [2]int{}, true
+	case countries.SM:
+		return "1!a5!n5!n12!c", [2]int{1, 6}, [2]int{6, 11}, true
+	case countries.SO:
+		return "4!n3!n12!n", [2]int{0, 4}, [2]int{4, 7}, false
+	case countries.ST:
+		return "4!n4!n11!n2!n", [2]int{0, 4}, [2]int{4, 8}, false
+	case countries.SV:
+		return "4!a20!n", [2]int{0, 4}, [2]int{}, false
+	case countries.TL:
+		return "3!n14!n2!n", [2]int{0, 3}, [2]int{}, false
+	case countries.TN:
+		return "2!n3!n13!n2!n", [2]int{0, 2}, [2]int{2, 5}, false
+	case countries.TR:
+		return "5!n1!n16!c", [2]int{0, 5}, [2]int{}, false
+	case countries.UA:
+		return "6!n19!c", [2]int{0, 6}, [2]int{}, false
+	case countries.VA:
+		return "3!n15!n", [2]int{0, 3}, [2]int{}, true
+	case countries.VG:
+		return "4!a16!n", [2]int{0, 4}, [2]int{}, false
+	case countries.XK:
+		return "4!n10!n2!n", [2]int{0, 2}, [2]int{2, 4}, false
+	case countries.YE:
+		return "4!a4!n18!c", [2]int{0, 4}, [2]int{4, 8}, false
+	}
+	return "", [2]int{}, [2]int{}, false
+}
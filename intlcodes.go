@@ -0,0 +1,276 @@
+package countries
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	intlCodesIndexOnce sync.Once
+	countryByITU       map[string]CountryCode
+	countryByICAO      map[string]CountryCode
+	countryByIVR       map[string]CountryCode
+	countryByWMO       map[string]CountryCode
+	countryByMARC      map[string]CountryCode
+	countryByGAUL      map[int]CountryCode
+	countryBySTANAG    map[string]CountryCode
+	countryByUNDP      map[string]CountryCode
+	countryByFIPS      map[string]CountryCode
+	countryByIOC       map[string]CountryCode
+	countryByFIFA      map[string]CountryCode
+	icaoPrefixMaxLen   int
+)
+
+// ITU - returns an ITU letter code (ITU-T/ITU-R country designation) of country, example "D" for Germany, "HOL" for Netherlands
+func (c CountryCode) ITU() string {
+	return intlCodeOrUnknown(intlCodesData(c).ITU)
+}
+
+// ICAO - returns an ICAO aircraft nationality and registration mark prefix of country, example "D" for Germany, "VP-B" for Bermuda
+func (c CountryCode) ICAO() string {
+	return intlCodeOrUnknown(intlCodesData(c).ICAO)
+}
+
+// IVR - returns an international vehicle registration code (distinguishing sign of vehicles in international traffic),
+// example "D" for Germany, "CH" for Switzerland
+func (c CountryCode) IVR() string {
+	return intlCodeOrUnknown(intlCodesData(c).IVR)
+}
+
+// WMO - returns a World Meteorological Organization country code, example "DL" for Germany
+func (c CountryCode) WMO() string {
+	return intlCodeOrUnknown(intlCodesData(c).WMO)
+}
+
+// GAUL - returns a FAO Global Administrative Unit Layers code of country, example 93 for Germany, 0 if not assigned
+func (c CountryCode) GAUL() int {
+	return intlCodesData(c).GAUL
+}
+
+// MARC - returns a MARC (Library of Congress) country code, example "gw" for Germany
+func (c CountryCode) MARC() string {
+	return intlCodeOrUnknown(intlCodesData(c).MARC)
+}
+
+// STANAG - returns a NATO STANAG 1059 (edition 9) country code, which is ISO 3166-1 Alpha-3
+func (c CountryCode) STANAG() string {
+	return c.Alpha3()
+}
+
+// UNDP - returns a United Nations Development Programme country code, Alpha-3 except historical codes kept by UNDP
+func (c CountryCode) UNDP() string {
+	switch c {
+	case ROU:
+		return "ROM"
+	case COD:
+		return "ZAR"
+	case TLS:
+		return "TMP"
+	}
+	return c.Alpha3()
+}
+
+// ByITU - returns CountryCode by ITU letter code, case-insensitive, example: deu := ByITU("D"),
+// returns countries.Unknown, if the code not found
+func ByITU(code string) CountryCode {
+	intlCodesIndex()
+	return countryByCode(countryByITU, strings.ToUpper(code))
+}
+
+// ByICAO - returns CountryCode by ICAO registration mark prefix or a full aircraft registration, case-insensitive,
+// example: deu := ByICAO("D") OR deu := ByICAO("D-AIMA") OR bmu := ByICAO("VP-BAA"), the longest prefix wins;
+// a prefix shared by a state and its territories returns the state, returns countries.Unknown, if the prefix not found
+func ByICAO(registration string) CountryCode {
+	intlCodesIndex()
+	registration = strings.ToUpper(strings.TrimSpace(registration))
+	for n := icaoPrefixMaxLen; n > 0; n-- {
+		if n > len(registration) {
+			continue
+		}
+		if c, ok := countryByICAO[registration[:n]]; ok {
+			return c
+		}
+	}
+	return Unknown
+}
+
+// ByIVR - returns CountryCode by international vehicle registration code, case-insensitive, example: deu := ByIVR("D"),
+// returns countries.Unknown, if the code not found
+func ByIVR(code string) CountryCode {
+	intlCodesIndex()
+	return countryByCode(countryByIVR, strings.ToUpper(code))
+}
+
+// ByWMO - returns CountryCode by WMO country code, case-insensitive, example: deu := ByWMO("DL"),
+// returns countries.Unknown, if the code not found
+func ByWMO(code string) CountryCode {
+	intlCodesIndex()
+	return countryByCode(countryByWMO, strings.ToUpper(code))
+}
+
+// ByGAUL - returns CountryCode by FAO GAUL code, example: deu := ByGAUL(93),
+// returns countries.Unknown, if the code not found
+func ByGAUL(code int) CountryCode {
+	intlCodesIndex()
+	if c, ok := countryByGAUL[code]; ok {
+		return c
+	}
+	return Unknown
+}
+
+// ByMARC - returns CountryCode by MARC country code, case-insensitive, example: deu := ByMARC("gw"),
+// returns countries.Unknown, if the code not found
+func ByMARC(code string) CountryCode {
+	intlCodesIndex()
+	return countryByCode(countryByMARC, strings.ToLower(code))
+}
+
+// BySTANAG - returns CountryCode by NATO STANAG 1059 country code, case-insensitive, example: deu := BySTANAG("DEU"),
+// returns countries.Unknown, if the code not found
+func BySTANAG(code string) CountryCode {
+	intlCodesIndex()
+	return countryByCode(countryBySTANAG, strings.ToUpper(code))
+}
+
+// ByUNDP - returns CountryCode by UNDP country code, case-insensitive, example: rou := ByUNDP("ROM"),
+// returns countries.Unknown, if the code not found
+func ByUNDP(code string) CountryCode {
+	intlCodesIndex()
+	return countryByCode(countryByUNDP, strings.ToUpper(code))
+}
+
+// ByFIPS - returns CountryCode by FIPS 10-4 code only, case-insensitive, example: deu := ByFIPS("GM");
+// FIPS codes are not ISO codes: ByFIPS("GM") is Germany, but ByName("GM") is Gambia (FIPS "GA"),
+// returns countries.Unknown, if the code not found
+func ByFIPS(code string) CountryCode {
+	intlCodesIndex()
+	return countryByCode(countryByFIPS, strings.ToUpper(code))
+}
+
+// ByIOC - returns CountryCode by International Olympic Committee code, case-insensitive, example: dza := ByIOC("ALG"),
+// historic codes are accepted too, example: deu := ByIOC("FRG") OR lbn := ByIOC("LIB") OR mmr := ByIOC("BIR");
+// codes of teams of several present countries, example: "URS", "TCH", "EUN", return countries.Unknown
+func ByIOC(code string) CountryCode {
+	intlCodesIndex()
+	code = strings.ToUpper(code)
+	if c := countryByCode(countryByIOC, code); c != Unknown {
+		return c
+	}
+	return iocHistoric(code)
+}
+
+// ByFIFA - returns CountryCode by FIFA code, case-insensitive, example: deu := ByFIFA("GER") OR che := ByFIFA("SUI"),
+// codes of teams of subdivisions and associate members are accepted too, example: gbr := ByFIFA("SCO"),
+// returns countries.Unknown, if the code not found
+func ByFIFA(code string) CountryCode {
+	intlCodesIndex()
+	if c := countryByCode(countryByFIFA, strings.ToUpper(code)); c != Unknown {
+		return c
+	}
+	if team, err := SportsTeamByCode(FederationFIFA, code); err == nil {
+		return team.Country
+	}
+	return Unknown
+}
+
+// iocHistoric - returns CountryCode by a former IOC code, countries.Unknown if the team has no single successor
+func iocHistoric(code string) CountryCode { //nolint:gocyclo
+	switch code {
+	case "BIR":
+		return MMR
+	case "BOH":
+		return CZE
+	case "CEY":
+		return LKA
+	case "DAH":
+		return BEN
+	case "EUA", "FRG", "GDR", "SAA":
+		return DEU
+	case "HOL":
+		return NLD
+	case "LIB":
+		return LBN
+	case "MAL", "NBO":
+		return MYS
+	case "NRH":
+		return ZMB
+	case "RAU", "UAR":
+		return EGY
+	case "RHO":
+		return ZWE
+	case "ROM":
+		return ROU
+	case "SCG":
+		return YUG
+	case "VOL":
+		return BFA
+	case "YAR", "YMD":
+		return YEM
+	case "ZAI":
+		return COD
+	}
+	return Unknown
+}
+
+// intlCodesIndex - builds reverse maps of the international codes once, the first country of a shared code wins
+func intlCodesIndex() {
+	intlCodesIndexOnce.Do(func() {
+		intlCodesData(Unknown)
+		countryByITU = map[string]CountryCode{}
+		countryByICAO = map[string]CountryCode{}
+		countryByIVR = map[string]CountryCode{}
+		countryByWMO = map[string]CountryCode{}
+		countryByMARC = map[string]CountryCode{}
+		countryByGAUL = map[int]CountryCode{}
+		countryBySTANAG = map[string]CountryCode{}
+		countryByUNDP = map[string]CountryCode{}
+		add := func(index map[string]CountryCode, code string, c CountryCode) {
+			if _, ok := index[code]; !ok && code != "" {
+				index[code] = c
+			}
+		}
+		for _, record := range intlCodesRecords {
+			c := ByAlpha2(record.Alpha2)
+			if c == Unknown {
+				continue
+			}
+			add(countryByITU, record.ITU, c)
+			add(countryByICAO, record.ICAO, c)
+			add(countryByIVR, record.IVR, c)
+			add(countryByWMO, record.WMO, c)
+			add(countryByMARC, record.MARC, c)
+			if _, ok := countryByGAUL[record.GAUL]; !ok && record.GAUL != 0 {
+				countryByGAUL[record.GAUL] = c
+			}
+			if len(record.ICAO) > icaoPrefixMaxLen {
+				icaoPrefixMaxLen = len(record.ICAO)
+			}
+		}
+		countryByFIPS = map[string]CountryCode{}
+		countryByIOC = map[string]CountryCode{}
+		countryByFIFA = map[string]CountryCode{}
+		for _, c := range All() {
+			add(countryBySTANAG, c.STANAG(), c)
+			add(countryByUNDP, c.UNDP(), c)
+			if fips := c.FIPS(); len(fips) == 2 {
+				add(countryByFIPS, fips, c)
+			}
+			add(countryByIOC, c.IOC(), c)
+			add(countryByFIFA, c.FIFA(), c)
+		}
+	})
+}
+
+func countryByCode(index map[string]CountryCode, code string) CountryCode {
+	if c, ok := index[code]; ok {
+		return c
+	}
+	return Unknown
+}
+
+func intlCodeOrUnknown(code string) string {
+	if code == "" {
+		return UnknownMsg
+	}
+	return code
+}
@@ -0,0 +1,144 @@
+package countries
+
+import (
+	"golang.org/x/text/language"
+)
+
+// LanguageStatus - 语言地位（官方语言、地区官方语言、事实官方语言、通用语言）
+type LanguageStatus int64 // int64 for database/sql/driver.Valuer compatibility
+
+// Language - 国家使用的语言
+type Language struct {
+	Code              string         `json:"code"`              // ISO 639-1 代码，没有时为 ISO 639-3 代码
+	Status            LanguageStatus `json:"status"`            // 语言地位
+	PopulationPercent float64        `json:"populationPercent"` // 使用人口比例（%）
+}
+
+// Type implements Typer interface
+func (_ LanguageStatus) Type() string {
+	return TypeLanguageStatus
+}
+
+// String - implements fmt.Stringer, returns a language status in english
+func (s LanguageStatus) String() string {
+	switch s {
+	case LanguageStatusOfficial:
+		return "Official"
+	case LanguageStatusOfficialRegional:
+		return "Official regional"
+	case LanguageStatusDeFactoOfficial:
+		return "De facto official"
+	case LanguageStatusSpoken:
+		return "Spoken"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (s LanguageStatus) IsValid() bool {
+	return s.String() != UnknownMsg
+}
+
+// Type implements Typer interface
+func (_ *Language) Type() string {
+	return TypeLanguage
+}
+
+// Tag - returns the language as golang.org/x/text/language.Tag without a region, example: "de"
+func (l *Language) Tag() language.Tag {
+	return language.Make(l.Code)
+}
+
+// IsOfficial - returns true, if the language is official or de facto official in the whole country
+func (l *Language) IsOfficial() bool {
+	return l.Status == LanguageStatusOfficial || l.Status == LanguageStatusDeFactoOfficial
+}
+
+// Languages - returns ISO 639 languages of the country with their status and share of the population speaking them,
+// the language of DefaultLocale() first, example: CHE.Languages() returns de, fr, it, rm (official), gsw and en (spoken);
+// nil for unknown codes and uninhabited territories
+func (c CountryCode) Languages() []*Language {
+	languagesData()
+	records := languagesByCountry[c]
+	if len(records) == 0 {
+		return nil
+	}
+	languages := make([]*Language, 0, len(records))
+	for _, record := range records {
+		l := record
+		languages = append(languages, &l)
+	}
+	return languages
+}
+
+// OfficialLanguages - returns ISO 639 codes of official and de facto official languages of the country,
+// example: BEL.OfficialLanguages() == []string{"nl", "fr", "de"}; nil, if there are none
+func (c CountryCode) OfficialLanguages() []string {
+	var codes []string
+	for _, l := range c.Languages() {
+		if l.IsOfficial() {
+			codes = append(codes, l.Code)
+		}
+	}
+	return codes
+}
+
+// DefaultLocale - returns the default locale of the country: the CLDR likely language of the region, if the country speaks it,
+// otherwise its first official language, with the country as the region, example: CHE.DefaultLocale() == language.MustParse("de-CH"),
+// ZAF.DefaultLocale() == language.MustParse("en-ZA"); the likely language of the region for countries without
+// language data, language.Und for unknown codes
+func (c CountryCode) DefaultLocale() language.Tag {
+	region, err := language.ParseRegion(c.Alpha2())
+	if err != nil || len(c.Successors()) > 0 {
+		return language.Und
+	}
+	base, _ := language.Und.Base()
+	if languages := c.Languages(); len(languages) > 0 {
+		base, _ = languages[0].Tag().Base()
+	} else if likely, confidence := language.Make("und-" + region.String()).Base(); confidence != language.No {
+		base = likely
+	}
+	tag, err := language.Compose(base, region)
+	if err != nil {
+		return language.Und
+	}
+	return tag
+}
+
+// FromLocale - returns CountryCode of the region of a locale, example: FromLocale(language.MustParse("de-CH")) == CHE;
+// a group region (UN M.49 code) is expanded to the contained country with the most speakers of the language,
+// example: FromLocale(language.MustParse("es-419")) == MEX; a tag without a region resolves to the likely region,
+// example: FromLocale(language.German) == DEU; returns countries.Unknown for language.Und and unknown regions
+func FromLocale(tag language.Tag) CountryCode {
+	if tag.IsRoot() {
+		return Unknown
+	}
+	region, confidence := tag.Region()
+	if confidence == language.No {
+		return Unknown
+	}
+	if !region.IsGroup() {
+		return ByAlpha2(region.String())
+	}
+	base, _ := tag.Base()
+	languagesData()
+	found, speakers, population := Unknown, 0.0, 0.0
+	for _, c := range All() {
+		country, err := language.ParseRegion(c.Alpha2())
+		if err != nil || len(c.Successors()) > 0 || !region.Contains(country) {
+			continue
+		}
+		total := languagePopulation[c]
+		share := 0.0
+		for _, l := range languagesByCountry[c] {
+			if l.Code == base.String() {
+				share = l.PopulationPercent
+				break
+			}
+		}
+		if n := total * share / 100; n > speakers || (speakers == 0 && total > population) {
+			found, speakers, population = c, n, total
+		}
+	}
+	return found
+}
@@ -0,0 +1,15 @@
+package countries
+
+// TypeLanguageStatus for Typer interface
+const TypeLanguageStatus string = "countries.LanguageStatus"
+
+// TypeLanguage for Typer interface
+const TypeLanguage string = "countries.Language"
+
+const (
+	LanguageStatusUnknown          LanguageStatus = 0
+	LanguageStatusOfficial         LanguageStatus = 1 // official in the whole country, example de in CHE
+	LanguageStatusOfficialRegional LanguageStatus = 2 // official in a part of the country, example ca in ESP
+	LanguageStatusDeFactoOfficial  LanguageStatus = 3 // used by the government, but not official by law, example en in AUS
+	LanguageStatusSpoken           LanguageStatus = 4 // spoken by a significant part of the population without official status
+)
@@ -0,0 +1,199 @@
+package countries
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+//go:embed data/i18n/*.json
+var dataI18N embed.FS
+
+// Localizable - a code with an english name: CountryCode, RegionCode, CurrencyCode, CapitalCode or SubdivisionCode
+type Localizable interface {
+	Typer
+	fmt.Stringer
+}
+
+// Catalog - 本地化名称目录，a source of localized names of codes
+type Catalog interface {
+	// Localized - returns a name of the code in the language, false if the catalog has no such name
+	Localized(code Localizable, lang language.Tag) (string, bool)
+}
+
+// Localizer - returns localized names of codes from the catalogs, the first catalog with a name wins
+type Localizer struct {
+	catalogs []Catalog
+}
+
+// NewLocalizer - returns a Localizer using the catalogs in the given order
+func NewLocalizer(catalogs ...Catalog) *Localizer {
+	return &Localizer{catalogs: append([]Catalog(nil), catalogs...)}
+}
+
+// With - returns a new Localizer which looks up the catalogs first and then the catalogs of l,
+// example: l := countries.DefaultLocalizer().With(myCatalog)
+func (l *Localizer) With(catalogs ...Catalog) *Localizer {
+	return NewLocalizer(append(append([]Catalog(nil), catalogs...), l.catalogs...)...)
+}
+
+// Localized - returns a name of the code in the language, example: Localized(CapitalJP, language.Chinese) == "东京",
+// returns the english name (String), if no catalog has a name in the language
+func (l *Localizer) Localized(code Localizable, lang language.Tag) string {
+	for _, catalog := range l.catalogs {
+		if name, ok := catalog.Localized(code, lang); ok {
+			return name
+		}
+	}
+	return code.String()
+}
+
+var (
+	defaultLocalizerOnce sync.Once
+	defaultLocalizer     *Localizer
+)
+
+// DefaultLocalizer - returns the package Localizer: embedded catalogs (data/i18n), Chinese names of StringCn and CLDR names of countries and regions
+func DefaultLocalizer() *Localizer {
+	defaultLocalizerOnce.Do(func() {
+		var catalogs []Catalog
+		files, _ := dataI18N.ReadDir("data/i18n")
+		for _, file := range files {
+			f, err := dataI18N.Open("data/i18n/" + file.Name())
+			if err != nil {
+				continue
+			}
+			if catalog, err := LoadCatalog(f); err == nil {
+				catalogs = append(catalogs, catalog)
+			}
+			_ = f.Close()
+		}
+		catalogs = append(catalogs, chineseCatalog{}, cldrCatalog{})
+		defaultLocalizer = NewLocalizer(catalogs...)
+	})
+	return defaultLocalizer
+}
+
+// Localized - returns a name of the code in the language using DefaultLocalizer,
+// example: Localized(CurrencyJPY, language.Chinese) == "日元" OR Localized(SubdivisionUSCA, language.Chinese) == "加利福尼亚州"
+func Localized(code Localizable, lang language.Tag) string {
+	return DefaultLocalizer().Localized(code, lang)
+}
+
+// CatalogKey - returns a key of the code in catalog files: "country:JP", "region:142", "currency:JPY", "capital:JP" or "subdivision:US-CA",
+// returns "", if the code type is not supported
+func CatalogKey(code Localizable) string {
+	switch code := code.(type) {
+	case CountryCode:
+		return "country:" + code.Alpha2()
+	case RegionCode:
+		return "region:" + strconv.FormatInt(int64(code), 10)
+	case CurrencyCode:
+		return "currency:" + code.Alpha()
+	case CapitalCode:
+		if n := int64(code) / 1000; n > 0 {
+			return "capital:" + code.Country().Alpha2() + "-" + strconv.FormatInt(n, 10)
+		}
+		return "capital:" + code.Country().Alpha2()
+	case SubdivisionCode:
+		return "subdivision:" + string(code)
+	}
+	return ""
+}
+
+// jsonCatalog - a catalog of names in one language, loaded from JSON
+type jsonCatalog struct {
+	lang  language.Tag
+	names map[string]string
+}
+
+// LoadCatalog - loads a catalog from JSON: {"language": "zh", "names": {"capital:JP": "东京", "currency:JPY": "日元"}},
+// keys are CatalogKey of codes; the catalog serves the language and its sublanguages (zh serves zh-Hans and zh-CN, but not zh-Hant)
+func LoadCatalog(r io.Reader) (Catalog, error) {
+	var data struct {
+		Language string            `json:"language"`
+		Names    map[string]string `json:"names"`
+	}
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("countries::LoadCatalog: catalog decode err: %w", err)
+	}
+	lang, err := language.Parse(data.Language)
+	if err != nil {
+		return nil, fmt.Errorf("countries::LoadCatalog: catalog language err: %w", err)
+	}
+	return &jsonCatalog{lang: lang, names: data.Names}, nil
+}
+
+// Localized implements Catalog interface
+func (c *jsonCatalog) Localized(code Localizable, lang language.Tag) (string, bool) {
+	if !languageServes(c.lang, lang) {
+		return "", false
+	}
+	name, ok := c.names[CatalogKey(code)]
+	return name, ok && name != ""
+}
+
+// chineseCatalog - a catalog of StringCn names of countries and regions
+type chineseCatalog struct{}
+
+// Localized implements Catalog interface
+func (chineseCatalog) Localized(code Localizable, lang language.Tag) (string, bool) {
+	if !languageServes(language.Chinese, lang) {
+		return "", false
+	}
+	var name string
+	switch code := code.(type) {
+	case CountryCode:
+		name = code.StringCn()
+	case RegionCode:
+		name = code.StringCn()
+	}
+	return name, name != "" && name != UnknownMsg
+}
+
+// cldrCatalog - a catalog of CLDR names of countries and regions in any language
+type cldrCatalog struct{}
+
+// Localized implements Catalog interface
+func (cldrCatalog) Localized(code Localizable, lang language.Tag) (string, bool) {
+	switch code := code.(type) {
+	case CountryCode:
+		return cldrRegionName(code.Alpha2(), lang)
+	case RegionCode:
+		return cldrRegionName(fmt.Sprintf("%03d", code), lang)
+	}
+	return "", false
+}
+
+// cldrRegionName - returns CLDR name of the region (ISO 3166-1 Alpha-2 or UN M.49 code) in the language
+func cldrRegionName(region string, lang language.Tag) (string, bool) {
+	r, err := language.ParseRegion(region)
+	if err != nil {
+		return "", false
+	}
+	namer := display.Regions(lang)
+	if namer == nil {
+		return "", false
+	}
+	name := namer.Name(r)
+	return name, name != ""
+}
+
+// languageServes - returns true, if names in the language of catalog are fine for lang: lang is the language or its sublanguage
+func languageServes(catalog, lang language.Tag) bool {
+	for {
+		if lang == catalog {
+			return true
+		}
+		if lang.IsRoot() {
+			return false
+		}
+		lang = lang.Parent()
+	}
+}
@@ -0,0 +1,313 @@
+package countries
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+// NameForm - 国家名称的形式（标准、简称、变体、正式）
+type NameForm int64 // int64 for database/sql/driver.Valuer compatibility
+
+// Type implements Typer interface
+func (_ NameForm) Type() string {
+	return TypeNameForm
+}
+
+// String - implements fmt.Stringer, returns a name form in english
+func (f NameForm) String() string {
+	switch f {
+	case NameFormDefault:
+		return "Default"
+	case NameFormShort:
+		return "Short"
+	case NameFormVariant:
+		return "Variant"
+	case NameFormOfficial:
+		return "Official"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (f NameForm) IsValid() bool {
+	return f.String() != UnknownMsg
+}
+
+// NameLanguages - returns the languages of country names used by ByName, the top 30 CLDR locales
+func NameLanguages() []language.Tag {
+	return []language.Tag{
+		language.English,
+		language.SimplifiedChinese,
+		language.TraditionalChinese,
+		language.Spanish,
+		language.French,
+		language.German,
+		language.Russian,
+		language.Portuguese,
+		language.Japanese,
+		language.Arabic,
+		language.Hindi,
+		language.Italian,
+		language.Korean,
+		language.Turkish,
+		language.Polish,
+		language.Dutch,
+		language.Swedish,
+		language.Ukrainian,
+		language.Indonesian,
+		language.Vietnamese,
+		language.Thai,
+		language.Persian,
+		language.Hebrew,
+		language.Czech,
+		language.Romanian,
+		language.Hungarian,
+		language.Greek,
+		language.Danish,
+		language.Finnish,
+		language.Norwegian,
+	}
+}
+
+// Name - returns a name of country in the language, example: DEU.Name(language.German) == "Deutschland",
+// names are taken from CLDR, so DEU.Name(language.English) may differ from DEU.String();
+// returns the english name (String), if there is no name in the language
+func (c CountryCode) Name(lang language.Tag) string {
+	return c.NameIn(lang, NameFormDefault)
+}
+
+// NameIn - returns a name of country in the language and the form, example: GBR.NameIn(language.English, NameFormShort) == "UK",
+// short and variant forms are known in english only, official forms are taken from ISO 3166-1 and are english only too;
+// returns the default form, if the country has no name of the form in the language
+func (c CountryCode) NameIn(lang language.Tag, form NameForm) string {
+	if isEnglish(lang) {
+		switch form {
+		case NameFormShort:
+			if name := c.nameShort(); name != "" {
+				return name
+			}
+		case NameFormVariant:
+			if name := c.nameVariant(); name != "" {
+				return name
+			}
+		case NameFormOfficial:
+			if record := iso31661Data(c); record != nil && record.OfficialName != "" {
+				return record.OfficialName
+			}
+		}
+	}
+	if name, ok := cldrRegionName(c.Alpha2(), lang); ok {
+		return name
+	}
+	return c.String()
+}
+
+// nameShort - returns CLDR english short name (alt="short") of country
+func (c CountryCode) nameShort() string {
+	switch c {
+	case BIH:
+		return "Bosnia"
+	case GBR:
+		return "UK"
+	case HKG:
+		return "Hong Kong"
+	case MAC:
+		return "Macao"
+	case PSE:
+		return "Palestine"
+	case USA:
+		return "US"
+	}
+	return ""
+}
+
+// nameVariant - returns CLDR english variant name (alt="variant") of country or ISO 3166-1 name, if it differs from the CLDR name
+func (c CountryCode) nameVariant() string {
+	switch c {
+	case COD:
+		return "Congo (DRC)"
+	case COG:
+		return "Congo (Republic)"
+	case CIV:
+		return "Ivory Coast"
+	case CZE:
+		return "Czech Republic"
+	case FLK:
+		return "Falkland Islands (Islas Malvinas)"
+	case SWZ:
+		return "Swaziland"
+	case TLS:
+		return "East Timor"
+	case TUR:
+		return "Turkey"
+	case MMR:
+		return "Burma"
+	}
+	if record := iso31661Data(c); record != nil && record.Name != c.Name(language.English) {
+		return record.Name
+	}
+	return ""
+}
+
+func isEnglish(lang language.Tag) bool {
+	base, _ := lang.Base()
+	english, _ := language.English.Base()
+	return base == english
+}
+
+var (
+	countryNamesOnce sync.Once
+	countryNames     *nameIndex
+)
+
+// nameKey - prepares a localized name for the index: unlike textPrepare, it keeps brackets content and combining marks,
+// so "Saint-Martin (partie française)" differs from "Saint-Martin (partie néerlandaise)" and Hindi "टोगो" from "टोंगा"
+func nameKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsMark(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, name)
+}
+
+// nameIndex - an index of localized names: exact keys are matched first,
+// then latin keys of names without diacritics, pinyin of Chinese names and transliterations of Cyrillic and Arabic names
+type nameIndex struct {
+	exact map[string]interface{}
+	latin map[string]interface{}
+}
+
+func newNameIndex() *nameIndex {
+	return &nameIndex{exact: map[string]interface{}{}, latin: map[string]interface{}{}}
+}
+
+// add - adds the name of the code, the first added code wins on collisions
+func (idx *nameIndex) add(name string, code interface{}) {
+	if key := nameKey(name); key != "" {
+		if _, ok := idx.exact[key]; !ok {
+			idx.exact[key] = code
+		}
+	}
+	keys := []string{latinKey(name)}
+	if p := pinyin(name); p != "" {
+		keys = append(keys, latinKey(p))
+	}
+	if t := transliterate(name); t != "" {
+		keys = append(keys, latinKey(t))
+	}
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if _, ok := idx.latin[key]; !ok {
+			idx.latin[key] = code
+		}
+	}
+}
+
+// find - returns the code by the name, pinyin with or without tones ("riben", "Rìběn", "ri4ben3") or transliteration,
+// returns nil, if the name not found
+func (idx *nameIndex) find(name string) interface{} {
+	if code, ok := idx.exact[nameKey(name)]; ok {
+		return code
+	}
+	if code, ok := idx.latin[latinKey(name)]; ok {
+		return code
+	}
+	return nil
+}
+
+// findExact - returns the code by the exact name, returns nil, if the name not found
+func (idx *nameIndex) findExact(name string) interface{} {
+	return idx.exact[nameKey(name)]
+}
+
+// countryByLocalizedName - returns CountryCode by a name in any of NameLanguages and forms, by String, StringCn, its pinyin
+// or a transliteration of Russian, Ukrainian and Arabic names; returns countries.Unknown, if country name not found
+func countryByLocalizedName(name string) CountryCode {
+	if c, ok := countryNameIndex().find(name).(CountryCode); ok {
+		return c
+	}
+	return Unknown
+}
+
+// countryByExactName - returns CountryCode by a name in any of NameLanguages and forms, by String or StringCn as is,
+// brackets content and diacritics included; returns countries.Unknown, if country name not found
+func countryByExactName(name string) CountryCode {
+	if c, ok := countryNameIndex().findExact(name).(CountryCode); ok {
+		return c
+	}
+	return Unknown
+}
+
+// countryNameIndex - returns the index of localized country names, builds it once
+func countryNameIndex() *nameIndex {
+	countryNamesOnce.Do(func() {
+		all := All()
+		forms := []NameForm{NameFormDefault, NameFormShort, NameFormVariant, NameFormOfficial}
+		countryNames = newNameIndex()
+		for _, lang := range NameLanguages() {
+			for _, c := range all {
+				for _, form := range forms {
+					countryNames.add(c.NameIn(lang, form), c)
+				}
+			}
+		}
+		for _, c := range all {
+			countryNames.add(c.String(), c)
+			countryNames.add(c.StringCn(), c)
+		}
+	})
+	return countryNames
+}
+
+var (
+	capitalNamesOnce sync.Once
+	capitalNames     *nameIndex
+)
+
+// capitalByLocalizedName - returns CapitalCode by a name in the DefaultLocalizer catalogs or its pinyin,
+// returns CapitalUnknown, if capital name not found
+func capitalByLocalizedName(name string) CapitalCode {
+	capitalNamesOnce.Do(func() {
+		capitalNames = newNameIndex()
+		localizer := DefaultLocalizer()
+		for _, c := range AllCapitals() {
+			if c == CapitalXX {
+				continue
+			}
+			capitalNames.add(c.String(), c)
+			capitalNames.add(localizer.Localized(c, language.Chinese), c)
+		}
+	})
+	if c, ok := capitalNames.find(name).(CapitalCode); ok {
+		return c
+	}
+	return CapitalUnknown
+}
+
+var (
+	subdivisionNamesOnce sync.Once
+	subdivisionNames     *nameIndex
+)
+
+// subdivisionByLocalizedName - returns SubdivisionCode by an english name, a name in the DefaultLocalizer catalogs or its pinyin,
+// returns SubdivisionUnknown, if subdivision name not found
+func subdivisionByLocalizedName(name string) SubdivisionCode {
+	subdivisionNamesOnce.Do(func() {
+		subdivisionNames = newNameIndex()
+		localizer := DefaultLocalizer()
+		for _, s := range AllSubdivisions() {
+			subdivisionNames.add(s.String(), s)
+			subdivisionNames.add(localizer.Localized(s, language.Chinese), s)
+		}
+	})
+	if s, ok := subdivisionNames.find(name).(SubdivisionCode); ok {
+		return s
+	}
+	return SubdivisionUnknown
+}
@@ -0,0 +1,12 @@
+package countries
+
+// TypeNameForm for Typer interface
+const TypeNameForm string = "countries.NameForm"
+
+// Name forms
+const (
+	NameFormDefault  NameForm = 0 // CLDR standard name, example "United Kingdom"
+	NameFormShort    NameForm = 1 // CLDR short name, example "UK"
+	NameFormVariant  NameForm = 2 // CLDR or ISO 3166-1 variant name, example "Czech Republic"
+	NameFormOfficial NameForm = 3 // ISO 3166-1 official name, example "United Kingdom of Great Britain and Northern Ireland"
+)
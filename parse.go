@@ -0,0 +1,303 @@
+package countries
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CodeFormat - 国家代码格式（两位字母、三位字母、数字、四位字母）
+type CodeFormat int64 // int64 for database/sql/driver.Valuer compatibility
+
+var (
+	countryCodesOnce sync.Once
+	countryByAlpha2  map[string]CountryCode
+	countryByAlpha3  map[string]CountryCode
+)
+
+// Type implements Typer interface
+func (_ CodeFormat) Type() string {
+	return TypeCodeFormat
+}
+
+// String - implements fmt.Stringer, returns a code format in english
+func (f CodeFormat) String() string {
+	switch f {
+	case CodeFormatAlpha2:
+		return "Alpha-2"
+	case CodeFormatAlpha3:
+		return "Alpha-3"
+	case CodeFormatNumeric:
+		return "Numeric"
+	case CodeFormatAlpha4:
+		return "Alpha-4"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (f CodeFormat) IsValid() bool {
+	return f.String() != UnknownMsg
+}
+
+// ByAlpha2 - returns CountryCode by ISO 3166-1 alpha-2 code only, case-insensitive, example: aut := ByAlpha2("AT"),
+// unlike ByName it does not accept names, aliases and other code formats, returns countries.Unknown, if the code not found
+func ByAlpha2(code string) CountryCode {
+	if len(code) != 2 {
+		return Unknown
+	}
+	countryCodesData()
+	if c, ok := countryByAlpha2[strings.ToUpper(code)]; ok {
+		return c
+	}
+	return Unknown
+}
+
+// ByAlpha3 - returns CountryCode by ISO 3166-1 alpha-3 code only, case-insensitive, example: aut := ByAlpha3("AUT"),
+// returns countries.Unknown, if the code not found
+func ByAlpha3(code string) CountryCode {
+	if len(code) != 3 {
+		return Unknown
+	}
+	countryCodesData()
+	if c, ok := countryByAlpha3[strings.ToUpper(code)]; ok {
+		return c
+	}
+	return Unknown
+}
+
+// ByNumericString - returns CountryCode by ISO 3166-1 numeric code of exactly three digits, example: aut := ByNumericString("040"),
+// returns countries.Unknown, if the code not found or has not three digits, example: ByNumericString("40") == Unknown
+func ByNumericString(code string) CountryCode {
+	if len(code) != 3 {
+		return Unknown
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return Unknown
+		}
+	}
+	numeric, err := strconv.Atoi(code)
+	if err != nil {
+		return Unknown
+	}
+	return ByNumeric(numeric)
+}
+
+// ByAlpha4 - returns CountryCode by ISO 3166-3 alpha-4 code of a formerly used name, case-insensitive,
+// the withdrawn country, if the package has it, example: ant := ByAlpha4("ANHH"), or the only country that took its territory,
+// example: mmr := ByAlpha4("BUMM"); returns countries.Unknown, if the code not found or the territory was split, example: ByAlpha4("SUHH")
+func ByAlpha4(code string) CountryCode { //nolint:gocyclo
+	if len(code) != 4 {
+		return Unknown
+	}
+	switch strings.ToUpper(code) {
+	case "AIDJ":
+		return DJI
+	case "ANHH":
+		return ANT
+	case "BQAQ", "NQAQ":
+		return ATA
+	case "BUMM":
+		return MMR
+	case "BYAA":
+		return BLR
+	case "CSXX", "YUCS":
+		return YUG
+	case "CTKI":
+		return KIR
+	case "DDDE":
+		return DEU
+	case "DYBJ":
+		return BEN
+	case "FXFR":
+		return FRA
+	case "HVBF":
+		return BFA
+	case "JTUM", "MIUM", "PUUM", "WKUM":
+		return UMI
+	case "NHVU":
+		return VUT
+	case "PZPA":
+		return PAN
+	case "RHZW":
+		return ZWE
+	case "SKIN":
+		return IND
+	case "TPTL":
+		return TLS
+	case "VDVN":
+		return VNM
+	case "YDYE":
+		return YEM
+	case "ZRCD":
+		return COD
+	}
+	return Unknown
+}
+
+// ParseAny - returns CountryCode by a code in any of ISO 3166 formats and the matched format, names are not accepted,
+// example: ParseAny("040") returns AUT, CodeFormatNumeric; errors are ErrNotFound and *WithdrawnError like ParseCountry
+func ParseAny(code string) (CountryCode, CodeFormat, error) {
+	c, format := Unknown, CodeFormatUnknown
+	switch len(code) {
+	case 2:
+		c, format = ByAlpha2(code), CodeFormatAlpha2
+	case 3:
+		if c = ByNumericString(code); c != Unknown {
+			format = CodeFormatNumeric
+		} else {
+			c, format = ByAlpha3(code), CodeFormatAlpha3
+		}
+	case 4:
+		c, format = ByAlpha4(code), CodeFormatAlpha4
+	}
+	if c == Unknown {
+		return Unknown, CodeFormatUnknown, fmt.Errorf("countries::ParseAny: %q err: %w", code, ErrNotFound)
+	}
+	if successors := c.Successors(); len(successors) > 0 {
+		return c, format, fmt.Errorf("countries::ParseAny: err: %w", &WithdrawnError{Name: code, Code: c, Successors: countriesLocalizable(successors)})
+	}
+	return c, format, nil
+}
+
+// countryCodesData - builds maps of ISO 3166-1 alpha-2 and alpha-3 codes once
+func countryCodesData() {
+	countryCodesOnce.Do(func() {
+		all := All()
+		countryByAlpha2 = make(map[string]CountryCode, len(all))
+		countryByAlpha3 = make(map[string]CountryCode, len(all))
+		for _, c := range all {
+			if _, ok := countryByAlpha2[c.Alpha2()]; !ok {
+				countryByAlpha2[c.Alpha2()] = c
+			}
+			if _, ok := countryByAlpha3[c.Alpha3()]; !ok {
+				countryByAlpha3[c.Alpha3()] = c
+			}
+		}
+	})
+}
+
+// ParseCountry - returns CountryCode by Alpha-2 / Alpha-3 / name like ByName, but reports why the lookup failed:
+// ErrNotFound, *AmbiguousError for names of several countries (example: "Korea", "Virgin Islands")
+// and *WithdrawnError with the code and successors for withdrawn codes (example: "ANT"), check them with errors.Is or errors.As;
+// an exact name of a country is never ambiguous, example: ParseCountry("Congo") == COG, ParseCountry("Korea (Republic of)") == KOR
+func ParseCountry(s string) (CountryCode, error) {
+	whole, key, _ := textPrepareAlternate(s)
+	if countryByExactName(s) == Unknown {
+		if candidates := ambiguousCountries(whole); len(candidates) > 0 {
+			return Unknown, fmt.Errorf("countries::ParseCountry: err: %w", &AmbiguousError{Name: s, Candidates: countriesLocalizable(candidates)})
+		}
+	}
+	if c := ByName(s); c != Unknown {
+		if successors := c.Successors(); len(successors) > 0 {
+			return c, fmt.Errorf("countries::ParseCountry: err: %w", &WithdrawnError{Name: s, Code: c, Successors: countriesLocalizable(successors)})
+		}
+		return c, nil
+	}
+	var candidates []Localizable
+	for _, c := range All() {
+		if containsToken(searchTokens(c.String()), key) {
+			candidates = append(candidates, c)
+		}
+	}
+	if len(candidates) > 1 {
+		return Unknown, fmt.Errorf("countries::ParseCountry: err: %w", &AmbiguousError{Name: s, Candidates: candidates})
+	}
+	return Unknown, fmt.Errorf("countries::ParseCountry: %q err: %w", s, ErrNotFound)
+}
+
+// ParseCurrency - returns CurrencyCode by Alpha code / name like CurrencyCodeByName, but reports why the lookup failed:
+// ErrNotFound, *AmbiguousError for a word of several currency names (example: "Dollar")
+// and *WithdrawnError with the code and successors for withdrawn currencies (example: "HRK")
+func ParseCurrency(s string) (CurrencyCode, error) {
+	if c := CurrencyCodeByName(s); c != CurrencyUnknown {
+		if successors := c.Successors(); len(successors) > 0 {
+			return c, fmt.Errorf("countries::ParseCurrency: err: %w", &WithdrawnError{Name: s, Code: c, Successors: currenciesLocalizable(successors)})
+		}
+		return c, nil
+	}
+	key := textPrepare(s)
+	var candidates []Localizable
+	for _, c := range AllCurrencies() {
+		if containsToken(searchTokens(c.String()), key) {
+			candidates = append(candidates, c)
+		}
+	}
+	if len(candidates) > 1 {
+		return CurrencyUnknown, fmt.Errorf("countries::ParseCurrency: err: %w", &AmbiguousError{Name: s, Candidates: candidates})
+	}
+	return CurrencyUnknown, fmt.Errorf("countries::ParseCurrency: %q err: %w", s, ErrNotFound)
+}
+
+var (
+	subdivisionsByNameOnce sync.Once
+	subdivisionsByName     map[string][]SubdivisionCode
+)
+
+// ParseSubdivision - returns SubdivisionCode by ISO 3166-2 code / name like SubdivisionCodeByName, but reports why the lookup failed:
+// ErrNotFound and *AmbiguousError for english names of several subdivisions (example: "Limburg" is in Belgium and Netherlands)
+func ParseSubdivision(s string) (SubdivisionCode, error) {
+	subdivisionsByNameOnce.Do(func() {
+		subdivisionsByName = map[string][]SubdivisionCode{}
+		for _, code := range AllSubdivisions() {
+			key := latinKey(code.String())
+			subdivisionsByName[key] = append(subdivisionsByName[key], code)
+		}
+	})
+	if codes := subdivisionsByName[latinKey(s)]; len(codes) > 1 {
+		candidates := make([]Localizable, len(codes))
+		for i, code := range codes {
+			candidates[i] = code
+		}
+		return SubdivisionUnknown, fmt.Errorf("countries::ParseSubdivision: err: %w", &AmbiguousError{Name: s, Candidates: candidates})
+	}
+	if code := SubdivisionCodeByName(s); code != SubdivisionUnknown {
+		return code, nil
+	}
+	return SubdivisionUnknown, fmt.Errorf("countries::ParseSubdivision: %q err: %w", s, ErrNotFound)
+}
+
+// ambiguousCountries - returns countries, which share the name, nil if the name is not ambiguous
+func ambiguousCountries(key string) []CountryCode {
+	switch key {
+	case "CONGO", "KONGO":
+		return []CountryCode{COG, COD}
+	case "KOREA", "KOREYA":
+		return []CountryCode{KOR, PRK}
+	case "VIRGINISLANDS":
+		return []CountryCode{VGB, VIR}
+	case "SAINTMARTIN", "STMARTIN", "SANKTMARTIN":
+		return []CountryCode{MAF, SXM}
+	}
+	return nil
+}
+
+func countriesLocalizable(codes []CountryCode) []Localizable {
+	items := make([]Localizable, len(codes))
+	for i, c := range codes {
+		items[i] = c
+	}
+	return items
+}
+
+func currenciesLocalizable(codes []CurrencyCode) []Localizable {
+	items := make([]Localizable, len(codes))
+	for i, c := range codes {
+		items[i] = c
+	}
+	return items
+}
+
+func containsToken(tokens []string, key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, token := range tokens {
+		if token == key {
+			return true
+		}
+	}
+	return false
+}
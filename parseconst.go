@@ -0,0 +1,13 @@
+package countries
+
+// TypeCodeFormat for Typer interface
+const TypeCodeFormat string = "countries.CodeFormat"
+
+// Code formats of ParseAny
+const (
+	CodeFormatUnknown CodeFormat = 0
+	CodeFormatAlpha2  CodeFormat = 1 // ISO 3166-1 alpha-2, example "AT"
+	CodeFormatAlpha3  CodeFormat = 2 // ISO 3166-1 alpha-3, example "AUT"
+	CodeFormatNumeric CodeFormat = 3 // ISO 3166-1 numeric, example "040"
+	CodeFormatAlpha4  CodeFormat = 4 // ISO 3166-3 alpha-4 of formerly used names, example "ANHH"
+)
@@ -0,0 +1,217 @@
+package countries
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// PostalCodeFormat - 邮政编码格式
+type PostalCodeFormat struct {
+	Name     string   `json:"name"`     // 名称，例如 "ZIP Code"、"PIN Code"、"PLZ"
+	Regexp   string   `json:"regexp"`   // 规范格式的正则表达式
+	Example  string   `json:"example"`  // 示例
+	Required bool     `json:"required"` // 地址中是否必填
+	formats  []string // 格式：# 数字，@ 字母，* 字母或数字，[ABC] 其中之一的字母
+}
+
+// Type implements Typer interface
+func (_ *PostalCodeFormat) Type() string {
+	return TypePostalCodeFormat
+}
+
+// PostalCode - returns the format of postal codes of the country, example: USA.PostalCode().Name == "ZIP Code",
+// GBR.PostalCode().Example == "SW1A 1AA"; nil for countries without postal codes and unknown codes
+func (c CountryCode) PostalCode() *PostalCodeFormat {
+	postalCodesData()
+	format, ok := postalCodesByCountry[c]
+	if !ok {
+		return nil
+	}
+	out := *format
+	return &out
+}
+
+// ValidatePostalCode - returns the postal code normalised to the format of the country: upper case, spacing and hyphens
+// as in the example, a prefix of the country is added or removed, example: ValidatePostalCode(GBR, "sw1a1aa") returns "SW1A 1AA",
+// ValidatePostalCode(DEU, "DE-10115") returns "10115"; an empty code is valid, if the postal code is not required;
+// errors are ErrNotFound for unknown countries and ErrInvalidFormat
+func ValidatePostalCode(c CountryCode, code string) (string, error) {
+	if !c.IsValid() {
+		return "", fmt.Errorf("countries::ValidatePostalCode: country %v err: %w", c, ErrNotFound)
+	}
+	postalCodesData()
+	format := postalCodesByCountry[c]
+	code = strings.ToUpper(strings.Join(strings.Fields(code), " "))
+	if code == "" {
+		if format != nil && format.Required {
+			return "", fmt.Errorf("countries::ValidatePostalCode: %v %s is required err: %w", c, format.Name, ErrInvalidFormat)
+		}
+		return "", nil
+	}
+	if format == nil {
+		return "", fmt.Errorf("countries::ValidatePostalCode: %q, %v has no postal codes err: %w", code, c, ErrInvalidFormat)
+	}
+	candidates := []string{code}
+	if alpha2 := c.Alpha2(); strings.HasPrefix(code, alpha2) {
+		candidates = append(candidates, strings.TrimLeft(code[len(alpha2):], " -"))
+	}
+	for _, candidate := range candidates {
+		for _, f := range format.formats {
+			if normalized, ok := postalCodeApply(f, candidate); ok {
+				return normalized, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("countries::ValidatePostalCode: %q is not a %v %s like %q err: %w", code, c, format.Name, format.Example, ErrInvalidFormat)
+}
+
+// PostalCodeSubdivision - returns the subdivision of a valid postal code by its prefix (US ZIP Code, CA forward sortation area,
+// DE PLZ), example: PostalCodeSubdivision(USA, "94105") == SubdivisionUSCA, PostalCodeSubdivision(CAN, "H2X 1Y4") == SubdivisionCAQC;
+// PLZ regions do not follow the borders of the Länder, so codes near the borders may resolve to a neighbouring Land;
+// returns SubdivisionUnknown for invalid codes and other countries
+func PostalCodeSubdivision(c CountryCode, code string) SubdivisionCode {
+	normalized, err := ValidatePostalCode(c, code)
+	if err != nil || normalized == "" {
+		return SubdivisionUnknown
+	}
+	normalized = postalCodeCompact(normalized)
+	found, length := SubdivisionUnknown, 0
+	for _, prefix := range postalPrefixes[c] {
+		n := len(prefix.first)
+		if n > length && len(normalized) >= n && normalized[:n] >= prefix.first && normalized[:n] <= prefix.last {
+			found, length = prefix.subdivision, n
+		}
+	}
+	return found
+}
+
+// postalCodeApply - returns the code formatted by the format, if the code without spaces and hyphens matches it;
+// a missing literal prefix of the format like "LV-" is added
+func postalCodeApply(format, code string) (string, bool) {
+	tokens := postalCodeTokens(format)
+	pattern := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if token != " " && token != "-" {
+			pattern = append(pattern, token)
+		}
+	}
+	input := []rune(postalCodeCompact(code))
+	if !postalCodeMatch(pattern, input) {
+		prefix := 0
+		for prefix < len(pattern) && !postalCodePlaceholder(pattern[prefix]) {
+			prefix++
+		}
+		input = append([]rune(strings.Join(pattern[:prefix], "")), input...)
+		if prefix == 0 || !postalCodeMatch(pattern, input) {
+			return "", false
+		}
+	}
+	var b strings.Builder
+	i := 0
+	for _, token := range tokens {
+		if token == " " || token == "-" {
+			b.WriteString(token)
+			continue
+		}
+		b.WriteRune(input[i])
+		i++
+	}
+	return b.String(), true
+}
+
+// postalCodeTokens - returns characters of the format, a class of letters in square brackets like "[ABC]" is one token
+func postalCodeTokens(format string) []string {
+	var tokens []string
+	for i := 0; i < len(format); {
+		if end := strings.IndexByte(format[i:], ']'); format[i] == '[' && end > 0 {
+			tokens = append(tokens, format[i:i+end+1])
+			i += end + 1
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(format[i:])
+		tokens = append(tokens, format[i:i+size])
+		i += size
+	}
+	return tokens
+}
+
+// postalCodePlaceholder - returns true, if the token of the format is a placeholder rather than a literal character
+func postalCodePlaceholder(token string) bool {
+	return token == "#" || token == "@" || token == "*" || strings.HasPrefix(token, "[")
+}
+
+// postalCodeMatch - returns true, if the compact code matches tokens of the compact format
+func postalCodeMatch(pattern []string, input []rune) bool {
+	if len(pattern) != len(input) {
+		return false
+	}
+	for i, r := range input {
+		digit, letter := r >= '0' && r <= '9', r >= 'A' && r <= 'Z'
+		switch token := pattern[i]; {
+		case token == "#":
+			if !digit {
+				return false
+			}
+		case token == "@":
+			if !letter {
+				return false
+			}
+		case token == "*":
+			if !digit && !letter {
+				return false
+			}
+		case strings.HasPrefix(token, "["):
+			if !letter || !strings.ContainsRune(token, r) {
+				return false
+			}
+		default:
+			if string(r) != token {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// postalCodeCompact - returns the code without spaces and hyphens
+func postalCodeCompact(code string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(code)
+}
+
+// postalCodeRegexp - returns a regular expression matching postal codes of the formats in the normalised form,
+// example: `^(?:[0-9]{5}|[0-9]{5}-[0-9]{4})$` for "#####|#####-####"
+func postalCodeRegexp(formats []string) string {
+	classes := map[string]string{"#": "[0-9]", "@": "[A-Z]", "*": "[A-Z0-9]"}
+	expressions := make([]string, 0, len(formats))
+	for _, format := range formats {
+		var b strings.Builder
+		tokens := postalCodeTokens(format)
+		for i := 0; i < len(tokens); {
+			j := i
+			for j < len(tokens) && tokens[j] == tokens[i] {
+				j++
+			}
+			class, ok := classes[tokens[i]]
+			if strings.HasPrefix(tokens[i], "[") {
+				class, ok = tokens[i], true
+			}
+			switch {
+			case !ok:
+				b.WriteString(regexp.QuoteMeta(strings.Join(tokens[i:j], "")))
+			case j-i > 1:
+				b.WriteString(class + "{" + strconv.Itoa(j-i) + "}")
+			default:
+				b.WriteString(class)
+			}
+			i = j
+		}
+		expressions = append(expressions, b.String())
+	}
+	if len(expressions) == 1 {
+		return "^" + expressions[0] + "$"
+	}
+	return "^(?:" + strings.Join(expressions, "|") + ")$"
+}
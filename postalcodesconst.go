@@ -0,0 +1,4 @@
+package countries
+
+// TypePostalCodeFormat for Typer interface
+const TypePostalCodeFormat string = "countries.PostalCodeFormat"
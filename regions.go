@@ -133,7 +133,17 @@ func AllRegionsInfo() []*Region {
 
 // RegionCodeByName - return RegionCode by region name, case-insensitive, example: regionEU := RegionCodeByName("eu") OR regionEU := RegionCodeByName("europe")
 func RegionCodeByName(name string) RegionCode {
-	switch textPrepare(name) {
+	code := RegionUnknown
+	textLookup(name, func(key string) bool {
+		code = regionByKey(key)
+		return code != RegionUnknown
+	}, nil)
+	return code
+}
+
+// regionByKey - returns RegionCode by a key of textPrepare, returns RegionUnknown, if the key not found
+func regionByKey(key string) RegionCode {
+	switch key {
 	case "AF", "AFRICA", "AFRIKA":
 		return RegionAF
 	case "NA", "NORTHAMERICA", "NORTHAMERIC":
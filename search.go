@@ -0,0 +1,346 @@
+package countries
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+// EntityKind - 实体类型（国家、首都、货币、细分区域）
+type EntityKind int64 // int64 for database/sql/driver.Valuer compatibility
+
+// MatchKind - 搜索匹配类型（代码、精确、别名、前缀、词、模糊）
+type MatchKind int64 // int64 for database/sql/driver.Valuer compatibility
+
+// SearchResult - a candidate found by Search
+type SearchResult struct {
+	Code  Localizable // CountryCode, CapitalCode or CurrencyCode
+	Kind  EntityKind
+	Name  string // the best matching name or code of the candidate
+	Match MatchKind
+	Score float64 // from 0 to 1, 1 for code and exact name matches
+}
+
+// searchMinScore - candidates with a lower score are not returned by Search
+const searchMinScore = 0.5
+
+// searchMinFuzzyLength - names and queries shorter than this number of letters are not compared by the edit distance
+const searchMinFuzzyLength = 3
+
+// searchEntry - a name or a code of a searchable entity
+type searchEntry struct {
+	code    Localizable
+	kind    EntityKind
+	name    string
+	key     string
+	letters []rune
+	tokens  []string
+	isCode  bool
+}
+
+// searchQuery - a Search query with the buffer of edit distance rows reused for all entries
+type searchQuery struct {
+	key     string
+	letters []rune
+	tokens  []string
+	rows    []int
+}
+
+var (
+	searchEntriesOnce sync.Once
+	searchEntries     []searchEntry
+)
+
+// Type implements Typer interface
+func (_ EntityKind) Type() string {
+	return TypeEntityKind
+}
+
+// String - implements fmt.Stringer, returns an entity kind in english
+func (k EntityKind) String() string {
+	switch k {
+	case EntityKindCountry:
+		return "Country"
+	case EntityKindCapital:
+		return "Capital"
+	case EntityKindCurrency:
+		return "Currency"
+	case EntityKindSubdivision:
+		return "Subdivision"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (k EntityKind) IsValid() bool {
+	return k.String() != UnknownMsg
+}
+
+// Type implements Typer interface
+func (_ MatchKind) Type() string {
+	return TypeMatchKind
+}
+
+// String - implements fmt.Stringer, returns a match kind in english
+func (k MatchKind) String() string {
+	switch k {
+	case MatchKindFuzzy:
+		return "Fuzzy"
+	case MatchKindToken:
+		return "Token"
+	case MatchKindPrefix:
+		return "Prefix"
+	case MatchKindAlias:
+		return "Alias"
+	case MatchKindExact:
+		return "Exact"
+	case MatchKindCode:
+		return "Code"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (k MatchKind) IsValid() bool {
+	return k.String() != UnknownMsg
+}
+
+// Type implements Typer interface
+func (_ SearchResult) Type() string {
+	return TypeSearchResult
+}
+
+// Search - returns up to limit countries, capitals and currencies matching the query, ordered by score,
+// case-insensitive and tolerant to typos, example: Search("Untied States", 3)[0].Code == USA OR Search("Philipines", 3)[0].Code == PHL;
+// codes ("DE", "EUR") and names accepted by ByName, CapitalCodeByName and CurrencyCodeByName score 1 or 0.95,
+// prefixes, shared words and misspellings score less; limit <= 0 returns all candidates with score >= 0.5
+func Search(query string, limit int) []SearchResult {
+	qkey := latinKey(query)
+	if qkey == "" {
+		return nil
+	}
+	q := &searchQuery{key: qkey, letters: []rune(qkey), tokens: searchTokens(query)}
+	aliases := map[Localizable]bool{}
+	if c := ByName(query); c.IsValid() {
+		aliases[c] = true
+	}
+	if c := CapitalCodeByName(query); c.IsValid() && c != CapitalXX {
+		aliases[c] = true
+	}
+	if c := CurrencyCodeByName(query); c.IsValid() {
+		aliases[c] = true
+	}
+
+	best := map[Localizable]int{}
+	var results []SearchResult
+	for _, entry := range allSearchEntries() {
+		score, match := entry.score(q)
+		if aliases[entry.code] && score < 0.95 {
+			score, match = 0.95, MatchKindAlias
+		}
+		if score < searchMinScore {
+			continue
+		}
+		result := SearchResult{Code: entry.code, Kind: entry.kind, Name: entry.name, Match: match, Score: score}
+		if match == MatchKindAlias {
+			result.Name = entry.code.String()
+		}
+		if i, ok := best[entry.code]; ok {
+			if score > results[i].Score {
+				results[i] = result
+			}
+			continue
+		}
+		best[entry.code] = len(results)
+		results = append(results, result)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Kind < results[j].Kind
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// BestMatch - returns the top Search result, if its score is at least minScore, for auto-correction of messy input,
+// example: BestMatch("Untied States", 0.8) returns USA
+func BestMatch(query string, minScore float64) (SearchResult, bool) {
+	results := Search(query, 1)
+	if len(results) == 0 || results[0].Score < minScore {
+		return SearchResult{}, false
+	}
+	return results[0], true
+}
+
+// allSearchEntries - returns english names and codes of all countries, capitals and currencies
+func allSearchEntries() []searchEntry {
+	searchEntriesOnce.Do(func() {
+		type entryKey struct {
+			code Localizable
+			key  string
+		}
+		seen := map[entryKey]bool{}
+		add := func(code Localizable, kind EntityKind, name string, isCode bool) {
+			key := latinKey(name)
+			if key == "" || seen[entryKey{code, key}] {
+				return
+			}
+			seen[entryKey{code, key}] = true
+			searchEntries = append(searchEntries, searchEntry{
+				code: code, kind: kind, name: name, key: key, letters: []rune(key), tokens: searchTokens(name), isCode: isCode,
+			})
+		}
+		for _, c := range All() {
+			add(c, EntityKindCountry, c.Alpha2(), true)
+			add(c, EntityKindCountry, c.Alpha3(), true)
+			for _, form := range []NameForm{NameFormDefault, NameFormShort, NameFormVariant, NameFormOfficial} {
+				add(c, EntityKindCountry, c.NameIn(language.English, form), false)
+			}
+			add(c, EntityKindCountry, c.String(), false)
+		}
+		for _, c := range AllCapitals() {
+			if c != CapitalXX {
+				add(c, EntityKindCapital, c.String(), false)
+			}
+		}
+		for _, c := range AllCurrencies() {
+			add(c, EntityKindCurrency, c.Alpha(), true)
+			add(c, EntityKindCurrency, c.String(), false)
+		}
+	})
+	return searchEntries
+}
+
+// score - returns the score of the entry for the query, names shorter than searchMinFuzzyLength letters are not compared
+// by the edit distance, so "DE" does not match "BE" or "Dili"
+func (e searchEntry) score(q *searchQuery) (float64, MatchKind) {
+	if q.key == e.key {
+		if e.isCode {
+			return 1, MatchKindCode
+		}
+		return 1, MatchKindExact
+	}
+	if e.isCode {
+		return 0, MatchKindUnknown
+	}
+	score, match := 0.0, MatchKindUnknown
+	if len(q.key) >= 2 && strings.HasPrefix(e.key, q.key) {
+		score, match = 0.6+0.3*float64(len(q.key))/float64(len(e.key)), MatchKindPrefix
+	}
+	if overlap := tokenOverlap(q.tokens, e.tokens); overlap > 0 && 0.85*overlap > score {
+		score, match = 0.85*overlap, MatchKindToken
+	}
+	if len(q.letters) < searchMinFuzzyLength || len(e.letters) < searchMinFuzzyLength {
+		return score, match
+	}
+	if similarity := q.editSimilarity(e.letters, math.Max(score, searchMinScore)/0.9); 0.9*similarity > score {
+		score, match = 0.9*similarity, MatchKindFuzzy
+	}
+	return score, match
+}
+
+// searchTokens - returns words of the text as latin keys, example: searchTokens("Korea, Republic of") == ["KOREA", "REPUBLIC", "OF"]
+func searchTokens(text string) []string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsMark(r)
+	})
+	tokens := words[:0]
+	for _, word := range words {
+		if key := latinKey(word); key != "" {
+			tokens = append(tokens, key)
+		}
+	}
+	return tokens
+}
+
+// tokenOverlap - returns the share of distinct words found in both lists (Jaccard index)
+func tokenOverlap(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[string]bool, len(a))
+	for _, token := range a {
+		set[token] = true
+	}
+	common, union := 0, len(set)
+	seen := map[string]bool{}
+	for _, token := range b {
+		if seen[token] {
+			continue
+		}
+		seen[token] = true
+		if set[token] {
+			common++
+		} else {
+			union++
+		}
+	}
+	return float64(common) / float64(union)
+}
+
+// editSimilarity - returns 1 - distance / length, where distance is the optimal string alignment distance
+// (insertions, deletions, substitutions and transpositions of adjacent letters) of the query and the name;
+// returns 0 as soon as the similarity is known to be below minSimilarity, the rows of the distance matrix are reused
+func (q *searchQuery) editSimilarity(name []rune, minSimilarity float64) float64 {
+	a := q.letters
+	n, m := len(a), len(name)
+	length := n
+	if m > length {
+		length = m
+	}
+	maxDistance := int(math.Floor((1-minSimilarity)*float64(length) + 1e-9))
+	if n-m > maxDistance || m-n > maxDistance {
+		return 0
+	}
+	if cap(q.rows) < 3*(m+1) {
+		q.rows = make([]int, 3*(m+1))
+	}
+	prev2, prev, row := q.rows[:m+1], q.rows[m+1:2*(m+1)], q.rows[2*(m+1):3*(m+1)]
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= n; i++ {
+		row[0] = i
+		rowMin := i
+		for j := 1; j <= m; j++ {
+			cost := 1
+			if a[i-1] == name[j-1] {
+				cost = 0
+			}
+			d := min3(prev[j]+1, row[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == name[j-2] && a[i-2] == name[j-1] && prev2[j-2]+1 < d {
+				d = prev2[j-2] + 1
+			}
+			row[j] = d
+			if d < rowMin {
+				rowMin = d
+			}
+		}
+		if rowMin > maxDistance {
+			return 0
+		}
+		prev2, prev, row = prev, row, prev2
+	}
+	if prev[m] > maxDistance {
+		return 0
+	}
+	return 1 - float64(prev[m])/float64(length)
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
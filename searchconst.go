@@ -0,0 +1,33 @@
+package countries
+
+// TypeEntityKind for Typer interface
+const TypeEntityKind string = "countries.EntityKind"
+
+// TypeMatchKind for Typer interface
+const TypeMatchKind string = "countries.MatchKind"
+
+// TypeSearchResult for Typer interface
+const TypeSearchResult string = "countries.SearchResult"
+
+// TypeSuggestion for Typer interface
+const TypeSuggestion string = "countries.Suggestion"
+
+// Entity kinds
+const (
+	EntityKindUnknown     EntityKind = 0
+	EntityKindCountry     EntityKind = 1 // CountryCode
+	EntityKindCapital     EntityKind = 2 // CapitalCode
+	EntityKindCurrency    EntityKind = 3 // CurrencyCode
+	EntityKindSubdivision EntityKind = 4 // SubdivisionCode
+)
+
+// Match kinds, ordered by confidence
+const (
+	MatchKindUnknown MatchKind = 0
+	MatchKindFuzzy   MatchKind = 1 // names differ by a few edits, example "Philipines" for "Philippines"
+	MatchKindToken   MatchKind = 2 // names share words, example "Korea" for "Korea, Republic of"
+	MatchKindPrefix  MatchKind = 3 // the query is a beginning of the name, example "Switz" for "Switzerland"
+	MatchKindAlias   MatchKind = 4 // the query is an alias or a localized name, example "Deutschland" for "Germany"
+	MatchKindExact   MatchKind = 5 // the query is the name
+	MatchKindCode    MatchKind = 6 // the query is the code, example "DE" or "EUR"
+)
@@ -0,0 +1,111 @@
+package countries
+
+import (
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// AllSortedBy - returns all country codes (All) ordered by the name in the language (Localized) using the language collation,
+// example: AllSortedBy(language.Swedish) puts "Åland" after "Zambia"; the pinned countries go first in the given order, example for dropdowns:
+// AllSortedBy(language.English, USA, CAN)
+func AllSortedBy(lang language.Tag, pinned ...CountryCode) []CountryCode {
+	codes := All()
+	items := make([]Localizable, len(codes))
+	for i, c := range codes {
+		items[i] = c
+	}
+	pins := make([]Localizable, len(pinned))
+	for i, c := range pinned {
+		pins[i] = c
+	}
+	sorted := sortLocalized(items, lang, pins)
+	for i, item := range sorted {
+		codes[i] = item.(CountryCode)
+	}
+	return codes[:len(sorted)]
+}
+
+// AllCurrenciesSortedBy - returns all currency codes (AllCurrencies) ordered by the name in the language (Localized) using the language collation,
+// the pinned currencies go first in the given order
+func AllCurrenciesSortedBy(lang language.Tag, pinned ...CurrencyCode) []CurrencyCode {
+	codes := AllCurrencies()
+	items := make([]Localizable, len(codes))
+	for i, c := range codes {
+		items[i] = c
+	}
+	pins := make([]Localizable, len(pinned))
+	for i, c := range pinned {
+		pins[i] = c
+	}
+	sorted := sortLocalized(items, lang, pins)
+	for i, item := range sorted {
+		codes[i] = item.(CurrencyCode)
+	}
+	return codes[:len(sorted)]
+}
+
+// SubdivisionsSortedBy - returns the subdivisions of a country (SubdivisionsByCountryCode) ordered by the name in the language (Localized)
+// using the language collation, the pinned subdivisions go first in the given order
+func SubdivisionsSortedBy(c CountryCode, lang language.Tag, pinned ...SubdivisionCode) []SubdivisionCode {
+	codes := SubdivisionsByCountryCode(c)
+	items := make([]Localizable, len(codes))
+	for i, s := range codes {
+		items[i] = s
+	}
+	pins := make([]Localizable, len(pinned))
+	for i, s := range pinned {
+		pins[i] = s
+	}
+	sorted := sortLocalized(items, lang, pins)
+	for i, item := range sorted {
+		codes[i] = item.(SubdivisionCode)
+	}
+	return codes[:len(sorted)]
+}
+
+// localizedList - implements collate.Lister for codes and their localized names
+type localizedList struct {
+	codes []Localizable
+	names [][]byte
+}
+
+func (l *localizedList) Len() int {
+	return len(l.codes)
+}
+
+func (l *localizedList) Swap(i, j int) {
+	l.codes[i], l.codes[j] = l.codes[j], l.codes[i]
+	l.names[i], l.names[j] = l.names[j], l.names[i]
+}
+
+func (l *localizedList) Bytes(i int) []byte {
+	return l.names[i]
+}
+
+// sortLocalized - returns the pinned codes present in items followed by the other items ordered by the localized names
+func sortLocalized(items []Localizable, lang language.Tag, pinned []Localizable) []Localizable {
+	result := make([]Localizable, 0, len(items))
+	isPinned := make(map[Localizable]bool, len(pinned))
+	present := make(map[Localizable]bool, len(items))
+	for _, item := range items {
+		present[item] = true
+	}
+	for _, pin := range pinned {
+		if present[pin] && !isPinned[pin] {
+			isPinned[pin] = true
+			result = append(result, pin)
+		}
+	}
+
+	localizer := DefaultLocalizer()
+	list := &localizedList{}
+	for _, item := range items {
+		if isPinned[item] {
+			continue
+		}
+		list.codes = append(list.codes, item)
+		list.names = append(list.names, []byte(localizer.Localized(item, lang)))
+	}
+	collate.New(lang).Sort(list)
+	return append(result, list.codes...)
+}
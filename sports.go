@@ -0,0 +1,325 @@
+package countries
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Federation - 国际体育组织（FIFA、IOC）
+type Federation int64 // int64 for database/sql/driver.Valuer compatibility
+
+// Confederation - 洲际体育联合会（UEFA、CONMEBOL、EOC 等）
+type Confederation int64 // int64 for database/sql/driver.Valuer compatibility
+
+// SportsTeam - 国家或地区代表队
+type SportsTeam struct {
+	Code          string          `json:"code"`          // FIFA 或 IOC 代码
+	Name          string          `json:"name"`          // 代表队名称
+	Federation    Federation      `json:"federation"`    // 国际体育组织
+	Confederation Confederation   `json:"confederation"` // 洲际联合会
+	Country       CountryCode     `json:"country"`       // 国家代码
+	Subdivision   SubdivisionCode `json:"subdivision"`   // 子区域代码，例如 GB-SCT
+	Member        bool            `json:"member"`        // false 表示仅为洲际联合会成员
+}
+
+var (
+	sportsTeamsOnce sync.Once
+	sportsTeams     map[Federation][]*SportsTeam
+)
+
+// Type implements Typer interface
+func (_ Federation) Type() string {
+	return TypeFederation
+}
+
+// String - implements fmt.Stringer, returns an abbreviation of the federation
+func (f Federation) String() string {
+	switch f {
+	case FederationFIFA:
+		return "FIFA"
+	case FederationIOC:
+		return "IOC"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (f Federation) IsValid() bool {
+	return f.String() != UnknownMsg
+}
+
+// Type implements Typer interface
+func (_ Confederation) Type() string {
+	return TypeConfederation
+}
+
+// String - implements fmt.Stringer, returns an abbreviation of the confederation
+func (c Confederation) String() string { //nolint:gocyclo
+	switch c {
+	case ConfederationAFC:
+		return "AFC"
+	case ConfederationCAF:
+		return "CAF"
+	case ConfederationCONCACAF:
+		return "CONCACAF"
+	case ConfederationCONMEBOL:
+		return "CONMEBOL"
+	case ConfederationOFC:
+		return "OFC"
+	case ConfederationUEFA:
+		return "UEFA"
+	case ConfederationANOCA:
+		return "ANOCA"
+	case ConfederationPanamSports:
+		return "Panam Sports"
+	case ConfederationOCA:
+		return "OCA"
+	case ConfederationEOC:
+		return "EOC"
+	case ConfederationONOC:
+		return "ONOC"
+	}
+	return UnknownMsg
+}
+
+// IsValid - returns true, if code is correct
+func (c Confederation) IsValid() bool {
+	return c.String() != UnknownMsg
+}
+
+// Federation - returns the federation of the confederation
+func (c Confederation) Federation() Federation {
+	switch {
+	case c >= ConfederationAFC && c <= ConfederationUEFA:
+		return FederationFIFA
+	case c >= ConfederationANOCA && c <= ConfederationONOC:
+		return FederationIOC
+	}
+	return FederationUnknown
+}
+
+// Type implements Typer interface
+func (_ SportsTeam) Type() string {
+	return TypeSportsTeam
+}
+
+// Confederation - returns the FIFA confederation of the country football association, example: UEFA for Germany,
+// associate members of confederations, example: Réunion, Guadeloupe, are included
+func (c CountryCode) Confederation() Confederation { //nolint:gocyclo
+	switch c {
+	case AFG, AUS, BHR, BGD, BTN, BRN, KHM, CHN, TWN, GUM, HKG, IND, IDN, IRN, IRQ, JPN, JOR, PRK, KOR, KWT,
+		KGZ, LAO, LBN, MAC, MYS, MDV, MNG, MMR, NPL, OMN, PAK, PSE, PHL, QAT, SAU, SGP, LKA, SYR, TJK, THA, TLS,
+		TKM, ARE, UZB, VNM, YEM, MNP:
+		return ConfederationAFC
+	case DZA, AGO, BEN, BWA, BFA, BDI, CMR, CPV, CAF, TCD, COM, COG, COD, DJI, EGY, GNQ, ERI, SWZ, ETH, GAB,
+		GMB, GHA, GIN, GNB, CIV, KEN, LSO, LBR, LBY, MDG, MWI, MLI, MRT, MUS, MAR, MOZ, NAM, NER, NGA, RWA, STP,
+		SEN, SYC, SLE, SOM, ZAF, SSD, SDN, TZA, TGO, TUN, UGA, ZMB, ZWE, REU:
+		return ConfederationCAF
+	case AIA, ATG, ABW, BHS, BRB, BLZ, BMU, VGB, CAN, CYM, CRI, CUB, CUW, DMA, DOM, SLV, GRD, GTM, GUY, HTI,
+		HND, JAM, MEX, MSR, NIC, PAN, PRI, KNA, LCA, VCT, SUR, TTO, TCA, USA, VIR, BES, GUF, GLP, MTQ, MAF, SXM:
+		return ConfederationCONCACAF
+	case ARG, BOL, BRA, CHL, COL, ECU, PRY, PER, URY, VEN:
+		return ConfederationCONMEBOL
+	case ASM, COK, FJI, NCL, NZL, PNG, WSM, SLB, PYF, TON, VUT, KIR, NIU, TUV:
+		return ConfederationOFC
+	case ALB, AND, ARM, AUT, AZE, BLR, BEL, BIH, BGR, HRV, CYP, CZE, DNK, EST, FRO, FIN, FRA, GEO, DEU, GIB,
+		GRC, HUN, ISL, ISR, ITA, KAZ, XKX, LVA, LIE, LTU, LUX, MLT, MDA, MNE, NLD, MKD, GBR, NOR, POL, PRT, IRL,
+		ROU, RUS, SMR, SRB, SVK, SVN, ESP, SWE, CHE, TUR, UKR:
+		return ConfederationUEFA
+	}
+	return ConfederationUnknown
+}
+
+// OlympicAssociation - returns the continental association of the country National Olympic Committee,
+// example: EOC for Germany, ConfederationUnknown if the country has no NOC
+func (c CountryCode) OlympicAssociation() Confederation { //nolint:gocyclo
+	switch c {
+	case AFG, BHR, BGD, BTN, BRN, KHM, CHN, TWN, HKG, IND, IDN, IRN, IRQ, JPN, JOR, KAZ, PRK, KOR, KWT, KGZ,
+		LAO, LBN, MYS, MDV, MNG, MMR, NPL, OMN, PAK, PSE, PHL, QAT, SAU, SGP, LKA, SYR, TJK, THA, TLS, TKM, ARE,
+		UZB, VNM, YEM:
+		return ConfederationOCA
+	case ALB, AND, ARM, AUT, AZE, BLR, BEL, BIH, BGR, HRV, CYP, CZE, DNK, EST, FIN, FRA, GEO, DEU, GBR, GRC,
+		HUN, ISL, IRL, ISR, ITA, XKX, LVA, LIE, LTU, LUX, MLT, MDA, MCO, MNE, NLD, MKD, NOR, POL, PRT, ROU, RUS,
+		SMR, SRB, SVK, SVN, ESP, SWE, CHE, TUR, UKR:
+		return ConfederationEOC
+	case AUS, FJI, KIR, MHL, FSM, NRU, NZL, PLW, PNG, WSM, SLB, TON, TUV, VUT, ASM, COK, GUM:
+		return ConfederationONOC
+	case ATG, ARG, ABW, BHS, BRB, BLZ, BMU, BOL, BRA, VGB, CAN, CYM, CHL, COL, CRI, CUB, DMA, DOM, ECU, SLV,
+		GRD, GTM, GUY, HTI, HND, JAM, MEX, NIC, PAN, PRY, PER, PRI, KNA, LCA, VCT, SUR, TTO, USA, URY, VEN, VIR:
+		return ConfederationPanamSports
+	case DZA, AGO, BEN, BWA, BFA, BDI, CMR, CPV, CAF, TCD, COM, COG, COD, DJI, EGY, GNQ, ERI, SWZ, ETH, GAB,
+		GMB, GHA, GIN, GNB, CIV, KEN, LSO, LBR, LBY, MDG, MWI, MLI, MRT, MUS, MAR, MOZ, NAM, NER, NGA, RWA, STP,
+		SEN, SYC, SLE, SOM, ZAF, SSD, SDN, TZA, TGO, TUN, UGA, ZMB, ZWE:
+		return ConfederationANOCA
+	}
+	return ConfederationUnknown
+}
+
+// SportsTeams - returns teams of the country in the federation, example: GBR.SportsTeams(FederationFIFA)
+// returns England, Scotland, Wales and Northern Ireland, GBR.SportsTeams(FederationIOC) returns Great Britain
+func (c CountryCode) SportsTeams(federation Federation) []*SportsTeam {
+	var teams []*SportsTeam
+	for _, team := range sportsTeamsData()[federation] {
+		if team.Country == c {
+			teams = append(teams, team.copy())
+		}
+	}
+	return teams
+}
+
+// SportsTeam - returns the team of the subdivision in the federation, example: SubdivisionGBSCT.SportsTeam(FederationFIFA)
+// returns Scotland, errors are ErrNotFound, if the subdivision has no own team
+func (s SubdivisionCode) SportsTeam(federation Federation) (*SportsTeam, error) {
+	for _, team := range sportsTeamsData()[federation] {
+		if team.Subdivision == s && s != SubdivisionUnknown {
+			return team.copy(), nil
+		}
+	}
+	return nil, fmt.Errorf("countries::SportsTeam: %q err: %w", s, ErrNotFound)
+}
+
+// AllSportsTeams - returns all teams of the federation: members and associate members of confederations
+func AllSportsTeams(federation Federation) []*SportsTeam {
+	teams := sportsTeamsData()[federation]
+	out := make([]*SportsTeam, len(teams))
+	for i, team := range teams {
+		out[i] = team.copy()
+	}
+	return out
+}
+
+// SportsTeamByCode - returns the team by FIFA or IOC code, case-insensitive, example: SportsTeamByCode(FederationFIFA, "SCO")
+// returns Scotland with Country GBR and Subdivision GB-SCT; errors are ErrNotFound
+func SportsTeamByCode(federation Federation, code string) (*SportsTeam, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	for _, team := range sportsTeamsData()[federation] {
+		if team.Code == code {
+			return team.copy(), nil
+		}
+	}
+	return nil, fmt.Errorf("countries::SportsTeamByCode: %v %q err: %w", federation, code, ErrNotFound)
+}
+
+func (team *SportsTeam) copy() *SportsTeam {
+	out := *team
+	return &out
+}
+
+// fifaAssociateCode - returns the code of a team, which is a member of a FIFA confederation only, "" for FIFA members
+func fifaAssociateCode(c CountryCode) string {
+	switch c {
+	case MNP:
+		return "NMI"
+	case REU:
+		return "REU"
+	case BES:
+		return "BOE"
+	case GUF:
+		return "GYF"
+	case GLP:
+		return "GLP"
+	case MTQ:
+		return "MTQ"
+	case MAF:
+		return "SMN"
+	case SXM:
+		return "SMA"
+	case KIR:
+		return "KIR"
+	case NIU:
+		return "NIU"
+	case TUV:
+		return "TUV"
+	}
+	return ""
+}
+
+// iocTeamName - returns the name of the National Olympic Committee team as designated by the IOC, example: "Great Britain"
+// for GBR and "Chinese Taipei" for TWN, the english name of the country for others
+func iocTeamName(c CountryCode) string {
+	switch c {
+	case GBR:
+		return "Great Britain"
+	case TWN:
+		return "Chinese Taipei"
+	case HKG:
+		return "Hong Kong, China"
+	case IRN:
+		return "Islamic Republic of Iran"
+	case TZA:
+		return "United Republic of Tanzania"
+	case FSM:
+		return "Federated States of Micronesia"
+	case MDA:
+		return "Republic of Moldova"
+	case VGB:
+		return "British Virgin Islands"
+	case VIR:
+		return "Virgin Islands, US"
+	case PSE:
+		return "Palestine"
+	case MKD:
+		return "North Macedonia"
+	case TLS:
+		return "Timor-Leste"
+	case SWZ:
+		return "Eswatini"
+	case CPV:
+		return "Cabo Verde"
+	case GMB:
+		return "The Gambia"
+	case TUR:
+		return "Türkiye"
+	}
+	return c.String()
+}
+
+// sportsTeamsData - returns teams of all federations, built once
+func sportsTeamsData() map[Federation][]*SportsTeam {
+	sportsTeamsOnce.Do(func() {
+		sportsTeams = map[Federation][]*SportsTeam{}
+		for _, c := range All() {
+			if confederation := c.Confederation(); confederation != ConfederationUnknown {
+				switch code := fifaAssociateCode(c); {
+				case c == GBR:
+					for _, team := range []struct {
+						code, name  string
+						subdivision SubdivisionCode
+					}{
+						{"ENG", "England", SubdivisionGBENG},
+						{"SCO", "Scotland", SubdivisionGBSCT},
+						{"WAL", "Wales", SubdivisionGBWLS},
+						{"NIR", "Northern Ireland", SubdivisionGBNIR},
+					} {
+						sportsTeams[FederationFIFA] = append(sportsTeams[FederationFIFA], &SportsTeam{
+							Code: team.code, Name: team.name, Federation: FederationFIFA, Confederation: confederation,
+							Country: c, Subdivision: team.subdivision, Member: true,
+						})
+					}
+				case code != "":
+					sportsTeams[FederationFIFA] = append(sportsTeams[FederationFIFA], &SportsTeam{
+						Code: code, Name: c.String(), Federation: FederationFIFA, Confederation: confederation, Country: c,
+					})
+				default:
+					name := c.String()
+					if c == PYF {
+						name = "Tahiti"
+					}
+					sportsTeams[FederationFIFA] = append(sportsTeams[FederationFIFA], &SportsTeam{
+						Code: c.FIFA(), Name: name, Federation: FederationFIFA, Confederation: confederation, Country: c, Member: true,
+					})
+				}
+			}
+			if association := c.OlympicAssociation(); association != ConfederationUnknown {
+				sportsTeams[FederationIOC] = append(sportsTeams[FederationIOC], &SportsTeam{
+					Code: c.IOC(), Name: iocTeamName(c), Federation: FederationIOC, Confederation: association, Country: c, Member: true,
+				})
+			}
+		}
+		sportsTeams[FederationFIFA] = append(sportsTeams[FederationFIFA], &SportsTeam{
+			Code: "ZAN", Name: "Zanzibar", Federation: FederationFIFA, Confederation: ConfederationCAF, Country: TZA,
+		})
+	})
+	return sportsTeams
+}
@@ -0,0 +1,33 @@
+package countries
+
+// TypeFederation for Typer interface
+const TypeFederation string = "countries.Federation"
+
+// TypeConfederation for Typer interface
+const TypeConfederation string = "countries.Confederation"
+
+// TypeSportsTeam for Typer interface
+const TypeSportsTeam string = "countries.SportsTeam"
+
+// Federations
+const (
+	FederationUnknown Federation = 0
+	FederationFIFA    Federation = 1 // Fédération Internationale de Football Association
+	FederationIOC     Federation = 2 // International Olympic Committee
+)
+
+// Confederations of FIFA and continental associations of National Olympic Committees
+const (
+	ConfederationUnknown     Confederation = 0
+	ConfederationAFC         Confederation = 1  // Asian Football Confederation
+	ConfederationCAF         Confederation = 2  // Confederation of African Football
+	ConfederationCONCACAF    Confederation = 3  // North, Central America and Caribbean
+	ConfederationCONMEBOL    Confederation = 4  // South American Football Confederation
+	ConfederationOFC         Confederation = 5  // Oceania Football Confederation
+	ConfederationUEFA        Confederation = 6  // Union of European Football Associations
+	ConfederationANOCA       Confederation = 7  // Association of National Olympic Committees of Africa
+	ConfederationPanamSports Confederation = 8  // Panam Sports, Americas
+	ConfederationOCA         Confederation = 9  // Olympic Council of Asia
+	ConfederationEOC         Confederation = 10 // European Olympic Committees
+	ConfederationONOC        Confederation = 11 // Oceania National Olympic Committees
+)
@@ -3,6 +3,7 @@ package countries
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // SubdivisionCode - 细分区域代码
@@ -34314,6 +34315,17 @@ func SubdivisionsByCountryCode(c CountryCode) []SubdivisionCode {
 	return AllSubdivisionsByCountryCode()[c]
 }
 
+// SubdivisionCodeByName - returns SubdivisionCode by ISO 3166-2 code, english or chinese name, case-insensitive,
+// pinyin with or without tones is accepted too, example: subdivisionUSCA := SubdivisionCodeByName("US-CA") OR subdivisionUSCA := SubdivisionCodeByName("California")
+// OR subdivisionUSCA := SubdivisionCodeByName("加利福尼亚州") OR subdivisionUSCA := SubdivisionCodeByName("jialifuniyazhou"),
+// returns SubdivisionUnknown, if subdivision name not found; the first subdivision of AllSubdivisions wins on names shared by several subdivisions
+func SubdivisionCodeByName(name string) SubdivisionCode {
+	if code := SubdivisionCode(strings.ToUpper(strings.TrimSpace(name))); code != SubdivisionUnknown && code.IsValid() {
+		return code
+	}
+	return subdivisionByLocalizedName(name)
+}
+
 // TotalSubdivisions - returns number of subdivisions in the package
 func TotalSubdivisions() int {
 	return 4885
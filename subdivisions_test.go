@@ -2,7 +2,10 @@ package countries
 
 import (
 	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 )
 
 // Test Subdivisions
@@ -177,3 +180,153 @@ func TestSubdivisionsByCountryCode(t *testing.T) {
 		t.Errorf("Australia has 8 subdivisions but got %d", count)
 	}
 }
+
+//nolint:gocyclo
+func TestSubdivisionCodeByName(t *testing.T) {
+	tests := map[string]SubdivisionCode{
+		"US-CA":           SubdivisionUSCA,
+		"us-ca":           SubdivisionUSCA,
+		"California":      SubdivisionUSCA,
+		"加利福尼亚州":          SubdivisionUSCA,
+		"jialifuniyazhou": SubdivisionUSCA,
+		"Guǎngdōng Shěng": SubdivisionCNGD,
+		"广东省":             SubdivisionCNGD,
+		"Atlantis":        SubdivisionUnknown,
+	}
+	for name, want := range tests {
+		if out := SubdivisionCodeByName(name); out != want {
+			t.Errorf("Test SubdivisionCodeByName() err, name %v, want %v, got %v", name, want, out)
+		}
+	}
+}
+
+//nolint:gocyclo
+func TestParseSubdivision(t *testing.T) {
+	if s, err := ParseSubdivision("US-CA"); s != SubdivisionUSCA || err != nil {
+		t.Errorf("Test ParseSubdivision() err, want %v, got %v, %v", SubdivisionUSCA, s, err)
+	}
+	if s, err := ParseSubdivision("Atlantis"); s != SubdivisionUnknown || !errors.Is(err, ErrNotFound) {
+		t.Errorf("Test ParseSubdivision() err, want %v, got %v, %v", ErrNotFound, s, err)
+	}
+	var ambiguous *AmbiguousError
+	if _, err := ParseSubdivision("Limburg"); !errors.As(err, &ambiguous) || len(ambiguous.Candidates) != 2 {
+		t.Errorf("Test ParseSubdivision() err, want %v, got %v", ErrAmbiguous, err)
+	}
+}
+
+//nolint:gocyclo
+func TestSubdivisionExternalIDs(t *testing.T) {
+	if out := SubdivisionUSCA.WikidataID(); out != "Q99" {
+		t.Errorf("Test WikidataID() err, want %v, got %v", "Q99", out)
+	}
+	if out := SubdivisionCodeByWikidataID("q99"); out != SubdivisionUSCA {
+		t.Errorf("Test SubdivisionCodeByWikidataID() err, want %v, got %v", SubdivisionUSCA, out)
+	}
+	if out := SubdivisionCodeByGeoNamesID(SubdivisionDEBY.GeoNamesID()); out != SubdivisionDEBY {
+		t.Errorf("Test SubdivisionCodeByGeoNamesID() err, want %v, got %v", SubdivisionDEBY, out)
+	}
+	if out := SubdivisionCodeByOSMRelationID(165475); out != SubdivisionUSCA {
+		t.Errorf("Test SubdivisionCodeByOSMRelationID() err, want %v, got %v", SubdivisionUSCA, out)
+	}
+	if out := SubdivisionUnknown.OSMRelationID(); out != 0 || SubdivisionCodeByWikidataID("") != SubdivisionUnknown {
+		t.Errorf("Test OSMRelationID() err, want %v, got %v", 0, out)
+	}
+}
+
+func TestSubdivisionGeo(t *testing.T) {
+	for _, s := range append(USA.Subdivisions(), DEU.Subdivisions()...) {
+		latitude, longitude := s.Centroid()
+		if box := s.BoundingBox(); !box.IsValid() || !box.Contains(latitude, longitude) {
+			t.Errorf("Test BoundingBox() err, subdivision %v, centroid %v %v, got %v", s, latitude, longitude, box)
+		}
+	}
+	if box := SubdivisionUSCA.BoundingBox(); !box.Contains(34.05, -118.24) || box.Contains(40.71, -74.01) {
+		t.Errorf("Test BoundingBox() err, want Los Angeles inside and New York outside, got %v", box)
+	}
+	if !SubdivisionUSAK.BoundingBox().CrossesAntimeridian() {
+		t.Errorf("Test BoundingBox() err, want a box crossing the antimeridian, got %v", SubdivisionUSAK.BoundingBox())
+	}
+	if latitude, longitude := SubdivisionUnknown.Centroid(); latitude != 0 || longitude != 0 || SubdivisionUnknown.BoundingBox().IsValid() {
+		t.Errorf("Test Centroid() err, want zeros, got %v %v", latitude, longitude)
+	}
+}
+
+func TestSubdivisionGeoCoverage(t *testing.T) {
+	for _, s := range AllSubdivisions() {
+		if s == SubdivisionUnknown {
+			continue
+		}
+		latitude, longitude := s.Centroid()
+		if box := s.BoundingBox(); !box.IsValid() || !box.Contains(latitude, longitude) {
+			t.Errorf("Test Centroid() err, subdivision %v, want inside %v, got %v %v", s, box, latitude, longitude)
+		}
+		if len(s.Boundary()) == 0 {
+			t.Errorf("Test Boundary() err, subdivision %v, got no boundary", s)
+		}
+	}
+	for _, s := range []SubdivisionCode{SubdivisionDEBB, SubdivisionUSHI} {
+		if out := SubdivisionAt(s.Centroid()); out != s {
+			t.Errorf("Test Centroid() err, want a centroid inside %v, got %v", s, out)
+		}
+	}
+}
+
+func TestSubdivisionAt(t *testing.T) {
+	points := []struct {
+		latitude, longitude float64
+		want                SubdivisionCode
+	}{
+		{39.74, -104.99, SubdivisionUSCO}, {40.76, -111.89, SubdivisionUSUT}, {34.05, -118.24, SubdivisionUSCA},
+		{38.90, -77.03, SubdivisionUSDC}, {52.52, 13.40, SubdivisionDEBE}, {52.39, 13.06, SubdivisionDEBB},
+		{48.14, 11.58, SubdivisionDEBY}, {48.85, 2.35, SubdivisionFR75}, {51.5074, -0.1278, SubdivisionGBWSM},
+		{35.68, 139.69, SubdivisionJP13}, {-33.87, 151.21, SubdivisionAUNSW}, {55.75, 37.62, SubdivisionRUMOW},
+		{0, -30, SubdivisionUnknown},
+	}
+	for _, p := range points {
+		if out := SubdivisionAt(p.latitude, p.longitude); out != p.want {
+			t.Errorf("Test SubdivisionAt(%v, %v) err, want %v, got %v", p.latitude, p.longitude, p.want, out)
+		}
+	}
+}
+
+func TestSubdivisionTimeZones(t *testing.T) {
+	loaded := map[string]error{}
+	for _, s := range AllSubdivisions() {
+		if s == SubdivisionUnknown || len(s.Country().TimeZones()) < 2 {
+			continue
+		}
+		zones := s.TimeZones()
+		if len(zones) == 0 {
+			t.Errorf("Test TimeZones() err, subdivision %v, got no zones", s)
+		}
+		for _, zone := range zones {
+			if _, ok := loaded[zone]; !ok {
+				_, loaded[zone] = time.LoadLocation(zone)
+			}
+			if TimeZoneCountry(zone) == Unknown && (!strings.HasPrefix(zone, "Etc/") || loaded[zone] != nil) {
+				t.Errorf("Test TimeZones() err, subdivision %v, unknown zone %v", s, zone)
+			}
+		}
+	}
+	for s, want := range map[SubdivisionCode]string{
+		SubdivisionRUMOW: "Europe/Moscow", SubdivisionRUKGD: "Europe/Kaliningrad", SubdivisionMXBCN: "America/Tijuana",
+		SubdivisionIDPA: "Asia/Jayapura", SubdivisionKZMAN: "Asia/Aqtau", SubdivisionUA43: "Europe/Simferopol",
+		SubdivisionESCN: "Atlantic/Canary", SubdivisionPT20: "Atlantic/Azores", SubdivisionCLVS: "America/Santiago",
+	} {
+		if out := s.TimeZone(); out != want {
+			t.Errorf("Test TimeZone() err, subdivision %v, want %v, got %v", s, want, out)
+		}
+	}
+	if out := SubdivisionUSCA.TimeZone(); out != "America/Los_Angeles" {
+		t.Errorf("Test TimeZone() err, want %v, got %v", "America/Los_Angeles", out)
+	}
+	if out := SubdivisionUSTX.TimeZones(); len(out) != 2 || out[0] != "America/Chicago" {
+		t.Errorf("Test TimeZones() err, want %v, got %v", []string{"America/Chicago", "America/Denver"}, out)
+	}
+	if out := SubdivisionFRIDF.TimeZone(); out != "Europe/Paris" {
+		t.Errorf("Test TimeZone() err, want %v, got %v", "Europe/Paris", out)
+	}
+	if out := SubdivisionUnknown.TimeZone(); out != UnknownMsg {
+		t.Errorf("Test TimeZone() err, want %v, got %v", UnknownMsg, out)
+	}
+}
@@ -0,0 +1,464 @@
+package taxid
+
+import (
+	"strings"
+)
+
+// digits - returns the digits of the string, nil if it has other characters
+func digits(s string) []int {
+	out := make([]int, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return nil
+		}
+		out[i] = int(s[i] - '0')
+	}
+	return out
+}
+
+// weighted - returns the sum of the digits multiplied by the weights
+func weighted(d []int, weights ...int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += d[i] * w
+	}
+	return sum
+}
+
+// luhn - returns true, if the number passes the Luhn algorithm
+func luhn(s string) bool {
+	d := digits(s)
+	if d == nil {
+		return false
+	}
+	sum := 0
+	for i := len(d) - 1; i >= 0; i-- {
+		n := d[i]
+		if (len(d)-i)%2 == 0 {
+			if n *= 2; n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+	}
+	return sum%10 == 0
+}
+
+// mod11x10 - returns true, if the number passes ISO 7064 MOD 11,10 (DE, HR)
+func mod11x10(s string) bool {
+	d := digits(s)
+	if d == nil {
+		return false
+	}
+	product := 10
+	for _, n := range d[:len(d)-1] {
+		sum := (n + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = 2 * sum % 11
+	}
+	return (11-product)%10 == d[len(d)-1]
+}
+
+// mod11 - returns the check digit 11 - sum % 11 of the weighted digits, 11 is 0, -1 for 10
+func mod11(d []int, weights ...int) int {
+	switch check := 11 - weighted(d, weights...)%11; check {
+	case 11:
+		return 0
+	case 10:
+		return -1
+	default:
+		return check
+	}
+}
+
+// checkAT - Austria, U and 8 digits
+func checkAT(s string) bool {
+	d := digits(s[1:])
+	sum := 0
+	for i, n := range d[:7] {
+		if i%2 == 1 {
+			n = n*2/10 + n*2%10
+		}
+		sum += n
+	}
+	return (10-(sum+4)%10)%10 == d[7]
+}
+
+// checkBE - Belgium, 10 digits, the last two are 97 - the first eight mod 97
+func checkBE(s string) bool {
+	d := digits(s)
+	return d != nil && 97-(atoi(s[:8])%97) == atoi(s[8:])
+}
+
+// checkBG - Bulgaria, 9 digits of a legal entity or 10 digits of a person (EGN)
+func checkBG(s string) bool {
+	d := digits(s)
+	if len(d) == 10 {
+		return weighted(d, 2, 4, 8, 5, 10, 9, 7, 3, 6)%11%10 == d[9]
+	}
+	check := weighted(d, 1, 2, 3, 4, 5, 6, 7, 8) % 11
+	if check == 10 {
+		check = weighted(d, 3, 4, 5, 6, 7, 8, 9, 10) % 11 % 10
+	}
+	return check == d[8]
+}
+
+// checkCY - Cyprus, 8 digits and a check letter
+func checkCY(s string) bool {
+	d := digits(s[:8])
+	odd := [10]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21}
+	sum := 0
+	for i, n := range d {
+		if i%2 == 0 {
+			n = odd[n]
+		}
+		sum += n
+	}
+	return s[8] == byte('A'+sum%26)
+}
+
+// checkCZ - Czechia, 8 digits of a legal entity are checked, 9 and 10 digits of persons by the format only
+func checkCZ(s string) bool {
+	d := digits(s)
+	if len(d) != 8 {
+		return true
+	}
+	check := 11 - weighted(d, 8, 7, 6, 5, 4, 3, 2)%11
+	return check%10 == d[7]
+}
+
+// checkDK - Denmark, 8 digits, the weighted sum is divisible by 11
+func checkDK(s string) bool {
+	return weighted(digits(s), 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+}
+
+// checkEE - Estonia, 9 digits
+func checkEE(s string) bool {
+	d := digits(s)
+	return (10-weighted(d, 3, 7, 1, 3, 7, 1, 3, 7)%10)%10 == d[8]
+}
+
+// checkEL - Greece, 9 digits
+func checkEL(s string) bool {
+	d := digits(s)
+	return weighted(d, 256, 128, 64, 32, 16, 8, 4, 2)%11%10 == d[8]
+}
+
+// checkES - Spain, NIF of a person (8 digits and a letter), NIE of a foreigner (X, Y or Z, 7 digits and a letter)
+// or CIF of a legal entity (a letter, 7 digits and a digit or a letter)
+func checkES(s string) bool {
+	const letters = "TRWAGMYFPDXBNJZSQVHLCKE"
+	switch first := s[0]; {
+	case first >= '0' && first <= '9':
+		return s[8] == letters[atoi(s[:8])%23]
+	case first == 'X' || first == 'Y' || first == 'Z':
+		return s[8] == letters[atoi(string('0'+first-'X')+s[1:8])%23]
+	case first == 'K' || first == 'L' || first == 'M':
+		return s[8] == letters[atoi(s[1:8])%23]
+	}
+	d := digits(s[1:8])
+	if d == nil {
+		return false
+	}
+	sum := 0
+	for i, n := range d {
+		if i%2 == 0 {
+			n = n*2/10 + n*2%10
+		}
+		sum += n
+	}
+	check := (10 - sum%10) % 10
+	return s[8] == byte('0'+check) || s[8] == "JABCDEFGHI"[check]
+}
+
+// checkFI - Finland, 8 digits
+func checkFI(s string) bool {
+	d := digits(s)
+	check := mod11(d, 7, 9, 10, 5, 8, 4, 2)
+	return check == d[7]
+}
+
+// checkFR - France, a key of 2 digits or letters and SIREN of 9 digits, a numeric key is (12 + 3 * (SIREN mod 97)) mod 97
+func checkFR(s string) bool {
+	if !luhn(s[2:]) && s[2:] != "356000000" {
+		return false
+	}
+	if digits(s[:2]) == nil {
+		return true
+	}
+	return atoi(s[:2]) == (12+3*(atoi(s[2:])%97))%97
+}
+
+// checkGB - United Kingdom, 9 digits or 12 digits of a branch (modulus 97 or 9755), GD and HA numbers of government
+// departments and health authorities are checked by the format only
+func checkGB(s string) bool {
+	if strings.HasPrefix(s, "GD") || strings.HasPrefix(s, "HA") {
+		return true
+	}
+	d := digits(s[:9])
+	sum := weighted(d, 8, 7, 6, 5, 4, 3, 2) + atoi(s[7:9])
+	return sum%97 == 0 || (sum+55)%97 == 0
+}
+
+// checkHU - Hungary, 8 digits
+func checkHU(s string) bool {
+	d := digits(s)
+	return (10-weighted(d, 9, 7, 3, 1, 9, 7, 3)%10)%10 == d[7]
+}
+
+// checkIE - Ireland, 7 digits and one or two letters, or the old format of a digit, a letter or + or *, 5 digits and a letter
+func checkIE(s string) bool {
+	if s[1] < '0' || s[1] > '9' {
+		s = "0" + s[2:7] + s[0:1] + s[7:8]
+	}
+	d := digits(s[:7])
+	sum := weighted(d, 8, 7, 6, 5, 4, 3, 2)
+	if len(s) == 9 && s[8] != 'W' {
+		sum += int(s[8]-'A'+1) * 9
+	}
+	return s[7] == "WABCDEFGHIJKLMNOPQRSTUV"[sum%23]
+}
+
+// checkLT - Lithuania, 9 digits of a legal entity or 12 digits of a temporary registration
+func checkLT(s string) bool {
+	d := digits(s)
+	n := len(d) - 1
+	sum := 0
+	for i := 0; i < n; i++ {
+		sum += d[i] * (1 + i%9)
+	}
+	check := sum % 11
+	if check == 10 {
+		sum = 0
+		for i := 0; i < n; i++ {
+			sum += d[i] * (1 + (i+2)%9)
+		}
+		check = sum % 11 % 10
+	}
+	return check == d[n]
+}
+
+// checkLU - Luxembourg, 8 digits, the last two are the first six mod 89
+func checkLU(s string) bool {
+	return atoi(s[:6])%89 == atoi(s[6:])
+}
+
+// checkLV - Latvia, 11 digits, numbers of legal entities (the first digit above 3) are checked
+func checkLV(s string) bool {
+	d := digits(s)
+	if d[0] <= 3 {
+		return true
+	}
+	check := 3 - weighted(d, 9, 1, 4, 8, 3, 10, 2, 5, 7, 6)%11
+	if check < -1 {
+		check += 11
+	}
+	return check == d[10]
+}
+
+// checkMT - Malta, 8 digits, the last two are 37 - the weighted sum mod 37
+func checkMT(s string) bool {
+	return 37-weighted(digits(s), 3, 4, 6, 7, 8, 9)%37 == atoi(s[6:])
+}
+
+// checkNL - Netherlands, 9 digits, B and 2 digits; the sole proprietor number (mod 97 of "NL" and the number is 1)
+// or the 11 test of the RSIN of legal entities
+func checkNL(s string) bool {
+	remainder := 0
+	for _, c := range "2321" + s {
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	if remainder == 1 {
+		return true
+	}
+	d := digits(s[:9])
+	return (weighted(d, 9, 8, 7, 6, 5, 4, 3, 2)-d[8])%11 == 0
+}
+
+// checkPL - Poland, 10 digits
+func checkPL(s string) bool {
+	d := digits(s)
+	return weighted(d, 6, 5, 7, 2, 3, 4, 5, 6, 7)%11 == d[9]
+}
+
+// checkPT - Portugal, 9 digits
+func checkPT(s string) bool {
+	d := digits(s)
+	check := 11 - weighted(d, 9, 8, 7, 6, 5, 4, 3, 2)%11
+	if check >= 10 {
+		check = 0
+	}
+	return check == d[8]
+}
+
+// checkRO - Romania, 2 to 10 digits
+func checkRO(s string) bool {
+	d := digits(strings.Repeat("0", 10-len(s)) + s)
+	return weighted(d, 7, 5, 3, 2, 1, 7, 5, 3, 2)*10%11%10 == d[9]
+}
+
+// checkSE - Sweden, 10 digits of the organisation number (Luhn) and 01
+func checkSE(s string) bool {
+	return luhn(s[:10]) && s[10:] == "01"
+}
+
+// checkSI - Slovenia, 8 digits
+func checkSI(s string) bool {
+	d := digits(s)
+	check := 11 - weighted(d, 8, 7, 6, 5, 4, 3, 2)%11
+	return check != 11 && check%10 == d[7]
+}
+
+// checkSK - Slovakia, 10 digits divisible by 11
+func checkSK(s string) bool {
+	return atoi(s)%11 == 0
+}
+
+// checkCH - Switzerland, UID of 9 digits
+func checkCH(s string) bool {
+	d := digits(s[:9])
+	return mod11(d, 5, 4, 3, 2, 7, 6, 5, 4) == d[8]
+}
+
+// checkNO - Norway, organisation number of 9 digits
+func checkNO(s string) bool {
+	d := digits(s[:9])
+	return mod11(d, 3, 2, 7, 6, 5, 4, 3, 2) == d[8]
+}
+
+// checkABN - Australian Business Number of 11 digits
+func checkABN(s string) bool {
+	d := digits(s)
+	d[0]--
+	return d[0] >= 0 && weighted(d, 10, 1, 3, 5, 7, 9, 11, 13, 15, 17, 19)%89 == 0
+}
+
+// checkCNPJ - Brazil, CNPJ of a legal entity, 12 digits or upper case letters (alphanumeric CNPJ from July 2026) with two check
+// digits, a character has the value of its ASCII code - 48
+func checkCNPJ(s string) bool {
+	d := make([]int, len(s))
+	for i := 0; i < len(s); i++ {
+		d[i] = int(s[i]) - '0'
+	}
+	if strings.Count(s, s[:1]) == len(s) {
+		return false
+	}
+	first := weighted(d, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2) % 11
+	second := weighted(d, 6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2) % 11
+	return cnpjDigit(first) == d[12] && cnpjDigit(second) == d[13]
+}
+
+// cnpjDigit - returns a check digit of CNPJ and CPF of the remainder mod 11
+func cnpjDigit(remainder int) int {
+	if remainder < 2 {
+		return 0
+	}
+	return 11 - remainder
+}
+
+// checkCPF - Brazil, CPF of a person, 11 digits with two check digits
+func checkCPF(s string) bool {
+	d := digits(s)
+	if strings.Count(s, s[:1]) == len(s) {
+		return false
+	}
+	first := weighted(d, 10, 9, 8, 7, 6, 5, 4, 3, 2) % 11
+	second := weighted(d, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2) % 11
+	return cnpjDigit(first) == d[9] && cnpjDigit(second) == d[10]
+}
+
+// checkEIN - United States, Employer Identification Number of 9 digits with a prefix assigned by the IRS
+func checkEIN(s string) bool {
+	switch s[:2] {
+	case "00", "07", "08", "09", "17", "18", "19", "28", "29", "49", "69", "70", "78", "79", "89", "96", "97":
+		return false
+	}
+	return true
+}
+
+// checkBN - Canada, Business Number of 9 digits (Luhn) with an optional program account like RT0001
+func checkBN(s string) bool {
+	return luhn(s[:9])
+}
+
+// checkGSTIN - India, GSTIN of 15 characters: a state code, PAN, an entity number, Z and a mod 36 check character
+func checkGSTIN(s string) bool {
+	const alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	if state := atoi(s[:2]); state < 1 || state > 99 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 14; i++ {
+		product := strings.IndexByte(alphabet, s[i]) * (1 + i%2)
+		sum += product/36 + product%36
+	}
+	return s[14] == alphabet[(36-sum%36)%36]
+}
+
+// checkIRD - New Zealand, IRD number of 8 or 9 digits used as the GST number
+func checkIRD(s string) bool {
+	d := digits(strings.Repeat("0", 9-len(s)) + s)
+	if n := atoi(s); n < 10000000 || n > 150000000 {
+		return false
+	}
+	for _, weights := range [][]int{{3, 2, 7, 6, 5, 4, 3, 2}, {7, 4, 3, 2, 5, 2, 7, 6}} {
+		check := 11 - weighted(d, weights...)%11
+		if check == 11 {
+			check = 0
+		}
+		if check != 10 {
+			return check == d[8]
+		}
+	}
+	return false
+}
+
+// checkUSCC - China, Unified Social Credit Code of 18 characters with a mod 31 check character
+func checkUSCC(s string) bool {
+	const alphabet = "0123456789ABCDEFGHJKLMNPQRTUWXY"
+	weights := []int{1, 3, 9, 27, 19, 26, 16, 17, 20, 29, 25, 13, 8, 24, 10, 30, 28}
+	sum := 0
+	for i, w := range weights {
+		n := strings.IndexByte(alphabet, s[i])
+		if n < 0 {
+			return false
+		}
+		sum += n * w
+	}
+	return s[17] == alphabet[(31-sum%31)%31]
+}
+
+// checkJPCN - Japan, Corporate Number of 13 digits, the first is the check digit
+func checkJPCN(s string) bool {
+	d := digits(s)
+	sum := 0
+	for i := 1; i < 13; i++ {
+		sum += d[i] * (1 + (13-i+1)%2)
+	}
+	return 9-sum%9 == d[0]
+}
+
+// checkINN - Russia, INN of 10 digits of a legal entity or 12 digits of a person
+func checkINN(s string) bool {
+	d := digits(s)
+	if len(d) == 10 {
+		return weighted(d, 2, 4, 10, 3, 5, 9, 4, 6, 8)%11%10 == d[9]
+	}
+	return weighted(d, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8)%11%10 == d[10] &&
+		weighted(d, 3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8)%11%10 == d[11]
+}
+
+// atoi - returns the number of the digits, the string is validated by the regular expression
+func atoi(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	return n
+}
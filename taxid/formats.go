@@ -0,0 +1,113 @@
+package taxid
+
+import (
+	"github.com/xianb/countries"
+)
+
+// formatsOf - returns tax identifier formats of the country, VAT numbers of the EU member states first
+func formatsOf(c countries.CountryCode) []*Format { //nolint:gocyclo
+	switch c {
+	case countries.AUT:
+		return []*Format{vat(c, "AT", "Umsatzsteuer-Identifikationsnummer", `U\d{8}`, "U13585627", checkAT)}
+	case countries.BEL:
+		return []*Format{vat(c, "BE", "BTW-nummer", `[01]\d{9}`, "0403170701", checkBE)}
+	case countries.BGR:
+		return []*Format{vat(c, "BG", "Идентификационен номер по ДДС", `\d{9,10}`, "175074752", checkBG)}
+	case countries.CYP:
+		return []*Format{vat(c, "CY", "Αριθμός Εγγραφής Φ.Π.Α.", `[0-59]\d{7}[A-Z]`, "10259033P", checkCY)}
+	case countries.CZE:
+		return []*Format{vat(c, "CZ", "DIČ", `\d{8,10}`, "25123891", checkCZ)}
+	case countries.DEU:
+		return []*Format{vat(c, "DE", "Umsatzsteuer-Identifikationsnummer", `\d{9}`, "136695976", mod11x10)}
+	case countries.DNK:
+		return []*Format{vat(c, "DK", "CVR-nummer", `\d{8}`, "13585628", checkDK)}
+	case countries.EST:
+		return []*Format{vat(c, "EE", "Käibemaksukohustuslase number", `10\d{7}`, "100931558", checkEE)}
+	case countries.GRC:
+		return []*Format{vat(c, "EL", "Αριθμός Φορολογικού Μητρώου", `\d{9}`, "094014201", checkEL)}
+	case countries.ESP:
+		return []*Format{vat(c, "ES", "Número de Identificación Fiscal", `[0-9A-Z]\d{7}[0-9A-Z]`, "A28015865", checkES)}
+	case countries.FIN:
+		return []*Format{vat(c, "FI", "Arvonlisäverotunniste", `\d{8}`, "20774740", checkFI)}
+	case countries.FRA:
+		return []*Format{vat(c, "FR", "Numéro de TVA intracommunautaire", `[0-9A-HJ-NP-Z]{2}\d{9}`, "40303265045", checkFR)}
+	case countries.HRV:
+		return []*Format{vat(c, "HR", "Osobni identifikacijski broj", `\d{11}`, "33392005961", mod11x10)}
+	case countries.HUN:
+		return []*Format{vat(c, "HU", "Közösségi adószám", `\d{8}`, "12892312", checkHU)}
+	case countries.IRL:
+		return []*Format{vat(c, "IE", "VAT Registration Number", `\d{7}[A-W][A-IW]?|\d[A-Z+*]\d{5}[A-W]`, "6433435F", checkIE)}
+	case countries.ITA:
+		return []*Format{vat(c, "IT", "Partita IVA", `\d{11}`, "00743110157", luhn)}
+	case countries.LTU:
+		return []*Format{vat(c, "LT", "PVM mokėtojo kodas", `\d{9}|\d{12}`, "119511515", checkLT)}
+	case countries.LUX:
+		return []*Format{vat(c, "LU", "Numéro d'identification à la TVA", `\d{8}`, "15027442", checkLU)}
+	case countries.LVA:
+		return []*Format{vat(c, "LV", "PVN reģistrācijas numurs", `\d{11}`, "40003521600", checkLV)}
+	case countries.MLT:
+		return []*Format{vat(c, "MT", "VAT Number", `[1-9]\d{7}`, "11679112", checkMT)}
+	case countries.NLD:
+		return []*Format{vat(c, "NL", "Btw-identificatienummer", `\d{9}B\d{2}`, "004495445B01", checkNL)}
+	case countries.POL:
+		return []*Format{vat(c, "PL", "NIP", `\d{10}`, "5260250274", checkPL)}
+	case countries.PRT:
+		return []*Format{vat(c, "PT", "Número de Identificação Fiscal", `\d{9}`, "501964843", checkPT)}
+	case countries.ROU:
+		return []*Format{vat(c, "RO", "Cod de identificare fiscală", `[1-9]\d{1,9}`, "18547290", checkRO)}
+	case countries.SWE:
+		return []*Format{vat(c, "SE", "Momsregistreringsnummer", `\d{10}01`, "556188840401", checkSE)}
+	case countries.SVN:
+		return []*Format{vat(c, "SI", "Identifikacijska številka za DDV", `[1-9]\d{7}`, "50223054", checkSI)}
+	case countries.SVK:
+		return []*Format{vat(c, "SK", "IČ DPH", `[1-9]\d[2-47-9]\d{7}`, "2022749619", checkSK)}
+	case countries.GBR:
+		return []*Format{
+			vat(c, "GB", "VAT Registration Number", `\d{9}|\d{12}|GD[0-4]\d{2}|HA[5-9]\d{2}`, "980780684", checkGB),
+			vat(c, "XI", "VAT Registration Number (Northern Ireland)", `\d{9}|\d{12}|GD[0-4]\d{2}|HA[5-9]\d{2}`, "980780684", checkGB),
+		}
+	case countries.CHE:
+		return []*Format{vat(c, "CHE", "Mehrwertsteuernummer", `\d{9}(MWST|TVA|IVA)?`, "116281710", checkCH)}
+	case countries.NOR:
+		return []*Format{vat(c, "NO", "Organisasjonsnummer", `\d{9}(MVA)?`, "923609016MVA", checkNO)}
+	case countries.AUS:
+		return []*Format{{Country: c, Type: TypeABN, Name: "Australian Business Number", Regexp: `\d{11}`, Example: "51 824 753 556",
+			check: checkABN, layout: "## ### ### ###"}}
+	case countries.BRA:
+		return []*Format{
+			{Country: c, Type: TypeCNPJ, Name: "Cadastro Nacional da Pessoa Jurídica", Regexp: `[0-9A-Z]{12}\d{2}`, Example: "11.222.333/0001-81",
+				check: checkCNPJ, layout: "##.###.###/####-##"},
+			{Country: c, Type: TypeCPF, Name: "Cadastro de Pessoas Físicas", Regexp: `\d{11}`, Example: "529.982.247-25",
+				check: checkCPF, layout: "###.###.###-##"},
+		}
+	case countries.USA:
+		return []*Format{{Country: c, Type: TypeEIN, Name: "Employer Identification Number", Regexp: `\d{9}`, Example: "12-3456789",
+			check: checkEIN, layout: "##-#######"}}
+	case countries.CAN:
+		return []*Format{{Country: c, Type: TypeGST, Name: "Business Number", Regexp: `\d{9}(RT\d{4})?`, Example: "123456782RT0001",
+			check: checkBN}}
+	case countries.IND:
+		return []*Format{{Country: c, Type: TypeGST, Name: "Goods and Services Tax Identification Number", Regexp: `\d{2}[A-Z]{5}\d{4}[A-Z][1-9A-Z]Z[0-9A-Z]`,
+			Example: "27AAPFU0939F1ZV", check: checkGSTIN}}
+	case countries.NZL:
+		return []*Format{{Country: c, Type: TypeGST, Name: "IRD Number", Regexp: `\d{8,9}`, Example: "49091850", check: checkIRD}}
+	case countries.CHN:
+		return []*Format{{Country: c, Type: TypeUSCC, Name: "统一社会信用代码", Regexp: `[0-9A-HJ-NPQRTUWXY]{2}\d{6}[0-9A-HJ-NPQRTUWXY]{10}`,
+			Example: "91350100M000100Y43", check: checkUSCC}}
+	case countries.JPN:
+		return []*Format{{Country: c, Type: TypeCorporateNumber, Name: "法人番号", Regexp: `[1-9]\d{12}`, Example: "8700110005901", check: checkJPCN}}
+	case countries.RUS:
+		return []*Format{{Country: c, Type: TypeINN, Name: "Идентификационный номер налогоплательщика", Regexp: `\d{10}|\d{12}`,
+			Example: "7707083893", check: checkINN}}
+	}
+	return nil
+}
+
+// vat - returns a VAT number format of the country with the prefix of VIES (EL for Greece, XI for Northern Ireland)
+func vat(c countries.CountryCode, prefix, name, regexp, example string, check func(string) bool) *Format {
+	f := &Format{Country: c, Type: TypeVAT, Name: name, Prefix: prefix, Regexp: regexp, Example: prefix + example, check: check}
+	if prefix == "XI" {
+		f.Subdivision = countries.SubdivisionGBNIR
+	}
+	return f
+}
@@ -0,0 +1,212 @@
+// Package taxid validates VAT numbers and other tax identifiers (GST, ABN, CNPJ, CPF, EIN and others) by country formats
+// and checksum algorithms keyed by the codes of package countries.
+package taxid
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/xianb/countries"
+)
+
+// ErrInvalidChecksum - the identifier matches the format, but its check digits are wrong; countries.ErrNotFound
+// and countries.ErrInvalidFormat are returned for unknown countries and identifiers of a wrong format
+var ErrInvalidChecksum = errors.New("invalid checksum")
+
+// Type - 税号类型
+type Type int64 // int64 for database/sql/driver.Valuer compatibility
+
+// Tax identifier types
+const (
+	TypeUnknown         Type = 0
+	TypeVAT             Type = 1 // value added tax number, example DE136695976
+	TypeGST             Type = 2 // goods and services tax number, example GSTIN of India
+	TypeABN             Type = 3 // Australian Business Number
+	TypeCNPJ            Type = 4 // Brazilian number of legal entities
+	TypeCPF             Type = 5 // Brazilian number of persons
+	TypeEIN             Type = 6 // US Employer Identification Number
+	TypeUSCC            Type = 7 // Chinese Unified Social Credit Code
+	TypeCorporateNumber Type = 8 // Japanese Corporate Number
+	TypeINN             Type = 9 // Russian taxpayer number
+)
+
+// Format - 国家的税号格式
+type Format struct {
+	Country     countries.CountryCode     `json:"country"`     // 国家代码
+	Subdivision countries.SubdivisionCode `json:"subdivision"` // 子区域代码，北爱尔兰（XI）以外为空
+	Type        Type                      `json:"type"`        // 类型
+	Name        string                    `json:"name"`        // 本地名称
+	Prefix      string                    `json:"prefix"`      // VAT 前缀，例如希腊为 "EL"
+	Regexp      string                    `json:"regexp"`      // 不含前缀和分隔符的正则表达式
+	Example     string                    `json:"example"`     // 示例
+	check       func(string) bool         // 校验算法
+	layout      string                    // 规范格式，# 为字符
+	pattern     *regexp.Regexp            // 编译后的 Regexp
+}
+
+// Result - 税号验证结果
+type Result struct {
+	Valid       bool                      `json:"valid"`       // 是否有效
+	Country     countries.CountryCode     `json:"country"`     // 国家代码
+	Subdivision countries.SubdivisionCode `json:"subdivision"` // 子区域代码，北爱尔兰（XI）以外为空
+	Type        Type                      `json:"type"`        // 类型
+	Name        string                    `json:"name"`        // 本地名称
+	Normalized  string                    `json:"normalized"`  // 规范格式
+}
+
+// String - implements fmt.Stringer, returns the abbreviation of the type
+func (t Type) String() string {
+	switch t {
+	case TypeVAT:
+		return "VAT"
+	case TypeGST:
+		return "GST"
+	case TypeABN:
+		return "ABN"
+	case TypeCNPJ:
+		return "CNPJ"
+	case TypeCPF:
+		return "CPF"
+	case TypeEIN:
+		return "EIN"
+	case TypeUSCC:
+		return "USCC"
+	case TypeCorporateNumber:
+		return "Corporate Number"
+	case TypeINN:
+		return "INN"
+	}
+	return countries.UnknownMsg
+}
+
+// IsValid - returns true, if the type is correct
+func (t Type) IsValid() bool {
+	return t.String() != countries.UnknownMsg
+}
+
+// Formats - returns copies of tax identifier formats of the country, example: taxid.Formats(countries.BRA) returns CNPJ and CPF;
+// nil for countries without known formats
+func Formats(c countries.CountryCode) []*Format {
+	var formats []*Format
+	for _, f := range lookup(c) {
+		format := *f
+		formats = append(formats, &format)
+	}
+	return formats
+}
+
+// Validate - validates a tax identifier of the country by all its formats, spaces, dots, hyphens and slashes are ignored,
+// a VAT prefix is optional, example: taxid.Validate(countries.DEU, "DE 136 695 976") returns a valid VAT number "DE136695976";
+// errors are countries.ErrNotFound for countries without known formats, countries.ErrInvalidFormat and ErrInvalidChecksum,
+// the result is not nil
+func Validate(c countries.CountryCode, id string) (*Result, error) {
+	return validate("Validate", c, lookup(c), id)
+}
+
+// ValidateVAT - validates a VAT number with the prefix of its country as in VIES: EL for Greece (GR is accepted),
+// XI for Northern Ireland, CHE for Switzerland, example: taxid.ValidateVAT("EL094014201") returns GRC;
+// errors are as of Validate
+func ValidateVAT(id string) (*Result, error) {
+	code := compact(id)
+	var c countries.CountryCode
+	switch {
+	case strings.HasPrefix(code, "CHE"):
+		c = countries.CHE
+	case strings.HasPrefix(code, "EL"):
+		c = countries.GRC
+	case strings.HasPrefix(code, "XI"):
+		c = countries.GBR
+	case len(code) >= 2:
+		c = countries.ByAlpha2(code[:2])
+	}
+	var formats []*Format
+	for _, f := range lookup(c) {
+		if f.Type == TypeVAT && (strings.HasPrefix(code, f.Prefix) || f.Prefix == "EL" && strings.HasPrefix(code, "GR")) {
+			formats = append(formats, f)
+		}
+	}
+	return validate("ValidateVAT", c, formats, id)
+}
+
+var (
+	formatsOnce      sync.Once
+	formatsByCountry map[countries.CountryCode][]*Format
+)
+
+// lookup - returns formats of the country, the formats of all countries are built and their regular expressions compiled once
+func lookup(c countries.CountryCode) []*Format {
+	formatsOnce.Do(func() {
+		formatsByCountry = map[countries.CountryCode][]*Format{}
+		for _, code := range countries.All() {
+			for _, f := range formatsOf(code) {
+				f.pattern = regexp.MustCompile("^(?:" + f.Regexp + ")$")
+				formatsByCountry[code] = append(formatsByCountry[code], f)
+			}
+		}
+	})
+	return formatsByCountry[c]
+}
+
+// validate - returns the result of the first format matching the identifier, the type of an invalid identifier is
+// of the first format it matches, of the first format of the country, if it matches none
+func validate(function string, c countries.CountryCode, formats []*Format, id string) (*Result, error) {
+	if len(formats) == 0 {
+		return &Result{Country: c}, fmt.Errorf("taxid::%s: %q, country %v err: %w", function, id, c, countries.ErrNotFound)
+	}
+	code := compact(id)
+	err, typ := countries.ErrInvalidFormat, formats[0].Type
+	for _, f := range formats {
+		body, ok := f.match(code)
+		if !ok {
+			continue
+		}
+		if !f.check(body) {
+			if err != ErrInvalidChecksum {
+				err, typ = ErrInvalidChecksum, f.Type
+			}
+			continue
+		}
+		return &Result{
+			Valid: true, Country: f.Country, Subdivision: f.Subdivision, Type: f.Type, Name: f.Name, Normalized: f.normalize(body),
+		}, nil
+	}
+	return &Result{Country: c, Type: typ}, fmt.Errorf("taxid::%s: %q, country %v err: %w", function, id, c, err)
+}
+
+// match - returns the identifier without the prefix, if it matches the regular expression of the format
+func (f *Format) match(code string) (string, bool) {
+	body := code
+	switch {
+	case f.Prefix != "" && strings.HasPrefix(code, f.Prefix):
+		body = code[len(f.Prefix):]
+	case f.Prefix == "EL" && strings.HasPrefix(code, "GR"):
+		body = code[2:]
+	}
+	return body, f.pattern.MatchString(body)
+}
+
+// normalize - returns the identifier with the prefix or in the layout of the format
+func (f *Format) normalize(body string) string {
+	if f.layout == "" {
+		return f.Prefix + body
+	}
+	var b strings.Builder
+	i := 0
+	for _, r := range f.layout {
+		if r == '#' && i < len(body) {
+			b.WriteByte(body[i])
+			i++
+		} else if r != '#' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// compact - returns the identifier in upper case without spaces, dots, hyphens and slashes
+func compact(id string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", ".", "", "-", "", "/", "", "\t", "").Replace(id))
+}
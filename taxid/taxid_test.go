@@ -0,0 +1,93 @@
+package taxid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xianb/countries"
+)
+
+//nolint:gocyclo
+func TestValidate(t *testing.T) {
+	for _, c := range countries.All() {
+		for _, f := range Formats(c) {
+			out, err := Validate(c, f.Example)
+			if err != nil || !out.Valid || out.Type != f.Type || !f.Type.IsValid() {
+				t.Errorf("Test Validate() err, country %v, example %v, got %v, %v", c, f.Example, out, err)
+			}
+		}
+	}
+	ids := []struct {
+		country     countries.CountryCode
+		id, want    string
+		wantType    Type
+		wantCountry countries.CountryCode
+	}{
+		{countries.DEU, "DE 136 695 976", "DE136695976", TypeVAT, countries.DEU},
+		{countries.DEU, "136695976", "DE136695976", TypeVAT, countries.DEU},
+		{countries.GRC, "GR094014201", "EL094014201", TypeVAT, countries.GRC},
+		{countries.AUS, "51824753556", "51 824 753 556", TypeABN, countries.AUS},
+		{countries.BRA, "11222333000181", "11.222.333/0001-81", TypeCNPJ, countries.BRA},
+		{countries.BRA, "12ABC34501DE35", "12.ABC.345/01DE-35", TypeCNPJ, countries.BRA},
+		{countries.BRA, "12.abc.345/01de-35", "12.ABC.345/01DE-35", TypeCNPJ, countries.BRA},
+		{countries.BRA, "52998224725", "529.982.247-25", TypeCPF, countries.BRA},
+		{countries.USA, "123456789", "12-3456789", TypeEIN, countries.USA},
+		{countries.IND, "27aapfu0939f1zv", "27AAPFU0939F1ZV", TypeGST, countries.IND},
+	}
+	for _, id := range ids {
+		out, err := Validate(id.country, id.id)
+		if err != nil || out.Normalized != id.want || out.Type != id.wantType || out.Country != id.wantCountry {
+			t.Errorf("Test Validate() err, want %v, got %v, %v", id.want, out, err)
+		}
+	}
+	invalid := []struct {
+		country  countries.CountryCode
+		id       string
+		want     error
+		wantType Type
+	}{
+		{countries.DEU, "DE136695977", ErrInvalidChecksum, TypeVAT},
+		{countries.DEU, "DE13669597", countries.ErrInvalidFormat, TypeVAT},
+		{countries.BRA, "11.111.111/1111-11", ErrInvalidChecksum, TypeCNPJ},
+		{countries.BRA, "111.111.111-11", ErrInvalidChecksum, TypeCPF},
+		{countries.BRA, "12ABC34501DE36", ErrInvalidChecksum, TypeCNPJ},
+		{countries.BRA, "12ABC34501DEA5", countries.ErrInvalidFormat, TypeCNPJ},
+		{countries.USA, "07-1234567", ErrInvalidChecksum, TypeEIN},
+		{countries.KEN, "P051234567X", countries.ErrNotFound, TypeUnknown},
+	}
+	for _, id := range invalid {
+		if out, err := Validate(id.country, id.id); !errors.Is(err, id.want) || out == nil || out.Valid || out.Type != id.wantType {
+			t.Errorf("Test Validate() err, id %v, want %v %v, got %v, %v", id.id, id.want, id.wantType, out, err)
+		}
+	}
+	formats := Formats(countries.BRA)
+	formats[0].Name = "changed"
+	if out := Formats(countries.BRA); len(out) != 2 || out[0].Name == "changed" || out[0] == formats[0] {
+		t.Errorf("Test Formats() err, want copies, got %v", out)
+	}
+}
+
+func TestValidateVAT(t *testing.T) {
+	out, err := ValidateVAT("XI 980 7806 84")
+	if err != nil || out.Country != countries.GBR || out.Subdivision != countries.SubdivisionGBNIR || out.Normalized != "XI980780684" {
+		t.Errorf("Test ValidateVAT() err, want %v, got %v, %v", "XI980780684", out, err)
+	}
+	if out, err = ValidateVAT("GB980780684"); err != nil || out.Subdivision != "" || out.Normalized != "GB980780684" {
+		t.Errorf("Test ValidateVAT() err, want %v, got %v, %v", "GB980780684", out, err)
+	}
+	if out, err = ValidateVAT("EL094014201"); err != nil || out.Country != countries.GRC {
+		t.Errorf("Test ValidateVAT() err, want %v, got %v, %v", countries.GRC, out, err)
+	}
+	if out, err = ValidateVAT("CHE-116.281.710 MWST"); err != nil || out.Country != countries.CHE {
+		t.Errorf("Test ValidateVAT() err, want %v, got %v, %v", countries.CHE, out, err)
+	}
+	if _, err = ValidateVAT("US123456789"); !errors.Is(err, countries.ErrNotFound) {
+		t.Errorf("Test ValidateVAT() err, want %v, got %v", countries.ErrNotFound, err)
+	}
+	if _, err = ValidateVAT("136695976"); !errors.Is(err, countries.ErrNotFound) {
+		t.Errorf("Test ValidateVAT() err, want %v, got %v", countries.ErrNotFound, err)
+	}
+	if out := Type(100); out.IsValid() || TypeVAT.String() != "VAT" {
+		t.Errorf("Test Type.IsValid() err, want %v, got %v", false, out.IsValid())
+	}
+}
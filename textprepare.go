@@ -1,32 +1,177 @@
 package countries
 
 import (
-	"io"
 	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
 )
 
-// NOTE: it works very more faster than strings.Replacer and regexp.Regexp
+// textPrepare - returns the text outside brackets as a lookup key: upper-case letters without diacritics (NFKD),
+// "ß", "æ", "ø" and other letters without decomposition are transliterated, example: textPrepare("Côte d'Ivoire (Ivory Coast)") == "COTEDIVOIRE"
+//
+// NOTE: it works very more faster than strings.Replacer and regexp.Regexp, does not allocate for prepared text
+// and allocates once for text without brackets or with brackets at the end
 func textPrepare(text string) string {
-	indx := strings.Index(text, "(")
-	if indx > -1 {
-		text = text[:indx]
+	_, outside, _ := textPrepareAlternate(text)
+	return outside
+}
+
+// textPrepareAlternate - returns keys of the whole text, of the text outside brackets and of the text inside brackets,
+// example: textPrepareAlternate("Côte d'Ivoire (Ivory Coast)") == "COTEDIVOIREIVORYCOAST", "COTEDIVOIRE", "IVORYCOAST";
+// the key and the outside key are the same and the alternate is "", if the text has no brackets
+func textPrepareAlternate(text string) (key, outside, alternate string) {
+	if textPrepared(text) {
+		return text, text, ""
+	}
+	var b strings.Builder
+	b.Grow(len(text))
+	depth, split, interleaved := 0, -1, false
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch r {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			if depth > 0 {
+				depth--
+			}
+		default:
+			n := b.Len()
+			writePrepared(&b, r, text[i:])
+			switch {
+			case b.Len() == n:
+			case depth > 0 && split < 0:
+				split = n
+			case depth == 0 && split >= 0:
+				interleaved = true
+			}
+		}
+		i += size
+	}
+	key = b.String()
+	switch {
+	case split < 0:
+		return key, key, ""
+	case !interleaved:
+		return key, key[:split], key[split:]
+	}
+	return key, textPrepareBrackets(text, false), textPrepareBrackets(text, true)
+}
+
+// textLookup - calls lookup with the whole key of the name, the key outside brackets and the alternate inside brackets in turn,
+// until it returns true; exact, if not nil, is called with the name itself after the whole key and before the bracket keys,
+// so a localized name with brackets wins over its parts; returns false, if the name not found
+func textLookup(name string, lookup func(key string) bool, exact func(name string) bool) bool {
+	key, outside, alternate := textPrepareAlternate(name)
+	if (alternate == "" || !textIsCode(key)) && lookup(key) {
+		return true
+	}
+	if exact != nil && exact(name) {
+		return true
+	}
+	if outside != key && lookup(outside) {
+		return true
+	}
+	return alternate != "" && !textIsCode(alternate) && lookup(alternate)
+}
+
+// textPrepareBrackets - returns the key of the text inside or outside brackets, for brackets in the middle of the text
+func textPrepareBrackets(text string, inside bool) string {
+	var b strings.Builder
+	depth := 0
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch r {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			if depth > 0 {
+				depth--
+			}
+		default:
+			if (depth > 0) == inside {
+				writePrepared(&b, r, text[i:])
+			}
+		}
+		i += size
 	}
+	return b.String()
+}
 
-	reader := strings.NewReader(text)
-	text = ""
+// textIsCode - returns true, if the key may be a code rather than a name: up to three letters, example: "US", "USA", "EUR";
+// a key inside brackets is an abbreviation of the name ("Virgin Islands (U.S.)"), so it is never looked up as a code
+func textIsCode(key string) bool {
+	return len(key) <= 3
+}
 
-	var r rune
-	var err error
-	for {
-		r, _, err = reader.ReadRune()
-		if err == io.EOF {
-			break
+// textPrepared - returns true, if the text is a key already: upper-case ASCII letters only
+func textPrepared(text string) bool {
+	for i := 0; i < len(text); i++ {
+		if text[i] < 'A' || text[i] > 'Z' {
+			return false
 		}
+	}
+	return true
+}
+
+// writePrepared - writes the rune r, which starts the text, as upper-case letters without diacritics
+func writePrepared(b *strings.Builder, r rune, text string) {
+	switch {
+	case r >= 'a' && r <= 'z':
+		b.WriteByte(byte(r) - 'a' + 'A')
+		return
+	case r >= 'A' && r <= 'Z':
+		b.WriteByte(byte(r))
+		return
+	case r < utf8.RuneSelf:
+		return
+	}
+	if latin := transliteratedLetter(r); latin != "" {
+		b.WriteString(latin)
+		return
+	}
+	decomposition := norm.NFKD.PropertiesString(text).Decomposition()
+	if decomposition == nil {
 		if unicode.IsLetter(r) {
-			text += string(r)
+			b.WriteRune(unicode.ToUpper(r))
+		}
+		return
+	}
+	for len(decomposition) > 0 {
+		d, size := utf8.DecodeRune(decomposition)
+		decomposition = decomposition[size:]
+		switch {
+		case d >= 'a' && d <= 'z':
+			b.WriteByte(byte(d) - 'a' + 'A')
+		case unicode.IsLetter(d):
+			b.WriteRune(unicode.ToUpper(d))
 		}
 	}
+}
 
-	return strings.ToUpper(text)
+// transliteratedLetter - returns latin letters of a letter without canonical decomposition, example: "ß" is "SS", "ø" is "O"
+func transliteratedLetter(r rune) string {
+	switch r {
+	case 'ß', 'ẞ':
+		return "SS"
+	case 'æ', 'Æ':
+		return "AE"
+	case 'ø', 'Ø':
+		return "O"
+	case 'œ', 'Œ':
+		return "OE"
+	case 'đ', 'Đ', 'ð', 'Ð':
+		return "D"
+	case 'ł', 'Ł':
+		return "L"
+	case 'þ', 'Þ':
+		return "TH"
+	case 'ı':
+		return "I"
+	case 'ħ', 'Ħ':
+		return "H"
+	}
+	return ""
 }
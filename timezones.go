@@ -0,0 +1,500 @@
+package countries
+
+import (
+	"strings"
+)
+
+// TimeZones - returns IANA time zone names of the country usable with time.LoadLocation, the primary zone first,
+// example: DEU.TimeZones() == []string{"Europe/Berlin", "Europe/Busingen"}; nil for unknown codes
+func (c CountryCode) TimeZones() []string {
+	primary := c.TimeZone()
+	if primary == UnknownMsg {
+		return nil
+	}
+	timeZonesData()
+	zones := []string{primary}
+	for _, zone := range timeZonesByCountry[c] {
+		if zone != primary {
+			zones = append(zones, zone)
+		}
+	}
+	return zones
+}
+
+// TimeZone - returns the primary IANA time zone of the country, the zone of its capital, example: RUS.TimeZone() == "Europe/Moscow",
+// returns UnknownMsg, if the country has no time zone
+func (c CountryCode) TimeZone() string {
+	switch c {
+	case AUS:
+		return "Australia/Sydney"
+	case BRA:
+		return "America/Sao_Paulo"
+	case CAN:
+		return "America/Toronto"
+	case FSM:
+		return "Pacific/Pohnpei"
+	case PSE:
+		return "Asia/Gaza"
+	case RUS:
+		return "Europe/Moscow"
+	case UKR:
+		return "Europe/Kyiv"
+	case UZB:
+		return "Asia/Tashkent"
+	case XKX:
+		return "Europe/Belgrade"
+	}
+	timeZonesData()
+	if zones := timeZonesByCountry[c]; len(zones) > 0 {
+		return zones[0]
+	}
+	return UnknownMsg
+}
+
+// TimeZones - returns IANA time zone names of the subdivision, the primary zone (of the subdivision capital) first,
+// example: SubdivisionUSIN.TimeZones()[0] == "America/Indiana/Indianapolis"; the zone of the country, if the country
+// has only one, nil if not known
+func (s SubdivisionCode) TimeZones() []string {
+	if zones := subdivisionTimeZones(s); zones != nil {
+		return zones
+	}
+	if zones := s.Country().TimeZones(); len(zones) == 1 {
+		return zones
+	}
+	return nil
+}
+
+// TimeZone - returns the primary IANA time zone of the subdivision, example: SubdivisionUSCA.TimeZone() == "America/Los_Angeles",
+// returns UnknownMsg, if not known
+func (s SubdivisionCode) TimeZone() string {
+	if zones := s.TimeZones(); len(zones) > 0 {
+		return zones[0]
+	}
+	return UnknownMsg
+}
+
+// TimeZoneCountry - returns CountryCode of an IANA time zone, case-insensitive, example: deu := TimeZoneCountry("Europe/Berlin"),
+// the most populous country of a zone shared by several countries (zone1970.tab), example: TimeZoneCountry("Europe/Zurich") == CHE;
+// old names are resolved by tzdb backward links, example: TimeZoneCountry("Asia/Calcutta") == IND; returns countries.Unknown, if the zone not found
+func TimeZoneCountry(zone string) CountryCode {
+	timeZonesData()
+	if countries := countriesByZone[strings.ToLower(strings.TrimSpace(zone))]; len(countries) > 0 {
+		return countries[0]
+	}
+	return Unknown
+}
+
+// TimeZoneCountries - returns all countries overlapping an IANA time zone, case-insensitive, the most populous first,
+// example: TimeZoneCountries("Europe/Zurich") == []CountryCode{CHE, DEU, LIE}, old names as in TimeZoneCountry; nil, if the zone not found
+func TimeZoneCountries(zone string) []CountryCode {
+	timeZonesData()
+	if countries := countriesByZone[strings.ToLower(strings.TrimSpace(zone))]; len(countries) > 0 {
+		return append([]CountryCode(nil), countries...)
+	}
+	return nil
+}
+
+// subdivisionTimeZones - returns time zones of every subdivision of countries with several zones (zone.tab comments), the primary
+// zone first; uninhabited islands without a tzdb zone get the Etc zone of their offset
+func subdivisionTimeZones(s SubdivisionCode) []string { //nolint:gocyclo
+	switch s {
+	case SubdivisionUSCT, SubdivisionUSDE, SubdivisionUSDC, SubdivisionUSGA, SubdivisionUSME, SubdivisionUSMD, SubdivisionUSMA,
+		SubdivisionUSNH, SubdivisionUSNJ, SubdivisionUSNY, SubdivisionUSNC, SubdivisionUSOH, SubdivisionUSPA, SubdivisionUSRI,
+		SubdivisionUSSC, SubdivisionUSVT, SubdivisionUSVA, SubdivisionUSWV:
+		return []string{"America/New_York"}
+	case SubdivisionUSAL, SubdivisionUSAR, SubdivisionUSIL, SubdivisionUSIA, SubdivisionUSLA, SubdivisionUSMN, SubdivisionUSMS,
+		SubdivisionUSMO, SubdivisionUSOK, SubdivisionUSWI:
+		return []string{"America/Chicago"}
+	case SubdivisionUSCO, SubdivisionUSMT, SubdivisionUSNM, SubdivisionUSUT, SubdivisionUSWY:
+		return []string{"America/Denver"}
+	case SubdivisionUSCA, SubdivisionUSWA:
+		return []string{"America/Los_Angeles"}
+	case SubdivisionUSAK:
+		return []string{"America/Juneau", "America/Anchorage", "America/Sitka", "America/Metlakatla", "America/Yakutat", "America/Nome", "America/Adak"}
+	case SubdivisionUSAZ:
+		return []string{"America/Phoenix", "America/Denver"}
+	case SubdivisionUSFL:
+		return []string{"America/New_York", "America/Chicago"}
+	case SubdivisionUSHI:
+		return []string{"Pacific/Honolulu"}
+	case SubdivisionUSID:
+		return []string{"America/Boise", "America/Los_Angeles"}
+	case SubdivisionUSIN:
+		return []string{"America/Indiana/Indianapolis", "America/Indiana/Vincennes", "America/Indiana/Winamac", "America/Indiana/Marengo",
+			"America/Indiana/Petersburg", "America/Indiana/Vevay", "America/Indiana/Tell_City", "America/Indiana/Knox", "America/Chicago"}
+	case SubdivisionUSKS, SubdivisionUSNE, SubdivisionUSSD, SubdivisionUSTX:
+		return []string{"America/Chicago", "America/Denver"}
+	case SubdivisionUSKY:
+		return []string{"America/New_York", "America/Kentucky/Louisville", "America/Kentucky/Monticello", "America/Chicago"}
+	case SubdivisionUSMI:
+		return []string{"America/Detroit", "America/Menominee"}
+	case SubdivisionUSNV:
+		return []string{"America/Los_Angeles", "America/Denver"}
+	case SubdivisionUSND:
+		return []string{"America/Chicago", "America/North_Dakota/Center", "America/North_Dakota/New_Salem", "America/North_Dakota/Beulah", "America/Denver"}
+	case SubdivisionUSOR:
+		return []string{"America/Los_Angeles", "America/Boise"}
+	case SubdivisionUSTN:
+		return []string{"America/Chicago", "America/New_York"}
+	case SubdivisionUSAS:
+		return []string{"Pacific/Pago_Pago"}
+	case SubdivisionUSGU:
+		return []string{"Pacific/Guam"}
+	case SubdivisionUSMP:
+		return []string{"Pacific/Saipan"}
+	case SubdivisionUSPR:
+		return []string{"America/Puerto_Rico"}
+	case SubdivisionUSUM:
+		return []string{"Pacific/Midway", "Pacific/Wake"}
+	case SubdivisionUSVI:
+		return []string{"America/St_Thomas"}
+	case SubdivisionCAAB:
+		return []string{"America/Edmonton"}
+	case SubdivisionCABC:
+		return []string{"America/Vancouver", "America/Creston", "America/Dawson_Creek", "America/Fort_Nelson", "America/Edmonton"}
+	case SubdivisionCAMB:
+		return []string{"America/Winnipeg"}
+	case SubdivisionCANB:
+		return []string{"America/Moncton"}
+	case SubdivisionCANL:
+		return []string{"America/St_Johns", "America/Goose_Bay"}
+	case SubdivisionCANS:
+		return []string{"America/Halifax", "America/Glace_Bay"}
+	case SubdivisionCANT:
+		return []string{"America/Edmonton", "America/Inuvik"}
+	case SubdivisionCANU:
+		return []string{"America/Iqaluit", "America/Rankin_Inlet", "America/Resolute", "America/Cambridge_Bay", "America/Atikokan"}
+	case SubdivisionCAON:
+		return []string{"America/Toronto", "America/Winnipeg", "America/Atikokan"}
+	case SubdivisionCAPE:
+		return []string{"America/Halifax"}
+	case SubdivisionCAQC:
+		return []string{"America/Toronto", "America/Blanc-Sablon"}
+	case SubdivisionCASK:
+		return []string{"America/Regina", "America/Swift_Current", "America/Edmonton"}
+	case SubdivisionCAYT:
+		return []string{"America/Whitehorse", "America/Dawson"}
+	case SubdivisionDEBY, SubdivisionDEBE, SubdivisionDEBB, SubdivisionDEHB, SubdivisionDEHH, SubdivisionDEHE, SubdivisionDEMV,
+		SubdivisionDENI, SubdivisionDENW, SubdivisionDERP, SubdivisionDESL, SubdivisionDESN, SubdivisionDEST, SubdivisionDESH, SubdivisionDETH:
+		return []string{"Europe/Berlin"}
+	case SubdivisionDEBW:
+		return []string{"Europe/Berlin", "Europe/Busingen"}
+	case SubdivisionAUACT:
+		return []string{"Australia/Sydney"}
+	case SubdivisionAUNSW:
+		return []string{"Australia/Sydney", "Australia/Broken_Hill", "Australia/Lord_Howe"}
+	case SubdivisionAUNT:
+		return []string{"Australia/Darwin"}
+	case SubdivisionAUQLD:
+		return []string{"Australia/Brisbane", "Australia/Lindeman"}
+	case SubdivisionAUSA:
+		return []string{"Australia/Adelaide"}
+	case SubdivisionAUTAS:
+		return []string{"Australia/Hobart", "Antarctica/Macquarie"}
+	case SubdivisionAUVIC:
+		return []string{"Australia/Melbourne"}
+	case SubdivisionAUWA:
+		return []string{"Australia/Perth", "Australia/Eucla"}
+	case SubdivisionBRAC:
+		return []string{"America/Rio_Branco"}
+	case SubdivisionBRAL, SubdivisionBRSE:
+		return []string{"America/Maceio"}
+	case SubdivisionBRAM:
+		return []string{"America/Manaus", "America/Eirunepe"}
+	case SubdivisionBRAP:
+		return []string{"America/Belem"}
+	case SubdivisionBRBA:
+		return []string{"America/Bahia"}
+	case SubdivisionBRCE, SubdivisionBRMA, SubdivisionBRPB, SubdivisionBRPI, SubdivisionBRRN:
+		return []string{"America/Fortaleza"}
+	case SubdivisionBRDF, SubdivisionBRES, SubdivisionBRGO, SubdivisionBRMG, SubdivisionBRPR, SubdivisionBRRJ, SubdivisionBRRS,
+		SubdivisionBRSC, SubdivisionBRSP:
+		return []string{"America/Sao_Paulo"}
+	case SubdivisionBRFN:
+		return []string{"America/Noronha"}
+	case SubdivisionBRMS:
+		return []string{"America/Campo_Grande"}
+	case SubdivisionBRMT:
+		return []string{"America/Cuiaba"}
+	case SubdivisionBRPA:
+		return []string{"America/Belem", "America/Santarem"}
+	case SubdivisionBRPE:
+		return []string{"America/Recife", "America/Noronha"}
+	case SubdivisionBRRO:
+		return []string{"America/Porto_Velho"}
+	case SubdivisionBRRR:
+		return []string{"America/Boa_Vista"}
+	case SubdivisionBRTO:
+		return []string{"America/Araguaina"}
+	case SubdivisionARB, SubdivisionARC:
+		return []string{"America/Argentina/Buenos_Aires"}
+	case SubdivisionARE, SubdivisionARG, SubdivisionARH, SubdivisionARN, SubdivisionARP, SubdivisionARS, SubdivisionARW,
+		SubdivisionARX:
+		return []string{"America/Argentina/Cordoba"}
+	case SubdivisionARA, SubdivisionARL, SubdivisionARQ, SubdivisionARR:
+		return []string{"America/Argentina/Salta"}
+	case SubdivisionARD:
+		return []string{"America/Argentina/San_Luis"}
+	case SubdivisionARJ:
+		return []string{"America/Argentina/San_Juan"}
+	case SubdivisionARK, SubdivisionARU:
+		return []string{"America/Argentina/Catamarca"}
+	case SubdivisionARM:
+		return []string{"America/Argentina/Mendoza"}
+	case SubdivisionART:
+		return []string{"America/Argentina/Tucuman"}
+	case SubdivisionARV:
+		return []string{"America/Argentina/Ushuaia"}
+	case SubdivisionARY:
+		return []string{"America/Argentina/Jujuy"}
+	case SubdivisionARZ:
+		return []string{"America/Argentina/Rio_Gallegos"}
+	case SubdivisionCDBC, SubdivisionCDBN, SubdivisionCDEQ, SubdivisionCDKN:
+		return []string{"Africa/Kinshasa"}
+	case SubdivisionCDKA, SubdivisionCDKE, SubdivisionCDKW, SubdivisionCDMA, SubdivisionCDNK, SubdivisionCDOR, SubdivisionCDSK:
+		return []string{"Africa/Lubumbashi"}
+	case SubdivisionCLAN, SubdivisionCLAP, SubdivisionCLAR, SubdivisionCLAT, SubdivisionCLBI, SubdivisionCLCO, SubdivisionCLLI,
+		SubdivisionCLLL, SubdivisionCLLR, SubdivisionCLML, SubdivisionCLRM, SubdivisionCLTA:
+		return []string{"America/Santiago"}
+	case SubdivisionCLAI:
+		return []string{"America/Coyhaique"}
+	case SubdivisionCLMA:
+		return []string{"America/Punta_Arenas"}
+	case SubdivisionCLVS:
+		return []string{"America/Santiago", "Pacific/Easter"}
+	case SubdivisionCNAH, SubdivisionCNBJ, SubdivisionCNCQ, SubdivisionCNFJ, SubdivisionCNGD, SubdivisionCNGS, SubdivisionCNGX,
+		SubdivisionCNGZ, SubdivisionCNHA, SubdivisionCNHB, SubdivisionCNHE, SubdivisionCNHI, SubdivisionCNHL, SubdivisionCNHN,
+		SubdivisionCNJL, SubdivisionCNJS, SubdivisionCNJX, SubdivisionCNLN, SubdivisionCNNM, SubdivisionCNNX, SubdivisionCNQH,
+		SubdivisionCNSC, SubdivisionCNSD, SubdivisionCNSH, SubdivisionCNSN, SubdivisionCNSX, SubdivisionCNTJ, SubdivisionCNXZ,
+		SubdivisionCNYN, SubdivisionCNZJ:
+		return []string{"Asia/Shanghai"}
+	case SubdivisionCNXJ:
+		return []string{"Asia/Urumqi", "Asia/Shanghai"}
+	case SubdivisionCNHK:
+		return []string{"Asia/Hong_Kong"}
+	case SubdivisionCNMO:
+		return []string{"Asia/Macau"}
+	case SubdivisionCNTW:
+		return []string{"Asia/Taipei"}
+	case SubdivisionCY02, SubdivisionCY05:
+		return []string{"Asia/Nicosia"}
+	case SubdivisionCY01, SubdivisionCY03:
+		return []string{"Asia/Nicosia", "Asia/Famagusta"}
+	case SubdivisionCY04:
+		return []string{"Asia/Famagusta", "Asia/Nicosia"}
+	case SubdivisionCY06:
+		return []string{"Asia/Famagusta"}
+	case SubdivisionECA, SubdivisionECB, SubdivisionECC, SubdivisionECD, SubdivisionECE, SubdivisionECF, SubdivisionECG,
+		SubdivisionECH, SubdivisionECI, SubdivisionECL, SubdivisionECM, SubdivisionECN, SubdivisionECO, SubdivisionECP,
+		SubdivisionECR, SubdivisionECS, SubdivisionECSD, SubdivisionECSE, SubdivisionECT, SubdivisionECU, SubdivisionECX,
+		SubdivisionECY, SubdivisionECZ:
+		return []string{"America/Guayaquil"}
+	case SubdivisionECW:
+		return []string{"Pacific/Galapagos"}
+	case SubdivisionESA, SubdivisionESAB, SubdivisionESAL, SubdivisionESAN, SubdivisionESAR, SubdivisionESAS, SubdivisionESAV,
+		SubdivisionESB, SubdivisionESBA, SubdivisionESBI, SubdivisionESBU, SubdivisionESC, SubdivisionESCA, SubdivisionESCB,
+		SubdivisionESCC, SubdivisionESCL, SubdivisionESCM, SubdivisionESCO, SubdivisionESCR, SubdivisionESCS, SubdivisionESCT,
+		SubdivisionESCU, SubdivisionESEX, SubdivisionESGA, SubdivisionESGI, SubdivisionESGR, SubdivisionESGU, SubdivisionESH,
+		SubdivisionESHU, SubdivisionESIB, SubdivisionESJ, SubdivisionESL, SubdivisionESLE, SubdivisionESLO, SubdivisionESLU,
+		SubdivisionESM, SubdivisionESMA, SubdivisionESMC, SubdivisionESMD, SubdivisionESMU, SubdivisionESNA, SubdivisionESNC,
+		SubdivisionESO, SubdivisionESOR, SubdivisionESP, SubdivisionESPM, SubdivisionESPO, SubdivisionESPV, SubdivisionESRI,
+		SubdivisionESS, SubdivisionESSA, SubdivisionESSE, SubdivisionESSG, SubdivisionESSO, SubdivisionESSS, SubdivisionEST,
+		SubdivisionESTE, SubdivisionESTO, SubdivisionESV, SubdivisionESVA, SubdivisionESVC, SubdivisionESVI, SubdivisionESZ,
+		SubdivisionESZA:
+		return []string{"Europe/Madrid"}
+	case SubdivisionESCE, SubdivisionESML:
+		return []string{"Africa/Ceuta"}
+	case SubdivisionESCN, SubdivisionESGC, SubdivisionESTF:
+		return []string{"Atlantic/Canary"}
+	case SubdivisionFMKSA:
+		return []string{"Pacific/Kosrae"}
+	case SubdivisionFMPNI:
+		return []string{"Pacific/Pohnpei"}
+	case SubdivisionFMTRK, SubdivisionFMYAP:
+		return []string{"Pacific/Chuuk"}
+	case SubdivisionGLKU, SubdivisionGLQE:
+		return []string{"America/Nuuk"}
+	case SubdivisionGLQA:
+		return []string{"America/Nuuk", "America/Thule"}
+	case SubdivisionGLSM:
+		return []string{"America/Nuuk", "America/Scoresbysund"}
+	case SubdivisionIDAC, SubdivisionIDBB, SubdivisionIDBE, SubdivisionIDBT, SubdivisionIDJA, SubdivisionIDJB, SubdivisionIDJI,
+		SubdivisionIDJK, SubdivisionIDJT, SubdivisionIDJW, SubdivisionIDKR, SubdivisionIDLA, SubdivisionIDRI, SubdivisionIDSB,
+		SubdivisionIDSM, SubdivisionIDSS, SubdivisionIDSU, SubdivisionIDYO:
+		return []string{"Asia/Jakarta"}
+	case SubdivisionIDKB, SubdivisionIDKT:
+		return []string{"Asia/Pontianak"}
+	case SubdivisionIDKA:
+		return []string{"Asia/Pontianak", "Asia/Makassar"}
+	case SubdivisionIDBA, SubdivisionIDGO, SubdivisionIDKI, SubdivisionIDKS, SubdivisionIDNB, SubdivisionIDNT, SubdivisionIDNU,
+		SubdivisionIDSA, SubdivisionIDSG, SubdivisionIDSL, SubdivisionIDSN, SubdivisionIDSR, SubdivisionIDST:
+		return []string{"Asia/Makassar"}
+	case SubdivisionIDIJ, SubdivisionIDMA, SubdivisionIDML, SubdivisionIDMU, SubdivisionIDPA, SubdivisionIDPB:
+		return []string{"Asia/Jayapura"}
+	case SubdivisionKIG:
+		return []string{"Pacific/Tarawa"}
+	case SubdivisionKIL:
+		return []string{"Pacific/Kiritimati"}
+	case SubdivisionKIP:
+		return []string{"Pacific/Kanton"}
+	case SubdivisionKZAKM, SubdivisionKZALA, SubdivisionKZALM, SubdivisionKZAST, SubdivisionKZKAR, SubdivisionKZPAV,
+		SubdivisionKZSEV, SubdivisionKZVOS, SubdivisionKZYUZ, SubdivisionKZZHA:
+		return []string{"Asia/Almaty"}
+	case SubdivisionKZAKT:
+		return []string{"Asia/Aqtobe"}
+	case SubdivisionKZATY:
+		return []string{"Asia/Atyrau"}
+	case SubdivisionKZKUS:
+		return []string{"Asia/Qostanay"}
+	case SubdivisionKZKZY:
+		return []string{"Asia/Qyzylorda"}
+	case SubdivisionKZMAN:
+		return []string{"Asia/Aqtau"}
+	case SubdivisionKZZAP:
+		return []string{"Asia/Oral"}
+	case SubdivisionMHALK, SubdivisionMHALL, SubdivisionMHARN, SubdivisionMHAUR, SubdivisionMHEBO, SubdivisionMHENI,
+		SubdivisionMHJAB, SubdivisionMHJAL, SubdivisionMHKIL, SubdivisionMHLAE, SubdivisionMHLIB, SubdivisionMHLIK, SubdivisionMHMAJ,
+		SubdivisionMHMAL, SubdivisionMHMEJ, SubdivisionMHMIL, SubdivisionMHNMK, SubdivisionMHNMU, SubdivisionMHRON, SubdivisionMHT,
+		SubdivisionMHUJA, SubdivisionMHUTI, SubdivisionMHWTJ, SubdivisionMHWTN:
+		return []string{"Pacific/Majuro"}
+	case SubdivisionMHKWA:
+		return []string{"Pacific/Kwajalein"}
+	case SubdivisionMHL:
+		return []string{"Pacific/Majuro", "Pacific/Kwajalein"}
+	case SubdivisionMN1, SubdivisionMN035, SubdivisionMN037, SubdivisionMN039, SubdivisionMN041, SubdivisionMN047,
+		SubdivisionMN049, SubdivisionMN051, SubdivisionMN053, SubdivisionMN055, SubdivisionMN057, SubdivisionMN059, SubdivisionMN061,
+		SubdivisionMN063, SubdivisionMN064, SubdivisionMN065, SubdivisionMN067, SubdivisionMN069, SubdivisionMN073:
+		return []string{"Asia/Ulaanbaatar"}
+	case SubdivisionMN043, SubdivisionMN046, SubdivisionMN071:
+		return []string{"Asia/Hovd"}
+	case SubdivisionMXAGU, SubdivisionMXCHP, SubdivisionMXCMX, SubdivisionMXCOL, SubdivisionMXGRO, SubdivisionMXGUA,
+		SubdivisionMXHID, SubdivisionMXJAL, SubdivisionMXMEX, SubdivisionMXMIC, SubdivisionMXMOR, SubdivisionMXOAX, SubdivisionMXPUE,
+		SubdivisionMXQUE, SubdivisionMXSLP, SubdivisionMXTAB, SubdivisionMXTLA, SubdivisionMXVER, SubdivisionMXZAC:
+		return []string{"America/Mexico_City"}
+	case SubdivisionMXBCN:
+		return []string{"America/Tijuana"}
+	case SubdivisionMXBCS, SubdivisionMXSIN:
+		return []string{"America/Mazatlan"}
+	case SubdivisionMXCAM, SubdivisionMXYUC:
+		return []string{"America/Merida"}
+	case SubdivisionMXCHH:
+		return []string{"America/Chihuahua", "America/Ciudad_Juarez", "America/Ojinaga"}
+	case SubdivisionMXCOA, SubdivisionMXNLE, SubdivisionMXTAM:
+		return []string{"America/Monterrey", "America/Matamoros"}
+	case SubdivisionMXDUR:
+		return []string{"America/Monterrey"}
+	case SubdivisionMXNAY:
+		return []string{"America/Mazatlan", "America/Bahia_Banderas"}
+	case SubdivisionMXROO:
+		return []string{"America/Cancun"}
+	case SubdivisionMXSON:
+		return []string{"America/Hermosillo"}
+	case SubdivisionMY01, SubdivisionMY02, SubdivisionMY03, SubdivisionMY04, SubdivisionMY05, SubdivisionMY06, SubdivisionMY07,
+		SubdivisionMY08, SubdivisionMY09, SubdivisionMY10, SubdivisionMY11, SubdivisionMY14, SubdivisionMY16:
+		return []string{"Asia/Kuala_Lumpur"}
+	case SubdivisionMY12, SubdivisionMY13, SubdivisionMY15:
+		return []string{"Asia/Kuching"}
+	case SubdivisionNZAUK, SubdivisionNZBOP, SubdivisionNZCAN, SubdivisionNZGIS, SubdivisionNZHKB, SubdivisionNZMBH,
+		SubdivisionNZMWT, SubdivisionNZN, SubdivisionNZNSN, SubdivisionNZNTL, SubdivisionNZOTA, SubdivisionNZS, SubdivisionNZSTL,
+		SubdivisionNZTAS, SubdivisionNZTKI, SubdivisionNZWGN, SubdivisionNZWKO, SubdivisionNZWTC:
+		return []string{"Pacific/Auckland"}
+	case SubdivisionNZCIT:
+		return []string{"Pacific/Chatham"}
+	case SubdivisionPGCPK, SubdivisionPGCPM, SubdivisionPGEBR, SubdivisionPGEHG, SubdivisionPGEPW, SubdivisionPGESW,
+		SubdivisionPGGPK, SubdivisionPGMBA, SubdivisionPGMPL, SubdivisionPGMPM, SubdivisionPGMRL, SubdivisionPGNCD, SubdivisionPGNIK,
+		SubdivisionPGNPP, SubdivisionPGSAN, SubdivisionPGSHM, SubdivisionPGWBK, SubdivisionPGWHM, SubdivisionPGWPD:
+		return []string{"Pacific/Port_Moresby"}
+	case SubdivisionPGNSB:
+		return []string{"Pacific/Bougainville"}
+	case SubdivisionPSDEB, SubdivisionPSGZA, SubdivisionPSKYS, SubdivisionPSNGZ, SubdivisionPSRFH:
+		return []string{"Asia/Gaza"}
+	case SubdivisionPSBTH, SubdivisionPSHBN, SubdivisionPSJEM, SubdivisionPSJEN, SubdivisionPSJRH, SubdivisionPSNBS,
+		SubdivisionPSQQA, SubdivisionPSRBH, SubdivisionPSSLT, SubdivisionPSTBS, SubdivisionPSTKM:
+		return []string{"Asia/Hebron"}
+	case SubdivisionPT01, SubdivisionPT02, SubdivisionPT03, SubdivisionPT04, SubdivisionPT05, SubdivisionPT06, SubdivisionPT07,
+		SubdivisionPT08, SubdivisionPT09, SubdivisionPT10, SubdivisionPT11, SubdivisionPT12, SubdivisionPT13, SubdivisionPT14,
+		SubdivisionPT15, SubdivisionPT16, SubdivisionPT17, SubdivisionPT18:
+		return []string{"Europe/Lisbon"}
+	case SubdivisionPT20:
+		return []string{"Atlantic/Azores"}
+	case SubdivisionPT30:
+		return []string{"Atlantic/Madeira"}
+	case SubdivisionRUAD, SubdivisionRUARK, SubdivisionRUBEL, SubdivisionRUBRY, SubdivisionRUCE, SubdivisionRUCU, SubdivisionRUDA,
+		SubdivisionRUIN, SubdivisionRUIVA, SubdivisionRUKB, SubdivisionRUKC, SubdivisionRUKDA, SubdivisionRUKL, SubdivisionRUKLU,
+		SubdivisionRUKO, SubdivisionRUKOS, SubdivisionRUKR, SubdivisionRUKRS, SubdivisionRULEN, SubdivisionRULIP, SubdivisionRUME,
+		SubdivisionRUMO, SubdivisionRUMOS, SubdivisionRUMOW, SubdivisionRUMUR, SubdivisionRUNEN, SubdivisionRUNGR, SubdivisionRUNIZ,
+		SubdivisionRUORL, SubdivisionRUPNZ, SubdivisionRUPSK, SubdivisionRUROS, SubdivisionRURYA, SubdivisionRUSE, SubdivisionRUSMO,
+		SubdivisionRUSPE, SubdivisionRUSTA, SubdivisionRUTA, SubdivisionRUTAM, SubdivisionRUTUL, SubdivisionRUTVE, SubdivisionRUVLA,
+		SubdivisionRUVLG, SubdivisionRUVOR, SubdivisionRUYAR:
+		return []string{"Europe/Moscow"}
+	case SubdivisionRUKGD:
+		return []string{"Europe/Kaliningrad"}
+	case SubdivisionRUKIR:
+		return []string{"Europe/Kirov"}
+	case SubdivisionRUVGG:
+		return []string{"Europe/Volgograd"}
+	case SubdivisionRUAST:
+		return []string{"Europe/Astrakhan"}
+	case SubdivisionRUSAR:
+		return []string{"Europe/Saratov"}
+	case SubdivisionRUULY:
+		return []string{"Europe/Ulyanovsk"}
+	case SubdivisionRUSAM, SubdivisionRUUD:
+		return []string{"Europe/Samara"}
+	case SubdivisionRUBA, SubdivisionRUCHE, SubdivisionRUKGN, SubdivisionRUKHM, SubdivisionRUORE, SubdivisionRUPER,
+		SubdivisionRUSVE, SubdivisionRUTYU, SubdivisionRUYAN:
+		return []string{"Asia/Yekaterinburg"}
+	case SubdivisionRUOMS:
+		return []string{"Asia/Omsk"}
+	case SubdivisionRUNVS:
+		return []string{"Asia/Novosibirsk"}
+	case SubdivisionRUAL, SubdivisionRUALT:
+		return []string{"Asia/Barnaul"}
+	case SubdivisionRUTOM:
+		return []string{"Asia/Tomsk"}
+	case SubdivisionRUKEM:
+		return []string{"Asia/Novokuznetsk"}
+	case SubdivisionRUKK, SubdivisionRUKYA, SubdivisionRUTY:
+		return []string{"Asia/Krasnoyarsk"}
+	case SubdivisionRUBU, SubdivisionRUIRK:
+		return []string{"Asia/Irkutsk"}
+	case SubdivisionRUZAB:
+		return []string{"Asia/Chita"}
+	case SubdivisionRUAMU:
+		return []string{"Asia/Yakutsk"}
+	case SubdivisionRUSA:
+		return []string{"Asia/Yakutsk", "Asia/Khandyga", "Asia/Ust-Nera", "Asia/Srednekolymsk"}
+	case SubdivisionRUKHA, SubdivisionRUPRI, SubdivisionRUYEV:
+		return []string{"Asia/Vladivostok"}
+	case SubdivisionRUMAG:
+		return []string{"Asia/Magadan"}
+	case SubdivisionRUSAK:
+		return []string{"Asia/Sakhalin", "Asia/Srednekolymsk"}
+	case SubdivisionRUKAM:
+		return []string{"Asia/Kamchatka"}
+	case SubdivisionRUCHU:
+		return []string{"Asia/Anadyr"}
+	case SubdivisionUA05, SubdivisionUA07, SubdivisionUA09, SubdivisionUA12, SubdivisionUA14, SubdivisionUA18, SubdivisionUA21,
+		SubdivisionUA23, SubdivisionUA26, SubdivisionUA30, SubdivisionUA32, SubdivisionUA35, SubdivisionUA46, SubdivisionUA48,
+		SubdivisionUA51, SubdivisionUA53, SubdivisionUA56, SubdivisionUA59, SubdivisionUA61, SubdivisionUA63, SubdivisionUA65,
+		SubdivisionUA68, SubdivisionUA71, SubdivisionUA74, SubdivisionUA77:
+		return []string{"Europe/Kyiv"}
+	case SubdivisionUA40, SubdivisionUA43:
+		return []string{"Europe/Simferopol"}
+	case SubdivisionUM67:
+		return []string{"Pacific/Honolulu"}
+	case SubdivisionUM71, SubdivisionUM86, SubdivisionUM89, SubdivisionUM95:
+		return []string{"Pacific/Midway"}
+	case SubdivisionUM76:
+		return []string{"America/Jamaica"}
+	case SubdivisionUM79:
+		return []string{"Pacific/Wake"}
+	case SubdivisionUM81, SubdivisionUM84:
+		return []string{"Etc/GMT+12"}
+	case SubdivisionUZAN, SubdivisionUZFA, SubdivisionUZJI, SubdivisionUZNG, SubdivisionUZSI, SubdivisionUZTK, SubdivisionUZTO:
+		return []string{"Asia/Tashkent"}
+	case SubdivisionUZBU, SubdivisionUZNW, SubdivisionUZQA, SubdivisionUZQR, SubdivisionUZSA, SubdivisionUZSU, SubdivisionUZXO:
+		return []string{"Asia/Samarkand"}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package countries
+
+//go:generate go run gen_pinyin.go
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// pinyin - returns toneless pinyin of the Han characters in the text, other runes are kept,
+// example: pinyin("日本") == "riben"; returns "", if the text has no Han characters or a Han character with unknown pinyin
+func pinyin(text string) string {
+	var b strings.Builder
+	found := false
+	for _, r := range text {
+		if p := hanPinyin(r); p != "" {
+			b.WriteString(p)
+			found = true
+			continue
+		}
+		if unicode.Is(unicode.Han, r) {
+			return ""
+		}
+		b.WriteRune(r)
+	}
+	if !found {
+		return ""
+	}
+	return b.String()
+}
+
+// transliterate - returns a latin transliteration of the Cyrillic and Arabic letters in the text, other runes are kept,
+// example: transliterate("Германия") == "Germaniya"; returns "", if the text has no Cyrillic or Arabic letters
+func transliterate(text string) string {
+	var b strings.Builder
+	found := false
+	for _, r := range text {
+		lower := unicode.ToLower(r)
+		latin, ok := cyrillicLatin[lower]
+		if !ok {
+			latin, ok = arabicLatin[lower]
+		}
+		if !ok {
+			b.WriteRune(r)
+			continue
+		}
+		found = true
+		if lower != r && latin != "" {
+			latin = strings.ToUpper(latin[:1]) + latin[1:]
+		}
+		b.WriteString(latin)
+	}
+	if !found {
+		return ""
+	}
+	return b.String()
+}
+
+// latinKey - returns a key of the name without diacritics, tones and non-letters in upper case,
+// example: latinKey("Rìběn") == latinKey("ri4ben3") == "RIBEN"
+func latinKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, norm.NFD.String(name))
+}
+
+// cyrillicLatin - BGN/PCGN romanization of Russian, Ukrainian and Belarusian letters
+var cyrillicLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g", 'ў': "w",
+}
+
+// arabicLatin - simplified romanization of Arabic and Persian letters, short vowels are not written in Arabic
+var arabicLatin = map[rune]string{
+	'ا': "a", 'أ': "a", 'إ': "i", 'آ': "a", 'ب': "b", 'ت': "t", 'ث': "th", 'ج': "j",
+	'ح': "h", 'خ': "kh", 'د': "d", 'ذ': "dh", 'ر': "r", 'ز': "z", 'س': "s", 'ش': "sh",
+	'ص': "s", 'ض': "d", 'ط': "t", 'ظ': "z", 'ع': "", 'غ': "gh", 'ف': "f", 'ق': "q",
+	'ك': "k", 'ل': "l", 'م': "m", 'ن': "n", 'ه': "h", 'و': "w", 'ي': "y", 'ى': "a",
+	'ة': "a", 'ء': "", 'ؤ': "", 'ئ': "", 'پ': "p", 'چ': "ch", 'ژ': "zh", 'گ': "g",
+	'ک': "k", 'ی': "y",
+}